@@ -2,27 +2,36 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	stdlog "log"
+	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/github/github-mcp-server/pkg/github"
 	iolog "github.com/github/github-mcp-server/pkg/log"
+	"github.com/github/github-mcp-server/pkg/toolsets"
 	"github.com/github/github-mcp-server/pkg/translations"
 	gogithub "github.com/google/go-github/v69/github"
-	ghv4 "github.com/shurcooL/githubv4"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
-	log "github.com/sirupsen/logrus"
+	ghv4 "github.com/shurcooL/githubv4"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
-
 var version = "version"
 var commit = "commit"
 var date = "date"
@@ -40,15 +49,14 @@ var (
 		Short: "Start stdio server",
 		Long:  `Start a server that communicates via standard input/output streams using JSON-RPC messages.`,
 		Run: func(_ *cobra.Command, _ []string) {
-			logFile := viper.GetString("log-file")
 			readOnly := viper.GetBool("read-only")
 			exportTranslations := viper.GetBool("export-translations")
-			logger, err := initLogger(logFile)
+			logger, err := initLogger(viper.GetString("log-file"), viper.GetString("log-level"), viper.GetString("log-format"))
 			if err != nil {
 				stdlog.Fatal("Failed to initialize logger:", err)
 			}
 
-			enabledToolsets := viper.GetStringSlice("toolsets")
+			enabledToolsets := toolsetsFromConfig()
 
 			logCommands := viper.GetBool("enable-command-logging")
 			cfg := runConfig{
@@ -63,6 +71,45 @@ var (
 			}
 		},
 	}
+
+	httpCmd = &cobra.Command{
+		Use:   "http",
+		Short: "Start HTTP server",
+		Long:  `Start a server that communicates over HTTP, using the streamable HTTP transport on /mcp and the older SSE transport on /sse. Each request may carry its own GitHub token in the Authorization header, so one deployment can serve many users.`,
+		Run: func(_ *cobra.Command, _ []string) {
+			logger, err := initLogger(viper.GetString("log-file"), viper.GetString("log-level"), viper.GetString("log-format"))
+			if err != nil {
+				stdlog.Fatal("Failed to initialize logger:", err)
+			}
+
+			cfg := runConfig{
+				readOnly:           viper.GetBool("read-only"),
+				logger:             logger,
+				logCommands:        viper.GetBool("enable-command-logging"),
+				exportTranslations: viper.GetBool("export-translations"),
+				enabledToolsets:    toolsetsFromConfig(),
+				hosted:             true,
+			}
+			httpCfg := httpConfig{
+				address:          viper.GetString("address"),
+				baseURL:          viper.GetString("base-url"),
+				tlsCert:          viper.GetString("tls-cert"),
+				tlsKey:           viper.GetString("tls-key"),
+				envTokenFallback: viper.GetBool("env-token-fallback"),
+			}
+			if (httpCfg.tlsCert == "") != (httpCfg.tlsKey == "") {
+				stdlog.Fatal("--tls-cert and --tls-key must be set together")
+			}
+			// Dynamic toolsets change the tools of the server every session
+			// shares, so one user enabling a toolset would change them for all.
+			if viper.GetBool("dynamic_toolsets") {
+				stdlog.Fatal("--dynamic-toolsets is not supported in http mode")
+			}
+			if err := runHTTPServer(cfg, httpCfg); err != nil {
+				stdlog.Fatal("failed to run http server:", err)
+			}
+		},
+	}
 )
 
 func init() {
@@ -74,45 +121,139 @@ func init() {
 	rootCmd.PersistentFlags().StringSlice("toolsets", github.DefaultTools, "An optional comma separated list of groups of tools to allow, defaults to enabling all")
 	rootCmd.PersistentFlags().Bool("dynamic-toolsets", false, "Enable dynamic toolsets")
 	rootCmd.PersistentFlags().Bool("read-only", false, "Restrict the server to read-only operations")
+	rootCmd.PersistentFlags().Bool("confirm-destructive", false, "Ask the user to confirm destructive tools, such as delete_file, through MCP elicitation; calls from clients that cannot ask fail")
 	rootCmd.PersistentFlags().String("log-file", "", "Path to log file")
+	rootCmd.PersistentFlags().String("log-level", "info", "Log level: debug, info, warn or error; debug also logs every GitHub request")
+	rootCmd.PersistentFlags().String("log-format", "text", "Log format: text, or json for log collectors")
+	rootCmd.PersistentFlags().String("otlp-endpoint", "", "OTLP/HTTP endpoint to export traces to, such as http://localhost:4318; the standard OTEL_EXPORTER_OTLP_* variables are honored too")
 	rootCmd.PersistentFlags().Bool("enable-command-logging", false, "When enabled, the server will log all command requests and responses to the log file")
 	rootCmd.PersistentFlags().Bool("export-translations", false, "Save translations to a JSON file")
 	rootCmd.PersistentFlags().String("gh-host", "", "Specify the GitHub hostname (for GitHub Enterprise etc.)")
+	rootCmd.PersistentFlags().Bool("disable-cache", false, "Do not cache GitHub API responses")
+	rootCmd.PersistentFlags().String("cache-dir", "", "Directory to keep the response cache in across restarts, instead of only in memory")
+	rootCmd.PersistentFlags().Duration("graphql-cache-ttl", 30*time.Second, "How long GraphQL query results are reused without asking GitHub; 0 disables GraphQL caching")
 
 	// Bind flag to viper
 	_ = viper.BindPFlag("toolsets", rootCmd.PersistentFlags().Lookup("toolsets"))
 	_ = viper.BindPFlag("dynamic_toolsets", rootCmd.PersistentFlags().Lookup("dynamic-toolsets"))
 	_ = viper.BindPFlag("read-only", rootCmd.PersistentFlags().Lookup("read-only"))
+	_ = viper.BindPFlag("confirm-destructive", rootCmd.PersistentFlags().Lookup("confirm-destructive"))
 	_ = viper.BindPFlag("log-file", rootCmd.PersistentFlags().Lookup("log-file"))
+	_ = viper.BindPFlag("log-level", rootCmd.PersistentFlags().Lookup("log-level"))
+	_ = viper.BindPFlag("log-format", rootCmd.PersistentFlags().Lookup("log-format"))
+	_ = viper.BindPFlag("otlp-endpoint", rootCmd.PersistentFlags().Lookup("otlp-endpoint"))
 	_ = viper.BindPFlag("enable-command-logging", rootCmd.PersistentFlags().Lookup("enable-command-logging"))
 	_ = viper.BindPFlag("export-translations", rootCmd.PersistentFlags().Lookup("export-translations"))
 	_ = viper.BindPFlag("host", rootCmd.PersistentFlags().Lookup("gh-host"))
+	_ = viper.BindPFlag("disable-cache", rootCmd.PersistentFlags().Lookup("disable-cache"))
+	_ = viper.BindPFlag("cache-dir", rootCmd.PersistentFlags().Lookup("cache-dir"))
+	_ = viper.BindPFlag("graphql-cache-ttl", rootCmd.PersistentFlags().Lookup("graphql-cache-ttl"))
+
+	httpCmd.Flags().String("address", ":8080", "Address to listen on")
+	httpCmd.Flags().String("base-url", "", "Public URL of the server, used in the message endpoint sent to SSE clients")
+	_ = viper.BindPFlag("address", httpCmd.Flags().Lookup("address"))
+	httpCmd.Flags().String("tls-cert", "", "Path to a TLS certificate to serve HTTPS with")
+	httpCmd.Flags().String("tls-key", "", "Path to the private key of the TLS certificate")
+	httpCmd.Flags().Bool("env-token-fallback", false, "Serve requests without an Authorization header with GITHUB_PERSONAL_ACCESS_TOKEN instead of refusing them; every such caller acts as that token's user")
+	_ = viper.BindPFlag("base-url", httpCmd.Flags().Lookup("base-url"))
+	_ = viper.BindPFlag("tls-cert", httpCmd.Flags().Lookup("tls-cert"))
+	_ = viper.BindPFlag("tls-key", httpCmd.Flags().Lookup("tls-key"))
+	_ = viper.BindPFlag("env-token-fallback", httpCmd.Flags().Lookup("env-token-fallback"))
 
 	// Add subcommands
 	rootCmd.AddCommand(stdioCmd)
+	rootCmd.AddCommand(httpCmd)
 }
 
 func initConfig() {
 	// Initialize Viper configuration
 	viper.SetEnvPrefix("github")
+	// Let flags such as --read-only be set as GITHUB_READ_ONLY
+	viper.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
 	viper.AutomaticEnv()
 }
 
-func initLogger(outPath string) (*log.Logger, error) {
-	if outPath == "" {
-		return log.New(), nil
+// toolsetsFromConfig returns the toolsets named by --toolsets or
+// GITHUB_TOOLSETS. Viper does not split environment variables on commas, so
+// "repos,issues" from the environment arrives as a single value.
+func toolsetsFromConfig() []string {
+	var names []string
+	for _, value := range viper.GetStringSlice("toolsets") {
+		for _, name := range strings.Split(value, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// initLogger creates the server's logger, writing to outPath or, when it is
+// empty, to stderr.
+func initLogger(outPath, level, format string) (*slog.Logger, error) {
+	var lvl slog.Level
+	if err := lvl.UnmarshalText([]byte(level)); err != nil {
+		return nil, fmt.Errorf("invalid log level %q: %w", level, err)
 	}
 
-	file, err := os.OpenFile(outPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open log file: %w", err)
+	out := io.Writer(os.Stderr)
+	if outPath != "" {
+		file, err := os.OpenFile(outPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open log file: %w", err)
+		}
+		out = file
+	}
+
+	opts := &slog.HandlerOptions{Level: lvl}
+	switch format {
+	case "text":
+		return slog.New(slog.NewTextHandler(out, opts)), nil
+	case "json":
+		return slog.New(slog.NewJSONHandler(out, opts)), nil
+	default:
+		return nil, fmt.Errorf("invalid log format %q: must be text or json", format)
 	}
+}
+
+// initTracing installs an OpenTelemetry tracer provider exporting over
+// OTLP/HTTP when --otlp-endpoint or the standard OTEL_EXPORTER_OTLP_ENDPOINT
+// variables name a collector, and the W3C trace context propagator either
+// way. The returned function flushes and stops the exporter.
+func initTracing(ctx context.Context) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
 
-	logger := log.New()
-	logger.SetLevel(log.DebugLevel)
-	logger.SetOutput(file)
+	endpoint := viper.GetString("otlp-endpoint")
+	if endpoint == "" && os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" && os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+	var opts []otlptracehttp.Option
+	if endpoint != "" {
+		opts = append(opts, otlptracehttp.WithEndpointURL(endpoint))
+	}
+	exporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", "github-mcp-server"),
+			attribute.String("service.version", version),
+		)),
+	)
+	otel.SetTracerProvider(tp)
+	return tp.Shutdown, nil
+}
 
-	return logger, nil
+// shutdownTracing flushes the spans not yet exported, giving up after a few
+// seconds.
+func shutdownTracing(logger *slog.Logger, shutdown func(context.Context) error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := shutdown(ctx); err != nil {
+		logger.Warn("could not flush traces", "error", err)
+	}
 }
 
 // authTransport injects the Authorization header for GitHub GraphQL requests
@@ -129,62 +270,146 @@ func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	return t.Base.RoundTrip(req)
 }
 
+type httpConfig struct {
+	address string
+	baseURL string
+	tlsCert string
+	tlsKey  string
+	// envTokenFallback serves requests without an Authorization header with
+	// GITHUB_PERSONAL_ACCESS_TOKEN; otherwise they are refused.
+	envTokenFallback bool
+}
+
 type runConfig struct {
 	readOnly           bool
-	logger             *log.Logger
+	logger             *slog.Logger
 	logCommands        bool
 	exportTranslations bool
 	enabledToolsets    []string
+	// hosted is set in http mode, where every session shares the server
+	// and the client of the default token.
+	hosted bool
 }
 
-func runStdioServer(cfg runConfig) error {
-	// Create app context
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
-	defer stop()
+// errMissingToken is returned by the client getters when neither the request
+// nor the server configuration supplies a GitHub token.
+var errMissingToken = errors.New("no GitHub token: send one in the Authorization header or set GITHUB_PERSONAL_ACCESS_TOKEN")
 
-	// Create GH client
-	token := viper.GetString("personal_access_token")
-	if token == "" {
-		cfg.logger.Fatal("GITHUB_PERSONAL_ACCESS_TOKEN not set")
+// newMCPServer builds the MCP server shared by the stdio and HTTP transports.
+// Requests whose context carries a token (see github.ContextWithToken) talk to
+// GitHub as that user; all others fall back to defaultToken.
+func newMCPServer(cfg runConfig, defaultToken string) (*server.MCPServer, error) {
+	apiHost, err := github.NewAPIHost(viper.GetString("host"))
+	if err != nil {
+		return nil, err
+	}
+	userAgent := fmt.Sprintf("github-mcp-server/%s", version)
+	github.SetLogger(cfg.logger)
+
+	transport := http.DefaultTransport
+	var enterpriseVersion string
+	if apiHost.Enterprise {
+		transport, enterpriseVersion = detectEnterprise(cfg.logger, apiHost)
+	}
+
+	// The clients of defaultToken share a tracker for get_rate_limit; those
+	// made for per-request tokens only retry.
+	tracker := github.NewRateLimitTracker()
+	trackerFor := func(token string) *github.RateLimitTracker {
+		if token == defaultToken {
+			return tracker
+		}
+		return nil
 	}
-	ghClient := gogithub.NewClient(nil).WithAuthToken(token)
-	ghClient.UserAgent = fmt.Sprintf("github-mcp-server/%s", version)
 
-	host := viper.GetString("host")
+	// Clients of every token share one cache; its entries are keyed by token.
+	var cache *github.ResponseCache
+	if !viper.GetBool("disable-cache") {
+		cache, err = github.NewResponseCache(0, viper.GetString("cache-dir"))
+		if err != nil {
+			return nil, err
+		}
+	}
+	apiTransport := func(base http.RoundTripper, token string) http.RoundTripper {
+		base = &github.TracingTransport{Base: &github.LoggingTransport{Base: base}}
+		if cache != nil {
+			base = &github.CachingTransport{Base: base, Cache: cache, GraphQLTTL: viper.GetDuration("graphql-cache-ttl")}
+		}
+		return &github.RateLimitTransport{Base: base, Tracker: trackerFor(token)}
+	}
+
+	newClient := func(token string) (*gogithub.Client, error) {
+		client := gogithub.NewClient(&http.Client{Transport: apiTransport(transport, token)}).WithAuthToken(token)
+		client.UserAgent = userAgent
+		if apiHost.Enterprise {
+			var err error
+			client, err = client.WithEnterpriseURLs(apiHost.RESTURL, apiHost.UploadURL)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create GitHub client with host: %w", err)
+			}
+		}
+		return client, nil
+	}
 
-	if host != "" {
-		var err error
-		ghClient, err = ghClient.WithEnterpriseURLs(host, host)
+	var ghClient *gogithub.Client
+	if defaultToken != "" {
+		ghClient, err = newClient(defaultToken)
 		if err != nil {
-			return fmt.Errorf("failed to create GitHub client with host: %w", err)
+			return nil, err
 		}
 	}
 
 	t, dumpTranslations := translations.TranslationHelper()
 
 	beforeInit := func(_ context.Context, _ any, message *mcp.InitializeRequest) {
-		ghClient.UserAgent = fmt.Sprintf("github-mcp-server/%s (%s/%s)", version, message.Params.ClientInfo.Name, message.Params.ClientInfo.Version)
+		if ghClient != nil {
+			ghClient.UserAgent = fmt.Sprintf("github-mcp-server/%s (%s/%s)", version, message.Params.ClientInfo.Name, message.Params.ClientInfo.Version)
+		}
 	}
 
-	getClient := func(_ context.Context) (*gogithub.Client, error) {
+	getClient := func(ctx context.Context) (*gogithub.Client, error) {
+		if token, ok := github.TokenFromContext(ctx); ok {
+			return newClient(token)
+		}
+		if ghClient == nil {
+			return nil, errMissingToken
+		}
 		return ghClient, nil // closing over client
 	}
 
-	getGraphQLClient := func(_ context.Context) (*ghv4.Client, error) {
+	getGraphQLClient := func(ctx context.Context) (*ghv4.Client, error) {
+		token, ok := github.TokenFromContext(ctx)
+		if !ok {
+			token = defaultToken
+		}
+		if token == "" {
+			return nil, errMissingToken
+		}
 		httpClient := &http.Client{
 			Transport: &authTransport{
 				Token: token,
-				Base:  http.DefaultTransport,
+				Base:  apiTransport(http.DefaultTransport, token),
 			},
 		}
-		return ghv4.NewClient(httpClient), nil
+		return ghv4.NewEnterpriseClient(apiHost.GraphQLURL, httpClient), nil
 	}
 
 	hooks := &server.Hooks{
-		OnBeforeInitialize: []server.OnBeforeInitializeFunc{beforeInit},
+		OnBeforeCallTool: []server.OnBeforeCallToolFunc{github.TraceRequestID},
+	}
+	// A hosted server's default client is shared by every session, so it
+	// keeps the plain user agent rather than that of whichever client
+	// initialized last.
+	if !cfg.hosted {
+		hooks.AddBeforeInitialize(beforeInit)
+	}
+	confirmDestructive := viper.GetBool("confirm-destructive")
+	serverOpts := []server.ServerOption{server.WithHooks(hooks)}
+	if confirmDestructive {
+		serverOpts = append(serverOpts, server.WithElicitation())
 	}
 	// Create server
-	ghServer := github.NewServer(version, server.WithHooks(hooks))
+	ghServer := github.NewServer(version, serverOpts...)
 
 	enabled := cfg.enabledToolsets
 	dynamic := viper.GetBool("dynamic_toolsets")
@@ -200,14 +425,23 @@ func runStdioServer(cfg runConfig) error {
 
 	// Create default toolsets
 	toolsets, err := github.InitToolsets(enabled, cfg.readOnly, getClient, getGraphQLClient, t)
-	context := github.InitContextToolset(getClient, t)
-
 	if err != nil {
-		stdlog.Fatal("Failed to initialize toolsets:", err)
+		return nil, fmt.Errorf("failed to initialize toolsets: %w", err)
+	}
+	if enterpriseVersion != "" {
+		if removed := github.RemoveUnsupportedTools(toolsets, enterpriseVersion); len(removed) > 0 {
+			cfg.logger.Info("tools not supported by GitHub Enterprise Server are not registered", "version", enterpriseVersion, "tools", strings.Join(removed, ", "))
+		}
 	}
+	if confirmDestructive {
+		github.RequireConfirmation(toolsets)
+	}
+	context := github.InitContextToolset(getClient, tracker, toolsets, t)
 
 	// Register resources with the server
 	github.RegisterResources(ghServer, getClient, t)
+	// Register the workflow prompts
+	github.RegisterPrompts(ghServer, getClient, getGraphQLClient, t)
 	// Register the tools with the server
 	toolsets.RegisterTools(ghServer)
 	context.RegisterTools(ghServer)
@@ -217,16 +451,103 @@ func runStdioServer(cfg runConfig) error {
 		dynamic.RegisterTools(ghServer)
 	}
 
-	stdioServer := server.NewStdioServer(ghServer)
-
-	stdLogger := stdlog.New(cfg.logger.Writer(), "stdioserver", 0)
-	stdioServer.SetErrorLogger(stdLogger)
-
 	if cfg.exportTranslations {
 		// Once server is initialized, all translations are loaded
 		dumpTranslations()
 	}
 
+	if ghClient != nil {
+		logScopeWarnings(cfg.logger, ghClient, toolsets)
+	}
+
+	return ghServer, nil
+}
+
+// detectEnterprise asks a GitHub Enterprise Server instance for its release
+// and the REST API versions it supports, and returns a transport that sends
+// the negotiated API version. Both endpoints answer without authentication.
+// Failures are logged and leave every tool enabled.
+func detectEnterprise(logger *slog.Logger, apiHost github.APIHost) (http.RoundTripper, string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := gogithub.NewClient(nil).WithEnterpriseURLs(apiHost.RESTURL, apiHost.UploadURL)
+	if err != nil {
+		logger.Warn("could not create GitHub Enterprise Server client", "error", err)
+		return http.DefaultTransport, ""
+	}
+
+	release, err := github.EnterpriseVersion(ctx, client)
+	if err != nil {
+		logger.Warn("could not detect the GitHub Enterprise Server version, all tools stay enabled", "error", err)
+	}
+
+	apiVersion, err := github.NegotiateAPIVersion(ctx, client)
+	if err != nil {
+		logger.Warn("could not negotiate the REST API version", "error", err)
+		return http.DefaultTransport, release
+	}
+	return &github.APIVersionTransport{Version: apiVersion, Base: http.DefaultTransport}, release
+}
+
+// logScopeWarnings checks the configured token at startup and logs the tools
+// it lacks the scopes for, so they are known up front instead of failing with
+// 403s mid-conversation.
+func logScopeWarnings(logger *slog.Logger, client *gogithub.Client, tsg *toolsets.ToolsetGroup) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	report, err := github.CheckTokenScopes(ctx, client, tsg)
+	if err != nil {
+		logger.Warn("could not check token scopes", "error", err)
+		return
+	}
+	if report.Note != "" {
+		logger.Info(report.Note)
+		return
+	}
+
+	// Group by toolset and missing scopes to keep the log short.
+	type group struct{ toolset, scopes string }
+	var groups []group
+	tools := make(map[group][]string)
+	for _, tool := range report.Unavailable {
+		g := group{tool.Toolset, strings.Join(tool.NeedsOneOf, ", ")}
+		if _, ok := tools[g]; !ok {
+			groups = append(groups, g)
+		}
+		tools[g] = append(tools[g], tool.Name)
+	}
+	for _, g := range groups {
+		logger.Warn("token lacks a scope; these tools will fail", "toolset", g.toolset, "needs_one_of", g.scopes, "tools", strings.Join(tools[g], ", "))
+	}
+}
+
+func runStdioServer(cfg runConfig) error {
+	// Create app context
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	token := viper.GetString("personal_access_token")
+	if token == "" {
+		return errors.New("GITHUB_PERSONAL_ACCESS_TOKEN not set")
+	}
+
+	shutdown, err := initTracing(ctx)
+	if err != nil {
+		return err
+	}
+	defer shutdownTracing(cfg.logger, shutdown)
+
+	ghServer, err := newMCPServer(cfg, token)
+	if err != nil {
+		stdlog.Fatal("Failed to initialize server:", err)
+	}
+
+	stdioServer := server.NewStdioServer(ghServer)
+
+	stdioServer.SetErrorLogger(slog.NewLogLogger(cfg.logger.With("component", "stdioserver").Handler(), slog.LevelError))
+
 	// Start listening for messages
 	errC := make(chan error, 1)
 	go func() {
@@ -246,7 +567,7 @@ func runStdioServer(cfg runConfig) error {
 	// Wait for shutdown signal
 	select {
 	case <-ctx.Done():
-		cfg.logger.Infof("shutting down server...")
+		cfg.logger.Info("shutting down server...")
 	case err := <-errC:
 		if err != nil {
 			return fmt.Errorf("error running server: %w", err)
@@ -256,6 +577,106 @@ func runStdioServer(cfg runConfig) error {
 	return nil
 }
 
+// maxRequestBodyBytes bounds the body of a single streamable HTTP POST.
+const maxRequestBodyBytes = 10 << 20
+
+func runHTTPServer(cfg runConfig, httpCfg httpConfig) error {
+	// Create app context
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// In hosted mode every caller brings their own token. The environment
+	// token is only used, for requests without one, when explicitly allowed:
+	// those callers all act as its user.
+	var token string
+	if httpCfg.envTokenFallback {
+		token = viper.GetString("personal_access_token")
+		if token == "" {
+			return errors.New("--env-token-fallback requires GITHUB_PERSONAL_ACCESS_TOKEN")
+		}
+	} else if viper.GetString("personal_access_token") != "" {
+		cfg.logger.Info("GITHUB_PERSONAL_ACCESS_TOKEN is ignored in http mode without --env-token-fallback; requests must carry their own token")
+	}
+	shutdown, err := initTracing(ctx)
+	if err != nil {
+		return err
+	}
+	defer shutdownTracing(cfg.logger, shutdown)
+
+	ghServer, err := newMCPServer(cfg, token)
+	if err != nil {
+		return err
+	}
+
+	withRequestToken := func(ctx context.Context, r *http.Request) context.Context {
+		// Continue the trace of the agent that sent the request, if any.
+		ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(r.Header))
+		if token := github.TokenFromRequest(r); token != "" {
+			return github.ContextWithToken(ctx, token)
+		}
+		return ctx
+	}
+
+	httpServer := &http.Server{
+		Addr:              httpCfg.address,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	// Streamable HTTP on /mcp, and the older SSE transport on /sse and
+	// /message for clients that do not support it yet.
+	streamable := server.NewStreamableHTTPServer(ghServer, server.WithHTTPContextFunc(withRequestToken))
+	sseOpts := []server.SSEOption{
+		server.WithSSEContextFunc(withRequestToken),
+		server.WithHTTPServer(httpServer),
+	}
+	if httpCfg.baseURL != "" {
+		sseOpts = append(sseOpts, server.WithBaseURL(httpCfg.baseURL))
+	}
+	sseServer := server.NewSSEServer(ghServer, sseOpts...)
+
+	mux := http.NewServeMux()
+	mux.Handle("/mcp", http.MaxBytesHandler(streamable, maxRequestBodyBytes))
+	mux.Handle("/sse", sseServer)
+	mux.Handle("/message", sseServer)
+	httpServer.Handler = mux
+	if !httpCfg.envTokenFallback {
+		httpServer.Handler = github.RequireToken(mux)
+	}
+
+	errC := make(chan error, 1)
+	go func() {
+		if httpCfg.tlsCert != "" {
+			errC <- httpServer.ListenAndServeTLS(httpCfg.tlsCert, httpCfg.tlsKey)
+			return
+		}
+		errC <- httpServer.ListenAndServe()
+	}()
+
+	scheme := "http"
+	if httpCfg.tlsCert != "" {
+		scheme = "https"
+	}
+	_, _ = fmt.Fprintf(os.Stderr, "GitHub MCP Server running on %s://%s/mcp\n", scheme, httpCfg.address)
+
+	// Wait for shutdown signal
+	select {
+	case <-ctx.Done():
+		cfg.logger.Info("shutting down server...")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := sseServer.Shutdown(shutdownCtx); err != nil {
+			// Open SSE streams only end when their clients disconnect.
+			return httpServer.Close()
+		}
+	case err := <-errC:
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return fmt.Errorf("error running server: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func main() {
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println(err)
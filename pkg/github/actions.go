@@ -0,0 +1,1031 @@
+package github
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// WorkflowSummary is one workflow in a list_workflows result.
+type WorkflowSummary struct {
+	ID      int64  `json:"id"`
+	Name    string `json:"name"`
+	Path    string `json:"path"`
+	State   string `json:"state"`
+	HTMLURL string `json:"html_url"`
+}
+
+// ListWorkflowsOutput is the result of list_workflows, with the same paging
+// fields as ListIssuesOutput.
+type ListWorkflowsOutput struct {
+	TotalCount  int               `json:"total_count"`
+	Workflows   []WorkflowSummary `json:"workflows"`
+	NextPage    int               `json:"next_page,omitempty"`
+	HasNextPage bool              `json:"has_next_page"`
+}
+
+// WorkflowRunSummary is one run in a list_workflow_runs result.
+type WorkflowRunSummary struct {
+	ID         int64      `json:"id"`
+	Name       string     `json:"name"`
+	WorkflowID int64      `json:"workflow_id"`
+	RunNumber  int        `json:"run_number"`
+	RunAttempt int        `json:"run_attempt"`
+	Event      string     `json:"event"`
+	Status     string     `json:"status"`
+	Conclusion string     `json:"conclusion,omitempty"`
+	HeadBranch string     `json:"head_branch"`
+	HeadSHA    string     `json:"head_sha"`
+	Actor      string     `json:"actor"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+	HTMLURL    string     `json:"html_url"`
+}
+
+// ListWorkflowRunsOutput is the result of list_workflow_runs, with the same
+// paging fields as ListIssuesOutput.
+type ListWorkflowRunsOutput struct {
+	TotalCount   int                  `json:"total_count"`
+	WorkflowRuns []WorkflowRunSummary `json:"workflow_runs"`
+	NextPage     int                  `json:"next_page,omitempty"`
+	HasNextPage  bool                 `json:"has_next_page"`
+}
+
+func workflowRunSummary(run *github.WorkflowRun) WorkflowRunSummary {
+	summary := WorkflowRunSummary{
+		ID:         run.GetID(),
+		Name:       run.GetName(),
+		WorkflowID: run.GetWorkflowID(),
+		RunNumber:  run.GetRunNumber(),
+		RunAttempt: run.GetRunAttempt(),
+		Event:      run.GetEvent(),
+		Status:     run.GetStatus(),
+		Conclusion: run.GetConclusion(),
+		HeadBranch: run.GetHeadBranch(),
+		HeadSHA:    run.GetHeadSHA(),
+		Actor:      run.GetActor().GetLogin(),
+		HTMLURL:    run.GetHTMLURL(),
+	}
+	if run.CreatedAt != nil {
+		summary.CreatedAt = &run.CreatedAt.Time
+	}
+	if run.UpdatedAt != nil {
+		summary.UpdatedAt = &run.UpdatedAt.Time
+	}
+	return summary
+}
+
+// withWorkflowRunParams adds the owner, repo and runId parameters.
+func withWorkflowRunParams() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner"),
+		)(tool)
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		)(tool)
+		mcp.WithNumber("runId",
+			mcp.Required(),
+			mcp.Description("Workflow run ID"),
+		)(tool)
+	}
+}
+
+// ListWorkflows creates a tool to list the workflows of a repository.
+func ListWorkflows(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_workflows",
+			mcp.WithDescription(t("TOOL_LIST_WORKFLOWS_DESCRIPTION", "List the GitHub Actions workflows of a repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			workflows, resp, err := client.Actions.ListWorkflows(ctx, owner, repo, &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list workflows: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list workflows: %s", string(body))), nil
+			}
+
+			out := ListWorkflowsOutput{
+				TotalCount:  workflows.GetTotalCount(),
+				Workflows:   make([]WorkflowSummary, 0, len(workflows.Workflows)),
+				NextPage:    resp.NextPage,
+				HasNextPage: resp.NextPage != 0,
+			}
+			for _, w := range workflows.Workflows {
+				out.Workflows = append(out.Workflows, WorkflowSummary{
+					ID:      w.GetID(),
+					Name:    w.GetName(),
+					Path:    w.GetPath(),
+					State:   w.GetState(),
+					HTMLURL: w.GetHTMLURL(),
+				})
+			}
+
+			r, err := json.Marshal(out)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListWorkflowRuns creates a tool to list the workflow runs of a repository,
+// optionally for a single workflow.
+func ListWorkflowRuns(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_workflow_runs",
+			mcp.WithDescription(t("TOOL_LIST_WORKFLOW_RUNS_DESCRIPTION", "List GitHub Actions workflow runs of a repository, newest first")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("workflowId",
+				mcp.Description("Only runs of this workflow, by ID or file name such as ci.yml"),
+			),
+			mcp.WithString("status",
+				mcp.Description("Only runs with this status or conclusion"),
+				mcp.Enum("queued", "in_progress", "completed", "waiting", "requested", "pending", "action_required", "cancelled", "failure", "neutral", "skipped", "stale", "success", "timed_out"),
+			),
+			mcp.WithString("branch",
+				mcp.Description("Only runs for this branch"),
+			),
+			mcp.WithString("actor",
+				mcp.Description("Only runs triggered by this user"),
+			),
+			mcp.WithString("event",
+				mcp.Description("Only runs triggered by this event, such as push or workflow_dispatch"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			workflowID, err := OptionalParam[string](request, "workflowId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			status, err := OptionalParam[string](request, "status")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := OptionalParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			actor, err := OptionalParam[string](request, "actor")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			event, err := OptionalParam[string](request, "event")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.ListWorkflowRunsOptions{
+				Status: status,
+				Branch: branch,
+				Actor:  actor,
+				Event:  event,
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			var runs *github.WorkflowRuns
+			var resp *github.Response
+			if workflowID == "" {
+				runs, resp, err = client.Actions.ListRepositoryWorkflowRuns(ctx, owner, repo, opts)
+			} else if id, parseErr := strconv.ParseInt(workflowID, 10, 64); parseErr == nil {
+				runs, resp, err = client.Actions.ListWorkflowRunsByID(ctx, owner, repo, id, opts)
+			} else {
+				runs, resp, err = client.Actions.ListWorkflowRunsByFileName(ctx, owner, repo, workflowID, opts)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to list workflow runs: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list workflow runs: %s", string(body))), nil
+			}
+
+			out := ListWorkflowRunsOutput{
+				TotalCount:   runs.GetTotalCount(),
+				WorkflowRuns: make([]WorkflowRunSummary, 0, len(runs.WorkflowRuns)),
+				NextPage:     resp.NextPage,
+				HasNextPage:  resp.NextPage != 0,
+			}
+			for _, run := range runs.WorkflowRuns {
+				out.WorkflowRuns = append(out.WorkflowRuns, workflowRunSummary(run))
+			}
+
+			r, err := json.Marshal(out)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetWorkflowRun creates a tool to get the details of a workflow run.
+func GetWorkflowRun(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_workflow_run",
+			mcp.WithDescription(t("TOOL_GET_WORKFLOW_RUN_DESCRIPTION", "Get the details of a GitHub Actions workflow run")),
+			withWorkflowRunParams(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runID, err := RequiredInt(request, "runId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			run, resp, err := client.Actions.GetWorkflowRunByID(ctx, owner, repo, int64(runID))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get workflow run: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get workflow run: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(run)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// RunWorkflow creates a tool to trigger a workflow_dispatch event.
+func RunWorkflow(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("run_workflow",
+			mcp.WithDescription(t("TOOL_RUN_WORKFLOW_DESCRIPTION", "Run a GitHub Actions workflow that has a workflow_dispatch trigger. The run starts asynchronously; find it with list_workflow_runs and event workflow_dispatch")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("workflowId",
+				mcp.Required(),
+				mcp.Description("Workflow ID or file name such as ci.yml"),
+			),
+			mcp.WithString("ref",
+				mcp.Required(),
+				mcp.Description("Branch or tag to run the workflow on"),
+			),
+			mcp.WithObject("inputs",
+				mcp.Description("Values for the workflow's inputs, at most 10"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			workflowID, err := requiredParam[string](request, "workflowId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := requiredParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			inputs, err := OptionalParam[map[string]interface{}](request, "inputs")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			event := github.CreateWorkflowDispatchEventRequest{
+				Ref:    ref,
+				Inputs: inputs,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			var resp *github.Response
+			if id, parseErr := strconv.ParseInt(workflowID, 10, 64); parseErr == nil {
+				resp, err = client.Actions.CreateWorkflowDispatchEventByID(ctx, owner, repo, id, event)
+			} else {
+				resp, err = client.Actions.CreateWorkflowDispatchEventByFileName(ctx, owner, repo, workflowID, event)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to run workflow: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to run workflow: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Workflow %s dispatched on %s", workflowID, ref)), nil
+		}
+}
+
+// CancelWorkflowRun creates a tool to cancel a workflow run.
+func CancelWorkflowRun(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("cancel_workflow_run",
+			mcp.WithDescription(t("TOOL_CANCEL_WORKFLOW_RUN_DESCRIPTION", "Cancel a GitHub Actions workflow run")),
+			withWorkflowRunParams(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runID, err := RequiredInt(request, "runId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			resp, err := client.Actions.CancelWorkflowRunByID(ctx, owner, repo, int64(runID))
+			if err != nil {
+				// The API answers 202 Accepted, which go-github reports as an acceptedError.
+				if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
+					return mcp.NewToolResultText(fmt.Sprintf("Workflow run %d is being cancelled", runID)), nil
+				}
+				// A run that has already finished cannot be cancelled.
+				if resp != nil && resp.StatusCode == http.StatusConflict {
+					return mcp.NewToolResultError(fmt.Sprintf("workflow run %d cannot be cancelled: %s", runID, err)), nil
+				}
+				return nil, fmt.Errorf("failed to cancel workflow run: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Workflow run %d is being cancelled", runID)), nil
+		}
+}
+
+// RerunWorkflowRun creates a tool to re-run a workflow run, or only its
+// failed jobs.
+func RerunWorkflowRun(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("rerun_workflow_run",
+			mcp.WithDescription(t("TOOL_RERUN_WORKFLOW_RUN_DESCRIPTION", "Re-run a GitHub Actions workflow run, or only its failed jobs and their dependents")),
+			withWorkflowRunParams(),
+			mcp.WithBoolean("failedJobsOnly",
+				mcp.Description("Only re-run the failed jobs and the jobs that depend on them"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runID, err := RequiredInt(request, "runId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			failedJobsOnly, err := OptionalParam[bool](request, "failedJobsOnly")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			var resp *github.Response
+			if failedJobsOnly {
+				resp, err = client.Actions.RerunFailedJobsByID(ctx, owner, repo, int64(runID))
+			} else {
+				resp, err = client.Actions.RerunWorkflowByID(ctx, owner, repo, int64(runID))
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to re-run workflow run: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to re-run workflow run: %s", string(body))), nil
+			}
+
+			if failedJobsOnly {
+				return mcp.NewToolResultText(fmt.Sprintf("Failed jobs of workflow run %d are re-running", runID)), nil
+			}
+			return mcp.NewToolResultText(fmt.Sprintf("Workflow run %d is re-running", runID)), nil
+		}
+}
+
+// defaultJobLogLines is how many trailing lines of each job log get_job_logs
+// returns unless told otherwise.
+const defaultJobLogLines = 500
+
+// maxJobLogBytes is the largest job log get_job_logs will read, both as
+// downloaded and once decompressed.
+const maxJobLogBytes = 64 << 20
+
+// signedURLClient downloads the pre-signed URLs that job log and artifact
+// downloads redirect to. They point at blob storage rather than the API, so
+// the requests must not carry the caller's token, nor go through the API
+// client's caching and rate-limit transports.
+var signedURLClient = &http.Client{Timeout: 5 * time.Minute}
+
+// JobLog is the log of one job in a get_job_logs result.
+type JobLog struct {
+	ID         int64  `json:"id"`
+	Name       string `json:"name"`
+	Conclusion string `json:"conclusion,omitempty"`
+	Logs       string `json:"logs"`
+	Truncated  bool   `json:"truncated,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// tailLines returns the last n lines of s, and whether any were dropped.
+func tailLines(s string, n int) (string, bool) {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) <= n {
+		return s, false
+	}
+	return strings.Join(lines[len(lines)-n:], ""), true
+}
+
+// jobLog downloads the log of a job, keeping only its last n lines when n is
+// positive. Logs served gzip-compressed are decompressed.
+func jobLog(ctx context.Context, client *github.Client, owner, repo string, job *github.WorkflowJob, n int) (JobLog, error) {
+	out := JobLog{ID: job.GetID(), Name: job.GetName(), Conclusion: job.GetConclusion()}
+
+	logURL, resp, err := client.Actions.GetWorkflowJobLogs(ctx, owner, repo, job.GetID(), 1)
+	if err != nil {
+		return out, fmt.Errorf("failed to get logs of job %d: %w", job.GetID(), err)
+	}
+	_ = resp.Body.Close()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, logURL.String(), nil)
+	if err != nil {
+		return out, fmt.Errorf("failed to create request: %w", err)
+	}
+	logResp, err := signedURLClient.Do(req)
+	if err != nil {
+		return out, fmt.Errorf("failed to download logs of job %d: %w", job.GetID(), err)
+	}
+	defer func() { _ = logResp.Body.Close() }()
+
+	body, err := io.ReadAll(io.LimitReader(logResp.Body, maxJobLogBytes+1))
+	if err != nil {
+		return out, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if logResp.StatusCode != http.StatusOK {
+		return out, fmt.Errorf("failed to download logs of job %d: %s", job.GetID(), string(body))
+	}
+	if len(body) > maxJobLogBytes {
+		return out, fmt.Errorf("logs of job %d are larger than %d bytes", job.GetID(), maxJobLogBytes)
+	}
+	if len(body) > 1 && body[0] == 0x1f && body[1] == 0x8b {
+		zr, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return out, fmt.Errorf("failed to decompress logs of job %d: %w", job.GetID(), err)
+		}
+		if body, err = io.ReadAll(io.LimitReader(zr, maxJobLogBytes+1)); err != nil {
+			return out, fmt.Errorf("failed to decompress logs of job %d: %w", job.GetID(), err)
+		}
+		if len(body) > maxJobLogBytes {
+			return out, fmt.Errorf("logs of job %d are larger than %d bytes", job.GetID(), maxJobLogBytes)
+		}
+	}
+
+	out.Logs = string(body)
+	if n > 0 {
+		out.Logs, out.Truncated = tailLines(out.Logs, n)
+	}
+	return out, nil
+}
+
+// GetJobLogs creates a tool to get the logs of a workflow job, or of the
+// jobs of a workflow run.
+func GetJobLogs(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_job_logs",
+			mcp.WithDescription(t("TOOL_GET_JOB_LOGS_DESCRIPTION", "Get the logs of a GitHub Actions job, or of the jobs of a workflow run. Only the last lines of each log are returned")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("jobId",
+				mcp.Description("Job ID; either jobId or runId is required"),
+			),
+			mcp.WithNumber("runId",
+				mcp.Description("Workflow run ID, to get the logs of its jobs from the latest attempt"),
+			),
+			mcp.WithBoolean("failedOnly",
+				mcp.Description("With runId, only get the logs of jobs that failed or timed out"),
+			),
+			mcp.WithNumber("tailLines",
+				mcp.Description("Lines to keep from the end of each log, defaults to 500; 0 keeps the whole log"),
+				mcp.Min(0),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			jobID, err := OptionalIntParam(request, "jobId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runID, err := OptionalIntParam(request, "runId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if (jobID == 0) == (runID == 0) {
+				return mcp.NewToolResultError("exactly one of jobId or runId is required"), nil
+			}
+			failedOnly, err := OptionalParam[bool](request, "failedOnly")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			// An explicit 0 keeps the whole log, so only an absent value
+			// takes the default.
+			n, err := OptionalIntParamInRange(request, "tailLines", defaultJobLogLines, 0, math.MaxInt32)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var jobs []*github.WorkflowJob
+			if jobID != 0 {
+				job, resp, err := client.Actions.GetWorkflowJobByID(ctx, owner, repo, int64(jobID))
+				if err != nil {
+					return nil, fmt.Errorf("failed to get job: %w", err)
+				}
+				_ = resp.Body.Close()
+				jobs = append(jobs, job)
+			} else {
+				runJobs, _, err := fetchAllPages(ctx, 0, func(ctx context.Context, page int) ([]*github.WorkflowJob, *github.Response, error) {
+					opts := &github.ListWorkflowJobsOptions{ListOptions: github.ListOptions{Page: page, PerPage: 100}}
+					jobs, resp, err := client.Actions.ListWorkflowJobs(ctx, owner, repo, int64(runID), opts)
+					if err != nil {
+						return nil, nil, err
+					}
+					return jobs.Jobs, resp, nil
+				})
+				if err != nil {
+					return nil, fmt.Errorf("failed to list jobs: %w", err)
+				}
+				for _, job := range runJobs {
+					if failedOnly && job.GetConclusion() != "failure" && job.GetConclusion() != "timed_out" {
+						continue
+					}
+					jobs = append(jobs, job)
+				}
+			}
+
+			logs := make([]JobLog, 0, len(jobs))
+			for _, job := range jobs {
+				entry, err := jobLog(ctx, client, owner, repo, job, n)
+				if err != nil {
+					if jobID != 0 {
+						return nil, err
+					}
+					// Skipped and unfinished jobs of a run have no logs;
+					// report that on the job rather than failing the run.
+					entry.Error = err.Error()
+				}
+				logs = append(logs, entry)
+			}
+
+			r, err := json.Marshal(logs)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// maxArtifactArchiveBytes is the largest artifact download_workflow_artifact
+// will fetch to return inline.
+const maxArtifactArchiveBytes = 10 << 20
+
+// ArtifactSummary is one artifact in a list_workflow_artifacts result.
+type ArtifactSummary struct {
+	ID            int64      `json:"id"`
+	Name          string     `json:"name"`
+	SizeInBytes   int64      `json:"size_in_bytes"`
+	Expired       bool       `json:"expired"`
+	WorkflowRunID int64      `json:"workflow_run_id,omitempty"`
+	CreatedAt     *time.Time `json:"created_at,omitempty"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+}
+
+// ListArtifactsOutput is the result of list_workflow_artifacts, with the
+// same paging fields as ListIssuesOutput.
+type ListArtifactsOutput struct {
+	TotalCount  int64             `json:"total_count"`
+	Artifacts   []ArtifactSummary `json:"artifacts"`
+	NextPage    int               `json:"next_page,omitempty"`
+	HasNextPage bool              `json:"has_next_page"`
+}
+
+// ArtifactFile is one file of an artifact returned inline.
+type ArtifactFile struct {
+	Name      string `json:"name"`
+	Size      int64  `json:"size"`
+	Encoding  string `json:"encoding,omitempty"`
+	Content   string `json:"content,omitempty"`
+	Truncated bool   `json:"truncated,omitempty"`
+}
+
+// ArtifactDownload is the result of download_workflow_artifact: a signed URL
+// to the artifact's zip archive, or the files inside it.
+type ArtifactDownload struct {
+	ID          int64          `json:"id"`
+	Name        string         `json:"name"`
+	SizeInBytes int64          `json:"size_in_bytes"`
+	URL         string         `json:"url,omitempty"`
+	Files       []ArtifactFile `json:"files,omitempty"`
+}
+
+// ListWorkflowArtifacts creates a tool to list the artifacts of a repository
+// or of a workflow run.
+func ListWorkflowArtifacts(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_workflow_artifacts",
+			mcp.WithDescription(t("TOOL_LIST_WORKFLOW_ARTIFACTS_DESCRIPTION", "List the GitHub Actions artifacts of a repository or of a workflow run")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("runId",
+				mcp.Description("Only artifacts of this workflow run"),
+			),
+			mcp.WithString("name",
+				mcp.Description("Only artifacts with exactly this name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runID, err := OptionalIntParam(request, "runId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := OptionalParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			listOpts := github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			var artifacts *github.ArtifactList
+			var resp *github.Response
+			if runID != 0 {
+				artifacts, resp, err = client.Actions.ListWorkflowRunArtifacts(ctx, owner, repo, int64(runID), &listOpts)
+			} else {
+				opts := &github.ListArtifactsOptions{ListOptions: listOpts}
+				if name != "" {
+					opts.Name = github.Ptr(name)
+				}
+				artifacts, resp, err = client.Actions.ListArtifacts(ctx, owner, repo, opts)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to list artifacts: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list artifacts: %s", string(body))), nil
+			}
+
+			out := ListArtifactsOutput{
+				TotalCount:  artifacts.GetTotalCount(),
+				Artifacts:   make([]ArtifactSummary, 0, len(artifacts.Artifacts)),
+				NextPage:    resp.NextPage,
+				HasNextPage: resp.NextPage != 0,
+			}
+			for _, a := range artifacts.Artifacts {
+				// The run artifacts endpoint has no name filter in go-github,
+				// so apply it to the page here.
+				if name != "" && a.GetName() != name {
+					continue
+				}
+				summary := ArtifactSummary{
+					ID:            a.GetID(),
+					Name:          a.GetName(),
+					SizeInBytes:   a.GetSizeInBytes(),
+					Expired:       a.GetExpired(),
+					WorkflowRunID: a.GetWorkflowRun().GetID(),
+				}
+				if a.CreatedAt != nil {
+					summary.CreatedAt = &a.CreatedAt.Time
+				}
+				if a.ExpiresAt != nil {
+					summary.ExpiresAt = &a.ExpiresAt.Time
+				}
+				out.Artifacts = append(out.Artifacts, summary)
+			}
+
+			r, err := json.Marshal(out)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// artifactFiles downloads an artifact's zip archive and returns its files,
+// with at most maxBytes of content across all of them.
+func artifactFiles(ctx context.Context, archiveURL string, maxBytes int) ([]ArtifactFile, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, archiveURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := signedURLClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download artifact: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxArtifactArchiveBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download artifact: %s", string(body))
+	}
+	if len(body) > maxArtifactArchiveBytes {
+		return nil, fmt.Errorf("artifact archive is larger than %d bytes", maxArtifactArchiveBytes)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open artifact archive: %w", err)
+	}
+	files := make([]ArtifactFile, 0, len(archive.File))
+	remaining := maxBytes
+	for _, f := range archive.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		file := ArtifactFile{Name: f.Name, Size: int64(f.UncompressedSize64)}
+		if remaining <= 0 {
+			file.Truncated = file.Size > 0
+			files = append(files, file)
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s in artifact: %w", f.Name, err)
+		}
+		// Read one byte past the budget so truncation is detected.
+		data, err := io.ReadAll(io.LimitReader(rc, int64(remaining)+1))
+		_ = rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s in artifact: %w", f.Name, err)
+		}
+		file.Content, file.Encoding, file.Truncated = encodeContent(data, remaining)
+		remaining -= min(len(data), remaining)
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// DownloadWorkflowArtifact creates a tool to download an artifact, either as
+// a signed URL or with its files inline.
+func DownloadWorkflowArtifact(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("download_workflow_artifact",
+			mcp.WithDescription(t("TOOL_DOWNLOAD_WORKFLOW_ARTIFACT_DESCRIPTION", "Download a GitHub Actions artifact, as a short-lived URL to its zip archive or with the files inside it returned inline")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("artifactId",
+				mcp.Required(),
+				mcp.Description("Artifact ID"),
+			),
+			mcp.WithBoolean("inline",
+				mcp.Description("Return the files inside the artifact instead of a download URL; text files are decoded, binary files are base64"),
+			),
+			mcp.WithNumber("maxBytes",
+				mcp.Description("With inline, the most file content to return across all files, defaults to 100000"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			artifactID, err := RequiredInt(request, "artifactId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			inline, err := OptionalParam[bool](request, "inline")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxBytes, err := OptionalIntParamWithDefault(request, "maxBytes", defaultMaxFileBytes)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			artifact, resp, err := client.Actions.GetArtifact(ctx, owner, repo, int64(artifactID))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get artifact: %w", err)
+			}
+			_ = resp.Body.Close()
+			if artifact.GetExpired() {
+				return mcp.NewToolResultError(fmt.Sprintf("artifact %d has expired", artifactID)), nil
+			}
+			if inline && artifact.GetSizeInBytes() > maxArtifactArchiveBytes {
+				return mcp.NewToolResultError(fmt.Sprintf("artifact %d is %d bytes, too large to return inline; download it from its URL instead", artifactID, artifact.GetSizeInBytes())), nil
+			}
+
+			archiveURL, resp, err := client.Actions.DownloadArtifact(ctx, owner, repo, int64(artifactID), 1)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get artifact download URL: %w", err)
+			}
+			_ = resp.Body.Close()
+
+			out := ArtifactDownload{
+				ID:          artifact.GetID(),
+				Name:        artifact.GetName(),
+				SizeInBytes: artifact.GetSizeInBytes(),
+			}
+			if inline {
+				if out.Files, err = artifactFiles(ctx, archiveURL.String(), maxBytes); err != nil {
+					return nil, err
+				}
+			} else {
+				out.URL = archiveURL.String()
+			}
+
+			r, err := json.Marshal(out)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,328 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ActionsCacheSummary is one cache in a list_actions_caches result.
+type ActionsCacheSummary struct {
+	ID             int64      `json:"id"`
+	Key            string     `json:"key"`
+	Ref            string     `json:"ref"`
+	SizeInBytes    int64      `json:"size_in_bytes"`
+	LastAccessedAt *time.Time `json:"last_accessed_at,omitempty"`
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+}
+
+// ListActionsCachesOutput is the result of list_actions_caches, with the
+// same paging fields as ListIssuesOutput.
+type ListActionsCachesOutput struct {
+	TotalCount  int                   `json:"total_count"`
+	Caches      []ActionsCacheSummary `json:"caches"`
+	NextPage    int                   `json:"next_page,omitempty"`
+	HasNextPage bool                  `json:"has_next_page"`
+}
+
+// ActionsUsageOutput is the result of get_actions_usage. Billing is only
+// reported for a whole account, and per-repository cache usage only for
+// organizations.
+type ActionsUsageOutput struct {
+	Owner            string                      `json:"owner"`
+	Repo             string                      `json:"repo,omitempty"`
+	Billing          *github.ActionBilling       `json:"billing,omitempty"`
+	CacheSizeInBytes int64                       `json:"cache_size_in_bytes"`
+	CacheCount       int                         `json:"cache_count"`
+	RepositoryCaches []*github.ActionsCacheUsage `json:"repository_caches,omitempty"`
+}
+
+// ListActionsCaches creates a tool to list the Actions caches of a repository.
+func ListActionsCaches(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_actions_caches",
+			mcp.WithDescription(t("TOOL_LIST_ACTIONS_CACHES_DESCRIPTION", "List the GitHub Actions caches of a repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Only caches for this ref, such as refs/heads/main or refs/pull/42/merge"),
+			),
+			mcp.WithString("key",
+				mcp.Description("Only caches whose key starts with this prefix"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort field, defaults to last_accessed_at"),
+				mcp.Enum("created_at", "last_accessed_at", "size_in_bytes"),
+			),
+			mcp.WithString("direction",
+				mcp.Description("Sort direction, defaults to desc"),
+				mcp.Enum("asc", "desc"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts := &github.ActionsCacheListOptions{
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
+			}
+			for param, field := range map[string]**string{
+				"ref":       &opts.Ref,
+				"key":       &opts.Key,
+				"sort":      &opts.Sort,
+				"direction": &opts.Direction,
+			} {
+				value, err := OptionalParam[string](request, param)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if value != "" {
+					*field = github.Ptr(value)
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			caches, resp, err := client.Actions.ListCaches(ctx, owner, repo, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list caches: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list caches: %s", string(body))), nil
+			}
+
+			out := ListActionsCachesOutput{
+				TotalCount:  caches.TotalCount,
+				Caches:      make([]ActionsCacheSummary, 0, len(caches.ActionsCaches)),
+				NextPage:    resp.NextPage,
+				HasNextPage: resp.NextPage != 0,
+			}
+			for _, c := range caches.ActionsCaches {
+				summary := ActionsCacheSummary{
+					ID:          c.GetID(),
+					Key:         c.GetKey(),
+					Ref:         c.GetRef(),
+					SizeInBytes: c.GetSizeInBytes(),
+				}
+				if c.LastAccessedAt != nil {
+					summary.LastAccessedAt = &c.LastAccessedAt.Time
+				}
+				if c.CreatedAt != nil {
+					summary.CreatedAt = &c.CreatedAt.Time
+				}
+				out.Caches = append(out.Caches, summary)
+			}
+
+			r, err := json.Marshal(out)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// DeleteActionsCache creates a tool to delete Actions caches by ID or key.
+func DeleteActionsCache(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_actions_cache",
+			mcp.WithDescription(t("TOOL_DELETE_ACTIONS_CACHE_DESCRIPTION", "Delete a GitHub Actions cache by ID, or all caches with a key, optionally only for one ref")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("cacheId",
+				mcp.Description("Cache ID; either cacheId or key is required"),
+			),
+			mcp.WithString("key",
+				mcp.Description("Delete every cache with exactly this key"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("With key, only delete caches for this ref"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			cacheID, err := OptionalIntParam(request, "cacheId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			key, err := OptionalParam[string](request, "key")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if (cacheID == 0) == (key == "") {
+				return mcp.NewToolResultError("exactly one of cacheId or key is required"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			var resp *github.Response
+			var deleted string
+			if cacheID != 0 {
+				resp, err = client.Actions.DeleteCachesByID(ctx, owner, repo, int64(cacheID))
+				deleted = fmt.Sprintf("Deleted cache %d", cacheID)
+			} else {
+				var refOpt *string
+				if ref != "" {
+					refOpt = github.Ptr(ref)
+				}
+				resp, err = client.Actions.DeleteCachesByKey(ctx, owner, repo, key, refOpt)
+				deleted = fmt.Sprintf("Deleted caches with key %s", key)
+				if ref != "" {
+					deleted += " on " + ref
+				}
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to delete cache: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to delete cache: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(deleted), nil
+		}
+}
+
+// accountActionsUsage fills in the billing and cache usage of an
+// organization, falling back to a user's billing when owner is not one.
+func accountActionsUsage(ctx context.Context, client *github.Client, owner string, out *ActionsUsageOutput) error {
+	billing, resp, err := client.Billing.GetActionsBillingOrg(ctx, owner)
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	if err != nil {
+		if resp == nil || resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("failed to get Actions billing: %w", err)
+		}
+		billing, resp, err = client.Billing.GetActionsBillingUser(ctx, owner)
+		if err != nil {
+			return fmt.Errorf("failed to get Actions billing: %w", err)
+		}
+		_ = resp.Body.Close()
+		out.Billing = billing
+		return nil
+	}
+	out.Billing = billing
+
+	total, resp, err := client.Actions.GetTotalCacheUsageForOrg(ctx, owner)
+	if err != nil {
+		return fmt.Errorf("failed to get cache usage: %w", err)
+	}
+	_ = resp.Body.Close()
+	out.CacheSizeInBytes = total.TotalActiveCachesUsageSizeInBytes
+	out.CacheCount = total.TotalActiveCachesCount
+
+	usage, resp, err := client.Actions.ListCacheUsageByRepoForOrg(ctx, owner, &github.ListOptions{PerPage: 100})
+	if err != nil {
+		return fmt.Errorf("failed to get cache usage by repository: %w", err)
+	}
+	_ = resp.Body.Close()
+	out.RepositoryCaches = usage.RepoCacheUsage
+	return nil
+}
+
+// GetActionsUsage creates a tool to report Actions billing and cache usage.
+func GetActionsUsage(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_actions_usage",
+			mcp.WithDescription(t("TOOL_GET_ACTIONS_USAGE_DESCRIPTION", "Get GitHub Actions usage: cache usage of a repository, or billed minutes and cache usage per repository of an organization or user")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Organization or user"),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Repository name, to get only its cache usage"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := OptionalParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			out := ActionsUsageOutput{Owner: owner, Repo: repo}
+			if repo != "" {
+				usage, resp, err := client.Actions.GetCacheUsageForRepo(ctx, owner, repo)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get cache usage: %w", err)
+				}
+				_ = resp.Body.Close()
+				out.CacheSizeInBytes = usage.ActiveCachesSizeInBytes
+				out.CacheCount = usage.ActiveCachesCount
+			} else if err := accountActionsUsage(ctx, client, owner, &out); err != nil {
+				return nil, err
+			}
+
+			r, err := json.Marshal(out)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
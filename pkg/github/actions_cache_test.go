@@ -0,0 +1,240 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListActionsCaches(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListActionsCaches(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_actions_caches", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.Contains(t, tool.InputSchema.Properties, "key")
+	assert.Contains(t, tool.InputSchema.Properties, "sort")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposActionsCachesByOwnerByRepo,
+			expectQueryParams(t, map[string]string{
+				"key":       "go-mod-",
+				"sort":      "size_in_bytes",
+				"direction": "desc",
+				"page":      "1",
+				"per_page":  "30",
+			}).andThen(
+				mockResponse(t, http.StatusOK, &github.ActionsCacheList{
+					TotalCount: 1,
+					ActionsCaches: []*github.ActionsCache{
+						{
+							ID:          github.Ptr(int64(505)),
+							Key:         github.Ptr("go-mod-abc123"),
+							Ref:         github.Ptr("refs/heads/main"),
+							SizeInBytes: github.Ptr(int64(1048576)),
+						},
+					},
+				}),
+			),
+		),
+	))
+	_, handler := ListActionsCaches(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":     "owner",
+		"repo":      "repo",
+		"key":       "go-mod-",
+		"sort":      "size_in_bytes",
+		"direction": "desc",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned ListActionsCachesOutput
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, ListActionsCachesOutput{
+		TotalCount: 1,
+		Caches: []ActionsCacheSummary{
+			{ID: 505, Key: "go-mod-abc123", Ref: "refs/heads/main", SizeInBytes: 1048576},
+		},
+	}, returned)
+}
+
+func Test_DeleteActionsCache(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := DeleteActionsCache(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "delete_actions_cache", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "cacheId")
+	assert.Contains(t, tool.InputSchema.Properties, "key")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name          string
+		mockedClient  *http.Client
+		requestArgs   map[string]interface{}
+		expectToolErr bool
+		expectedText  string
+	}{
+		{
+			name: "by ID",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposActionsCachesByOwnerByRepoByCacheId,
+					mockResponse(t, http.StatusNoContent, nil),
+				),
+			),
+			requestArgs:  map[string]interface{}{"cacheId": float64(505)},
+			expectedText: "Deleted cache 505",
+		},
+		{
+			name: "by key and ref",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposActionsCachesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"key": "go-mod-abc123",
+						"ref": "refs/pull/42/merge",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.ActionsCacheList{TotalCount: 1}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"key": "go-mod-abc123",
+				"ref": "refs/pull/42/merge",
+			},
+			expectedText: "Deleted caches with key go-mod-abc123 on refs/pull/42/merge",
+		},
+		{
+			name:          "both ID and key",
+			mockedClient:  mock.NewMockedHTTPClient(),
+			requestArgs:   map[string]interface{}{"cacheId": float64(505), "key": "go-mod-abc123"},
+			expectToolErr: true,
+			expectedText:  "exactly one of cacheId or key is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DeleteActionsCache(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			tc.requestArgs["owner"] = "owner"
+			tc.requestArgs["repo"] = "repo"
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectToolErr, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
+
+func Test_GetActionsUsage(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := GetActionsUsage(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_actions_usage", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner"})
+
+	billing := &github.ActionBilling{
+		TotalMinutesUsed:     305,
+		TotalPaidMinutesUsed: 0,
+		IncludedMinutes:      3000,
+		MinutesUsedBreakdown: github.MinutesUsedBreakdown{"UBUNTU": 205, "MACOS": 100},
+	}
+
+	tests := []struct {
+		name         string
+		mockedClient *http.Client
+		requestArgs  map[string]interface{}
+		expected     ActionsUsageOutput
+	}{
+		{
+			name: "repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsCacheUsageByOwnerByRepo,
+					&github.ActionsCacheUsage{FullName: "owner/repo", ActiveCachesSizeInBytes: 2048, ActiveCachesCount: 3},
+				),
+			),
+			requestArgs: map[string]interface{}{"owner": "owner", "repo": "repo"},
+			expected:    ActionsUsageOutput{Owner: "owner", Repo: "repo", CacheSizeInBytes: 2048, CacheCount: 3},
+		},
+		{
+			name: "organization",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetOrgsSettingsBillingActionsByOrg,
+					billing,
+				),
+				mock.WithRequestMatch(
+					mock.GetOrgsActionsCacheUsageByOrg,
+					&github.TotalCacheUsage{TotalActiveCachesUsageSizeInBytes: 4096, TotalActiveCachesCount: 5},
+				),
+				mock.WithRequestMatch(
+					mock.GetOrgsActionsCacheUsageByRepositoryByOrg,
+					&github.ActionsCacheUsageList{
+						TotalCount: 1,
+						RepoCacheUsage: []*github.ActionsCacheUsage{
+							{FullName: "acme/app", ActiveCachesSizeInBytes: 4096, ActiveCachesCount: 5},
+						},
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{"owner": "acme"},
+			expected: ActionsUsageOutput{
+				Owner:            "acme",
+				Billing:          billing,
+				CacheSizeInBytes: 4096,
+				CacheCount:       5,
+				RepositoryCaches: []*github.ActionsCacheUsage{
+					{FullName: "acme/app", ActiveCachesSizeInBytes: 4096, ActiveCachesCount: 5},
+				},
+			},
+		},
+		{
+			name: "user",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsSettingsBillingActionsByOrg,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+				mock.WithRequestMatch(
+					mock.GetUsersSettingsBillingActionsByUsername,
+					billing,
+				),
+			),
+			requestArgs: map[string]interface{}{"owner": "octocat"},
+			expected:    ActionsUsageOutput{Owner: "octocat", Billing: billing},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetActionsUsage(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			var returned ActionsUsageOutput
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
@@ -0,0 +1,594 @@
+package github
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"golang.org/x/crypto/nacl/box"
+)
+
+// ActionsSecretSummary is one secret in a list_actions_secrets result.
+// GitHub never returns secret values.
+type ActionsSecretSummary struct {
+	Name       string     `json:"name"`
+	Visibility string     `json:"visibility,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+}
+
+// ListActionsSecretsOutput is the result of list_actions_secrets.
+type ListActionsSecretsOutput struct {
+	TotalCount  int                    `json:"total_count"`
+	Secrets     []ActionsSecretSummary `json:"secrets"`
+	NextPage    int                    `json:"next_page,omitempty"`
+	HasNextPage bool                   `json:"has_next_page"`
+}
+
+// ActionsVariableSummary is one variable in a list_actions_variables result.
+type ActionsVariableSummary struct {
+	Name       string     `json:"name"`
+	Value      string     `json:"value"`
+	Visibility string     `json:"visibility,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+}
+
+// ListActionsVariablesOutput is the result of list_actions_variables.
+type ListActionsVariablesOutput struct {
+	TotalCount  int                      `json:"total_count"`
+	Variables   []ActionsVariableSummary `json:"variables"`
+	NextPage    int                      `json:"next_page,omitempty"`
+	HasNextPage bool                     `json:"has_next_page"`
+}
+
+// actionsScope is where a secret or variable lives: an organization when
+// repo is empty, otherwise a repository or one of its environments.
+type actionsScope struct {
+	owner       string
+	repo        string
+	environment string
+}
+
+func (s actionsScope) String() string {
+	switch {
+	case s.repo == "":
+		return "organization " + s.owner
+	case s.environment == "":
+		return s.owner + "/" + s.repo
+	default:
+		return fmt.Sprintf("environment %s of %s/%s", s.environment, s.owner, s.repo)
+	}
+}
+
+func withActionsScopeParams() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner, or the organization when repo is omitted"),
+		)(tool)
+		mcp.WithString("repo",
+			mcp.Description("Repository name; omit for organization level"),
+		)(tool)
+		mcp.WithString("environment",
+			mcp.Description("Deployment environment of the repository"),
+		)(tool)
+	}
+}
+
+func actionsScopeParams(request mcp.CallToolRequest) (actionsScope, error) {
+	var scope actionsScope
+	var err error
+	if scope.owner, err = requiredParam[string](request, "owner"); err != nil {
+		return scope, err
+	}
+	if scope.repo, err = OptionalParam[string](request, "repo"); err != nil {
+		return scope, err
+	}
+	if scope.environment, err = OptionalParam[string](request, "environment"); err != nil {
+		return scope, err
+	}
+	if scope.environment != "" && scope.repo == "" {
+		return scope, errors.New("environment requires repo")
+	}
+	return scope, nil
+}
+
+// repoID looks up the numeric repository ID, which the environment secret
+// endpoints use instead of owner and name.
+func (s actionsScope) repoID(ctx context.Context, client *github.Client) (int, error) {
+	repository, resp, err := client.Repositories.Get(ctx, s.owner, s.repo)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get repository: %w", err)
+	}
+	_ = resp.Body.Close()
+	return int(repository.GetID()), nil
+}
+
+// sealSecret encrypts value for GitHub with a libsodium sealed box under
+// the base64 encoded public key of the scope.
+func sealSecret(publicKey *github.PublicKey, value string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(publicKey.GetKey())
+	if err != nil {
+		return "", fmt.Errorf("failed to decode public key: %w", err)
+	}
+	if len(raw) != 32 {
+		return "", fmt.Errorf("unexpected public key length %d", len(raw))
+	}
+	var key [32]byte
+	copy(key[:], raw)
+	sealed, err := box.SealAnonymous(nil, []byte(value), &key, rand.Reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt secret: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// ListActionsSecrets creates a tool to list the names of Actions secrets.
+func ListActionsSecrets(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_actions_secrets",
+			mcp.WithDescription(t("TOOL_LIST_ACTIONS_SECRETS_DESCRIPTION", "List the names of GitHub Actions secrets of a repository, environment or organization. Values are never returned")),
+			withActionsScopeParams(),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			scope, err := actionsScopeParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			var secrets *github.Secrets
+			var resp *github.Response
+			switch {
+			case scope.repo == "":
+				secrets, resp, err = client.Actions.ListOrgSecrets(ctx, scope.owner, opts)
+			case scope.environment == "":
+				secrets, resp, err = client.Actions.ListRepoSecrets(ctx, scope.owner, scope.repo, opts)
+			default:
+				var repoID int
+				if repoID, err = scope.repoID(ctx, client); err != nil {
+					return nil, err
+				}
+				secrets, resp, err = client.Actions.ListEnvSecrets(ctx, repoID, scope.environment, opts)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to list secrets: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list secrets: %s", string(body))), nil
+			}
+
+			out := ListActionsSecretsOutput{
+				TotalCount:  secrets.TotalCount,
+				Secrets:     make([]ActionsSecretSummary, 0, len(secrets.Secrets)),
+				NextPage:    resp.NextPage,
+				HasNextPage: resp.NextPage != 0,
+			}
+			for _, s := range secrets.Secrets {
+				summary := ActionsSecretSummary{
+					Name:       s.Name,
+					Visibility: s.Visibility,
+				}
+				if !s.CreatedAt.IsZero() {
+					summary.CreatedAt = &s.CreatedAt.Time
+				}
+				if !s.UpdatedAt.IsZero() {
+					summary.UpdatedAt = &s.UpdatedAt.Time
+				}
+				out.Secrets = append(out.Secrets, summary)
+			}
+
+			r, err := json.Marshal(out)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// SetActionsSecret creates a tool to create or update an Actions secret.
+func SetActionsSecret(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("set_actions_secret",
+			mcp.WithDescription(t("TOOL_SET_ACTIONS_SECRET_DESCRIPTION", "Create or update a GitHub Actions secret of a repository, environment or organization. The value is encrypted with the scope's public key before it is sent")),
+			withActionsScopeParams(),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("Secret name"),
+			),
+			mcp.WithString("value",
+				mcp.Required(),
+				mcp.Description("Secret value"),
+			),
+			mcp.WithString("visibility",
+				mcp.Description("Which repositories of the organization can use an organization secret, defaults to private"),
+				mcp.Enum("all", "private"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			scope, err := actionsScopeParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := requiredParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			value, err := requiredParam[string](request, "value")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			visibility, err := OptionalParam[string](request, "visibility")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if visibility != "" && scope.repo != "" {
+				return mcp.NewToolResultError("visibility only applies to organization secrets"), nil
+			}
+			if visibility == "" && scope.repo == "" {
+				visibility = "private"
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			var repoID int
+			var publicKey *github.PublicKey
+			var resp *github.Response
+			switch {
+			case scope.repo == "":
+				publicKey, resp, err = client.Actions.GetOrgPublicKey(ctx, scope.owner)
+			case scope.environment == "":
+				publicKey, resp, err = client.Actions.GetRepoPublicKey(ctx, scope.owner, scope.repo)
+			default:
+				if repoID, err = scope.repoID(ctx, client); err != nil {
+					return nil, err
+				}
+				publicKey, resp, err = client.Actions.GetEnvPublicKey(ctx, repoID, scope.environment)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to get public key: %w", err)
+			}
+			_ = resp.Body.Close()
+
+			encrypted, err := sealSecret(publicKey, value)
+			if err != nil {
+				return nil, err
+			}
+			secret := &github.EncryptedSecret{
+				Name:           name,
+				KeyID:          publicKey.GetKeyID(),
+				EncryptedValue: encrypted,
+				Visibility:     visibility,
+			}
+			switch {
+			case scope.repo == "":
+				resp, err = client.Actions.CreateOrUpdateOrgSecret(ctx, scope.owner, secret)
+			case scope.environment == "":
+				resp, err = client.Actions.CreateOrUpdateRepoSecret(ctx, scope.owner, scope.repo, secret)
+			default:
+				resp, err = client.Actions.CreateOrUpdateEnvSecret(ctx, repoID, scope.environment, secret)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to set secret: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to set secret: %s", string(body))), nil
+			}
+
+			verb := "Updated"
+			if resp.StatusCode == http.StatusCreated {
+				verb = "Created"
+			}
+			return mcp.NewToolResultText(fmt.Sprintf("%s secret %s in %s", verb, name, scope)), nil
+		}
+}
+
+// DeleteActionsSecret creates a tool to delete an Actions secret.
+func DeleteActionsSecret(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_actions_secret",
+			mcp.WithDescription(t("TOOL_DELETE_ACTIONS_SECRET_DESCRIPTION", "Delete a GitHub Actions secret of a repository, environment or organization")),
+			withActionsScopeParams(),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("Secret name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			scope, err := actionsScopeParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := requiredParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			var resp *github.Response
+			switch {
+			case scope.repo == "":
+				resp, err = client.Actions.DeleteOrgSecret(ctx, scope.owner, name)
+			case scope.environment == "":
+				resp, err = client.Actions.DeleteRepoSecret(ctx, scope.owner, scope.repo, name)
+			default:
+				var repoID int
+				if repoID, err = scope.repoID(ctx, client); err != nil {
+					return nil, err
+				}
+				resp, err = client.Actions.DeleteEnvSecret(ctx, repoID, scope.environment, name)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to delete secret: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to delete secret: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Deleted secret %s from %s", name, scope)), nil
+		}
+}
+
+// ListActionsVariables creates a tool to list Actions variables.
+func ListActionsVariables(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_actions_variables",
+			mcp.WithDescription(t("TOOL_LIST_ACTIONS_VARIABLES_DESCRIPTION", "List the GitHub Actions variables of a repository, environment or organization, with their values")),
+			withActionsScopeParams(),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			scope, err := actionsScopeParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			var variables *github.ActionsVariables
+			var resp *github.Response
+			switch {
+			case scope.repo == "":
+				variables, resp, err = client.Actions.ListOrgVariables(ctx, scope.owner, opts)
+			case scope.environment == "":
+				variables, resp, err = client.Actions.ListRepoVariables(ctx, scope.owner, scope.repo, opts)
+			default:
+				variables, resp, err = client.Actions.ListEnvVariables(ctx, scope.owner, scope.repo, scope.environment, opts)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to list variables: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list variables: %s", string(body))), nil
+			}
+
+			out := ListActionsVariablesOutput{
+				TotalCount:  variables.TotalCount,
+				Variables:   make([]ActionsVariableSummary, 0, len(variables.Variables)),
+				NextPage:    resp.NextPage,
+				HasNextPage: resp.NextPage != 0,
+			}
+			for _, v := range variables.Variables {
+				summary := ActionsVariableSummary{
+					Name:       v.Name,
+					Value:      v.Value,
+					Visibility: v.GetVisibility(),
+				}
+				if v.CreatedAt != nil {
+					summary.CreatedAt = &v.CreatedAt.Time
+				}
+				if v.UpdatedAt != nil {
+					summary.UpdatedAt = &v.UpdatedAt.Time
+				}
+				out.Variables = append(out.Variables, summary)
+			}
+
+			r, err := json.Marshal(out)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// SetActionsVariable creates a tool to create or update an Actions variable.
+func SetActionsVariable(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("set_actions_variable",
+			mcp.WithDescription(t("TOOL_SET_ACTIONS_VARIABLE_DESCRIPTION", "Create or update a GitHub Actions variable of a repository, environment or organization")),
+			withActionsScopeParams(),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("Variable name"),
+			),
+			mcp.WithString("value",
+				mcp.Required(),
+				mcp.Description("Variable value"),
+			),
+			mcp.WithString("visibility",
+				mcp.Description("Which repositories of the organization can use an organization variable, defaults to private"),
+				mcp.Enum("all", "private"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			scope, err := actionsScopeParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := requiredParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			value, err := requiredParam[string](request, "value")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			visibility, err := OptionalParam[string](request, "visibility")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if visibility != "" && scope.repo != "" {
+				return mcp.NewToolResultError("visibility only applies to organization variables"), nil
+			}
+			if visibility == "" && scope.repo == "" {
+				visibility = "private"
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			variable := &github.ActionsVariable{Name: name, Value: value}
+			if visibility != "" {
+				variable.Visibility = github.Ptr(visibility)
+			}
+
+			// The API has separate create and update endpoints, so try the
+			// update first and create the variable when it does not exist.
+			var resp *github.Response
+			switch {
+			case scope.repo == "":
+				resp, err = client.Actions.UpdateOrgVariable(ctx, scope.owner, variable)
+			case scope.environment == "":
+				resp, err = client.Actions.UpdateRepoVariable(ctx, scope.owner, scope.repo, variable)
+			default:
+				resp, err = client.Actions.UpdateEnvVariable(ctx, scope.owner, scope.repo, scope.environment, variable)
+			}
+			verb := "Updated"
+			if err != nil && resp != nil && resp.StatusCode == http.StatusNotFound {
+				_ = resp.Body.Close()
+				verb = "Created"
+				switch {
+				case scope.repo == "":
+					resp, err = client.Actions.CreateOrgVariable(ctx, scope.owner, variable)
+				case scope.environment == "":
+					resp, err = client.Actions.CreateRepoVariable(ctx, scope.owner, scope.repo, variable)
+				default:
+					resp, err = client.Actions.CreateEnvVariable(ctx, scope.owner, scope.repo, scope.environment, variable)
+				}
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to set variable: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to set variable: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("%s variable %s in %s", verb, name, scope)), nil
+		}
+}
+
+// DeleteActionsVariable creates a tool to delete an Actions variable.
+func DeleteActionsVariable(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_actions_variable",
+			mcp.WithDescription(t("TOOL_DELETE_ACTIONS_VARIABLE_DESCRIPTION", "Delete a GitHub Actions variable of a repository, environment or organization")),
+			withActionsScopeParams(),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("Variable name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			scope, err := actionsScopeParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := requiredParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			var resp *github.Response
+			switch {
+			case scope.repo == "":
+				resp, err = client.Actions.DeleteOrgVariable(ctx, scope.owner, name)
+			case scope.environment == "":
+				resp, err = client.Actions.DeleteRepoVariable(ctx, scope.owner, scope.repo, name)
+			default:
+				resp, err = client.Actions.DeleteEnvVariable(ctx, scope.owner, scope.repo, scope.environment, name)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to delete variable: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to delete variable: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Deleted variable %s from %s", name, scope)), nil
+		}
+}
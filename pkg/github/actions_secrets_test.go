@@ -0,0 +1,354 @@
+package github
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/nacl/box"
+)
+
+func Test_ListActionsSecrets(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListActionsSecrets(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_actions_secrets", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "environment")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner"})
+
+	created := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+	secrets := &github.Secrets{
+		TotalCount: 1,
+		Secrets: []*github.Secret{
+			{Name: "NPM_TOKEN", CreatedAt: github.Timestamp{Time: created}, UpdatedAt: github.Timestamp{Time: created}},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		mockedClient  *http.Client
+		requestArgs   map[string]interface{}
+		expectToolErr bool
+		expectedText  string
+	}{
+		{
+			name: "repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposActionsSecretsByOwnerByRepo, secrets),
+			),
+			requestArgs:  map[string]interface{}{"owner": "owner", "repo": "repo"},
+			expectedText: `"name":"NPM_TOKEN"`,
+		},
+		{
+			name: "environment",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposByOwnerByRepo, &github.Repository{ID: github.Ptr(int64(42))}),
+				mock.WithRequestMatch(
+					mock.EndpointPattern{Pattern: "/repositories/42/environments/production/secrets", Method: "GET"},
+					secrets,
+				),
+			),
+			requestArgs:  map[string]interface{}{"owner": "owner", "repo": "repo", "environment": "production"},
+			expectedText: `"name":"NPM_TOKEN"`,
+		},
+		{
+			name: "organization",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetOrgsActionsSecretsByOrg, &github.Secrets{
+					TotalCount: 1,
+					Secrets:    []*github.Secret{{Name: "DEPLOY_KEY", Visibility: "all"}},
+				}),
+			),
+			requestArgs:  map[string]interface{}{"owner": "acme"},
+			expectedText: `"secrets":[{"name":"DEPLOY_KEY","visibility":"all"}]`,
+		},
+		{
+			name:          "environment without repo",
+			mockedClient:  mock.NewMockedHTTPClient(),
+			requestArgs:   map[string]interface{}{"owner": "acme", "environment": "production"},
+			expectToolErr: true,
+			expectedText:  "environment requires repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListActionsSecrets(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectToolErr, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
+
+func Test_SetActionsSecret(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := SetActionsSecret(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "set_actions_secret", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "visibility")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "name", "value"})
+
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	key := &github.PublicKey{
+		KeyID: github.Ptr("568250167242549743"),
+		Key:   github.Ptr(base64.StdEncoding.EncodeToString(publicKey[:])),
+	}
+
+	// expectSealed checks that the secret was sealed for key and returns status.
+	expectSealed := func(t *testing.T, visibility string, status int) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "568250167242549743", body["key_id"])
+			assert.Equal(t, visibility, body["visibility"])
+			sealed, err := base64.StdEncoding.DecodeString(body["encrypted_value"])
+			require.NoError(t, err)
+			opened, ok := box.OpenAnonymous(nil, sealed, publicKey, privateKey)
+			require.True(t, ok)
+			assert.Equal(t, "s3cr3t", string(opened))
+			w.WriteHeader(status)
+		}
+	}
+
+	tests := []struct {
+		name          string
+		mockedClient  *http.Client
+		requestArgs   map[string]interface{}
+		expectToolErr bool
+		expectedText  string
+	}{
+		{
+			name: "create repository secret",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposActionsSecretsPublicKeyByOwnerByRepo, key),
+				mock.WithRequestMatchHandler(
+					mock.PutReposActionsSecretsByOwnerByRepoBySecretName,
+					expectSealed(t, "", http.StatusCreated),
+				),
+			),
+			requestArgs:  map[string]interface{}{"owner": "owner", "repo": "repo"},
+			expectedText: "Created secret NPM_TOKEN in owner/repo",
+		},
+		{
+			name: "update environment secret",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposByOwnerByRepo, &github.Repository{ID: github.Ptr(int64(42))}),
+				mock.WithRequestMatch(
+					mock.EndpointPattern{Pattern: "/repositories/42/environments/production/secrets/public-key", Method: "GET"},
+					key,
+				),
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/repositories/42/environments/production/secrets/NPM_TOKEN", Method: "PUT"},
+					expectSealed(t, "", http.StatusNoContent),
+				),
+			),
+			requestArgs:  map[string]interface{}{"owner": "owner", "repo": "repo", "environment": "production"},
+			expectedText: "Updated secret NPM_TOKEN in environment production of owner/repo",
+		},
+		{
+			name: "organization secret defaults to private",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetOrgsActionsSecretsPublicKeyByOrg, key),
+				mock.WithRequestMatchHandler(
+					mock.PutOrgsActionsSecretsByOrgBySecretName,
+					expectSealed(t, "private", http.StatusCreated),
+				),
+			),
+			requestArgs:  map[string]interface{}{"owner": "acme"},
+			expectedText: "Created secret NPM_TOKEN in organization acme",
+		},
+		{
+			name:          "visibility on repository secret",
+			mockedClient:  mock.NewMockedHTTPClient(),
+			requestArgs:   map[string]interface{}{"owner": "owner", "repo": "repo", "visibility": "all"},
+			expectToolErr: true,
+			expectedText:  "visibility only applies to organization secrets",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := SetActionsSecret(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			tc.requestArgs["name"] = "NPM_TOKEN"
+			tc.requestArgs["value"] = "s3cr3t"
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectToolErr, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
+
+func Test_DeleteActionsSecret(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := DeleteActionsSecret(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "delete_actions_secret", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "name"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.DeleteReposActionsSecretsByOwnerByRepoBySecretName,
+			mockResponse(t, http.StatusNoContent, nil),
+		),
+	))
+	_, handler := DeleteActionsSecret(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+		"name":  "NPM_TOKEN",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Equal(t, "Deleted secret NPM_TOKEN from owner/repo", getTextResult(t, result).Text)
+}
+
+func Test_ListActionsVariables(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListActionsVariables(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_actions_variables", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposEnvironmentsVariablesByOwnerByRepoByEnvironmentName,
+			&github.ActionsVariables{
+				TotalCount: 1,
+				Variables:  []*github.ActionsVariable{{Name: "REGION", Value: "eu-west-1"}},
+			},
+		),
+	))
+	_, handler := ListActionsVariables(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":       "owner",
+		"repo":        "repo",
+		"environment": "production",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned ListActionsVariablesOutput
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, ListActionsVariablesOutput{
+		TotalCount: 1,
+		Variables:  []ActionsVariableSummary{{Name: "REGION", Value: "eu-west-1"}},
+	}, returned)
+}
+
+func Test_SetActionsVariable(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := SetActionsVariable(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "set_actions_variable", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "visibility")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "name", "value"})
+
+	tests := []struct {
+		name         string
+		mockedClient *http.Client
+		requestArgs  map[string]interface{}
+		expectedText string
+	}{
+		{
+			name: "update existing",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposActionsVariablesByOwnerByRepoByName,
+					expectRequestBody(t, map[string]interface{}{
+						"name":  "REGION",
+						"value": "eu-west-1",
+					}).andThen(
+						mockResponse(t, http.StatusNoContent, nil),
+					),
+				),
+			),
+			requestArgs:  map[string]interface{}{"owner": "owner", "repo": "repo"},
+			expectedText: "Updated variable REGION in owner/repo",
+		},
+		{
+			name: "create missing organization variable",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchOrgsActionsVariablesByOrgByName,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostOrgsActionsVariablesByOrg,
+					expectRequestBody(t, map[string]interface{}{
+						"name":       "REGION",
+						"value":      "eu-west-1",
+						"visibility": "all",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, nil),
+					),
+				),
+			),
+			requestArgs:  map[string]interface{}{"owner": "acme", "visibility": "all"},
+			expectedText: "Created variable REGION in organization acme",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := SetActionsVariable(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			tc.requestArgs["name"] = "REGION"
+			tc.requestArgs["value"] = "eu-west-1"
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
+
+func Test_DeleteActionsVariable(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := DeleteActionsVariable(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "delete_actions_variable", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "name"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.DeleteOrgsActionsVariablesByOrgByName,
+			mockResponse(t, http.StatusNoContent, nil),
+		),
+	))
+	_, handler := DeleteActionsVariable(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "acme",
+		"name":  "REGION",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Equal(t, "Deleted variable REGION from organization acme", getTextResult(t, result).Text)
+}
@@ -0,0 +1,810 @@
+package github
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListWorkflows(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListWorkflows(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_workflows", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposActionsWorkflowsByOwnerByRepo,
+			expectQueryParams(t, map[string]string{
+				"page":     "1",
+				"per_page": "30",
+			}).andThen(
+				mockResponse(t, http.StatusOK, &github.Workflows{
+					TotalCount: github.Ptr(1),
+					Workflows: []*github.Workflow{
+						{
+							ID:      github.Ptr(int64(161335)),
+							Name:    github.Ptr("CI"),
+							Path:    github.Ptr(".github/workflows/ci.yml"),
+							State:   github.Ptr("active"),
+							HTMLURL: github.Ptr("https://github.com/owner/repo/blob/main/.github/workflows/ci.yml"),
+						},
+					},
+				}),
+			),
+		),
+	))
+	_, handler := ListWorkflows(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned ListWorkflowsOutput
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, ListWorkflowsOutput{
+		TotalCount: 1,
+		Workflows: []WorkflowSummary{
+			{
+				ID:      161335,
+				Name:    "CI",
+				Path:    ".github/workflows/ci.yml",
+				State:   "active",
+				HTMLURL: "https://github.com/owner/repo/blob/main/.github/workflows/ci.yml",
+			},
+		},
+	}, returned)
+}
+
+func Test_ListWorkflowRuns(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListWorkflowRuns(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_workflow_runs", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "workflowId")
+	assert.Contains(t, tool.InputSchema.Properties, "status")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.Contains(t, tool.InputSchema.Properties, "actor")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockRuns := &github.WorkflowRuns{
+		TotalCount: github.Ptr(1),
+		WorkflowRuns: []*github.WorkflowRun{
+			{
+				ID:         github.Ptr(int64(30433642)),
+				Name:       github.Ptr("CI"),
+				WorkflowID: github.Ptr(int64(161335)),
+				RunNumber:  github.Ptr(562),
+				RunAttempt: github.Ptr(1),
+				Event:      github.Ptr("push"),
+				Status:     github.Ptr("completed"),
+				Conclusion: github.Ptr("failure"),
+				HeadBranch: github.Ptr("main"),
+				HeadSHA:    github.Ptr("acb5820ced9479c074f688cc328bf03f341a511d"),
+				Actor:      &github.User{Login: github.Ptr("octocat")},
+				HTMLURL:    github.Ptr("https://github.com/owner/repo/actions/runs/30433642"),
+			},
+		},
+	}
+	expected := ListWorkflowRunsOutput{
+		TotalCount: 1,
+		WorkflowRuns: []WorkflowRunSummary{
+			{
+				ID:         30433642,
+				Name:       "CI",
+				WorkflowID: 161335,
+				RunNumber:  562,
+				RunAttempt: 1,
+				Event:      "push",
+				Status:     "completed",
+				Conclusion: "failure",
+				HeadBranch: "main",
+				HeadSHA:    "acb5820ced9479c074f688cc328bf03f341a511d",
+				Actor:      "octocat",
+				HTMLURL:    "https://github.com/owner/repo/actions/runs/30433642",
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		mockedClient *http.Client
+		requestArgs  map[string]interface{}
+	}{
+		{
+			name: "all runs of a repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"status":   "failure",
+						"branch":   "main",
+						"actor":    "octocat",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockRuns),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"status": "failure",
+				"branch": "main",
+				"actor":  "octocat",
+			},
+		},
+		{
+			name: "runs of a workflow by file name",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsWorkflowsRunsByOwnerByRepoByWorkflowId,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/actions/workflows/ci.yml/runs", r.URL.Path)
+						mockResponse(t, http.StatusOK, mockRuns)(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"workflowId": "ci.yml",
+			},
+		},
+		{
+			name: "runs of a workflow by ID",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsWorkflowsRunsByOwnerByRepoByWorkflowId,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/actions/workflows/161335/runs", r.URL.Path)
+						mockResponse(t, http.StatusOK, mockRuns)(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"workflowId": "161335",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListWorkflowRuns(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			tc.requestArgs["owner"] = "owner"
+			tc.requestArgs["repo"] = "repo"
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			var returned ListWorkflowRunsOutput
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, expected, returned)
+		})
+	}
+}
+
+func Test_GetWorkflowRun(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := GetWorkflowRun(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_workflow_run", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "runId"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposActionsRunsByOwnerByRepoByRunId,
+			&github.WorkflowRun{
+				ID:     github.Ptr(int64(30433642)),
+				Status: github.Ptr("in_progress"),
+			},
+		),
+	))
+	_, handler := GetWorkflowRun(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+		"runId": float64(30433642),
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned github.WorkflowRun
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, int64(30433642), returned.GetID())
+	assert.Equal(t, "in_progress", returned.GetStatus())
+}
+
+func Test_RunWorkflow(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := RunWorkflow(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "run_workflow", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "inputs")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "workflowId", "ref"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostReposActionsWorkflowsDispatchesByOwnerByRepoByWorkflowId,
+			expectRequestBody(t, map[string]interface{}{
+				"ref": "main",
+				"inputs": map[string]interface{}{
+					"environment": "staging",
+				},
+			}).andThen(
+				mockResponse(t, http.StatusNoContent, nil),
+			),
+		),
+	))
+	_, handler := RunWorkflow(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":      "owner",
+		"repo":       "repo",
+		"workflowId": "deploy.yml",
+		"ref":        "main",
+		"inputs": map[string]interface{}{
+			"environment": "staging",
+		},
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Equal(t, "Workflow deploy.yml dispatched on main", getTextResult(t, result).Text)
+}
+
+func Test_CancelWorkflowRun(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := CancelWorkflowRun(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "cancel_workflow_run", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "runId"})
+
+	tests := []struct {
+		name          string
+		mockedClient  *http.Client
+		expectToolErr bool
+		expectedText  string
+	}{
+		{
+			name: "run cancelled",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsRunsCancelByOwnerByRepoByRunId,
+					mockResponse(t, http.StatusAccepted, map[string]interface{}{}),
+				),
+			),
+			expectedText: "Workflow run 42 is being cancelled",
+		},
+		{
+			name: "run already finished",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsRunsCancelByOwnerByRepoByRunId,
+					mockResponse(t, http.StatusConflict, map[string]string{"message": "Cannot cancel a workflow run that is completed."}),
+				),
+			),
+			expectToolErr: true,
+			expectedText:  "workflow run 42 cannot be cancelled",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CancelWorkflowRun(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"runId": float64(42),
+			}))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectToolErr, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
+
+func Test_RerunWorkflowRun(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := RerunWorkflowRun(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "rerun_workflow_run", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "failedJobsOnly")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "runId"})
+
+	tests := []struct {
+		name         string
+		mockedClient *http.Client
+		requestArgs  map[string]interface{}
+		expectedText string
+	}{
+		{
+			name: "whole run",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsRunsRerunByOwnerByRepoByRunId,
+					mockResponse(t, http.StatusCreated, map[string]interface{}{}),
+				),
+			),
+			requestArgs:  map[string]interface{}{},
+			expectedText: "Workflow run 42 is re-running",
+		},
+		{
+			name: "failed jobs only",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsRunsRerunFailedJobsByOwnerByRepoByRunId,
+					mockResponse(t, http.StatusCreated, map[string]interface{}{}),
+				),
+			),
+			requestArgs:  map[string]interface{}{"failedJobsOnly": true},
+			expectedText: "Failed jobs of workflow run 42 are re-running",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RerunWorkflowRun(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			tc.requestArgs["owner"] = "owner"
+			tc.requestArgs["repo"] = "repo"
+			tc.requestArgs["runId"] = float64(42)
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
+
+func Test_GetJobLogs(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := GetJobLogs(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_job_logs", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "jobId")
+	assert.Contains(t, tool.InputSchema.Properties, "runId")
+	assert.Contains(t, tool.InputSchema.Properties, "failedOnly")
+	assert.Contains(t, tool.InputSchema.Properties, "tailLines")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	longLog := strings.Repeat("line\n", defaultJobLogLines+1)
+
+	var gzipped bytes.Buffer
+	zw := gzip.NewWriter(&gzipped)
+	_, _ = zw.Write([]byte("setup\nbuild\nFAIL: Test_Thing\n"))
+	require.NoError(t, zw.Close())
+
+	// Log downloads are redirected to signed URLs on another host, which
+	// must not be sent the caller's token.
+	logServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/logs/1":
+			_, _ = w.Write([]byte("one\ntwo\nthree\n"))
+		case "/logs/2":
+			_, _ = w.Write(gzipped.Bytes())
+		case "/logs/4":
+			_, _ = w.Write([]byte(longLog))
+		default:
+			t.Errorf("unexpected log download %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer logServer.Close()
+
+	redirectTo := func(path string) http.HandlerFunc {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Location", logServer.URL+path)
+			w.WriteHeader(http.StatusFound)
+		}
+	}
+	jobLogsHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/owner/repo/actions/jobs/1/logs":
+			redirectTo("/logs/1")(w, r)
+		case "/repos/owner/repo/actions/jobs/2/logs":
+			redirectTo("/logs/2")(w, r)
+		case "/repos/owner/repo/actions/jobs/4/logs":
+			redirectTo("/logs/4")(w, r)
+		case "/repos/owner/repo/actions/jobs/3/logs":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+		default:
+			t.Errorf("unexpected request for %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	tests := []struct {
+		name          string
+		mockedClient  *http.Client
+		requestArgs   map[string]interface{}
+		expectToolErr string
+		expected      []JobLog
+	}{
+		{
+			name: "single job",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsJobsByOwnerByRepoByJobId,
+					&github.WorkflowJob{ID: github.Ptr(int64(1)), Name: github.Ptr("build"), Conclusion: github.Ptr("success")},
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsJobsLogsByOwnerByRepoByJobId,
+					jobLogsHandler,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"jobId":     float64(1),
+				"tailLines": float64(2),
+			},
+			expected: []JobLog{
+				{ID: 1, Name: "build", Conclusion: "success", Logs: "two\nthree\n", Truncated: true},
+			},
+		},
+		{
+			name: "whole log",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsJobsByOwnerByRepoByJobId,
+					&github.WorkflowJob{ID: github.Ptr(int64(4)), Name: github.Ptr("e2e"), Conclusion: github.Ptr("failure")},
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsJobsLogsByOwnerByRepoByJobId,
+					jobLogsHandler,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"jobId":     float64(4),
+				"tailLines": float64(0),
+			},
+			expected: []JobLog{
+				{ID: 4, Name: "e2e", Conclusion: "failure", Logs: longLog},
+			},
+		},
+		{
+			name: "failed jobs of a run",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsJobsByOwnerByRepoByRunId,
+					&github.Jobs{
+						TotalCount: github.Ptr(2),
+						Jobs: []*github.WorkflowJob{
+							{ID: github.Ptr(int64(1)), Name: github.Ptr("lint"), Conclusion: github.Ptr("success")},
+							{ID: github.Ptr(int64(2)), Name: github.Ptr("test"), Conclusion: github.Ptr("failure")},
+						},
+					},
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsJobsLogsByOwnerByRepoByJobId,
+					jobLogsHandler,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"runId":      float64(42),
+				"failedOnly": true,
+			},
+			expected: []JobLog{
+				{ID: 2, Name: "test", Conclusion: "failure", Logs: "setup\nbuild\nFAIL: Test_Thing\n"},
+			},
+		},
+		{
+			name: "run with a skipped job",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsJobsByOwnerByRepoByRunId,
+					&github.Jobs{
+						TotalCount: github.Ptr(2),
+						Jobs: []*github.WorkflowJob{
+							{ID: github.Ptr(int64(1)), Name: github.Ptr("build"), Conclusion: github.Ptr("success")},
+							{ID: github.Ptr(int64(3)), Name: github.Ptr("deploy"), Conclusion: github.Ptr("skipped")},
+						},
+					},
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsJobsLogsByOwnerByRepoByJobId,
+					jobLogsHandler,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"runId":     float64(42),
+				"tailLines": float64(0),
+			},
+			expected: []JobLog{
+				{ID: 1, Name: "build", Conclusion: "success", Logs: "one\ntwo\nthree\n"},
+				{ID: 3, Name: "deploy", Conclusion: "skipped"},
+			},
+		},
+		{
+			name:          "neither job nor run",
+			mockedClient:  mock.NewMockedHTTPClient(),
+			requestArgs:   map[string]interface{}{},
+			expectToolErr: "exactly one of jobId or runId is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient).WithAuthToken("token")
+			_, handler := GetJobLogs(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			tc.requestArgs["owner"] = "owner"
+			tc.requestArgs["repo"] = "repo"
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectToolErr != "" {
+				require.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectToolErr)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			var returned []JobLog
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			for i := range returned {
+				if returned[i].Error != "" {
+					assert.Contains(t, returned[i].Error, "failed to get logs of job")
+					returned[i].Error = ""
+				}
+			}
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
+
+func Test_ListWorkflowArtifacts(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListWorkflowArtifacts(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_workflow_artifacts", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "runId")
+	assert.Contains(t, tool.InputSchema.Properties, "name")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockArtifacts := &github.ArtifactList{
+		TotalCount: github.Ptr(int64(2)),
+		Artifacts: []*github.Artifact{
+			{
+				ID:          github.Ptr(int64(11)),
+				Name:        github.Ptr("test-report"),
+				SizeInBytes: github.Ptr(int64(556)),
+				WorkflowRun: &github.ArtifactWorkflowRun{ID: github.Ptr(int64(42))},
+			},
+			{
+				ID:          github.Ptr(int64(12)),
+				Name:        github.Ptr("binaries"),
+				SizeInBytes: github.Ptr(int64(1024)),
+				Expired:     github.Ptr(true),
+				WorkflowRun: &github.ArtifactWorkflowRun{ID: github.Ptr(int64(42))},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		mockedClient *http.Client
+		requestArgs  map[string]interface{}
+		expected     []ArtifactSummary
+	}{
+		{
+			name: "repository artifacts by name",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsArtifactsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"name":     "test-report",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.ArtifactList{
+							TotalCount: github.Ptr(int64(1)),
+							Artifacts:  mockArtifacts.Artifacts[:1],
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"name": "test-report",
+			},
+			expected: []ArtifactSummary{
+				{ID: 11, Name: "test-report", SizeInBytes: 556, WorkflowRunID: 42},
+			},
+		},
+		{
+			name: "run artifacts",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsArtifactsByOwnerByRepoByRunId,
+					mockArtifacts,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"runId": float64(42),
+			},
+			expected: []ArtifactSummary{
+				{ID: 11, Name: "test-report", SizeInBytes: 556, WorkflowRunID: 42},
+				{ID: 12, Name: "binaries", SizeInBytes: 1024, Expired: true, WorkflowRunID: 42},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListWorkflowArtifacts(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			tc.requestArgs["owner"] = "owner"
+			tc.requestArgs["repo"] = "repo"
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			var returned ListArtifactsOutput
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expected, returned.Artifacts)
+		})
+	}
+}
+
+func Test_DownloadWorkflowArtifact(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := DownloadWorkflowArtifact(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "download_workflow_artifact", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "inline")
+	assert.Contains(t, tool.InputSchema.Properties, "maxBytes")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "artifactId"})
+
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	for _, f := range []struct {
+		name    string
+		content []byte
+	}{
+		{"report/summary.txt", []byte("12 passed, 1 failed\n")},
+		{"report/coverage.bin", []byte{0x00, 0x01, 0x02}},
+		{"report/log.txt", []byte("more output\n")},
+	} {
+		w, err := zw.Create(f.name)
+		require.NoError(t, err)
+		_, _ = w.Write(f.content)
+	}
+	require.NoError(t, zw.Close())
+
+	artifact := &github.Artifact{
+		ID:          github.Ptr(int64(11)),
+		Name:        github.Ptr("test-report"),
+		SizeInBytes: github.Ptr(int64(archive.Len())),
+	}
+	// The archive is served from a signed URL on another host, which must
+	// not be sent the caller's token.
+	archiveServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		if r.URL.Path != "/artifacts/11.zip" {
+			t.Errorf("unexpected archive download %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(archive.Bytes())
+	}))
+	defer archiveServer.Close()
+	archiveURL := archiveServer.URL + "/artifacts/11.zip"
+	mockedClient := func(artifact *github.Artifact) *http.Client {
+		return mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(
+				mock.GetReposActionsArtifactsByOwnerByRepoByArtifactId,
+				artifact,
+			),
+			mock.WithRequestMatchHandler(
+				mock.GetReposActionsArtifactsByOwnerByRepoByArtifactIdByArchiveFormat,
+				http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.Header().Set("Location", archiveURL)
+					w.WriteHeader(http.StatusFound)
+				}),
+			),
+		)
+	}
+
+	tests := []struct {
+		name          string
+		mockedClient  *http.Client
+		requestArgs   map[string]interface{}
+		expectToolErr string
+		expected      ArtifactDownload
+	}{
+		{
+			name:         "download URL",
+			mockedClient: mockedClient(artifact),
+			requestArgs:  map[string]interface{}{},
+			expected: ArtifactDownload{
+				ID:          11,
+				Name:        "test-report",
+				SizeInBytes: int64(archive.Len()),
+				URL:         archiveURL,
+			},
+		},
+		{
+			name:         "inline files",
+			mockedClient: mockedClient(artifact),
+			requestArgs: map[string]interface{}{
+				"inline":   true,
+				"maxBytes": float64(25),
+			},
+			expected: ArtifactDownload{
+				ID:          11,
+				Name:        "test-report",
+				SizeInBytes: int64(archive.Len()),
+				Files: []ArtifactFile{
+					{Name: "report/summary.txt", Size: 20, Encoding: "utf-8", Content: "12 passed, 1 failed\n"},
+					{Name: "report/coverage.bin", Size: 3, Encoding: "base64", Content: "AAEC"},
+					{Name: "report/log.txt", Size: 12, Encoding: "utf-8", Content: "mo", Truncated: true},
+				},
+			},
+		},
+		{
+			name: "expired artifact",
+			mockedClient: mockedClient(&github.Artifact{
+				ID:      github.Ptr(int64(11)),
+				Expired: github.Ptr(true),
+			}),
+			requestArgs:   map[string]interface{}{},
+			expectToolErr: "artifact 11 has expired",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient).WithAuthToken("token")
+			_, handler := DownloadWorkflowArtifact(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			tc.requestArgs["owner"] = "owner"
+			tc.requestArgs["repo"] = "repo"
+			tc.requestArgs["artifactId"] = float64(11)
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectToolErr != "" {
+				require.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectToolErr)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			var returned ArtifactDownload
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
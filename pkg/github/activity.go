@@ -0,0 +1,516 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	ghv4 "github.com/shurcooL/githubv4"
+)
+
+// StarredRepositorySummary is one repository in a list_starred result.
+type StarredRepositorySummary struct {
+	FullName        string     `json:"full_name"`
+	Description     string     `json:"description,omitempty"`
+	Language        string     `json:"language,omitempty"`
+	StargazersCount int        `json:"stargazers_count"`
+	Archived        bool       `json:"archived,omitempty"`
+	StarredAt       *time.Time `json:"starred_at,omitempty"`
+	HTMLURL         string     `json:"html_url"`
+}
+
+// ListStarredOutput is the result of list_starred.
+type ListStarredOutput struct {
+	Repositories []StarredRepositorySummary `json:"repositories"`
+	NextPage     int                        `json:"next_page,omitempty"`
+	HasNextPage  bool                       `json:"has_next_page"`
+}
+
+// PinnedItem is a repository or gist pinned to a profile.
+type PinnedItem struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	URL         string `json:"url"`
+}
+
+// StarRepository creates a tool to star a repository as the authenticated
+// user.
+func StarRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("star_repository",
+			mcp.WithDescription(t("TOOL_STAR_REPOSITORY_DESCRIPTION", "Star a repository as the authenticated user")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return setStarred(ctx, getClient, request, true)
+		}
+}
+
+// UnstarRepository creates a tool to remove the authenticated user's star
+// from a repository.
+func UnstarRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("unstar_repository",
+			mcp.WithDescription(t("TOOL_UNSTAR_REPOSITORY_DESCRIPTION", "Remove the authenticated user's star from a repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return setStarred(ctx, getClient, request, false)
+		}
+}
+
+// setStarred stars or unstars the requested repository.
+func setStarred(ctx context.Context, getClient GetClientFn, request mcp.CallToolRequest, star bool) (*mcp.CallToolResult, error) {
+	owner, err := requiredParam[string](request, "owner")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	repo, err := requiredParam[string](request, "repo")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	client, err := getClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+	}
+	action, done := "star", "Starred"
+	var resp *github.Response
+	if star {
+		resp, err = client.Activity.Star(ctx, owner, repo)
+	} else {
+		action, done = "unstar", "Unstarred"
+		resp, err = client.Activity.Unstar(ctx, owner, repo)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s repository: %w", action, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusNoContent {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+		return mcp.NewToolResultError(fmt.Sprintf("failed to %s repository: %s", action, string(body))), nil
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("%s %s/%s", done, owner, repo)), nil
+}
+
+// ListStarred creates a tool to list the repositories a user has starred.
+func ListStarred(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_starred",
+			mcp.WithDescription(t("TOOL_LIST_STARRED_DESCRIPTION", "List the repositories a user has starred, with when each was starred")),
+			mcp.WithString("username",
+				mcp.Description("Login of the user, defaults to the authenticated user"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort by when the repository was starred (created) or last pushed to (updated), defaults to created"),
+				mcp.Enum("created", "updated"),
+			),
+			mcp.WithString("direction",
+				mcp.Description("Sort direction, defaults to desc"),
+				mcp.Enum("asc", "desc"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			username, err := OptionalParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts := &github.ActivityListStarredOptions{}
+			if opts.Sort, err = OptionalParam[string](request, "sort"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if opts.Direction, err = OptionalParam[string](request, "direction"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts.Page = pagination.page
+			opts.PerPage = pagination.perPage
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			starred, resp, err := client.Activity.ListStarred(ctx, username, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list starred repositories: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list starred repositories: %s", string(body))), nil
+			}
+
+			out := ListStarredOutput{
+				Repositories: make([]StarredRepositorySummary, 0, len(starred)),
+				NextPage:     resp.NextPage,
+				HasNextPage:  resp.NextPage != 0,
+			}
+			for _, s := range starred {
+				repo := s.GetRepository()
+				summary := StarredRepositorySummary{
+					FullName:        repo.GetFullName(),
+					Description:     repo.GetDescription(),
+					Language:        repo.GetLanguage(),
+					StargazersCount: repo.GetStargazersCount(),
+					Archived:        repo.GetArchived(),
+					HTMLURL:         repo.GetHTMLURL(),
+				}
+				if s.StarredAt != nil {
+					summary.StarredAt = &s.StarredAt.Time
+				}
+				out.Repositories = append(out.Repositories, summary)
+			}
+
+			r, err := json.Marshal(out)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// WatchRepository creates a tool to watch a repository, or to ignore its
+// notifications.
+func WatchRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("watch_repository",
+			mcp.WithDescription(t("TOOL_WATCH_REPOSITORY_DESCRIPTION", "Watch a repository to be notified of all its activity, or ignore it to get no notifications at all")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithBoolean("ignore",
+				mcp.Description("Ignore the repository instead, muting even mentions"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ignore, err := OptionalParam[bool](request, "ignore")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			subscription := &github.Subscription{Subscribed: github.Ptr(true)}
+			if ignore {
+				subscription = &github.Subscription{Ignored: github.Ptr(true)}
+			}
+			_, resp, err := client.Activity.SetRepositorySubscription(ctx, owner, repo, subscription)
+			if err != nil {
+				return nil, fmt.Errorf("failed to watch repository: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to watch repository: %s", string(body))), nil
+			}
+
+			if ignore {
+				return mcp.NewToolResultText(fmt.Sprintf("Ignoring %s/%s", owner, repo)), nil
+			}
+			return mcp.NewToolResultText(fmt.Sprintf("Watching %s/%s", owner, repo)), nil
+		}
+}
+
+// UnwatchRepository creates a tool to stop watching or ignoring a
+// repository.
+func UnwatchRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("unwatch_repository",
+			mcp.WithDescription(t("TOOL_UNWATCH_REPOSITORY_DESCRIPTION", "Stop watching or ignoring a repository, going back to notifications only for participation and mentions")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			resp, err := client.Activity.DeleteRepositorySubscription(ctx, owner, repo)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unwatch repository: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to unwatch repository: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Stopped watching %s/%s", owner, repo)), nil
+		}
+}
+
+// ListPinnedItems creates a tool to list the repositories and gists pinned
+// to a profile. GitHub has no API to change profile pins.
+func ListPinnedItems(getGraphQLClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_pinned_items",
+			mcp.WithDescription(t("TOOL_LIST_PINNED_ITEMS_DESCRIPTION", "List the repositories and gists pinned to the profile of a user or organization")),
+			mcp.WithString("login",
+				mcp.Description("Login of the user or organization, defaults to the authenticated user"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			login, err := OptionalParam[string](request, "login")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGraphQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+			type pinnedItems struct {
+				Nodes []struct {
+					Typename   ghv4.String `graphql:"__typename"`
+					Repository struct {
+						NameWithOwner ghv4.String
+						Description   ghv4.String
+						URL           ghv4.String
+					} `graphql:"... on Repository"`
+					Gist struct {
+						Name        ghv4.String
+						Description ghv4.String
+						URL         ghv4.String
+					} `graphql:"... on Gist"`
+				}
+			}
+			var items *pinnedItems
+			if login == "" {
+				var q struct {
+					Viewer struct {
+						PinnedItems pinnedItems `graphql:"pinnedItems(first: 6)"`
+					}
+				}
+				if err := graphQLQuery(ctx, client, "ListViewerPinnedItems", &q, nil); err != nil {
+					return nil, fmt.Errorf("github graphql error: %w", err)
+				}
+				items = &q.Viewer.PinnedItems
+			} else {
+				var q struct {
+					RepositoryOwner *struct {
+						User struct {
+							PinnedItems pinnedItems `graphql:"pinnedItems(first: 6)"`
+						} `graphql:"... on User"`
+						Organization struct {
+							PinnedItems pinnedItems `graphql:"pinnedItems(first: 6)"`
+						} `graphql:"... on Organization"`
+						Typename ghv4.String `graphql:"__typename"`
+					} `graphql:"repositoryOwner(login: $login)"`
+				}
+				vars := map[string]interface{}{
+					"login": ghv4.String(login),
+				}
+				if err := graphQLQuery(ctx, client, "ListPinnedItems", &q, vars); err != nil {
+					return nil, fmt.Errorf("github graphql error: %w", err)
+				}
+				if q.RepositoryOwner == nil {
+					return mcp.NewToolResultError(fmt.Sprintf("user or organization %s not found", login)), nil
+				}
+				items = &q.RepositoryOwner.User.PinnedItems
+				if q.RepositoryOwner.Typename == "Organization" {
+					items = &q.RepositoryOwner.Organization.PinnedItems
+				}
+			}
+
+			out := make([]PinnedItem, 0, len(items.Nodes))
+			for _, n := range items.Nodes {
+				switch n.Typename {
+				case "Repository":
+					out = append(out, PinnedItem{
+						Type:        "repository",
+						Name:        string(n.Repository.NameWithOwner),
+						Description: string(n.Repository.Description),
+						URL:         string(n.Repository.URL),
+					})
+				case "Gist":
+					out = append(out, PinnedItem{
+						Type:        "gist",
+						Name:        string(n.Gist.Name),
+						Description: string(n.Gist.Description),
+						URL:         string(n.Gist.URL),
+					})
+				}
+			}
+
+			r, err := json.Marshal(out)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// PinIssue creates a tool to pin an issue to the top of its repository's
+// issue list.
+func PinIssue(getGraphQLClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return pinIssueTool("pin_issue",
+		t("TOOL_PIN_ISSUE_DESCRIPTION", "Pin an issue to the top of its repository's issue list; a repository can have up to three pinned issues"),
+		getGraphQLClient, true)
+}
+
+// UnpinIssue creates a tool to unpin an issue.
+func UnpinIssue(getGraphQLClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return pinIssueTool("unpin_issue",
+		t("TOOL_UNPIN_ISSUE_DESCRIPTION", "Unpin an issue from its repository's issue list"),
+		getGraphQLClient, false)
+}
+
+// pinIssueTool builds pin_issue and unpin_issue, which differ only in the
+// mutation they run.
+func pinIssueTool(name, description string, getGraphQLClient GetGraphQLClientFn, pin bool) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool(name,
+			mcp.WithDescription(description),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGraphQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+			var q struct {
+				Repository *struct {
+					Issue *struct {
+						ID       ghv4.ID
+						IsPinned ghv4.Boolean
+					} `graphql:"issue(number: $number)"`
+				} `graphql:"repository(owner: $owner, name: $name)"`
+			}
+			vars := map[string]interface{}{
+				"owner":  ghv4.String(owner),
+				"name":   ghv4.String(repo),
+				"number": ghv4.Int(issueNumber),
+			}
+			err = graphQLQuery(ctx, client, "issueNode", &q, vars)
+			if err != nil && !isUnresolvedError(err) {
+				return nil, fmt.Errorf("github graphql error: %w", err)
+			}
+			if q.Repository == nil || q.Repository.Issue == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("issue %s/%s#%d not found", owner, repo, issueNumber)), nil
+			}
+
+			issue := q.Repository.Issue
+			if bool(issue.IsPinned) != pin {
+				if pin {
+					var m struct {
+						PinIssue struct {
+							Issue struct {
+								ID ghv4.ID
+							}
+						} `graphql:"pinIssue(input: $input)"`
+					}
+					if err := graphQLMutate(ctx, client, "PinIssue", &m, ghv4.PinIssueInput{IssueID: issue.ID}, nil); err != nil {
+						return nil, fmt.Errorf("github graphql error: %w", err)
+					}
+				} else {
+					var m struct {
+						UnpinIssue struct {
+							Issue struct {
+								ID ghv4.ID
+							}
+						} `graphql:"unpinIssue(input: $input)"`
+					}
+					if err := graphQLMutate(ctx, client, "UnpinIssue", &m, ghv4.UnpinIssueInput{IssueID: issue.ID}, nil); err != nil {
+						return nil, fmt.Errorf("github graphql error: %w", err)
+					}
+				}
+			}
+
+			if pin {
+				return mcp.NewToolResultText(fmt.Sprintf("Pinned %s/%s#%d", owner, repo, issueNumber)), nil
+			}
+			return mcp.NewToolResultText(fmt.Sprintf("Unpinned %s/%s#%d", owner, repo, issueNumber)), nil
+		}
+}
@@ -0,0 +1,339 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_StarRepository(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	starTool, _ := StarRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	unstarTool, _ := UnstarRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "star_repository", starTool.Name)
+	assert.Equal(t, "unstar_repository", unstarTool.Name)
+	assert.ElementsMatch(t, starTool.InputSchema.Required, []string{"owner", "repo"})
+	assert.ElementsMatch(t, unstarTool.InputSchema.Required, []string{"owner", "repo"})
+
+	noContent := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(mock.PutUserStarredByOwnerByRepo, noContent),
+		mock.WithRequestMatchHandler(mock.DeleteUserStarredByOwnerByRepo, noContent),
+	))
+	args := map[string]interface{}{"owner": "owner", "repo": "repo"}
+
+	_, star := StarRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+	result, err := star(context.Background(), createMCPRequest(args))
+	require.NoError(t, err)
+	assert.Equal(t, "Starred owner/repo", getTextResult(t, result).Text)
+
+	_, unstar := UnstarRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+	result, err = unstar(context.Background(), createMCPRequest(args))
+	require.NoError(t, err)
+	assert.Equal(t, "Unstarred owner/repo", getTextResult(t, result).Text)
+}
+
+func Test_ListStarred(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListStarred(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_starred", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "username")
+	assert.Empty(t, tool.InputSchema.Required)
+
+	starredAt := time.Date(2025, 4, 2, 8, 0, 0, 0, time.UTC)
+	starred := []*github.StarredRepository{
+		{
+			StarredAt: &github.Timestamp{Time: starredAt},
+			Repository: &github.Repository{
+				FullName:        github.Ptr("golang/go"),
+				Description:     github.Ptr("The Go programming language"),
+				Language:        github.Ptr("Go"),
+				StargazersCount: github.Ptr(125000),
+				HTMLURL:         github.Ptr("https://github.com/golang/go"),
+			},
+		},
+	}
+	expected := ListStarredOutput{
+		Repositories: []StarredRepositorySummary{
+			{
+				FullName:        "golang/go",
+				Description:     "The Go programming language",
+				Language:        "Go",
+				StargazersCount: 125000,
+				StarredAt:       &starredAt,
+				HTMLURL:         "https://github.com/golang/go",
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		pattern     mock.EndpointPattern
+		requestArgs map[string]interface{}
+	}{
+		{
+			name:        "authenticated user",
+			pattern:     mock.GetUserStarred,
+			requestArgs: map[string]interface{}{"sort": "created"},
+		},
+		{
+			name:        "other user",
+			pattern:     mock.GetUsersStarredByUsername,
+			requestArgs: map[string]interface{}{"username": "octocat", "sort": "created"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					tc.pattern,
+					expectQueryParams(t, map[string]string{
+						"sort":     "created",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, starred),
+					),
+				),
+			))
+			_, handler := ListStarred(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			var returned ListStarredOutput
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, expected, returned)
+		})
+	}
+}
+
+func Test_WatchRepository(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := WatchRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "watch_repository", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "ignore")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name         string
+		requestArgs  map[string]interface{}
+		expectedBody map[string]interface{}
+		expectedText string
+	}{
+		{
+			name:         "watch",
+			requestArgs:  map[string]interface{}{},
+			expectedBody: map[string]interface{}{"subscribed": true},
+			expectedText: "Watching owner/repo",
+		},
+		{
+			name:         "ignore",
+			requestArgs:  map[string]interface{}{"ignore": true},
+			expectedBody: map[string]interface{}{"ignored": true},
+			expectedText: "Ignoring owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposSubscriptionByOwnerByRepo,
+					expectRequestBody(t, tc.expectedBody).andThen(
+						mockResponse(t, http.StatusOK, &github.Subscription{Subscribed: github.Ptr(true)}),
+					),
+				),
+			))
+			_, handler := WatchRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			tc.requestArgs["owner"] = "owner"
+			tc.requestArgs["repo"] = "repo"
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
+
+func Test_UnwatchRepository(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := UnwatchRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "unwatch_repository", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.DeleteReposSubscriptionByOwnerByRepo,
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		),
+	))
+	_, handler := UnwatchRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Equal(t, "Stopped watching owner/repo", getTextResult(t, result).Text)
+}
+
+func Test_ListPinnedItems(t *testing.T) {
+	tool, _ := ListPinnedItems(stubGetGraphQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_pinned_items", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "login")
+	assert.Empty(t, tool.InputSchema.Required)
+
+	nodes := `[
+		{"__typename":"Repository","nameWithOwner":"acme/widgets","description":"Widget toolkit","url":"https://github.com/acme/widgets"},
+		{"__typename":"Gist","name":"0f1e2d","description":"dotfiles","url":"https://gist.github.com/acme/0f1e2d"}
+	]`
+	expected := []PinnedItem{
+		{Type: "repository", Name: "acme/widgets", Description: "Widget toolkit", URL: "https://github.com/acme/widgets"},
+		{Type: "gist", Name: "0f1e2d", Description: "dotfiles", URL: "https://gist.github.com/acme/0f1e2d"},
+	}
+
+	tests := []struct {
+		name          string
+		requestArgs   map[string]interface{}
+		expectQuery   string
+		response      string
+		expectToolErr bool
+		expectedText  string
+	}{
+		{
+			name:        "authenticated user",
+			requestArgs: map[string]interface{}{},
+			expectQuery: "viewer{pinnedItems(first: 6)",
+			response:    `{"data":{"viewer":{"pinnedItems":{"nodes":` + nodes + `}}}}`,
+		},
+		{
+			name:        "organization",
+			requestArgs: map[string]interface{}{"login": "acme"},
+			expectQuery: "repositoryOwner(login: $login)",
+			response:    `{"data":{"repositoryOwner":{"__typename":"Organization","pinnedItems":{"nodes":` + nodes + `}}}}`,
+		},
+		{
+			name:          "unknown login",
+			requestArgs:   map[string]interface{}{"login": "nobody"},
+			expectQuery:   "repositoryOwner(login: $login)",
+			response:      `{"data":{"repositoryOwner":null}}`,
+			expectToolErr: true,
+			expectedText:  "user or organization nobody not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query, _ := decodeGraphQLRequest(t, r)
+				assert.Contains(t, query, tc.expectQuery)
+				_, _ = w.Write([]byte(tc.response))
+			}))
+			defer server.Close()
+			_, handler := ListPinnedItems(stubGetGraphQLClientFn(githubv4.NewEnterpriseClient(server.URL, server.Client())), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			require.Equal(t, tc.expectToolErr, result.IsError)
+			if tc.expectToolErr {
+				assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+				return
+			}
+
+			var returned []PinnedItem
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, expected, returned)
+		})
+	}
+}
+
+func Test_PinIssue(t *testing.T) {
+	tool, _ := PinIssue(stubGetGraphQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+
+	assert.Equal(t, "pin_issue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
+
+	tests := []struct {
+		name           string
+		pin            bool
+		isPinned       bool
+		expectMutation string
+		expectedText   string
+	}{
+		{name: "pin", pin: true, expectMutation: "pinIssue(input: $input)", expectedText: "Pinned owner/repo#42"},
+		{name: "already pinned", pin: true, isPinned: true, expectedText: "Pinned owner/repo#42"},
+		{name: "unpin", isPinned: true, expectMutation: "unpinIssue(input: $input)", expectedText: "Unpinned owner/repo#42"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var mutations []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query, vars := decodeGraphQLRequest(t, r)
+				if vars["input"] != nil {
+					mutations = append(mutations, query)
+					assert.Equal(t, map[string]interface{}{"issueId": "I_kwDOA"}, vars["input"])
+					field := "unpinIssue"
+					if tc.pin {
+						field = "pinIssue"
+					}
+					_, _ = w.Write([]byte(`{"data":{"` + field + `":{"issue":{"id":"I_kwDOA"}}}}`))
+					return
+				}
+				assert.Equal(t, float64(42), vars["number"])
+				pinned, _ := json.Marshal(tc.isPinned)
+				_, _ = w.Write([]byte(`{"data":{"repository":{"issue":{"id":"I_kwDOA","isPinned":` + string(pinned) + `}}}}`))
+			}))
+			defer server.Close()
+			gqlClient := stubGetGraphQLClientFn(githubv4.NewEnterpriseClient(server.URL, server.Client()))
+
+			_, handler := UnpinIssue(gqlClient, translations.NullTranslationHelper)
+			if tc.pin {
+				_, handler = PinIssue(gqlClient, translations.NullTranslationHelper)
+			}
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			}))
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+			if tc.expectMutation == "" {
+				assert.Empty(t, mutations)
+				return
+			}
+			require.Len(t, mutations, 1)
+			assert.Contains(t, mutations[0], tc.expectMutation)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+type tokenContextKey struct{}
+
+// ContextWithToken returns a copy of ctx that carries the GitHub token of the
+// user making the current request. Hosted deployments use it to act as each
+// caller instead of with one server-wide token.
+func ContextWithToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, tokenContextKey{}, token)
+}
+
+// TokenFromContext returns the token stored by ContextWithToken, if any.
+func TokenFromContext(ctx context.Context) (string, bool) {
+	token, ok := ctx.Value(tokenContextKey{}).(string)
+	return token, ok && token != ""
+}
+
+// TokenFromRequest extracts a GitHub token from the Authorization header of
+// r. Both the "Bearer" and the GitHub-specific "token" schemes are accepted;
+// an empty string means the request carried no token.
+func TokenFromRequest(r *http.Request) string {
+	scheme, token, found := strings.Cut(strings.TrimSpace(r.Header.Get("Authorization")), " ")
+	if !found {
+		return ""
+	}
+	if !strings.EqualFold(scheme, "bearer") && !strings.EqualFold(scheme, "token") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+// RequireToken wraps next so that requests without a GitHub token in their
+// Authorization header are refused with 401, instead of falling back to a
+// token of the server's.
+func RequireToken(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if TokenFromRequest(r) == "" {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="github-mcp-server"`)
+			http.Error(w, "a GitHub token is required in the Authorization header", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,64 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_TokenFromContext(t *testing.T) {
+	_, ok := TokenFromContext(context.Background())
+	assert.False(t, ok)
+
+	_, ok = TokenFromContext(ContextWithToken(context.Background(), ""))
+	assert.False(t, ok)
+
+	token, ok := TokenFromContext(ContextWithToken(context.Background(), "ghp_user"))
+	assert.True(t, ok)
+	assert.Equal(t, "ghp_user", token)
+}
+
+func Test_TokenFromRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		expected string
+	}{
+		{name: "bearer", header: "Bearer ghp_user", expected: "ghp_user"},
+		{name: "token scheme", header: "token ghp_user", expected: "ghp_user"},
+		{name: "scheme is case insensitive", header: "bearer ghp_user", expected: "ghp_user"},
+		{name: "missing header", header: "", expected: ""},
+		{name: "no scheme", header: "ghp_user", expected: ""},
+		{name: "basic auth", header: "Basic dXNlcjpwYXNz", expected: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/message", nil)
+			if tc.header != "" {
+				r.Header.Set("Authorization", tc.header)
+			}
+			assert.Equal(t, tc.expected, TokenFromRequest(r))
+		})
+	}
+}
+
+func Test_RequireToken(t *testing.T) {
+	handler := RequireToken(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	r := httptest.NewRequest("POST", "/mcp", nil)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Equal(t, `Bearer realm="github-mcp-server"`, w.Header().Get("WWW-Authenticate"))
+
+	r.Header.Set("Authorization", "Bearer ghp_user")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
@@ -0,0 +1,779 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ActorSet lists the users, teams and apps named in a protection setting.
+type ActorSet struct {
+	Users []string `json:"users"`
+	Teams []string `json:"teams"`
+	Apps  []string `json:"apps"`
+}
+
+func actorSet(users []*github.User, teams []*github.Team, apps []*github.App) *ActorSet {
+	set := &ActorSet{Users: []string{}, Teams: []string{}, Apps: []string{}}
+	for _, u := range users {
+		set.Users = append(set.Users, u.GetLogin())
+	}
+	for _, t := range teams {
+		set.Teams = append(set.Teams, t.GetSlug())
+	}
+	for _, a := range apps {
+		set.Apps = append(set.Apps, a.GetSlug())
+	}
+	return set
+}
+
+// BranchProtectionSettings is a flattened view of a branch's protection,
+// returned by get_branch_protection and update_branch_protection.
+type BranchProtectionSettings struct {
+	Branch                        string    `json:"branch"`
+	Protected                     bool      `json:"protected"`
+	RequiredStatusChecks          []string  `json:"required_status_checks"`
+	StrictStatusChecks            bool      `json:"strict_status_checks"`
+	RequirePullRequest            bool      `json:"require_pull_request"`
+	RequiredApprovingReviewCount  int       `json:"required_approving_review_count"`
+	DismissStaleReviews           bool      `json:"dismiss_stale_reviews"`
+	RequireCodeOwnerReviews       bool      `json:"require_code_owner_reviews"`
+	RequireLastPushApproval       bool      `json:"require_last_push_approval"`
+	DismissalRestrictions         *ActorSet `json:"dismissal_restrictions,omitempty"`
+	BypassPullRequestAllowances   *ActorSet `json:"bypass_pull_request_allowances,omitempty"`
+	PushRestrictions              *ActorSet `json:"push_restrictions,omitempty"`
+	BlockCreations                bool      `json:"block_creations"`
+	EnforceAdmins                 bool      `json:"enforce_admins"`
+	RequireLinearHistory          bool      `json:"require_linear_history"`
+	AllowForcePushes              bool      `json:"allow_force_pushes"`
+	AllowDeletions                bool      `json:"allow_deletions"`
+	RequireConversationResolution bool      `json:"require_conversation_resolution"`
+	LockBranch                    bool      `json:"lock_branch"`
+
+	// checks keeps the app each status check is pinned to, so that writing
+	// the settings back does not drop it.
+	checks []*github.RequiredStatusCheck
+}
+
+func branchProtectionSettings(branch string, p *github.Protection) *BranchProtectionSettings {
+	s := &BranchProtectionSettings{Branch: branch, Protected: p != nil, RequiredStatusChecks: []string{}}
+	if p == nil {
+		return s
+	}
+	if c := p.RequiredStatusChecks; c != nil {
+		s.StrictStatusChecks = c.Strict
+		if c.Checks != nil {
+			s.checks = *c.Checks
+		} else if c.Contexts != nil {
+			for _, context := range *c.Contexts {
+				s.checks = append(s.checks, &github.RequiredStatusCheck{Context: context})
+			}
+		}
+		for _, check := range s.checks {
+			s.RequiredStatusChecks = append(s.RequiredStatusChecks, check.Context)
+		}
+	}
+	if r := p.RequiredPullRequestReviews; r != nil {
+		s.RequirePullRequest = true
+		s.RequiredApprovingReviewCount = r.RequiredApprovingReviewCount
+		s.DismissStaleReviews = r.DismissStaleReviews
+		s.RequireCodeOwnerReviews = r.RequireCodeOwnerReviews
+		s.RequireLastPushApproval = r.RequireLastPushApproval
+		if d := r.DismissalRestrictions; d != nil {
+			s.DismissalRestrictions = actorSet(d.Users, d.Teams, d.Apps)
+		}
+		if b := r.BypassPullRequestAllowances; b != nil {
+			s.BypassPullRequestAllowances = actorSet(b.Users, b.Teams, b.Apps)
+		}
+	}
+	if r := p.Restrictions; r != nil {
+		s.PushRestrictions = actorSet(r.Users, r.Teams, r.Apps)
+	}
+	s.BlockCreations = p.GetBlockCreations().GetEnabled()
+	s.EnforceAdmins = p.EnforceAdmins != nil && p.EnforceAdmins.Enabled
+	s.RequireLinearHistory = p.RequireLinearHistory != nil && p.RequireLinearHistory.Enabled
+	s.AllowForcePushes = p.AllowForcePushes != nil && p.AllowForcePushes.Enabled
+	s.AllowDeletions = p.AllowDeletions != nil && p.AllowDeletions.Enabled
+	s.RequireConversationResolution = p.RequiredConversationResolution != nil && p.RequiredConversationResolution.Enabled
+	s.LockBranch = p.GetLockBranch().GetEnabled()
+	return s
+}
+
+// setRequiredStatusChecks replaces the required checks, keeping the app
+// pinning of checks that stay required.
+func (s *BranchProtectionSettings) setRequiredStatusChecks(contexts []string) {
+	pinned := make(map[string]*github.RequiredStatusCheck, len(s.checks))
+	for _, check := range s.checks {
+		pinned[check.Context] = check
+	}
+	s.RequiredStatusChecks = contexts
+	s.checks = nil
+	for _, context := range contexts {
+		check, ok := pinned[context]
+		if !ok {
+			check = &github.RequiredStatusCheck{Context: context}
+		}
+		s.checks = append(s.checks, check)
+	}
+}
+
+// request builds the full protection payload; the API replaces every
+// setting on update, so nothing may be left out.
+func (s *BranchProtectionSettings) request() *github.ProtectionRequest {
+	req := &github.ProtectionRequest{
+		EnforceAdmins:                  s.EnforceAdmins,
+		RequireLinearHistory:           github.Ptr(s.RequireLinearHistory),
+		AllowForcePushes:               github.Ptr(s.AllowForcePushes),
+		AllowDeletions:                 github.Ptr(s.AllowDeletions),
+		RequiredConversationResolution: github.Ptr(s.RequireConversationResolution),
+		LockBranch:                     github.Ptr(s.LockBranch),
+		BlockCreations:                 github.Ptr(s.BlockCreations),
+	}
+	if len(s.checks) > 0 || s.StrictStatusChecks {
+		checks := s.checks
+		if checks == nil {
+			checks = []*github.RequiredStatusCheck{}
+		}
+		req.RequiredStatusChecks = &github.RequiredStatusChecks{
+			Strict: s.StrictStatusChecks,
+			Checks: &checks,
+		}
+	}
+	if s.RequirePullRequest {
+		reviews := &github.PullRequestReviewsEnforcementRequest{
+			DismissStaleReviews:          s.DismissStaleReviews,
+			RequireCodeOwnerReviews:      s.RequireCodeOwnerReviews,
+			RequiredApprovingReviewCount: s.RequiredApprovingReviewCount,
+			RequireLastPushApproval:      github.Ptr(s.RequireLastPushApproval),
+		}
+		if d := s.DismissalRestrictions; d != nil {
+			reviews.DismissalRestrictionsRequest = &github.DismissalRestrictionsRequest{
+				Users: &d.Users,
+				Teams: &d.Teams,
+				Apps:  &d.Apps,
+			}
+		}
+		if b := s.BypassPullRequestAllowances; b != nil {
+			reviews.BypassPullRequestAllowancesRequest = &github.BypassPullRequestAllowancesRequest{
+				Users: b.Users,
+				Teams: b.Teams,
+				Apps:  b.Apps,
+			}
+		}
+		req.RequiredPullRequestReviews = reviews
+	}
+	if r := s.PushRestrictions; r != nil {
+		req.Restrictions = &github.BranchRestrictionsRequest{
+			Users: r.Users,
+			Teams: r.Teams,
+			Apps:  r.Apps,
+		}
+	}
+	return req
+}
+
+// getBranchProtection returns the protection of branch, or nil when the
+// branch is not protected.
+func getBranchProtection(ctx context.Context, client *github.Client, owner, repo, branch string) (*github.Protection, error) {
+	protection, resp, err := client.Repositories.GetBranchProtection(ctx, owner, repo, branch)
+	if resp != nil {
+		defer func() { _ = resp.Body.Close() }()
+	}
+	if errors.Is(err, github.ErrBranchNotProtected) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return protection, nil
+}
+
+// withBranchParams adds the owner, repo and branch parameters.
+func withBranchParams() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner"),
+		)(tool)
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		)(tool)
+		mcp.WithString("branch",
+			mcp.Required(),
+			mcp.Description("Branch name"),
+		)(tool)
+	}
+}
+
+// GetBranchProtection creates a tool to get the protection rules of a branch.
+func GetBranchProtection(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_branch_protection",
+			mcp.WithDescription(t("TOOL_GET_BRANCH_PROTECTION_DESCRIPTION", "Get the protection rules of a branch: required checks, required reviews, bypass allowances and push restrictions")),
+			withBranchParams(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := requiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			protection, err := getBranchProtection(ctx, client, owner, repo, branch)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get branch protection: %w", err)
+			}
+
+			r, err := json.Marshal(branchProtectionSettings(branch, protection))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// UpdateBranchProtection creates a tool to change the protection rules of a
+// branch. Settings that are not given keep their current values.
+func UpdateBranchProtection(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_branch_protection",
+			mcp.WithDescription(t("TOOL_UPDATE_BRANCH_PROTECTION_DESCRIPTION", "Update the protection rules of a branch, protecting it if needed. Settings that are not given keep their current values")),
+			withBranchParams(),
+			mcp.WithArray("requiredStatusChecks",
+				mcp.Description("Status check contexts that must pass before merging; an empty list requires none"),
+				mcp.Items(map[string]interface{}{"type": "string"}),
+			),
+			mcp.WithBoolean("strictStatusChecks",
+				mcp.Description("Require branches to be up to date before merging"),
+			),
+			mcp.WithBoolean("requirePullRequest",
+				mcp.Description("Require a pull request before merging"),
+			),
+			mcp.WithNumber("requiredApprovingReviewCount",
+				mcp.Description("Number of approvals required, 0 to 6; implies requirePullRequest"),
+			),
+			mcp.WithBoolean("dismissStaleReviews",
+				mcp.Description("Dismiss approvals when new commits are pushed"),
+			),
+			mcp.WithBoolean("requireCodeOwnerReviews",
+				mcp.Description("Require review from code owners"),
+			),
+			mcp.WithBoolean("requireLastPushApproval",
+				mcp.Description("Require approval of the most recent push by someone other than its author"),
+			),
+			mcp.WithArray("bypassPullRequestUsers",
+				mcp.Description("Logins of users allowed to bypass the pull request requirement"),
+				mcp.Items(map[string]interface{}{"type": "string"}),
+			),
+			mcp.WithArray("bypassPullRequestTeams",
+				mcp.Description("Slugs of teams allowed to bypass the pull request requirement"),
+				mcp.Items(map[string]interface{}{"type": "string"}),
+			),
+			mcp.WithBoolean("enforceAdmins",
+				mcp.Description("Apply the rules to repository administrators too"),
+			),
+			mcp.WithBoolean("requireLinearHistory",
+				mcp.Description("Prevent merge commits from being pushed"),
+			),
+			mcp.WithBoolean("allowForcePushes",
+				mcp.Description("Allow force pushes"),
+			),
+			mcp.WithBoolean("allowDeletions",
+				mcp.Description("Allow the branch to be deleted"),
+			),
+			mcp.WithBoolean("requireConversationResolution",
+				mcp.Description("Require review conversations to be resolved before merging"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := requiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			current, err := getBranchProtection(ctx, client, owner, repo, branch)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get branch protection: %w", err)
+			}
+			settings := branchProtectionSettings(branch, current)
+
+			if _, ok := request.GetArguments()["requiredStatusChecks"]; ok {
+				contexts, err := OptionalStringArrayParam(request, "requiredStatusChecks")
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				settings.setRequiredStatusChecks(contexts)
+			}
+			for param, field := range map[string]*bool{
+				"strictStatusChecks":            &settings.StrictStatusChecks,
+				"requirePullRequest":            &settings.RequirePullRequest,
+				"dismissStaleReviews":           &settings.DismissStaleReviews,
+				"requireCodeOwnerReviews":       &settings.RequireCodeOwnerReviews,
+				"requireLastPushApproval":       &settings.RequireLastPushApproval,
+				"enforceAdmins":                 &settings.EnforceAdmins,
+				"requireLinearHistory":          &settings.RequireLinearHistory,
+				"allowForcePushes":              &settings.AllowForcePushes,
+				"allowDeletions":                &settings.AllowDeletions,
+				"requireConversationResolution": &settings.RequireConversationResolution,
+			} {
+				value, ok, err := OptionalParamOK[bool](request, param)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if ok {
+					*field = value
+				}
+			}
+			if _, ok := request.GetArguments()["requiredApprovingReviewCount"]; ok {
+				count, err := OptionalIntParam(request, "requiredApprovingReviewCount")
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if count < 0 || count > 6 {
+					return mcp.NewToolResultError("requiredApprovingReviewCount must be between 0 and 6"), nil
+				}
+				settings.RequiredApprovingReviewCount = count
+				settings.RequirePullRequest = true
+			}
+			_, bypassUsers := request.GetArguments()["bypassPullRequestUsers"]
+			_, bypassTeams := request.GetArguments()["bypassPullRequestTeams"]
+			if bypassUsers || bypassTeams {
+				if settings.BypassPullRequestAllowances == nil {
+					settings.BypassPullRequestAllowances = &ActorSet{Users: []string{}, Teams: []string{}, Apps: []string{}}
+				}
+				if bypassUsers {
+					if settings.BypassPullRequestAllowances.Users, err = OptionalStringArrayParam(request, "bypassPullRequestUsers"); err != nil {
+						return mcp.NewToolResultError(err.Error()), nil
+					}
+				}
+				if bypassTeams {
+					if settings.BypassPullRequestAllowances.Teams, err = OptionalStringArrayParam(request, "bypassPullRequestTeams"); err != nil {
+						return mcp.NewToolResultError(err.Error()), nil
+					}
+				}
+			}
+
+			updated, resp, err := client.Repositories.UpdateBranchProtection(ctx, owner, repo, branch, settings.request())
+			if err != nil {
+				return nil, fmt.Errorf("failed to update branch protection: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to update branch protection: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(branchProtectionSettings(branch, updated))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// RulesetSummary is one ruleset in a list_repository_rulesets result.
+type RulesetSummary struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Target      string `json:"target,omitempty"`
+	Enforcement string `json:"enforcement"`
+	Source      string `json:"source"`
+	SourceType  string `json:"source_type,omitempty"`
+}
+
+// ListRepositoryRulesets creates a tool to list the rulesets that apply to a repository.
+func ListRepositoryRulesets(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repository_rulesets",
+			mcp.WithDescription(t("TOOL_LIST_REPOSITORY_RULESETS_DESCRIPTION", "List the rulesets of a repository. Use get_repository_ruleset for a ruleset's rules and bypass actors")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithBoolean("includesParents",
+				mcp.Description("Include rulesets inherited from the organization"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includesParents, err := OptionalParam[bool](request, "includesParents")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			rulesets, resp, err := client.Repositories.GetAllRulesets(ctx, owner, repo, includesParents)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list rulesets: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list rulesets: %s", string(body))), nil
+			}
+
+			out := make([]RulesetSummary, 0, len(rulesets))
+			for _, rs := range rulesets {
+				summary := RulesetSummary{
+					ID:          rs.GetID(),
+					Name:        rs.Name,
+					Enforcement: string(rs.Enforcement),
+					Source:      rs.Source,
+				}
+				if rs.Target != nil {
+					summary.Target = string(*rs.Target)
+				}
+				if rs.SourceType != nil {
+					summary.SourceType = string(*rs.SourceType)
+				}
+				out = append(out, summary)
+			}
+
+			r, err := json.Marshal(out)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetRepositoryRuleset creates a tool to get a ruleset with its rules and bypass actors.
+func GetRepositoryRuleset(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_ruleset",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_RULESET_DESCRIPTION", "Get a repository ruleset with its conditions, rules and bypass actors")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("rulesetId",
+				mcp.Required(),
+				mcp.Description("Ruleset ID"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			rulesetID, err := RequiredInt(request, "rulesetId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			ruleset, resp, err := client.Repositories.GetRuleset(ctx, owner, repo, int64(rulesetID), true)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get ruleset: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get ruleset: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(ruleset)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// bypassActorsParam reads the bypassActors parameter, an array of
+// {actorId, actorType, bypassMode} objects.
+func bypassActorsParam(request mcp.CallToolRequest) ([]*github.BypassActor, error) {
+	raw, ok := request.GetArguments()["bypassActors"].([]interface{})
+	if !ok {
+		return nil, errors.New("bypassActors must be an array of objects with actorId, actorType and bypassMode")
+	}
+	actors := make([]*github.BypassActor, 0, len(raw))
+	for _, item := range raw {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("each bypass actor must be an object with actorId, actorType and bypassMode")
+		}
+		actorType, _ := m["actorType"].(string)
+		if actorType == "" {
+			return nil, errors.New("each bypass actor must have an actorType")
+		}
+		actor := &github.BypassActor{
+			ActorType:  github.Ptr(github.BypassActorType(actorType)),
+			BypassMode: github.Ptr(github.BypassModeAlways),
+		}
+		// Organization admins are identified by type alone.
+		if id, ok := m["actorId"].(float64); ok {
+			actor.ActorID = github.Ptr(int64(id))
+		} else if actorType != string(github.BypassActorTypeOrganizationAdmin) {
+			return nil, fmt.Errorf("bypass actor of type %s must have an actorId", actorType)
+		}
+		if mode, ok := m["bypassMode"].(string); ok && mode != "" {
+			actor.BypassMode = github.Ptr(github.BypassMode(mode))
+		}
+		actors = append(actors, actor)
+	}
+	return actors, nil
+}
+
+// UpdateRepositoryRuleset creates a tool to change a ruleset's enforcement,
+// required checks, required reviews and bypass actors.
+func UpdateRepositoryRuleset(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_repository_ruleset",
+			mcp.WithDescription(t("TOOL_UPDATE_REPOSITORY_RULESET_DESCRIPTION", "Update a repository ruleset's enforcement, required status checks, pull request rule and bypass actors. Settings that are not given keep their current values")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("rulesetId",
+				mcp.Required(),
+				mcp.Description("Ruleset ID"),
+			),
+			mcp.WithString("enforcement",
+				mcp.Description("Enforcement status"),
+				mcp.Enum("active", "evaluate", "disabled"),
+			),
+			mcp.WithArray("requiredStatusChecks",
+				mcp.Description("Status check contexts that must pass; an empty list removes the required status checks rule"),
+				mcp.Items(map[string]interface{}{"type": "string"}),
+			),
+			mcp.WithBoolean("strictStatusChecks",
+				mcp.Description("Require branches to be up to date before merging"),
+			),
+			mcp.WithNumber("requiredApprovingReviewCount",
+				mcp.Description("Number of approvals the pull request rule requires, 0 to 10; adds the rule if missing"),
+			),
+			mcp.WithBoolean("dismissStaleReviewsOnPush",
+				mcp.Description("Dismiss approvals when new commits are pushed"),
+			),
+			mcp.WithBoolean("requireCodeOwnerReview",
+				mcp.Description("Require review from code owners"),
+			),
+			mcp.WithArray("bypassActors",
+				mcp.Description("Actors allowed to bypass the ruleset, replacing the current list; an empty list removes them all"),
+				mcp.Items(
+					map[string]interface{}{
+						"type":                 "object",
+						"additionalProperties": false,
+						"required":             []string{"actorType"},
+						"properties": map[string]interface{}{
+							"actorId": map[string]interface{}{
+								"type":        "number",
+								"description": "ID of the team, app, repository role or deploy key; omitted for OrganizationAdmin",
+							},
+							"actorType": map[string]interface{}{
+								"type": "string",
+								"enum": []string{"Integration", "OrganizationAdmin", "RepositoryRole", "Team", "DeployKey"},
+							},
+							"bypassMode": map[string]interface{}{
+								"type":        "string",
+								"enum":        []string{"always", "pull_request"},
+								"description": "Defaults to always",
+							},
+						},
+					}),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			rulesetID, err := RequiredInt(request, "rulesetId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			var bypassActors []*github.BypassActor
+			_, setBypassActors := request.GetArguments()["bypassActors"]
+			if setBypassActors {
+				if bypassActors, err = bypassActorsParam(request); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			ruleset, resp, err := client.Repositories.GetRuleset(ctx, owner, repo, int64(rulesetID), false)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get ruleset: %w", err)
+			}
+			_ = resp.Body.Close()
+
+			if ruleset.Rules == nil {
+				ruleset.Rules = &github.RepositoryRulesetRules{}
+			}
+			if enforcement, err := OptionalParam[string](request, "enforcement"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if enforcement != "" {
+				ruleset.Enforcement = github.RulesetEnforcement(enforcement)
+			}
+
+			if _, ok := request.GetArguments()["requiredStatusChecks"]; ok {
+				contexts, err := OptionalStringArrayParam(request, "requiredStatusChecks")
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if len(contexts) == 0 {
+					ruleset.Rules.RequiredStatusChecks = nil
+				} else {
+					if ruleset.Rules.RequiredStatusChecks == nil {
+						ruleset.Rules.RequiredStatusChecks = &github.RequiredStatusChecksRuleParameters{}
+					}
+					checks := make([]*github.RuleStatusCheck, 0, len(contexts))
+					for _, context := range contexts {
+						checks = append(checks, &github.RuleStatusCheck{Context: context})
+					}
+					ruleset.Rules.RequiredStatusChecks.RequiredStatusChecks = checks
+				}
+			}
+			if strict, ok, err := OptionalParamOK[bool](request, "strictStatusChecks"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				if ruleset.Rules.RequiredStatusChecks == nil {
+					return mcp.NewToolResultError("strictStatusChecks needs a required status checks rule; pass requiredStatusChecks too"), nil
+				}
+				ruleset.Rules.RequiredStatusChecks.StrictRequiredStatusChecksPolicy = strict
+			}
+
+			pullRequest := func() *github.PullRequestRuleParameters {
+				if ruleset.Rules.PullRequest == nil {
+					ruleset.Rules.PullRequest = &github.PullRequestRuleParameters{
+						AllowedMergeMethods: []github.MergeMethod{github.MergeMethodMerge, github.MergeMethodSquash, github.MergeMethodRebase},
+					}
+				}
+				return ruleset.Rules.PullRequest
+			}
+			if _, ok := request.GetArguments()["requiredApprovingReviewCount"]; ok {
+				count, err := OptionalIntParam(request, "requiredApprovingReviewCount")
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if count < 0 || count > 10 {
+					return mcp.NewToolResultError("requiredApprovingReviewCount must be between 0 and 10"), nil
+				}
+				pullRequest().RequiredApprovingReviewCount = count
+			}
+			if dismiss, ok, err := OptionalParamOK[bool](request, "dismissStaleReviewsOnPush"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				pullRequest().DismissStaleReviewsOnPush = dismiss
+			}
+			if codeOwners, ok, err := OptionalParamOK[bool](request, "requireCodeOwnerReview"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				pullRequest().RequireCodeOwnerReview = codeOwners
+			}
+
+			if setBypassActors {
+				ruleset.BypassActors = bypassActors
+				// An empty list is dropped when marshalled, so clear the
+				// actors explicitly before writing the rest.
+				if len(bypassActors) == 0 {
+					resp, err := client.Repositories.UpdateRulesetClearBypassActor(ctx, owner, repo, int64(rulesetID))
+					if err != nil {
+						return nil, fmt.Errorf("failed to clear bypass actors: %w", err)
+					}
+					_ = resp.Body.Close()
+				}
+			}
+
+			updated, resp, err := client.Repositories.UpdateRuleset(ctx, owner, repo, int64(rulesetID), *ruleset)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update ruleset: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to update ruleset: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(updated)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,487 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var mockProtection = &github.Protection{
+	RequiredStatusChecks: &github.RequiredStatusChecks{
+		Strict: true,
+		Checks: &[]*github.RequiredStatusCheck{
+			{Context: "ci/build", AppID: github.Ptr(int64(15368))},
+			{Context: "ci/lint"},
+		},
+	},
+	RequiredPullRequestReviews: &github.PullRequestReviewsEnforcement{
+		RequiredApprovingReviewCount: 1,
+		DismissStaleReviews:          true,
+		BypassPullRequestAllowances: &github.BypassPullRequestAllowances{
+			Users: []*github.User{{Login: github.Ptr("octocat")}},
+			Teams: []*github.Team{{Slug: github.Ptr("release")}},
+		},
+	},
+	EnforceAdmins:        &github.AdminEnforcement{Enabled: true},
+	RequireLinearHistory: &github.RequireLinearHistory{Enabled: true},
+}
+
+func Test_GetBranchProtection(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := GetBranchProtection(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_branch_protection", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+
+	tests := []struct {
+		name         string
+		mockedClient *http.Client
+		expected     BranchProtectionSettings
+	}{
+		{
+			name: "protected branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					mockProtection,
+				),
+			),
+			expected: BranchProtectionSettings{
+				Branch:                       "main",
+				Protected:                    true,
+				RequiredStatusChecks:         []string{"ci/build", "ci/lint"},
+				StrictStatusChecks:           true,
+				RequirePullRequest:           true,
+				RequiredApprovingReviewCount: 1,
+				DismissStaleReviews:          true,
+				BypassPullRequestAllowances:  &ActorSet{Users: []string{"octocat"}, Teams: []string{"release"}, Apps: []string{}},
+				EnforceAdmins:                true,
+				RequireLinearHistory:         true,
+			},
+		},
+		{
+			name: "unprotected branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Branch not protected"}),
+				),
+			),
+			expected: BranchProtectionSettings{
+				Branch:               "main",
+				RequiredStatusChecks: []string{},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetBranchProtection(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+			}))
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			var returned BranchProtectionSettings
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
+
+func Test_UpdateBranchProtection(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateBranchProtection(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "update_branch_protection", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "requiredStatusChecks")
+	assert.Contains(t, tool.InputSchema.Properties, "requiredApprovingReviewCount")
+	assert.Contains(t, tool.InputSchema.Properties, "bypassPullRequestUsers")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+
+	t.Run("keeps settings that are not given", func(t *testing.T) {
+		var sent github.ProtectionRequest
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(
+				mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+				mockProtection,
+			),
+			mock.WithRequestMatchHandler(
+				mock.PutReposBranchesProtectionByOwnerByRepoByBranch,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&sent))
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(mockProtection)
+				}),
+			),
+		))
+		_, handler := UpdateBranchProtection(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner":                        "owner",
+			"repo":                         "repo",
+			"branch":                       "main",
+			"requiredStatusChecks":         []interface{}{"ci/build", "ci/test"},
+			"requiredApprovingReviewCount": float64(2),
+			"allowForcePushes":             false,
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		require.NotNil(t, sent.RequiredStatusChecks)
+		assert.True(t, sent.RequiredStatusChecks.Strict)
+		assert.Equal(t, []*github.RequiredStatusCheck{
+			{Context: "ci/build", AppID: github.Ptr(int64(15368))},
+			{Context: "ci/test"},
+		}, *sent.RequiredStatusChecks.Checks)
+		require.NotNil(t, sent.RequiredPullRequestReviews)
+		assert.Equal(t, 2, sent.RequiredPullRequestReviews.RequiredApprovingReviewCount)
+		assert.True(t, sent.RequiredPullRequestReviews.DismissStaleReviews)
+		assert.Equal(t, []string{"octocat"}, sent.RequiredPullRequestReviews.BypassPullRequestAllowancesRequest.Users)
+		assert.True(t, sent.EnforceAdmins)
+		assert.True(t, sent.GetRequireLinearHistory())
+		assert.False(t, sent.GetAllowForcePushes())
+		assert.Nil(t, sent.Restrictions)
+	})
+
+	t.Run("keeps push restrictions and block creations", func(t *testing.T) {
+		restricted := &github.Protection{
+			Restrictions: &github.BranchRestrictions{
+				Users: []*github.User{{Login: github.Ptr("octocat")}},
+			},
+			BlockCreations: &github.BlockCreations{Enabled: github.Ptr(true)},
+		}
+		var sent github.ProtectionRequest
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(
+				mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+				restricted,
+			),
+			mock.WithRequestMatchHandler(
+				mock.PutReposBranchesProtectionByOwnerByRepoByBranch,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&sent))
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(restricted)
+				}),
+			),
+		))
+		_, handler := UpdateBranchProtection(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner":          "owner",
+			"repo":           "repo",
+			"branch":         "main",
+			"allowDeletions": false,
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		require.NotNil(t, sent.Restrictions)
+		assert.Equal(t, []string{"octocat"}, sent.Restrictions.Users)
+		assert.True(t, sent.GetBlockCreations())
+
+		var returned BranchProtectionSettings
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+		assert.True(t, returned.BlockCreations)
+	})
+
+	t.Run("protects an unprotected branch", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+				mockResponse(t, http.StatusNotFound, map[string]string{"message": "Branch not protected"}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PutReposBranchesProtectionByOwnerByRepoByBranch,
+				expectRequestBody(t, map[string]interface{}{
+					"required_status_checks": nil,
+					"required_pull_request_reviews": map[string]interface{}{
+						"dismiss_stale_reviews":           false,
+						"require_code_owner_reviews":      true,
+						"required_approving_review_count": float64(0),
+						"require_last_push_approval":      false,
+					},
+					"enforce_admins":                   false,
+					"restrictions":                     nil,
+					"required_linear_history":          false,
+					"allow_force_pushes":               false,
+					"allow_deletions":                  false,
+					"required_conversation_resolution": false,
+					"lock_branch":                      false,
+					"block_creations":                  false,
+				}).andThen(
+					mockResponse(t, http.StatusOK, &github.Protection{
+						RequiredPullRequestReviews: &github.PullRequestReviewsEnforcement{RequireCodeOwnerReviews: true},
+					}),
+				),
+			),
+		))
+		_, handler := UpdateBranchProtection(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner":                   "owner",
+			"repo":                    "repo",
+			"branch":                  "main",
+			"requireCodeOwnerReviews": true,
+			"requirePullRequest":      true,
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var returned BranchProtectionSettings
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+		assert.True(t, returned.Protected)
+		assert.True(t, returned.RequireCodeOwnerReviews)
+	})
+
+	t.Run("invalid review count", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(
+				mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+				mockProtection,
+			),
+		))
+		_, handler := UpdateBranchProtection(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner":                        "owner",
+			"repo":                         "repo",
+			"branch":                       "main",
+			"requiredApprovingReviewCount": float64(7),
+		}))
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "between 0 and 6")
+	})
+}
+
+func Test_ListRepositoryRulesets(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRepositoryRulesets(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_repository_rulesets", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "includesParents")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposRulesetsByOwnerByRepo,
+			expectQueryParams(t, map[string]string{"includes_parents": "true"}).andThen(
+				mockResponse(t, http.StatusOK, []map[string]interface{}{
+					{"id": 42, "name": "main", "target": "branch", "enforcement": "active", "source": "owner/repo", "source_type": "Repository"},
+					{"id": 7, "name": "org", "enforcement": "evaluate", "source": "owner", "source_type": "Organization"},
+				}),
+			),
+		),
+	))
+	_, handler := ListRepositoryRulesets(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":           "owner",
+		"repo":            "repo",
+		"includesParents": true,
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned []RulesetSummary
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, []RulesetSummary{
+		{ID: 42, Name: "main", Target: "branch", Enforcement: "active", Source: "owner/repo", SourceType: "Repository"},
+		{ID: 7, Name: "org", Enforcement: "evaluate", Source: "owner", SourceType: "Organization"},
+	}, returned)
+}
+
+func Test_GetRepositoryRuleset(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepositoryRuleset(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_repository_ruleset", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "rulesetId"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposRulesetsByOwnerByRepoByRulesetId,
+			map[string]interface{}{
+				"id":          42,
+				"name":        "main",
+				"enforcement": "active",
+				"source":      "owner/repo",
+				"rules": []map[string]interface{}{
+					{"type": "deletion"},
+				},
+			},
+		),
+	))
+	_, handler := GetRepositoryRuleset(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":     "owner",
+		"repo":      "repo",
+		"rulesetId": float64(42),
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned github.RepositoryRuleset
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, "main", returned.Name)
+	require.NotNil(t, returned.Rules)
+	assert.NotNil(t, returned.Rules.Deletion)
+}
+
+func Test_UpdateRepositoryRuleset(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateRepositoryRuleset(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "update_repository_ruleset", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "enforcement")
+	assert.Contains(t, tool.InputSchema.Properties, "bypassActors")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "rulesetId"})
+
+	mockRuleset := map[string]interface{}{
+		"id":          42,
+		"name":        "main",
+		"target":      "branch",
+		"enforcement": "evaluate",
+		"source":      "owner/repo",
+		"bypass_actors": []map[string]interface{}{
+			{"actor_id": 1, "actor_type": "Team", "bypass_mode": "always"},
+		},
+		"rules": []map[string]interface{}{
+			{"type": "deletion"},
+			{"type": "required_status_checks", "parameters": map[string]interface{}{
+				"required_status_checks":               []map[string]interface{}{{"context": "ci/build"}},
+				"strict_required_status_checks_policy": false,
+			}},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		requestArgs   map[string]interface{}
+		clearActors   bool
+		expectToolErr string
+		check         func(t *testing.T, sent *github.RepositoryRuleset)
+	}{
+		{
+			name: "enforcement, checks and reviews",
+			requestArgs: map[string]interface{}{
+				"enforcement":                  "active",
+				"requiredStatusChecks":         []interface{}{"ci/build", "ci/test"},
+				"strictStatusChecks":           true,
+				"requiredApprovingReviewCount": float64(2),
+			},
+			check: func(t *testing.T, sent *github.RepositoryRuleset) {
+				assert.Equal(t, github.RulesetEnforcementActive, sent.Enforcement)
+				assert.Len(t, sent.BypassActors, 1)
+				require.NotNil(t, sent.Rules)
+				assert.NotNil(t, sent.Rules.Deletion)
+				require.NotNil(t, sent.Rules.RequiredStatusChecks)
+				assert.True(t, sent.Rules.RequiredStatusChecks.StrictRequiredStatusChecksPolicy)
+				assert.Equal(t, []*github.RuleStatusCheck{{Context: "ci/build"}, {Context: "ci/test"}}, sent.Rules.RequiredStatusChecks.RequiredStatusChecks)
+				require.NotNil(t, sent.Rules.PullRequest)
+				assert.Equal(t, 2, sent.Rules.PullRequest.RequiredApprovingReviewCount)
+			},
+		},
+		{
+			name: "replace bypass actors",
+			requestArgs: map[string]interface{}{
+				"bypassActors": []interface{}{
+					map[string]interface{}{"actorType": "OrganizationAdmin"},
+					map[string]interface{}{"actorId": float64(5), "actorType": "RepositoryRole", "bypassMode": "pull_request"},
+				},
+			},
+			check: func(t *testing.T, sent *github.RepositoryRuleset) {
+				assert.Equal(t, []*github.BypassActor{
+					{ActorType: github.Ptr(github.BypassActorTypeOrganizationAdmin), BypassMode: github.Ptr(github.BypassModeAlways)},
+					{ActorID: github.Ptr(int64(5)), ActorType: github.Ptr(github.BypassActorTypeRepositoryRole), BypassMode: github.Ptr(github.BypassModePullRequest)},
+				}, sent.BypassActors)
+			},
+		},
+		{
+			name: "clear bypass actors",
+			requestArgs: map[string]interface{}{
+				"bypassActors": []interface{}{},
+			},
+			clearActors: true,
+			check: func(t *testing.T, sent *github.RepositoryRuleset) {
+				assert.Empty(t, sent.BypassActors)
+			},
+		},
+		{
+			name: "bypass actor without id",
+			requestArgs: map[string]interface{}{
+				"bypassActors": []interface{}{
+					map[string]interface{}{"actorType": "Team"},
+				},
+			},
+			expectToolErr: "bypass actor of type Team must have an actorId",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var sent *github.RepositoryRuleset
+			cleared := false
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposRulesetsByOwnerByRepoByRulesetId,
+					mockRuleset,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PutReposRulesetsByOwnerByRepoByRulesetId,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						var body map[string]interface{}
+						require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+						if actors, ok := body["bypass_actors"]; ok && actors == nil && len(body) == 1 {
+							cleared = true
+							_ = json.NewEncoder(w).Encode(mockRuleset)
+							return
+						}
+						raw, _ := json.Marshal(body)
+						sent = &github.RepositoryRuleset{}
+						require.NoError(t, json.Unmarshal(raw, sent))
+						_ = json.NewEncoder(w).Encode(body)
+					}),
+				),
+			))
+			_, handler := UpdateRepositoryRuleset(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			tc.requestArgs["owner"] = "owner"
+			tc.requestArgs["repo"] = "repo"
+			tc.requestArgs["rulesetId"] = float64(42)
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectToolErr != "" {
+				require.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectToolErr)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+			require.NotNil(t, sent)
+			assert.Equal(t, tc.clearActors, cleared)
+			tc.check(t, sent)
+		})
+	}
+}
@@ -0,0 +1,356 @@
+package github
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultCacheEntries bounds the number of responses a ResponseCache
+	// holds.
+	defaultCacheEntries = 1000
+	// maxCachedBodySize keeps large responses, such as file contents and
+	// logs, out of the cache.
+	maxCachedBodySize = 1 << 20
+	// cacheHeader is set on responses served from the cache.
+	cacheHeader = "X-From-Cache"
+)
+
+// cacheEntry is a stored response. Entries written to disk are JSON encoded.
+type cacheEntry struct {
+	Key string `json:"key"`
+	// Scope groups the entries of one token and API, so that a GraphQL
+	// mutation can drop that token's cached GraphQL reads.
+	Scope      string      `json:"scope"`
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+	// Expires is set for entries served without revalidation.
+	Expires time.Time `json:"expires,omitempty"`
+}
+
+// ResponseCache is a bounded, least recently used store of GitHub API
+// responses, kept in memory and optionally mirrored to a directory so that
+// it survives restarts. It is safe for concurrent use.
+type ResponseCache struct {
+	dir        string
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+// NewResponseCache creates a cache holding up to maxEntries responses, or
+// 1000 when maxEntries is zero. When dir is not empty, entries are also
+// written there and read back on a miss; the directory is created if needed.
+func NewResponseCache(maxEntries int, dir string) (*ResponseCache, error) {
+	if maxEntries == 0 {
+		maxEntries = defaultCacheEntries
+	}
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return nil, fmt.Errorf("failed to create cache directory: %w", err)
+		}
+	}
+	return &ResponseCache{
+		dir:        dir,
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+	}, nil
+}
+
+func (c *ResponseCache) get(key string) (*cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[key]; ok {
+		c.order.MoveToFront(el)
+		return el.Value.(*cacheEntry), true
+	}
+	if c.dir == "" {
+		return nil, false
+	}
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	var e cacheEntry
+	if err := json.Unmarshal(data, &e); err != nil || e.Key != key {
+		return nil, false
+	}
+	c.add(&e)
+	return &e, true
+}
+
+func (c *ResponseCache) set(e *cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[e.Key]; ok {
+		c.remove(el)
+	}
+	c.add(e)
+	if c.dir != "" {
+		if data, err := json.Marshal(e); err == nil {
+			// A cache that cannot be written to is only slower.
+			_ = os.WriteFile(c.path(e.Key), data, 0o600)
+		}
+	}
+}
+
+// dropScope removes every entry in scope.
+func (c *ResponseCache) dropScope(scope string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for el := c.order.Front(); el != nil; {
+		next := el.Next()
+		if el.Value.(*cacheEntry).Scope == scope {
+			c.remove(el)
+		}
+		el = next
+	}
+}
+
+// add inserts e in memory, evicting the least recently used entries over the
+// limit. c.mu must be held.
+func (c *ResponseCache) add(e *cacheEntry) {
+	c.entries[e.Key] = c.order.PushFront(e)
+	for c.order.Len() > c.maxEntries {
+		c.remove(c.order.Back())
+	}
+}
+
+// remove deletes an entry from memory and disk. c.mu must be held.
+func (c *ResponseCache) remove(el *list.Element) {
+	e := c.order.Remove(el).(*cacheEntry)
+	delete(c.entries, e.Key)
+	if c.dir != "" {
+		_ = os.Remove(c.path(e.Key))
+	}
+}
+
+func (c *ResponseCache) path(key string) string {
+	return filepath.Join(c.dir, key+".json")
+}
+
+// CachingTransport serves repeated reads from Cache. REST GET responses that
+// carry an ETag or Last-Modified header are revalidated with a conditional
+// request: GitHub answers 304 Not Modified, which does not count against the
+// rate limit, and the cached body is returned. GraphQL queries are served
+// from the cache for GraphQLTTL without asking GitHub; a GraphQL mutation, or
+// any REST request other than GET or HEAD, drops the cached queries of its
+// token, since either may change what they return. A zero GraphQLTTL leaves
+// GraphQL uncached. Entries are keyed by the request's token, so users never
+// see each other's responses.
+type CachingTransport struct {
+	Base       http.RoundTripper
+	Cache      *ResponseCache
+	GraphQLTTL time.Duration
+}
+
+func (t *CachingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch {
+	case req.Method == http.MethodGet:
+		return t.roundTripREST(req)
+	case req.Method == http.MethodPost && strings.HasSuffix(req.URL.Path, "/graphql"):
+		return t.roundTripGraphQL(req)
+	case req.Method == http.MethodHead:
+		return t.Base.RoundTrip(req)
+	default:
+		// Drop the queries once the write is done, so that none cached
+		// while it was in flight survive it.
+		resp, err := t.Base.RoundTrip(req)
+		t.Cache.dropScope(cacheScope(req, "graphql"))
+		return resp, err
+	}
+}
+
+func (t *CachingTransport) roundTripREST(req *http.Request) (*http.Response, error) {
+	key := cacheKey(req, nil)
+	cached, ok := t.Cache.get(key)
+	if ok {
+		req = req.Clone(req.Context())
+		if etag := cached.Header.Get("ETag"); etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if modified := cached.Header.Get("Last-Modified"); modified != "" {
+			req.Header.Set("If-Modified-Since", modified)
+		}
+	}
+
+	resp, err := t.Base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if ok && resp.StatusCode == http.StatusNotModified {
+		_ = resp.Body.Close()
+		// Keep the rate limit headers of the fresh response.
+		header := cached.Header.Clone()
+		for name, values := range resp.Header {
+			if strings.HasPrefix(name, "X-Ratelimit-") {
+				header[name] = values
+			}
+		}
+		return cachedResponse(req, cached.StatusCode, header, cached.Body), nil
+	}
+	if resp.StatusCode != http.StatusOK || (resp.Header.Get("ETag") == "" && resp.Header.Get("Last-Modified") == "") {
+		return resp, nil
+	}
+
+	body, err := readCacheableBody(resp)
+	if err != nil {
+		return nil, err
+	}
+	if body == nil {
+		return resp, nil
+	}
+	t.Cache.set(&cacheEntry{
+		Key:        key,
+		Scope:      cacheScope(req, "rest"),
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       body,
+	})
+	return resp, nil
+}
+
+func (t *CachingTransport) roundTripGraphQL(req *http.Request) (*http.Response, error) {
+	if t.GraphQLTTL <= 0 || req.Body == nil {
+		return t.Base.RoundTrip(req)
+	}
+	reqBody, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(reqBody)), nil
+	}
+
+	scope := cacheScope(req, "graphql")
+	var payload struct {
+		Query string `json:"query"`
+	}
+	if err := json.Unmarshal(reqBody, &payload); err != nil {
+		return t.Base.RoundTrip(req)
+	}
+	if strings.HasPrefix(strings.TrimSpace(payload.Query), "mutation") {
+		t.Cache.dropScope(scope)
+		return t.Base.RoundTrip(req)
+	}
+
+	key := cacheKey(req, reqBody)
+	if cached, ok := t.Cache.get(key); ok && time.Now().Before(cached.Expires) {
+		return cachedResponse(req, cached.StatusCode, cached.Header.Clone(), cached.Body), nil
+	}
+
+	resp, err := t.Base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+	body, err := readCacheableBody(resp)
+	if err != nil {
+		return nil, err
+	}
+	if body == nil {
+		return resp, nil
+	}
+	// Queries that failed are not worth repeating from the cache.
+	var errs struct {
+		Errors []json.RawMessage `json:"errors"`
+	}
+	if json.Unmarshal(body, &errs) != nil || len(errs.Errors) > 0 {
+		return resp, nil
+	}
+
+	// The rate limit headers of a cached response would be stale.
+	header := resp.Header.Clone()
+	for name := range header {
+		if strings.HasPrefix(name, "X-Ratelimit-") {
+			header.Del(name)
+		}
+	}
+	t.Cache.set(&cacheEntry{
+		Key:        key,
+		Scope:      scope,
+		StatusCode: resp.StatusCode,
+		Header:     header,
+		Body:       body,
+		Expires:    time.Now().Add(t.GraphQLTTL),
+	})
+	return resp, nil
+}
+
+// readCacheableBody reads resp's body and replaces it with a copy. It returns
+// a nil body, leaving resp readable, when the body is too large to cache.
+func readCacheableBody(resp *http.Response) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxCachedBodySize+1))
+	if err != nil {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if len(body) > maxCachedBodySize {
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+		return nil, nil
+	}
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+func cachedResponse(req *http.Request, statusCode int, header http.Header, body []byte) *http.Response {
+	header.Set(cacheHeader, "1")
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// cacheKey identifies a request by everything that can change its response,
+// hashed so that tokens are neither kept in memory nor written to disk.
+func cacheKey(req *http.Request, body []byte) string {
+	h := sha256.New()
+	for _, part := range []string{
+		req.Method,
+		req.URL.String(),
+		req.Header.Get("Authorization"),
+		req.Header.Get("Accept"),
+		req.Header.Get("X-GitHub-Api-Version"),
+	} {
+		_, _ = io.WriteString(h, part)
+		_, _ = h.Write([]byte{0})
+	}
+	_, _ = h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func cacheScope(req *http.Request, api string) string {
+	sum := sha256.Sum256([]byte(req.URL.Host + "\x00" + req.Header.Get("Authorization")))
+	return api + ":" + hex.EncodeToString(sum[:])
+}
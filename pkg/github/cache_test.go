@@ -0,0 +1,158 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func cachedGet(t *testing.T, client *http.Client, url, token string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
+
+func readBody(t *testing.T, resp *http.Response) string {
+	t.Helper()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+// etagServer answers every GET with the same body and ETag, and with 304 when
+// the client already has it.
+func etagServer(t *testing.T, conditional *[]string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*conditional = append(*conditional, r.Header.Get("If-None-Match"))
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.Header().Set("X-RateLimit-Remaining", "4998")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(`{"name":"repo"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func Test_CachingTransport_REST(t *testing.T) {
+	var conditional []string
+	server := etagServer(t, &conditional)
+
+	cache, err := NewResponseCache(0, "")
+	require.NoError(t, err)
+	client := &http.Client{Transport: &CachingTransport{Base: http.DefaultTransport, Cache: cache}}
+
+	first := cachedGet(t, client, server.URL+"/repos/owner/repo", "alice")
+	assert.Equal(t, `{"name":"repo"}`, readBody(t, first))
+	assert.Empty(t, first.Header.Get(cacheHeader))
+
+	second := cachedGet(t, client, server.URL+"/repos/owner/repo", "alice")
+	assert.Equal(t, http.StatusOK, second.StatusCode)
+	assert.Equal(t, `{"name":"repo"}`, readBody(t, second))
+	assert.Equal(t, "1", second.Header.Get(cacheHeader))
+	assert.Equal(t, "4998", second.Header.Get("X-RateLimit-Remaining"))
+
+	// Another token does not share the entry.
+	third := cachedGet(t, client, server.URL+"/repos/owner/repo", "bob")
+	assert.Empty(t, third.Header.Get(cacheHeader))
+
+	assert.Equal(t, []string{"", `"v1"`, ""}, conditional)
+}
+
+func Test_CachingTransport_GraphQL(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		queries = append(queries, string(body))
+		if strings.Contains(string(body), "broken") {
+			_, _ = w.Write([]byte(`{"errors":[{"message":"Field 'broken' doesn't exist"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"viewer":{"login":"octocat"}}}`))
+	}))
+	defer server.Close()
+
+	cache, err := NewResponseCache(0, "")
+	require.NoError(t, err)
+	client := &http.Client{Transport: &CachingTransport{Base: http.DefaultTransport, Cache: cache, GraphQLTTL: time.Minute}}
+	post := func(query string) *http.Response {
+		resp, err := client.Post(server.URL+"/graphql", "application/json", strings.NewReader(`{"query":"`+query+`"}`))
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = resp.Body.Close() })
+		return resp
+	}
+
+	assert.Empty(t, post("query{viewer{login}}").Header.Get(cacheHeader))
+	resp := post("query{viewer{login}}")
+	assert.Equal(t, "1", resp.Header.Get(cacheHeader))
+	assert.Equal(t, `{"data":{"viewer":{"login":"octocat"}}}`, readBody(t, resp))
+	assert.Len(t, queries, 1)
+
+	// A mutation drops the cached queries.
+	post("mutation{addStar(input:{}){clientMutationId}}")
+	assert.Empty(t, post("query{viewer{login}}").Header.Get(cacheHeader))
+	assert.Len(t, queries, 3)
+
+	// So does a REST write.
+	assert.Equal(t, "1", post("query{viewer{login}}").Header.Get(cacheHeader))
+	req, err := http.NewRequest(http.MethodPatch, server.URL+"/repos/owner/repo/issues/1", strings.NewReader(`{"state":"closed"}`))
+	require.NoError(t, err)
+	resp, err = client.Do(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Empty(t, post("query{viewer{login}}").Header.Get(cacheHeader))
+	assert.Len(t, queries, 5)
+
+	// Failed queries are not cached.
+	post("query{broken}")
+	post("query{broken}")
+	assert.Len(t, queries, 7)
+}
+
+func Test_ResponseCache_Disk(t *testing.T) {
+	var conditional []string
+	server := etagServer(t, &conditional)
+	dir := t.TempDir()
+
+	cache, err := NewResponseCache(0, dir)
+	require.NoError(t, err)
+	client := &http.Client{Transport: &CachingTransport{Base: http.DefaultTransport, Cache: cache}}
+	cachedGet(t, client, server.URL+"/repos/owner/repo", "alice")
+
+	// A new cache over the same directory, as after a restart.
+	cache, err = NewResponseCache(0, dir)
+	require.NoError(t, err)
+	client = &http.Client{Transport: &CachingTransport{Base: http.DefaultTransport, Cache: cache}}
+	resp := cachedGet(t, client, server.URL+"/repos/owner/repo", "alice")
+	assert.Equal(t, "1", resp.Header.Get(cacheHeader))
+	assert.Equal(t, `{"name":"repo"}`, readBody(t, resp))
+	assert.Equal(t, []string{"", `"v1"`}, conditional)
+}
+
+func Test_ResponseCache_Eviction(t *testing.T) {
+	cache, err := NewResponseCache(2, t.TempDir())
+	require.NoError(t, err)
+	for _, key := range []string{"a", "b", "c"} {
+		cache.set(&cacheEntry{Key: key})
+	}
+	_, ok := cache.get("a")
+	assert.False(t, ok)
+	_, ok = cache.get("b")
+	assert.True(t, ok)
+	_, ok = cache.get("c")
+	assert.True(t, ok)
+}
@@ -0,0 +1,153 @@
+package github
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+var (
+	loggerMu sync.RWMutex
+	logger   = slog.New(slog.NewTextHandler(io.Discard, nil))
+)
+
+// SetLogger installs the logger that tool calls and GitHub requests are
+// logged to. Passing nil discards the logs, which is the default.
+func SetLogger(l *slog.Logger) {
+	if l == nil {
+		l = slog.New(slog.NewTextHandler(io.Discard, nil))
+	}
+	loggerMu.Lock()
+	defer loggerMu.Unlock()
+	logger = l
+}
+
+func getLogger() *slog.Logger {
+	loggerMu.RLock()
+	defer loggerMu.RUnlock()
+	return logger
+}
+
+// callTrace follows one tool call through the GitHub requests it makes.
+type callTrace struct {
+	id       string
+	requests atomic.Int64
+	// rateRemaining is the X-RateLimit-Remaining of the last response, or
+	// -1 before any.
+	rateRemaining atomic.Int64
+}
+
+type callTraceKey struct{}
+
+func newCallTrace() *callTrace {
+	var b [8]byte
+	_, _ = rand.Read(b[:])
+	trace := &callTrace{id: hex.EncodeToString(b[:])}
+	trace.rateRemaining.Store(-1)
+	return trace
+}
+
+func callTraceFromContext(ctx context.Context) *callTrace {
+	trace, _ := ctx.Value(callTraceKey{}).(*callTrace)
+	return trace
+}
+
+// withCallLogging wraps the handler of the tool name so that every call is
+// logged with its duration, the GitHub requests it made, the rate limit left
+// after them, its trace ID when it is traced and, when it failed, its
+// ToolError code. Failed calls are logged at warning level.
+func withCallLogging(name string, handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		trace := newCallTrace()
+		ctx = context.WithValue(ctx, callTraceKey{}, trace)
+		start := time.Now()
+		result, err := handler(ctx, request)
+
+		attrs := []slog.Attr{
+			slog.String("tool", name),
+			slog.String("call_id", trace.id),
+			slog.Duration("duration", time.Since(start)),
+			slog.Int64("github_requests", trace.requests.Load()),
+		}
+		if remaining := trace.rateRemaining.Load(); remaining >= 0 {
+			attrs = append(attrs, slog.Int64("rate_limit_remaining", remaining))
+		}
+		if span := oteltrace.SpanContextFromContext(ctx); span.IsValid() {
+			attrs = append(attrs, slog.String("trace_id", span.TraceID().String()))
+		}
+		level := slog.LevelInfo
+		switch {
+		case err != nil:
+			level = slog.LevelWarn
+			attrs = append(attrs, slog.String("error_class", ErrorCodeInternal), slog.String("error", err.Error()))
+		case result != nil && result.IsError:
+			level = slog.LevelWarn
+			attrs = append(attrs, slog.String("error_class", resultErrorCode(result)))
+		}
+		getLogger().LogAttrs(ctx, level, "tool call", attrs...)
+		return result, err
+	}
+}
+
+// resultErrorCode returns the ToolError code of an error result.
+func resultErrorCode(result *mcp.CallToolResult) string {
+	if len(result.Content) == 1 {
+		if text, ok := result.Content[0].(mcp.TextContent); ok {
+			var te ToolError
+			if json.Unmarshal([]byte(text.Text), &te) == nil && te.Code != "" {
+				return te.Code
+			}
+		}
+	}
+	return ErrorCodeInternal
+}
+
+// LoggingTransport logs every GitHub request at debug level and counts it
+// against the tool call in the request's context. Placed below a
+// CachingTransport, it sees only the requests that reach GitHub.
+type LoggingTransport struct {
+	Base http.RoundTripper
+}
+
+func (t *LoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	start := time.Now()
+	resp, err := t.Base.RoundTrip(req)
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.Duration("duration", time.Since(start)),
+	}
+	trace := callTraceFromContext(ctx)
+	if trace != nil {
+		trace.requests.Add(1)
+		attrs = append(attrs, slog.String("call_id", trace.id))
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+		getLogger().LogAttrs(ctx, slog.LevelDebug, "github request", attrs...)
+		return nil, err
+	}
+	attrs = append(attrs, slog.Int("status", resp.StatusCode))
+	if remaining, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Remaining"), 10, 64); err == nil {
+		if trace != nil {
+			trace.rateRemaining.Store(remaining)
+		}
+		attrs = append(attrs, slog.Int64("rate_limit_remaining", remaining))
+	}
+	getLogger().LogAttrs(ctx, slog.LevelDebug, "github request", attrs...)
+	return resp, nil
+}
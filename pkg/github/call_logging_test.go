@@ -0,0 +1,87 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// captureLogs installs a JSON logger at debug level for the test and returns
+// a function decoding what it logged.
+func captureLogs(t *testing.T) func() []map[string]interface{} {
+	t.Helper()
+	var buf bytes.Buffer
+	SetLogger(slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	t.Cleanup(func() { SetLogger(nil) })
+	return func() []map[string]interface{} {
+		var records []map[string]interface{}
+		for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+			var record map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(line), &record))
+			records = append(records, record)
+		}
+		buf.Reset()
+		return records
+	}
+}
+
+func Test_withCallLogging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "4321")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: &LoggingTransport{Base: http.DefaultTransport}}
+
+	var fail error
+	tool := newServerTool(mcp.NewTool("example"), func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		for i := 0; i < 2; i++ {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/repos/owner/repo", nil)
+			require.NoError(t, err)
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+		}
+		if fail != nil {
+			return nil, fail
+		}
+		return mcp.NewToolResultText("ok"), nil
+	})
+	logs := captureLogs(t)
+
+	_, err := tool.Handler(context.Background(), createMCPRequest(map[string]interface{}{}))
+	require.NoError(t, err)
+	records := logs()
+	require.Len(t, records, 3)
+	for _, record := range records[:2] {
+		assert.Equal(t, "github request", record["msg"])
+		assert.Equal(t, "DEBUG", record["level"])
+		assert.Equal(t, "/repos/owner/repo", record["path"])
+		assert.Equal(t, float64(200), record["status"])
+		assert.Equal(t, records[2]["call_id"], record["call_id"])
+	}
+	call := records[2]
+	assert.Equal(t, "tool call", call["msg"])
+	assert.Equal(t, "INFO", call["level"])
+	assert.Equal(t, "example", call["tool"])
+	assert.Equal(t, float64(2), call["github_requests"])
+	assert.Equal(t, float64(4321), call["rate_limit_remaining"])
+	assert.NotContains(t, call, "error_class")
+
+	fail = errors.New("parameter number must be an integer, is 1.5")
+	_, err = tool.Handler(context.Background(), createMCPRequest(map[string]interface{}{}))
+	require.NoError(t, err)
+	call = logs()[2]
+	assert.Equal(t, "WARN", call["level"])
+	assert.Equal(t, ErrorCodeValidation, call["error_class"])
+}
@@ -150,3 +150,104 @@ func ListCodeScanningAlerts(getClient GetClientFn, t translations.TranslationHel
 			return mcp.NewToolResultText(string(r)), nil
 		}
 }
+
+func UpdateCodeScanningAlert(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_code_scanning_alert",
+			mcp.WithDescription(t("TOOL_UPDATE_CODE_SCANNING_ALERT_DESCRIPTION", "Dismiss a code scanning alert with a reason, or reopen a dismissed alert.")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("The owner of the repository."),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("The name of the repository."),
+			),
+			mcp.WithNumber("alertNumber",
+				mcp.Required(),
+				mcp.Description("The number of the alert."),
+			),
+			mcp.WithString("state",
+				mcp.Required(),
+				mcp.Description("The new state of the alert."),
+				mcp.Enum("open", "dismissed"),
+			),
+			mcp.WithString("dismissedReason",
+				mcp.Description("Why the alert is dismissed. Required when state is dismissed."),
+				mcp.Enum("false positive", "won't fix", "used in tests"),
+			),
+			mcp.WithString("dismissedComment",
+				mcp.Description("A comment explaining the dismissal, at most 280 characters."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			alertNumber, err := RequiredInt(request, "alertNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			state, err := requiredParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			dismissedReason, err := OptionalParam[string](request, "dismissedReason")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			dismissedComment, err := OptionalParam[string](request, "dismissedComment")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			stateInfo := &github.CodeScanningAlertState{State: state}
+			switch state {
+			case "dismissed":
+				if dismissedReason == "" {
+					return mcp.NewToolResultError("dismissedReason is required to dismiss an alert"), nil
+				}
+				if len([]rune(dismissedComment)) > 280 {
+					return mcp.NewToolResultError("dismissedComment must be at most 280 characters"), nil
+				}
+				stateInfo.DismissedReason = github.Ptr(dismissedReason)
+				if dismissedComment != "" {
+					stateInfo.DismissedComment = github.Ptr(dismissedComment)
+				}
+			default:
+				if dismissedReason != "" || dismissedComment != "" {
+					return mcp.NewToolResultError("dismissedReason and dismissedComment only apply when dismissing an alert"), nil
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			alert, resp, err := client.CodeScanning.UpdateAlert(ctx, owner, repo, int64(alertNumber), stateInfo)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update alert: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to update alert: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(alert)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal alert: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -238,3 +238,97 @@ func Test_ListCodeScanningAlerts(t *testing.T) {
 		})
 	}
 }
+
+func Test_UpdateCodeScanningAlert(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateCodeScanningAlert(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "update_code_scanning_alert", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "state")
+	assert.Contains(t, tool.InputSchema.Properties, "dismissedReason")
+	assert.Contains(t, tool.InputSchema.Properties, "dismissedComment")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "alertNumber", "state"})
+
+	mockAlert := &github.Alert{
+		Number:          github.Ptr(42),
+		State:           github.Ptr("dismissed"),
+		DismissedReason: github.Ptr("used in tests"),
+		Rule:            &github.Rule{ID: github.Ptr("go/sql-injection")},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectToolErr  bool
+		expectedErrMsg string
+	}{
+		{
+			name: "dismiss alert",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposCodeScanningAlertsByOwnerByRepoByAlertNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"state":             "dismissed",
+						"dismissed_reason":  "used in tests",
+						"dismissed_comment": "Only reachable from test fixtures",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockAlert),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"state":            "dismissed",
+				"dismissedReason":  "used in tests",
+				"dismissedComment": "Only reachable from test fixtures",
+			},
+		},
+		{
+			name:         "dismiss without reason",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"state": "dismissed",
+			},
+			expectToolErr:  true,
+			expectedErrMsg: "dismissedReason is required to dismiss an alert",
+		},
+		{
+			name:         "reopen with reason",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"state":           "open",
+				"dismissedReason": "won't fix",
+			},
+			expectToolErr:  true,
+			expectedErrMsg: "only apply when dismissing an alert",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateCodeScanningAlert(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			tc.requestArgs["owner"] = "owner"
+			tc.requestArgs["repo"] = "repo"
+			tc.requestArgs["alertNumber"] = float64(42)
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectToolErr {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returnedAlert github.Alert
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returnedAlert))
+			assert.Equal(t, "dismissed", returnedAlert.GetState())
+			assert.Equal(t, "used in tests", returnedAlert.GetDismissedReason())
+		})
+	}
+}
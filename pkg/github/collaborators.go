@@ -0,0 +1,465 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// CollaboratorSummary is one user in a list_collaborators result. RoleName is
+// the role granting their highest access, which may be a custom role.
+type CollaboratorSummary struct {
+	Login    string `json:"login"`
+	ID       int64  `json:"id"`
+	RoleName string `json:"role_name"`
+	HTMLURL  string `json:"html_url"`
+}
+
+// ListCollaboratorsOutput is the result of list_collaborators.
+type ListCollaboratorsOutput struct {
+	Collaborators []CollaboratorSummary `json:"collaborators"`
+	NextPage      int                   `json:"next_page,omitempty"`
+	HasNextPage   bool                  `json:"has_next_page"`
+}
+
+// CollaboratorPermission is the result of get_collaborator_permission.
+// Permission is one of admin, write, read or none; RoleName tells the
+// triage and maintain roles apart from the permission they map to.
+type CollaboratorPermission struct {
+	Login      string `json:"login"`
+	Permission string `json:"permission"`
+	RoleName   string `json:"role_name"`
+}
+
+// InvitationSummary is a pending invitation to collaborate on a repository.
+type InvitationSummary struct {
+	ID          int64      `json:"id"`
+	Invitee     string     `json:"invitee"`
+	Inviter     string     `json:"inviter"`
+	Permissions string     `json:"permissions"`
+	Expired     bool       `json:"expired,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	HTMLURL     string     `json:"html_url"`
+}
+
+// ListInvitationsOutput is the result of list_repository_invitations.
+type ListInvitationsOutput struct {
+	Invitations []InvitationSummary `json:"invitations"`
+	NextPage    int                 `json:"next_page,omitempty"`
+	HasNextPage bool                `json:"has_next_page"`
+}
+
+// ListCollaborators creates a tool to list the collaborators of a repository.
+func ListCollaborators(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_collaborators",
+			mcp.WithDescription(t("TOOL_LIST_COLLABORATORS_DESCRIPTION", "List the users with access to a repository and the role each has. Requires push access to the repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("affiliation",
+				mcp.Description("outside for outside collaborators only, direct for users granted access directly rather than through a team, defaults to all"),
+				mcp.Enum("outside", "direct", "all"),
+			),
+			mcp.WithString("permission",
+				mcp.Description("Only collaborators with exactly this permission"),
+				mcp.Enum("pull", "triage", "push", "maintain", "admin"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts := &github.ListCollaboratorsOptions{}
+			if opts.Affiliation, err = OptionalParam[string](request, "affiliation"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if opts.Permission, err = OptionalParam[string](request, "permission"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts.Page = pagination.page
+			opts.PerPage = pagination.perPage
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			users, resp, err := client.Repositories.ListCollaborators(ctx, owner, repo, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list collaborators: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list collaborators: %s", string(body))), nil
+			}
+
+			out := ListCollaboratorsOutput{
+				Collaborators: make([]CollaboratorSummary, 0, len(users)),
+				NextPage:      resp.NextPage,
+				HasNextPage:   resp.NextPage != 0,
+			}
+			for _, u := range users {
+				out.Collaborators = append(out.Collaborators, CollaboratorSummary{
+					Login:    u.GetLogin(),
+					ID:       u.GetID(),
+					RoleName: u.GetRoleName(),
+					HTMLURL:  u.GetHTMLURL(),
+				})
+			}
+
+			r, err := json.Marshal(out)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetCollaboratorPermission creates a tool to get the access a user has to a
+// repository.
+func GetCollaboratorPermission(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_collaborator_permission",
+			mcp.WithDescription(t("TOOL_GET_COLLABORATOR_PERMISSION_DESCRIPTION", "Get the permission and role a user has on a repository, whether granted directly, through a team or through the organization")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("Login of the user"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := requiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			level, resp, err := client.Repositories.GetPermissionLevel(ctx, owner, repo, username)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get collaborator permission: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get collaborator permission: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(CollaboratorPermission{
+				Login:      level.GetUser().GetLogin(),
+				Permission: level.GetPermission(),
+				RoleName:   level.GetRoleName(),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// AddCollaborator creates a tool to give a user access to a repository, or
+// change the role of an existing collaborator.
+func AddCollaborator(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_collaborator",
+			mcp.WithDescription(t("TOOL_ADD_COLLABORATOR_DESCRIPTION", "Give a user access to a repository with a role, or change the role of an existing collaborator. Users who are not organization members are sent an invitation")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("Login of the user"),
+			),
+			mcp.WithString("role",
+				mcp.Description("pull, triage, push, maintain, admin or the name of a custom repository role, defaults to push. Only push applies to repositories owned by a user"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := requiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			role, err := OptionalParam[string](request, "role")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			invitation, resp, err := client.Repositories.AddCollaborator(ctx, owner, repo, username, &github.RepositoryAddCollaboratorOptions{
+				Permission: role,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to add collaborator: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			switch resp.StatusCode {
+			case http.StatusCreated:
+				return mcp.NewToolResultText(fmt.Sprintf("Invited %s to %s/%s with %s access (invitation %d); they get access once they accept", username, owner, repo, invitation.GetPermissions(), invitation.GetID())), nil
+			case http.StatusNoContent:
+				// Organization members are added directly, and existing
+				// collaborators have their role changed.
+				if role == "" {
+					role = "push"
+				}
+				return mcp.NewToolResultText(fmt.Sprintf("%s now has %s access to %s/%s", username, role, owner, repo)), nil
+			default:
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to add collaborator: %s", string(body))), nil
+			}
+		}
+}
+
+// RemoveCollaborator creates a tool to remove a collaborator from a
+// repository.
+func RemoveCollaborator(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("remove_collaborator",
+			mcp.WithDescription(t("TOOL_REMOVE_COLLABORATOR_DESCRIPTION", "Remove a collaborator from a repository. Access granted through teams or the organization is not affected")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("Login of the user"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := requiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			resp, err := client.Repositories.RemoveCollaborator(ctx, owner, repo, username)
+			if err != nil {
+				return nil, fmt.Errorf("failed to remove collaborator: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to remove collaborator: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Removed %s from %s/%s", username, owner, repo)), nil
+		}
+}
+
+// ListRepositoryInvitations creates a tool to list the pending invitations to
+// collaborate on a repository.
+func ListRepositoryInvitations(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repository_invitations",
+			mcp.WithDescription(t("TOOL_LIST_REPOSITORY_INVITATIONS_DESCRIPTION", "List the pending invitations to collaborate on a repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			invitations, resp, err := client.Repositories.ListInvitations(ctx, owner, repo, &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list invitations: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list invitations: %s", string(body))), nil
+			}
+
+			out := ListInvitationsOutput{
+				Invitations: make([]InvitationSummary, 0, len(invitations)),
+				NextPage:    resp.NextPage,
+				HasNextPage: resp.NextPage != 0,
+			}
+			for _, i := range invitations {
+				invitation := InvitationSummary{
+					ID:          i.GetID(),
+					Invitee:     i.GetInvitee().GetLogin(),
+					Inviter:     i.GetInviter().GetLogin(),
+					Permissions: i.GetPermissions(),
+					Expired:     i.GetExpired(),
+					HTMLURL:     i.GetHTMLURL(),
+				}
+				if i.CreatedAt != nil {
+					invitation.CreatedAt = &i.CreatedAt.Time
+				}
+				out.Invitations = append(out.Invitations, invitation)
+			}
+
+			r, err := json.Marshal(out)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CancelRepositoryInvitation creates a tool to withdraw a pending invitation
+// to collaborate on a repository.
+func CancelRepositoryInvitation(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("cancel_repository_invitation",
+			mcp.WithDescription(t("TOOL_CANCEL_REPOSITORY_INVITATION_DESCRIPTION", "Withdraw a pending invitation to collaborate on a repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("invitationId",
+				mcp.Required(),
+				mcp.Description("Invitation ID from list_repository_invitations"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			invitationID, err := RequiredInt(request, "invitationId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			resp, err := client.Repositories.DeleteInvitation(ctx, owner, repo, int64(invitationID))
+			if err != nil {
+				return nil, fmt.Errorf("failed to cancel invitation: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to cancel invitation: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Cancelled invitation %d to %s/%s", invitationID, owner, repo)), nil
+		}
+}
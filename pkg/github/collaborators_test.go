@@ -0,0 +1,266 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListCollaborators(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListCollaborators(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_collaborators", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "affiliation")
+	assert.Contains(t, tool.InputSchema.Properties, "permission")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposCollaboratorsByOwnerByRepo,
+			expectQueryParams(t, map[string]string{
+				"affiliation": "outside",
+				"page":        "1",
+				"per_page":    "30",
+			}).andThen(
+				mockResponse(t, http.StatusOK, []*github.User{
+					{Login: github.Ptr("contractor"), ID: github.Ptr(int64(5)), RoleName: github.Ptr("triage"), HTMLURL: github.Ptr("https://github.com/contractor")},
+				}),
+			),
+		),
+	))
+	_, handler := ListCollaborators(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":       "owner",
+		"repo":        "repo",
+		"affiliation": "outside",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned ListCollaboratorsOutput
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, ListCollaboratorsOutput{
+		Collaborators: []CollaboratorSummary{
+			{Login: "contractor", ID: 5, RoleName: "triage", HTMLURL: "https://github.com/contractor"},
+		},
+	}, returned)
+}
+
+func Test_GetCollaboratorPermission(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := GetCollaboratorPermission(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_collaborator_permission", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "username"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposCollaboratorsPermissionByOwnerByRepoByUsername,
+			&github.RepositoryPermissionLevel{
+				Permission: github.Ptr("write"),
+				RoleName:   github.Ptr("maintain"),
+				User:       &github.User{Login: github.Ptr("octocat")},
+			},
+		),
+	))
+	_, handler := GetCollaboratorPermission(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":    "owner",
+		"repo":     "repo",
+		"username": "octocat",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned CollaboratorPermission
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, CollaboratorPermission{Login: "octocat", Permission: "write", RoleName: "maintain"}, returned)
+}
+
+func Test_AddCollaborator(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := AddCollaborator(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "add_collaborator", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "role")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "username"})
+
+	tests := []struct {
+		name         string
+		requestArgs  map[string]interface{}
+		expectedBody map[string]interface{}
+		handler      http.HandlerFunc
+		expectError  bool
+		expectedText string
+	}{
+		{
+			name:         "invitation sent",
+			requestArgs:  map[string]interface{}{"role": "triage"},
+			expectedBody: map[string]interface{}{"permission": "triage"},
+			handler: mockResponse(t, http.StatusCreated, &github.CollaboratorInvitation{
+				ID:          github.Ptr(int64(99)),
+				Permissions: github.Ptr("triage"),
+			}),
+			expectedText: "Invited octocat to owner/repo with triage access (invitation 99); they get access once they accept",
+		},
+		{
+			name:         "added directly with default role",
+			requestArgs:  map[string]interface{}{},
+			expectedBody: map[string]interface{}{},
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			},
+			expectedText: "octocat now has push access to owner/repo",
+		},
+		{
+			name:         "unknown role",
+			requestArgs:  map[string]interface{}{"role": "owner"},
+			expectedBody: map[string]interface{}{"permission": "owner"},
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				_, _ = w.Write([]byte(`{"message":"Validation Failed"}`))
+			},
+			expectError:  true,
+			expectedText: "failed to add collaborator",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposCollaboratorsByOwnerByRepoByUsername,
+					expectRequestBody(t, tc.expectedBody).andThen(tc.handler),
+				),
+			))
+			_, handler := AddCollaborator(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			tc.requestArgs["owner"] = "owner"
+			tc.requestArgs["repo"] = "repo"
+			tc.requestArgs["username"] = "octocat"
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			if tc.expectError {
+				// go-github reports the 422 as an error before the status
+				// check is reached.
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedText)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
+
+func Test_RemoveCollaborator(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := RemoveCollaborator(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "remove_collaborator", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "username"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.DeleteReposCollaboratorsByOwnerByRepoByUsername,
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		),
+	))
+	_, handler := RemoveCollaborator(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":    "owner",
+		"repo":     "repo",
+		"username": "octocat",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Equal(t, "Removed octocat from owner/repo", getTextResult(t, result).Text)
+}
+
+func Test_ListRepositoryInvitations(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRepositoryInvitations(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_repository_invitations", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	createdAt := time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposInvitationsByOwnerByRepo,
+			[]*github.RepositoryInvitation{
+				{
+					ID:          github.Ptr(int64(99)),
+					Invitee:     &github.User{Login: github.Ptr("contractor")},
+					Inviter:     &github.User{Login: github.Ptr("octocat")},
+					Permissions: github.Ptr("write"),
+					CreatedAt:   &github.Timestamp{Time: createdAt},
+					HTMLURL:     github.Ptr("https://github.com/owner/repo/invitations"),
+				},
+			},
+		),
+	))
+	_, handler := ListRepositoryInvitations(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned ListInvitationsOutput
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, ListInvitationsOutput{
+		Invitations: []InvitationSummary{
+			{ID: 99, Invitee: "contractor", Inviter: "octocat", Permissions: "write", CreatedAt: &createdAt, HTMLURL: "https://github.com/owner/repo/invitations"},
+		},
+	}, returned)
+}
+
+func Test_CancelRepositoryInvitation(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := CancelRepositoryInvitation(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "cancel_repository_invitation", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "invitationId"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.DeleteReposInvitationsByOwnerByRepoByInvitationId,
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		),
+	))
+	_, handler := CancelRepositoryInvitation(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":        "owner",
+		"repo":         "repo",
+		"invitationId": float64(99),
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Equal(t, "Cancelled invitation 99 to owner/repo", getTextResult(t, result).Text)
+}
@@ -0,0 +1,342 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// GetCommit creates a tool to get a commit with its file stats and patches.
+func GetCommit(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_commit",
+			mcp.WithDescription(t("TOOL_GET_COMMITS_DESCRIPTION", "Get details for a commit from a GitHub repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("sha",
+				mcp.Required(),
+				mcp.Description("Commit SHA, branch name, or tag name"),
+			),
+			mcp.WithNumber("maxPatchLines",
+				mcp.Description("Truncate each file's patch to this many lines, 0 keeps the full patch"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sha, err := requiredParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxPatchLines, err := OptionalIntParam(request, "maxPatchLines")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			commit, resp, err := client.Repositories.GetCommit(ctx, owner, repo, sha, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get commit: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != 200 {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get commit: %s", string(body))), nil
+			}
+
+			if maxPatchLines > 0 {
+				for _, f := range commit.Files {
+					if patch, truncated := truncateLines(f.GetPatch(), maxPatchLines); truncated {
+						f.Patch = github.Ptr(patch)
+					}
+				}
+			}
+
+			r, err := json.Marshal(commit)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListCommits creates a tool to get commits of a branch in a repository,
+// optionally limited to a path, author or date range.
+func ListCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_commits",
+			mcp.WithDescription(t("TOOL_LIST_COMMITS_DESCRIPTION", "Get list of commits of a branch in a GitHub repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("sha",
+				mcp.Description("SHA or Branch name"),
+			),
+			mcp.WithString("path",
+				mcp.Description("Only commits touching this file or directory"),
+			),
+			mcp.WithString("author",
+				mcp.Description("Only commits by this GitHub login or email address"),
+			),
+			mcp.WithString("since",
+				mcp.Description("Only commits after this date (ISO 8601 timestamp)"),
+			),
+			mcp.WithString("until",
+				mcp.Description("Only commits before this date (ISO 8601 timestamp)"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sha, err := OptionalParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := OptionalParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			author, err := OptionalParam[string](request, "author")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.CommitsListOptions{
+				SHA:    sha,
+				Path:   path,
+				Author: author,
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
+			}
+			since, err := OptionalParam[string](request, "since")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if since != "" {
+				if opts.Since, err = parseISOTimestamp(since); err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to list commits: %s", err.Error())), nil
+				}
+			}
+			until, err := OptionalParam[string](request, "until")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if until != "" {
+				if opts.Until, err = parseISOTimestamp(until); err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to list commits: %s", err.Error())), nil
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			commits, resp, err := client.Repositories.ListCommits(ctx, owner, repo, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list commits: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != 200 {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list commits: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(commits)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CommitSummary is a commit listed by compare_commits.
+type CommitSummary struct {
+	SHA     string    `json:"sha"`
+	Message string    `json:"message"`
+	Author  string    `json:"author"`
+	Date    time.Time `json:"date"`
+	HTMLURL string    `json:"html_url,omitempty"`
+}
+
+// CompareCommitsOutput is the result of compare_commits. Status is one of
+// ahead, behind, identical or diverged, describing head relative to base.
+// Files reuses the get_pull_request_files shape.
+type CompareCommitsOutput struct {
+	Status       string            `json:"status"`
+	AheadBy      int               `json:"ahead_by"`
+	BehindBy     int               `json:"behind_by"`
+	TotalCommits int               `json:"total_commits"`
+	Commits      []CommitSummary   `json:"commits"`
+	Files        []PullRequestFile `json:"files"`
+	HTMLURL      string            `json:"html_url,omitempty"`
+	NextPage     int               `json:"next_page,omitempty"`
+	HasNextPage  bool              `json:"has_next_page"`
+}
+
+// CompareCommits creates a tool to compare two commits, branches or tags.
+func CompareCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("compare_commits",
+			mcp.WithDescription(t("TOOL_COMPARE_COMMITS_DESCRIPTION", "Compare two commits, branches or tags, listing the commits and files that differ")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("base",
+				mcp.Required(),
+				mcp.Description("Base commit SHA, branch or tag"),
+			),
+			mcp.WithString("head",
+				mcp.Required(),
+				mcp.Description("Head commit SHA, branch or tag; use owner:branch for a fork"),
+			),
+			mcp.WithNumber("maxPatchLines",
+				mcp.Description("Truncate each file's patch to this many lines, 0 keeps the full patch"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			base, err := requiredParam[string](request, "base")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			head, err := requiredParam[string](request, "head")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxPatchLines, err := OptionalIntParam(request, "maxPatchLines")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			comparison, resp, err := client.Repositories.CompareCommits(ctx, owner, repo, base, head, &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to compare commits: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to compare commits: %s", string(body))), nil
+			}
+
+			out := CompareCommitsOutput{
+				Status:       comparison.GetStatus(),
+				AheadBy:      comparison.GetAheadBy(),
+				BehindBy:     comparison.GetBehindBy(),
+				TotalCommits: comparison.GetTotalCommits(),
+				Commits:      make([]CommitSummary, 0, len(comparison.Commits)),
+				Files:        make([]PullRequestFile, 0, len(comparison.Files)),
+				HTMLURL:      comparison.GetHTMLURL(),
+				NextPage:     resp.NextPage,
+				HasNextPage:  resp.NextPage != 0,
+			}
+			for _, c := range comparison.Commits {
+				summary := CommitSummary{
+					SHA:     c.GetSHA(),
+					Message: c.GetCommit().GetMessage(),
+					Author:  c.GetAuthor().GetLogin(),
+					Date:    c.GetCommit().GetAuthor().GetDate().Time,
+					HTMLURL: c.GetHTMLURL(),
+				}
+				if summary.Author == "" {
+					summary.Author = c.GetCommit().GetAuthor().GetName()
+				}
+				out.Commits = append(out.Commits, summary)
+			}
+			for _, f := range comparison.Files {
+				out.Files = append(out.Files, pullRequestFile(f, maxPatchLines))
+			}
+
+			r, err := json.Marshal(out)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,488 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetCommit(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetCommit(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_commit", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "sha")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "sha"})
+
+	mockCommit := &github.RepositoryCommit{
+		SHA: github.Ptr("abc123def456"),
+		Commit: &github.Commit{
+			Message: github.Ptr("First commit"),
+			Author: &github.CommitAuthor{
+				Name:  github.Ptr("Test User"),
+				Email: github.Ptr("test@example.com"),
+				Date:  &github.Timestamp{Time: time.Now().Add(-48 * time.Hour)},
+			},
+		},
+		Author: &github.User{
+			Login: github.Ptr("testuser"),
+		},
+		HTMLURL: github.Ptr("https://github.com/owner/repo/commit/abc123def456"),
+		Stats: &github.CommitStats{
+			Additions: github.Ptr(10),
+			Deletions: github.Ptr(2),
+			Total:     github.Ptr(12),
+		},
+		Files: []*github.CommitFile{
+			{
+				Filename:  github.Ptr("file1.go"),
+				Status:    github.Ptr("modified"),
+				Additions: github.Ptr(10),
+				Deletions: github.Ptr(2),
+				Changes:   github.Ptr(12),
+				Patch:     github.Ptr("@@ -1,2 +1,10 @@"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedCommit *github.RepositoryCommit
+		expectedErrMsg string
+	}{
+		{
+			name: "successful commit fetch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusOK, mockCommit),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "abc123def456",
+			},
+			expectError:    false,
+			expectedCommit: mockCommit,
+		},
+		{
+			name: "commit fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "nonexistent-sha",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get commit",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetCommit(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedCommit github.RepositoryCommit
+			err = json.Unmarshal([]byte(textContent.Text), &returnedCommit)
+			require.NoError(t, err)
+
+			assert.Equal(t, *tc.expectedCommit.SHA, *returnedCommit.SHA)
+			assert.Equal(t, *tc.expectedCommit.Commit.Message, *returnedCommit.Commit.Message)
+			assert.Equal(t, *tc.expectedCommit.Author.Login, *returnedCommit.Author.Login)
+			assert.Equal(t, *tc.expectedCommit.HTMLURL, *returnedCommit.HTMLURL)
+		})
+	}
+}
+
+func Test_ListCommits(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListCommits(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_commits", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "sha")
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.Contains(t, tool.InputSchema.Properties, "since")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	// Setup mock commits for success case
+	mockCommits := []*github.RepositoryCommit{
+		{
+			SHA: github.Ptr("abc123def456"),
+			Commit: &github.Commit{
+				Message: github.Ptr("First commit"),
+				Author: &github.CommitAuthor{
+					Name:  github.Ptr("Test User"),
+					Email: github.Ptr("test@example.com"),
+					Date:  &github.Timestamp{Time: time.Now().Add(-48 * time.Hour)},
+				},
+			},
+			Author: &github.User{
+				Login: github.Ptr("testuser"),
+			},
+			HTMLURL: github.Ptr("https://github.com/owner/repo/commit/abc123def456"),
+		},
+		{
+			SHA: github.Ptr("def456abc789"),
+			Commit: &github.Commit{
+				Message: github.Ptr("Second commit"),
+				Author: &github.CommitAuthor{
+					Name:  github.Ptr("Another User"),
+					Email: github.Ptr("another@example.com"),
+					Date:  &github.Timestamp{Time: time.Now().Add(-24 * time.Hour)},
+				},
+			},
+			Author: &github.User{
+				Login: github.Ptr("anotheruser"),
+			},
+			HTMLURL: github.Ptr("https://github.com/owner/repo/commit/def456abc789"),
+		},
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedCommits []*github.RepositoryCommit
+		expectedErrMsg  string
+	}{
+		{
+			name: "successful commits fetch with default params",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCommitsByOwnerByRepo,
+					mockCommits,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:     false,
+			expectedCommits: mockCommits,
+		},
+		{
+			name: "successful commits fetch with branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"sha":      "main",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockCommits),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "main",
+			},
+			expectError:     false,
+			expectedCommits: mockCommits,
+		},
+		{
+			name: "successful commits fetch with pagination",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockCommits),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"page":    float64(2),
+				"perPage": float64(10),
+			},
+			expectError:     false,
+			expectedCommits: mockCommits,
+		},
+		{
+			name: "successful commits fetch with filters",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"path":     "pkg/github",
+						"author":   "testuser",
+						"since":    "2025-01-01T00:00:00Z",
+						"until":    "2025-02-01T00:00:00Z",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockCommits),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"path":   "pkg/github",
+				"author": "testuser",
+				"since":  "2025-01-01T00:00:00Z",
+				"until":  "2025-02-01T00:00:00Z",
+			},
+			expectError:     false,
+			expectedCommits: mockCommits,
+		},
+		{
+			name: "commits fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "nonexistent-repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list commits",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListCommits(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedCommits []*github.RepositoryCommit
+			err = json.Unmarshal([]byte(textContent.Text), &returnedCommits)
+			require.NoError(t, err)
+			assert.Len(t, returnedCommits, len(tc.expectedCommits))
+			for i, commit := range returnedCommits {
+				assert.Equal(t, *tc.expectedCommits[i].SHA, *commit.SHA)
+				assert.Equal(t, *tc.expectedCommits[i].Commit.Message, *commit.Commit.Message)
+				assert.Equal(t, *tc.expectedCommits[i].Author.Login, *commit.Author.Login)
+				assert.Equal(t, *tc.expectedCommits[i].HTMLURL, *commit.HTMLURL)
+			}
+		})
+	}
+}
+
+func Test_GetCommit_MaxPatchLines(t *testing.T) {
+	mockCommit := &github.RepositoryCommit{
+		SHA: github.Ptr("abc123def456"),
+		Files: []*github.CommitFile{
+			{Filename: github.Ptr("file1.go"), Patch: github.Ptr("@@ -1,2 +1,3 @@\n line\n+added\n line")},
+			{Filename: github.Ptr("file2.go"), Patch: github.Ptr("@@ -1 +1 @@")},
+		},
+	}
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposCommitsByOwnerByRepoByRef,
+			mockCommit,
+		),
+	))
+	_, handler := GetCommit(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":         "owner",
+		"repo":          "repo",
+		"sha":           "abc123def456",
+		"maxPatchLines": float64(2),
+	}))
+	require.NoError(t, err)
+
+	var returnedCommit github.RepositoryCommit
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returnedCommit))
+	require.Len(t, returnedCommit.Files, 2)
+	assert.Equal(t, "@@ -1,2 +1,3 @@\n line\n", returnedCommit.Files[0].GetPatch())
+	assert.Equal(t, "@@ -1 +1 @@", returnedCommit.Files[1].GetPatch())
+}
+
+func Test_CompareCommits(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := CompareCommits(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "compare_commits", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "maxPatchLines")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "base", "head"})
+
+	date := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	mockComparison := &github.CommitsComparison{
+		Status:       github.Ptr("ahead"),
+		AheadBy:      github.Ptr(2),
+		BehindBy:     github.Ptr(0),
+		TotalCommits: github.Ptr(2),
+		HTMLURL:      github.Ptr("https://github.com/owner/repo/compare/v1.0.0...main"),
+		Commits: []*github.RepositoryCommit{
+			{
+				SHA:    github.Ptr("abc123"),
+				Commit: &github.Commit{Message: github.Ptr("Add feature"), Author: &github.CommitAuthor{Name: github.Ptr("Test User"), Date: &github.Timestamp{Time: date}}},
+				Author: &github.User{Login: github.Ptr("testuser")},
+			},
+			{
+				SHA:    github.Ptr("def456"),
+				Commit: &github.Commit{Message: github.Ptr("Fix bug"), Author: &github.CommitAuthor{Name: github.Ptr("No Account"), Date: &github.Timestamp{Time: date}}},
+			},
+		},
+		Files: []*github.CommitFile{
+			{Filename: github.Ptr("main.go"), Status: github.Ptr("modified"), Additions: github.Ptr(3), Deletions: github.Ptr(1), Patch: github.Ptr("@@ -1 +1,3 @@\n-a\n+b\n+c\n+d")},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "successful comparison",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockComparison),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"base":          "v1.0.0",
+				"head":          "main",
+				"maxPatchLines": float64(2),
+			},
+		},
+		{
+			name: "comparison fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "v1.0.0",
+				"head":  "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to compare commits",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CompareCommits(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			var out CompareCommitsOutput
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &out))
+			assert.Equal(t, "ahead", out.Status)
+			assert.Equal(t, 2, out.AheadBy)
+			assert.Equal(t, 2, out.TotalCommits)
+			require.Len(t, out.Commits, 2)
+			assert.Equal(t, CommitSummary{SHA: "abc123", Message: "Add feature", Author: "testuser", Date: date}, out.Commits[0])
+			assert.Equal(t, "No Account", out.Commits[1].Author)
+			require.Len(t, out.Files, 1)
+			assert.Equal(t, "main.go", out.Files[0].Path)
+			assert.Equal(t, "@@ -1 +1,3 @@\n-a\n", out.Files[0].Patch)
+			assert.True(t, out.Files[0].PatchTruncated)
+			assert.False(t, out.HasNextPage)
+		})
+	}
+}
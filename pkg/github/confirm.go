@@ -0,0 +1,107 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/github/github-mcp-server/pkg/toolsets"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// destructiveTools describes, from its arguments, the operation of each tool
+// that RequireConfirmation asks the user to confirm.
+var destructiveTools = map[string]func(args map[string]any) string{
+	"delete_file": func(args map[string]any) string {
+		return fmt.Sprintf("Delete %v from %v/%v on branch %v?", args["path"], args["owner"], args["repo"], args["branch"])
+	},
+	"merge_pull_request": func(args map[string]any) string {
+		method := args["merge_method"]
+		if method == nil {
+			method = "merge"
+		}
+		return fmt.Sprintf("Merge pull request %v/%v#%v with the %v method?", args["owner"], args["repo"], args["pullNumber"], method)
+	},
+	"delete_project": func(args map[string]any) string {
+		return fmt.Sprintf("Permanently delete project %v with all of its items and fields?", args["project_id"])
+	},
+}
+
+// confirmationSchema is the form the user fills in: a single checkbox.
+var confirmationSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"confirm": map[string]any{
+			"type":        "boolean",
+			"title":       "Confirm",
+			"description": "Run the operation",
+		},
+	},
+	"required": []string{"confirm"},
+}
+
+// RequireConfirmation makes the destructive tools of tsg, such as
+// delete_file, ask the user to confirm each call through MCP elicitation
+// before running it. Calls the user declines or cancels fail without running,
+// and so do calls from clients that cannot be asked.
+func RequireConfirmation(tsg *toolsets.ToolsetGroup) {
+	names := make([]string, 0, len(destructiveTools))
+	for name := range destructiveTools {
+		names = append(names, name)
+	}
+	for _, toolset := range tsg.Toolsets {
+		toolset.WrapTools(func(tool mcp.Tool, handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+			return withConfirmation(destructiveTools[tool.Name], handler)
+		}, names...)
+	}
+}
+
+// withConfirmation wraps a tool handler so that it only runs once the user
+// has accepted the operation describe returns for the call.
+func withConfirmation(describe func(args map[string]any) string, handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		notConfirmed := ToolError{
+			Code: ErrorCodeNotConfirmed,
+			Hint: "This server requires the user to confirm this tool in their client; it was not run",
+		}
+		s := server.ServerFromContext(ctx)
+		if s == nil || !supportsElicitation(ctx) {
+			notConfirmed.Message = "the client does not support elicitation, which this server needs to ask the user to confirm the operation"
+			return notConfirmed.Result(), nil
+		}
+
+		result, err := s.RequestElicitation(ctx, mcp.ElicitationRequest{
+			Params: mcp.ElicitationParams{
+				Message:         describe(request.GetArguments()),
+				RequestedSchema: confirmationSchema,
+			},
+		})
+		if err != nil {
+			notConfirmed.Message = fmt.Sprintf("failed to ask the user to confirm the operation: %v", err)
+			return notConfirmed.Result(), nil
+		}
+		if result.Action != mcp.ElicitationResponseActionAccept {
+			notConfirmed.Message = fmt.Sprintf("the user did not confirm the operation (%s)", result.Action)
+			return notConfirmed.Result(), nil
+		}
+		if content, _ := result.Content.(map[string]any); content["confirm"] != true {
+			notConfirmed.Message = "the user did not confirm the operation"
+			return notConfirmed.Result(), nil
+		}
+		return handler(ctx, request)
+	}
+}
+
+// supportsElicitation reports whether the client of the call's session
+// declared the elicitation capability. Sessions that do not record the
+// client's capabilities are given the benefit of the doubt.
+func supportsElicitation(ctx context.Context) bool {
+	session := server.ClientSessionFromContext(ctx)
+	if _, ok := session.(server.SessionWithElicitation); !ok {
+		return false
+	}
+	if withInfo, ok := session.(server.SessionWithClientInfo); ok {
+		return withInfo.GetClientCapabilities().Elicitation != nil
+	}
+	return true
+}
@@ -0,0 +1,128 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/toolsets"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// elicitingSession answers elicitation requests with result, recording them.
+type elicitingSession struct {
+	capabilities mcp.ClientCapabilities
+	result       *mcp.ElicitationResult
+	requests     []mcp.ElicitationRequest
+}
+
+func (s *elicitingSession) SessionID() string { return "test" }
+
+func (s *elicitingSession) NotificationChannel() chan<- mcp.JSONRPCNotification {
+	return make(chan mcp.JSONRPCNotification, 10)
+}
+
+func (s *elicitingSession) Initialize() {}
+
+func (s *elicitingSession) Initialized() bool { return true }
+
+func (s *elicitingSession) GetClientInfo() mcp.Implementation { return mcp.Implementation{} }
+
+func (s *elicitingSession) SetClientInfo(mcp.Implementation) {}
+
+func (s *elicitingSession) GetClientCapabilities() mcp.ClientCapabilities { return s.capabilities }
+
+func (s *elicitingSession) SetClientCapabilities(mcp.ClientCapabilities) {}
+
+func (s *elicitingSession) RequestElicitation(_ context.Context, request mcp.ElicitationRequest) (*mcp.ElicitationResult, error) {
+	s.requests = append(s.requests, request)
+	return s.result, nil
+}
+
+func Test_RequireConfirmation(t *testing.T) {
+	elicitation := mcp.ClientCapabilities{Elicitation: &struct{}{}}
+	accept := func(content any) *mcp.ElicitationResult {
+		return &mcp.ElicitationResult{ElicitationResponse: mcp.ElicitationResponse{Action: mcp.ElicitationResponseActionAccept, Content: content}}
+	}
+
+	tests := []struct {
+		name            string
+		capabilities    mcp.ClientCapabilities
+		result          *mcp.ElicitationResult
+		expectMerged    bool
+		expectElicited  bool
+		expectedMessage string
+	}{
+		{
+			name:           "confirmed",
+			capabilities:   elicitation,
+			result:         accept(map[string]any{"confirm": true}),
+			expectMerged:   true,
+			expectElicited: true,
+		},
+		{
+			name:            "accepted unchecked",
+			capabilities:    elicitation,
+			result:          accept(map[string]any{"confirm": false}),
+			expectElicited:  true,
+			expectedMessage: "the user did not confirm the operation",
+		},
+		{
+			name:            "declined",
+			capabilities:    elicitation,
+			result:          &mcp.ElicitationResult{ElicitationResponse: mcp.ElicitationResponse{Action: mcp.ElicitationResponseActionDecline}},
+			expectElicited:  true,
+			expectedMessage: "the user did not confirm the operation (decline)",
+		},
+		{
+			name:            "client without elicitation",
+			expectedMessage: "the client does not support elicitation, which this server needs to ask the user to confirm the operation",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			merged := false
+			tsg := toolsets.NewToolsetGroup(false)
+			tsg.AddToolset(toolsets.NewToolset("pull_requests", "Pull requests").
+				AddWriteTools(newServerTool(mcp.NewTool("merge_pull_request"), func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+					merged = true
+					return mcp.NewToolResultText("merged"), nil
+				})))
+			require.NoError(t, tsg.EnableToolset("pull_requests"))
+			RequireConfirmation(tsg)
+
+			s := NewServer("test", server.WithElicitation())
+			tsg.RegisterTools(s)
+			session := &elicitingSession{capabilities: tc.capabilities, result: tc.result}
+			require.NoError(t, s.RegisterSession(context.Background(), session))
+
+			message := `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"merge_pull_request","arguments":{"owner":"owner","repo":"repo","pullNumber":42}}}`
+			response := s.HandleMessage(s.WithContext(context.Background(), session), json.RawMessage(message))
+			rpc, ok := response.(mcp.JSONRPCResponse)
+			require.True(t, ok, "unexpected response %#v", response)
+			result, ok := rpc.Result.(mcp.CallToolResult)
+			require.True(t, ok, "unexpected result %#v", rpc.Result)
+
+			assert.Equal(t, tc.expectMerged, merged)
+			if tc.expectElicited {
+				require.Len(t, session.requests, 1)
+				assert.Equal(t, "Merge pull request owner/repo#42 with the merge method?", session.requests[0].Params.Message)
+			} else {
+				assert.Empty(t, session.requests)
+			}
+			if tc.expectMerged {
+				assert.Equal(t, "merged", getTextResult(t, &result).Text)
+				return
+			}
+			require.True(t, result.IsError)
+			var te ToolError
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, &result).Text), &te))
+			assert.Equal(t, ErrorCodeNotConfirmed, te.Code)
+			assert.Equal(t, tc.expectedMessage, te.Message)
+		})
+	}
+}
@@ -8,14 +8,24 @@ import (
 	"net/http"
 
 	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 )
 
+// MeOutput is the authenticated user with what its token can do. Scopes
+// lists the OAuth scopes of classic tokens and is omitted for fine-grained
+// tokens and GitHub Apps, whose permissions GitHub does not report.
+type MeOutput struct {
+	*github.User
+	Scopes    []string     `json:"scopes,omitempty"`
+	RateLimit *github.Rate `json:"rate_limit,omitempty"`
+}
+
 // GetMe creates a tool to get details of the authenticated user.
 func GetMe(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_me",
-			mcp.WithDescription(t("TOOL_GET_ME_DESCRIPTION", "Get details of the authenticated GitHub user. Use this when a request include \"me\", \"my\"...")),
+			mcp.WithDescription(t("TOOL_GET_ME_DESCRIPTION", "Get details of the authenticated GitHub user, with the scopes of its token and its remaining rate limit. Use this when a request include \"me\", \"my\"...")),
 			mcp.WithString("reason",
 				mcp.Description("Optional: reason the session was created"),
 			),
@@ -39,7 +49,13 @@ func GetMe(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mc
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get user: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(user)
+			out := MeOutput{User: user}
+			out.Scopes, _ = tokenScopes(resp)
+			if resp.Rate.Limit > 0 {
+				out.RateLimit = &resp.Rate
+			}
+
+			r, err := json.Marshal(out)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal user: %w", err)
 			}
@@ -130,3 +130,36 @@ func Test_GetMe(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetMe_TokenDetails(t *testing.T) {
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetUser,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-OAuth-Scopes", "repo, read:org, workflow")
+				w.Header().Set("X-RateLimit-Limit", "5000")
+				w.Header().Set("X-RateLimit-Remaining", "4987")
+				w.Header().Set("X-RateLimit-Used", "13")
+				w.Header().Set("X-RateLimit-Reset", "1760000000")
+				w.Header().Set("X-RateLimit-Resource", "core")
+				mockResponse(t, http.StatusOK, &github.User{Login: github.Ptr("testuser")})(w, r)
+			}),
+		),
+	))
+	_, handler := GetMe(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned MeOutput
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, "testuser", returned.GetLogin())
+	assert.Equal(t, []string{"repo", "read:org", "workflow"}, returned.Scopes)
+	require.NotNil(t, returned.RateLimit)
+	assert.Equal(t, 5000, returned.RateLimit.Limit)
+	assert.Equal(t, 4987, returned.RateLimit.Remaining)
+	assert.Equal(t, 13, returned.RateLimit.Used)
+	assert.Equal(t, "core", returned.RateLimit.Resource)
+	assert.Equal(t, int64(1760000000), returned.RateLimit.Reset.Unix())
+}
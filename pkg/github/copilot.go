@@ -0,0 +1,288 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// copilotAgentAssignee is the assignee login that hands an issue to the
+// Copilot coding agent. The agent then shows up as "Copilot" on the issue
+// and on the pull requests it opens.
+const copilotAgentAssignee = "copilot-swe-agent[bot]"
+
+// copilotAgentAssignment carries the extra instructions the REST API accepts
+// alongside the Copilot assignee. go-github does not model it, so the
+// requests are built by hand.
+type copilotAgentAssignment struct {
+	TargetRepo         string `json:"target_repo"`
+	BaseBranch         string `json:"base_branch,omitempty"`
+	CustomInstructions string `json:"custom_instructions,omitempty"`
+}
+
+type copilotIssueRequest struct {
+	Title           string                  `json:"title,omitempty"`
+	Body            string                  `json:"body,omitempty"`
+	Assignees       []string                `json:"assignees"`
+	AgentAssignment *copilotAgentAssignment `json:"agent_assignment"`
+}
+
+// CopilotPullRequest is the pull request the Copilot coding agent opened for
+// an issue.
+type CopilotPullRequest struct {
+	Number  int    `json:"number"`
+	Title   string `json:"title"`
+	State   string `json:"state"`
+	Draft   bool   `json:"draft"`
+	Merged  bool   `json:"merged"`
+	HTMLURL string `json:"html_url"`
+}
+
+// CopilotSessionStatus is the get_copilot_session_status result.
+type CopilotSessionStatus struct {
+	// Status is one of not_assigned, queued, in_progress, ready_for_review,
+	// merged or closed.
+	Status      string              `json:"status"`
+	Assigned    bool                `json:"assigned"`
+	PullRequest *CopilotPullRequest `json:"pull_request,omitempty"`
+}
+
+// isCopilotLogin reports whether login belongs to the Copilot coding agent,
+// which appears as "Copilot" or "copilot-swe-agent" depending on the API.
+func isCopilotLogin(login string) bool {
+	login = strings.TrimSuffix(strings.ToLower(login), "[bot]")
+	return login == "copilot" || login == "copilot-swe-agent"
+}
+
+// AssignCopilotToIssue creates a tool to hand an issue, or a new task, to the
+// Copilot coding agent.
+func AssignCopilotToIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("assign_copilot_to_issue",
+			mcp.WithDescription(t("TOOL_ASSIGN_COPILOT_TO_ISSUE_DESCRIPTION", "Assign the Copilot coding agent to an issue so it works on it asynchronously and opens a pull request. Omit issue_number and pass a title to open a new issue for the task. Use get_copilot_session_status to follow progress")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Description("Issue to assign; omit to open a new issue for the task"),
+			),
+			mcp.WithString("title",
+				mcp.Description("Title of the new issue, required when issue_number is omitted"),
+			),
+			mcp.WithString("problem_statement",
+				mcp.Description("What Copilot should do. Used as the body of a new issue, or as extra instructions for an existing one"),
+			),
+			mcp.WithString("base_ref",
+				mcp.Description("Branch Copilot should start from and target with its pull request, defaults to the repository's default branch"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := OptionalIntParam(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			title, err := OptionalParam[string](request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			problemStatement, err := OptionalParam[string](request, "problem_statement")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			baseRef, err := OptionalParam[string](request, "base_ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			body := copilotIssueRequest{
+				Assignees: []string{copilotAgentAssignee},
+				AgentAssignment: &copilotAgentAssignment{
+					TargetRepo: owner + "/" + repo,
+					BaseBranch: baseRef,
+				},
+			}
+			var u string
+			if issueNumber == 0 {
+				if title == "" {
+					return mcp.NewToolResultError("title is required when issue_number is omitted"), nil
+				}
+				body.Title = title
+				body.Body = problemStatement
+				u = fmt.Sprintf("repos/%s/%s/issues", owner, repo)
+			} else {
+				body.AgentAssignment.CustomInstructions = problemStatement
+				u = fmt.Sprintf("repos/%s/%s/issues/%d/assignees", owner, repo, issueNumber)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			req, err := client.NewRequest(http.MethodPost, u, body)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+			issue := new(github.Issue)
+			resp, err := client.Do(ctx, req, issue)
+			if err != nil {
+				return nil, fmt.Errorf("failed to assign Copilot: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to assign Copilot: %s", string(body))), nil
+			}
+
+			assigned := false
+			for _, a := range issue.Assignees {
+				if isCopilotLogin(a.GetLogin()) {
+					assigned = true
+					break
+				}
+			}
+			if !assigned {
+				// The API drops assignees it cannot apply instead of failing.
+				return mcp.NewToolResultError(fmt.Sprintf("Copilot was not assigned to %s/%s#%d; check that the Copilot coding agent is enabled for this repository", owner, repo, issue.GetNumber())), nil
+			}
+
+			r, err := json.Marshal(issue)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetCopilotSessionStatus creates a tool to report how far the Copilot coding
+// agent has got with an issue, based on the pull request it opened for it.
+func GetCopilotSessionStatus(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_copilot_session_status",
+			mcp.WithDescription(t("TOOL_GET_COPILOT_SESSION_STATUS_DESCRIPTION", "Get the status of the Copilot coding agent's work on an issue: not_assigned, queued (assigned, no pull request yet), in_progress (draft pull request), ready_for_review, merged or closed")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue assigned to Copilot"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			issue, resp, err := client.Issues.Get(ctx, owner, repo, issueNumber)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get issue: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get issue: %s", string(body))), nil
+			}
+
+			var status CopilotSessionStatus
+			for _, a := range issue.Assignees {
+				if isCopilotLogin(a.GetLogin()) {
+					status.Assigned = true
+					break
+				}
+			}
+
+			// Copilot links its pull request to the issue, which shows up as a
+			// cross-reference on the issue timeline. The last one is the most
+			// recent session.
+			events, _, err := fetchAllPages(ctx, 0, func(ctx context.Context, page int) ([]*github.Timeline, *github.Response, error) {
+				return client.Issues.ListIssueTimeline(ctx, owner, repo, issueNumber, &github.ListOptions{Page: page, PerPage: 100})
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list issue timeline: %w", err)
+			}
+			for _, e := range events {
+				if e.GetEvent() != "cross-referenced" || e.Source == nil || e.Source.Issue == nil {
+					continue
+				}
+				pr := e.Source.Issue
+				if !pr.IsPullRequest() || !isCopilotLogin(pr.GetUser().GetLogin()) {
+					continue
+				}
+				status.PullRequest = &CopilotPullRequest{
+					Number:  pr.GetNumber(),
+					Title:   pr.GetTitle(),
+					State:   pr.GetState(),
+					Draft:   pr.GetDraft(),
+					Merged:  pr.GetPullRequestLinks().GetMergedAt() != github.Timestamp{},
+					HTMLURL: pr.GetHTMLURL(),
+				}
+			}
+
+			switch pr := status.PullRequest; {
+			case pr == nil && status.Assigned:
+				status.Status = "queued"
+			case pr == nil:
+				status.Status = "not_assigned"
+			case pr.Merged:
+				status.Status = "merged"
+			case pr.State == "closed":
+				status.Status = "closed"
+			case pr.Draft:
+				status.Status = "in_progress"
+			default:
+				status.Status = "ready_for_review"
+			}
+
+			r, err := json.Marshal(status)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
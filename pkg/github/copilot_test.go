@@ -0,0 +1,228 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AssignCopilotToIssue(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := AssignCopilotToIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "assign_copilot_to_issue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "problem_statement")
+	assert.Contains(t, tool.InputSchema.Properties, "base_ref")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	assignedIssue := &github.Issue{
+		Number:    github.Ptr(42),
+		Assignees: []*github.User{{Login: github.Ptr("Copilot")}},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectToolErr  bool
+		expectedErrMsg string
+	}{
+		{
+			name: "existing issue with instructions",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesAssigneesByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"assignees": []interface{}{"copilot-swe-agent[bot]"},
+						"agent_assignment": map[string]interface{}{
+							"target_repo":         "owner/repo",
+							"base_branch":         "release",
+							"custom_instructions": "Keep the public API unchanged",
+						},
+					}).andThen(mockResponse(t, http.StatusCreated, assignedIssue)),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"issue_number":      float64(42),
+				"problem_statement": "Keep the public API unchanged",
+				"base_ref":          "release",
+			},
+		},
+		{
+			name: "new task",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"title":     "Add retries",
+						"body":      "Retry failed uploads three times",
+						"assignees": []interface{}{"copilot-swe-agent[bot]"},
+						"agent_assignment": map[string]interface{}{
+							"target_repo": "owner/repo",
+						},
+					}).andThen(mockResponse(t, http.StatusCreated, assignedIssue)),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"title":             "Add retries",
+				"problem_statement": "Retry failed uploads three times",
+			},
+		},
+		{
+			name:           "new task without title",
+			mockedClient:   mock.NewMockedHTTPClient(),
+			requestArgs:    map[string]interface{}{"problem_statement": "Do something"},
+			expectToolErr:  true,
+			expectedErrMsg: "title is required when issue_number is omitted",
+		},
+		{
+			name: "agent not enabled",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesAssigneesByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusCreated, &github.Issue{Number: github.Ptr(42)}),
+				),
+			),
+			requestArgs:    map[string]interface{}{"issue_number": float64(42)},
+			expectToolErr:  true,
+			expectedErrMsg: "Copilot was not assigned to owner/repo#42",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := AssignCopilotToIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			tc.requestArgs["owner"] = "owner"
+			tc.requestArgs["repo"] = "repo"
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			text := getTextResult(t, result).Text
+			if tc.expectToolErr {
+				require.True(t, result.IsError)
+				assert.Contains(t, text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned github.Issue
+			require.NoError(t, json.Unmarshal([]byte(text), &returned))
+			assert.Equal(t, 42, returned.GetNumber())
+		})
+	}
+}
+
+func Test_GetCopilotSessionStatus(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := GetCopilotSessionStatus(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_copilot_session_status", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
+
+	copilotIssue := &github.Issue{
+		Number:    github.Ptr(42),
+		Assignees: []*github.User{{Login: github.Ptr("Copilot")}},
+	}
+	crossReference := func(pr *github.Issue) *github.Timeline {
+		return &github.Timeline{
+			Event:  github.Ptr("cross-referenced"),
+			Source: &github.Source{Type: github.Ptr("issue"), Issue: pr},
+		}
+	}
+	copilotPR := func(state string, draft bool, mergedAt *github.Timestamp) *github.Issue {
+		return &github.Issue{
+			Number:           github.Ptr(43),
+			Title:            github.Ptr("Add retries"),
+			State:            github.Ptr(state),
+			Draft:            github.Ptr(draft),
+			User:             &github.User{Login: github.Ptr("Copilot")},
+			HTMLURL:          github.Ptr("https://github.com/owner/repo/pull/43"),
+			PullRequestLinks: &github.PullRequestLinks{MergedAt: mergedAt},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		issue    *github.Issue
+		timeline []*github.Timeline
+		expected CopilotSessionStatus
+	}{
+		{
+			name:     "not assigned",
+			issue:    &github.Issue{Number: github.Ptr(42)},
+			timeline: []*github.Timeline{},
+			expected: CopilotSessionStatus{Status: "not_assigned"},
+		},
+		{
+			name:  "queued ignores pull requests by other authors",
+			issue: copilotIssue,
+			timeline: []*github.Timeline{
+				crossReference(&github.Issue{
+					Number:           github.Ptr(7),
+					User:             &github.User{Login: github.Ptr("octocat")},
+					PullRequestLinks: &github.PullRequestLinks{},
+				}),
+			},
+			expected: CopilotSessionStatus{Status: "queued", Assigned: true},
+		},
+		{
+			name:     "draft pull request",
+			issue:    copilotIssue,
+			timeline: []*github.Timeline{crossReference(copilotPR("open", true, nil))},
+			expected: CopilotSessionStatus{
+				Status:   "in_progress",
+				Assigned: true,
+				PullRequest: &CopilotPullRequest{
+					Number: 43, Title: "Add retries", State: "open", Draft: true,
+					HTMLURL: "https://github.com/owner/repo/pull/43",
+				},
+			},
+		},
+		{
+			name:     "merged",
+			issue:    &github.Issue{Number: github.Ptr(42)},
+			timeline: []*github.Timeline{crossReference(copilotPR("closed", false, &github.Timestamp{Time: time.Date(2025, 5, 2, 0, 0, 0, 0, time.UTC)}))},
+			expected: CopilotSessionStatus{
+				Status: "merged",
+				PullRequest: &CopilotPullRequest{
+					Number: 43, Title: "Add retries", State: "closed", Merged: true,
+					HTMLURL: "https://github.com/owner/repo/pull/43",
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposIssuesByOwnerByRepoByIssueNumber, tc.issue),
+				mock.WithRequestMatch(mock.GetReposIssuesTimelineByOwnerByRepoByIssueNumber, tc.timeline),
+			))
+			_, handler := GetCopilotSessionStatus(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			}))
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			var returned CopilotSessionStatus
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
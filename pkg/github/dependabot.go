@@ -0,0 +1,348 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// DependabotAlertSummary is one alert in a list_dependabot_alerts result,
+// flattened from the dependency, advisory and vulnerability it joins.
+type DependabotAlertSummary struct {
+	Number                 int        `json:"number"`
+	State                  string     `json:"state"`
+	Severity               string     `json:"severity"`
+	Ecosystem              string     `json:"ecosystem"`
+	Package                string     `json:"package"`
+	ManifestPath           string     `json:"manifest_path"`
+	Scope                  string     `json:"scope,omitempty"`
+	GHSAID                 string     `json:"ghsa_id"`
+	CVEID                  string     `json:"cve_id,omitempty"`
+	Summary                string     `json:"summary"`
+	VulnerableVersionRange string     `json:"vulnerable_version_range,omitempty"`
+	FirstPatchedVersion    string     `json:"first_patched_version,omitempty"`
+	HTMLURL                string     `json:"html_url"`
+	CreatedAt              *time.Time `json:"created_at,omitempty"`
+}
+
+// ListDependabotAlertsOutput is the result of list_dependabot_alerts. The
+// endpoint pages by cursor, so EndCursor can be passed as after to resume.
+type ListDependabotAlertsOutput struct {
+	Alerts      []DependabotAlertSummary `json:"alerts"`
+	EndCursor   string                   `json:"end_cursor,omitempty"`
+	HasNextPage bool                     `json:"has_next_page"`
+}
+
+func dependabotAlertSummary(alert *github.DependabotAlert) DependabotAlertSummary {
+	summary := DependabotAlertSummary{
+		Number:                 alert.GetNumber(),
+		State:                  alert.GetState(),
+		Severity:               alert.GetSecurityAdvisory().GetSeverity(),
+		Ecosystem:              alert.GetDependency().GetPackage().GetEcosystem(),
+		Package:                alert.GetDependency().GetPackage().GetName(),
+		ManifestPath:           alert.GetDependency().GetManifestPath(),
+		Scope:                  alert.GetDependency().GetScope(),
+		GHSAID:                 alert.GetSecurityAdvisory().GetGHSAID(),
+		CVEID:                  alert.GetSecurityAdvisory().GetCVEID(),
+		Summary:                alert.GetSecurityAdvisory().GetSummary(),
+		VulnerableVersionRange: alert.GetSecurityVulnerability().GetVulnerableVersionRange(),
+		FirstPatchedVersion:    alert.GetSecurityVulnerability().GetFirstPatchedVersion().GetIdentifier(),
+		HTMLURL:                alert.GetHTMLURL(),
+	}
+	if alert.CreatedAt != nil {
+		summary.CreatedAt = &alert.CreatedAt.Time
+	}
+	return summary
+}
+
+// ListDependabotAlerts creates a tool to list the Dependabot alerts of a
+// repository.
+func ListDependabotAlerts(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_dependabot_alerts",
+			mcp.WithDescription(t("TOOL_LIST_DEPENDABOT_ALERTS_DESCRIPTION", "List the Dependabot alerts of a repository with the vulnerable package, advisory and first patched version")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("state",
+				mcp.Description("Comma-separated states to include: auto_dismissed, dismissed, fixed, open"),
+			),
+			mcp.WithString("severity",
+				mcp.Description("Comma-separated severities to include: low, medium, high, critical"),
+			),
+			mcp.WithString("ecosystem",
+				mcp.Description("Comma-separated ecosystems to include, such as npm, pip or go"),
+			),
+			mcp.WithString("package",
+				mcp.Description("Comma-separated package names to include"),
+			),
+			mcp.WithString("scope",
+				mcp.Description("Only alerts for runtime or development dependencies"),
+				mcp.Enum("development", "runtime"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort field, defaults to created"),
+				mcp.Enum("created", "updated", "epss_percentage"),
+			),
+			mcp.WithString("direction",
+				mcp.Description("Sort direction, defaults to desc"),
+				mcp.Enum("asc", "desc"),
+			),
+			mcp.WithNumber("perPage",
+				mcp.Description("Results per page (max 100)"),
+				mcp.Min(1),
+				mcp.Max(100),
+			),
+			mcp.WithString("after",
+				mcp.Description("Cursor from a previous end_cursor to get the next page"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			perPage, err := OptionalIntParamInRange(request, "perPage", 30, 1, 100)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			after, err := OptionalParam[string](request, "after")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts := &github.ListAlertsOptions{
+				ListCursorOptions: github.ListCursorOptions{
+					PerPage: perPage,
+					After:   after,
+				},
+			}
+			for param, field := range map[string]**string{
+				"state":     &opts.State,
+				"severity":  &opts.Severity,
+				"ecosystem": &opts.Ecosystem,
+				"package":   &opts.Package,
+				"scope":     &opts.Scope,
+				"sort":      &opts.Sort,
+				"direction": &opts.Direction,
+			} {
+				value, err := OptionalParam[string](request, param)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if value != "" {
+					*field = github.Ptr(value)
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			alerts, resp, err := client.Dependabot.ListRepoAlerts(ctx, owner, repo, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list alerts: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list alerts: %s", string(body))), nil
+			}
+
+			out := ListDependabotAlertsOutput{
+				Alerts:      make([]DependabotAlertSummary, 0, len(alerts)),
+				EndCursor:   resp.After,
+				HasNextPage: resp.After != "",
+			}
+			for _, alert := range alerts {
+				out.Alerts = append(out.Alerts, dependabotAlertSummary(alert))
+			}
+
+			r, err := json.Marshal(out)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetDependabotAlert creates a tool to get one Dependabot alert with the full
+// advisory.
+func GetDependabotAlert(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_dependabot_alert",
+			mcp.WithDescription(t("TOOL_GET_DEPENDABOT_ALERT_DESCRIPTION", "Get a Dependabot alert with its full security advisory")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("alertNumber",
+				mcp.Required(),
+				mcp.Description("Alert number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			alertNumber, err := RequiredInt(request, "alertNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			alert, resp, err := client.Dependabot.GetRepoAlert(ctx, owner, repo, alertNumber)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get alert: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get alert: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(alert)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal alert: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// UpdateDependabotAlert creates a tool to dismiss or reopen a Dependabot
+// alert.
+func UpdateDependabotAlert(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_dependabot_alert",
+			mcp.WithDescription(t("TOOL_UPDATE_DEPENDABOT_ALERT_DESCRIPTION", "Dismiss a Dependabot alert with a reason, or reopen a dismissed alert")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("alertNumber",
+				mcp.Required(),
+				mcp.Description("Alert number"),
+			),
+			mcp.WithString("state",
+				mcp.Required(),
+				mcp.Description("New state of the alert"),
+				mcp.Enum("open", "dismissed"),
+			),
+			mcp.WithString("dismissedReason",
+				mcp.Description("Why the alert is dismissed; required when state is dismissed"),
+				mcp.Enum("fix_started", "inaccurate", "no_bandwidth", "not_used", "tolerable_risk"),
+			),
+			mcp.WithString("dismissedComment",
+				mcp.Description("Comment explaining the dismissal, at most 280 characters"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			alertNumber, err := RequiredInt(request, "alertNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			state, err := requiredParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			dismissedReason, err := OptionalParam[string](request, "dismissedReason")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			dismissedComment, err := OptionalParam[string](request, "dismissedComment")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			stateInfo := &github.DependabotAlertState{State: state}
+			switch state {
+			case "dismissed":
+				if dismissedReason == "" {
+					return mcp.NewToolResultError("dismissedReason is required to dismiss an alert"), nil
+				}
+				if len([]rune(dismissedComment)) > 280 {
+					return mcp.NewToolResultError("dismissedComment must be at most 280 characters"), nil
+				}
+				stateInfo.DismissedReason = github.Ptr(dismissedReason)
+				if dismissedComment != "" {
+					stateInfo.DismissedComment = github.Ptr(dismissedComment)
+				}
+			default:
+				if dismissedReason != "" || dismissedComment != "" {
+					return mcp.NewToolResultError("dismissedReason and dismissedComment only apply when dismissing an alert"), nil
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			alert, resp, err := client.Dependabot.UpdateAlert(ctx, owner, repo, alertNumber, stateInfo)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update alert: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to update alert: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(dependabotAlertSummary(alert))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal alert: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,235 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var mockDependabotAlert = &github.DependabotAlert{
+	Number: github.Ptr(7),
+	State:  github.Ptr("open"),
+	Dependency: &github.Dependency{
+		Package:      &github.VulnerabilityPackage{Ecosystem: github.Ptr("npm"), Name: github.Ptr("lodash")},
+		ManifestPath: github.Ptr("package-lock.json"),
+		Scope:        github.Ptr("runtime"),
+	},
+	SecurityAdvisory: &github.DependabotSecurityAdvisory{
+		GHSAID:   github.Ptr("GHSA-jf85-cpcp-j695"),
+		CVEID:    github.Ptr("CVE-2019-10744"),
+		Summary:  github.Ptr("Prototype Pollution in lodash"),
+		Severity: github.Ptr("critical"),
+	},
+	SecurityVulnerability: &github.AdvisoryVulnerability{
+		VulnerableVersionRange: github.Ptr("< 4.17.12"),
+		FirstPatchedVersion:    &github.FirstPatchedVersion{Identifier: github.Ptr("4.17.12")},
+	},
+	HTMLURL: github.Ptr("https://github.com/owner/repo/security/dependabot/7"),
+}
+
+var mockDependabotAlertSummary = DependabotAlertSummary{
+	Number:                 7,
+	State:                  "open",
+	Severity:               "critical",
+	Ecosystem:              "npm",
+	Package:                "lodash",
+	ManifestPath:           "package-lock.json",
+	Scope:                  "runtime",
+	GHSAID:                 "GHSA-jf85-cpcp-j695",
+	CVEID:                  "CVE-2019-10744",
+	Summary:                "Prototype Pollution in lodash",
+	VulnerableVersionRange: "< 4.17.12",
+	FirstPatchedVersion:    "4.17.12",
+	HTMLURL:                "https://github.com/owner/repo/security/dependabot/7",
+}
+
+func Test_ListDependabotAlerts(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListDependabotAlerts(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_dependabot_alerts", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "state")
+	assert.Contains(t, tool.InputSchema.Properties, "severity")
+	assert.Contains(t, tool.InputSchema.Properties, "after")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposDependabotAlertsByOwnerByRepo,
+			expectQueryParams(t, map[string]string{
+				"state":    "open",
+				"severity": "high,critical",
+				"per_page": "30",
+			}).andThen(
+				func(w http.ResponseWriter, _ *http.Request) {
+					w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/dependabot/alerts?after=Y3Vyc29yOjc&per_page=30>; rel="next"`)
+					mockResponse(t, http.StatusOK, []*github.DependabotAlert{mockDependabotAlert})(w, nil)
+				},
+			),
+		),
+	))
+	_, handler := ListDependabotAlerts(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":    "owner",
+		"repo":     "repo",
+		"state":    "open",
+		"severity": "high,critical",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned ListDependabotAlertsOutput
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, ListDependabotAlertsOutput{
+		Alerts:      []DependabotAlertSummary{mockDependabotAlertSummary},
+		EndCursor:   "Y3Vyc29yOjc",
+		HasNextPage: true,
+	}, returned)
+}
+
+func Test_GetDependabotAlert(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := GetDependabotAlert(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_dependabot_alert", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "alertNumber"})
+
+	tests := []struct {
+		name          string
+		mockedClient  *http.Client
+		expectError   bool
+		expectedAlert *github.DependabotAlert
+	}{
+		{
+			name: "alert found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposDependabotAlertsByOwnerByRepoByAlertNumber, mockDependabotAlert),
+			),
+			expectedAlert: mockDependabotAlert,
+		},
+		{
+			name: "alert not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDependabotAlertsByOwnerByRepoByAlertNumber,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetDependabotAlert(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"alertNumber": float64(7),
+			}))
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "failed to get alert")
+				return
+			}
+			require.NoError(t, err)
+
+			var returned github.DependabotAlert
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expectedAlert.GetNumber(), returned.GetNumber())
+			assert.Equal(t, tc.expectedAlert.GetSecurityAdvisory().GetGHSAID(), returned.GetSecurityAdvisory().GetGHSAID())
+		})
+	}
+}
+
+func Test_UpdateDependabotAlert(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateDependabotAlert(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "update_dependabot_alert", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "dismissedReason")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "alertNumber", "state"})
+
+	dismissed := *mockDependabotAlert
+	dismissed.State = github.Ptr("dismissed")
+
+	tests := []struct {
+		name          string
+		mockedClient  *http.Client
+		requestArgs   map[string]interface{}
+		expectToolErr bool
+		expectedText  string
+	}{
+		{
+			name: "dismiss",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposDependabotAlertsByOwnerByRepoByAlertNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"state":             "dismissed",
+						"dismissed_reason":  "not_used",
+						"dismissed_comment": "Only used by the docs build",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &dismissed),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"state":            "dismissed",
+				"dismissedReason":  "not_used",
+				"dismissedComment": "Only used by the docs build",
+			},
+			expectedText: `"state":"dismissed"`,
+		},
+		{
+			name: "reopen",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposDependabotAlertsByOwnerByRepoByAlertNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"state": "open",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockDependabotAlert),
+					),
+				),
+			),
+			requestArgs:  map[string]interface{}{"state": "open"},
+			expectedText: `"state":"open"`,
+		},
+		{
+			name:          "dismiss without reason",
+			mockedClient:  mock.NewMockedHTTPClient(),
+			requestArgs:   map[string]interface{}{"state": "dismissed"},
+			expectToolErr: true,
+			expectedText:  "dismissedReason is required to dismiss an alert",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateDependabotAlert(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			tc.requestArgs["owner"] = "owner"
+			tc.requestArgs["repo"] = "repo"
+			tc.requestArgs["alertNumber"] = float64(7)
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectToolErr, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
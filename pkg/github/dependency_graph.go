@@ -0,0 +1,292 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	ghv4 "github.com/shurcooL/githubv4"
+)
+
+const (
+	// maxDependencyGraphManifests and maxManifestDependencies bound one
+	// get_dependency_graph query; Truncated reports when they cut it short.
+	maxDependencyGraphManifests = 50
+	maxManifestDependencies     = 100
+)
+
+// ManifestDependency is one dependency declared by a manifest. License comes
+// from the repository SBOM and is empty when GitHub could not detect it.
+type ManifestDependency struct {
+	PackageName     string `json:"package_name"`
+	PackageManager  string `json:"package_manager"`
+	Requirements    string `json:"requirements,omitempty"`
+	License         string `json:"license,omitempty"`
+	HasDependencies bool   `json:"has_dependencies,omitempty"`
+	Repository      string `json:"repository,omitempty"`
+}
+
+// DependencyManifest is a manifest or lock file in the dependency graph.
+type DependencyManifest struct {
+	Path              string               `json:"path"`
+	DependenciesCount int                  `json:"dependencies_count"`
+	Parseable         bool                 `json:"parseable"`
+	ExceedsMaxSize    bool                 `json:"exceeds_max_size,omitempty"`
+	Dependencies      []ManifestDependency `json:"dependencies"`
+	Truncated         bool                 `json:"truncated,omitempty"`
+}
+
+// DependencyGraphOutput is the result of get_dependency_graph. Truncated
+// reports that the repository has more manifests than were returned.
+type DependencyGraphOutput struct {
+	TotalManifests int                  `json:"total_manifests"`
+	Manifests      []DependencyManifest `json:"manifests"`
+	Truncated      bool                 `json:"truncated,omitempty"`
+}
+
+// dependencyGraphManifests fetches the manifests of a repository with their
+// direct dependencies.
+func dependencyGraphManifests(ctx context.Context, client GraphQLClient, owner, repo string) (*DependencyGraphOutput, error) {
+	var q struct {
+		Repository *struct {
+			DependencyGraphManifests struct {
+				TotalCount ghv4.Int
+				PageInfo   struct {
+					HasNextPage ghv4.Boolean
+				}
+				Nodes []struct {
+					Filename          ghv4.String
+					DependenciesCount ghv4.Int
+					ExceedsMaxSize    ghv4.Boolean
+					Parseable         ghv4.Boolean
+					Dependencies      struct {
+						PageInfo struct {
+							HasNextPage ghv4.Boolean
+						}
+						Nodes []struct {
+							PackageName     ghv4.String
+							PackageManager  ghv4.String
+							Requirements    ghv4.String
+							HasDependencies ghv4.Boolean
+							Repository      *struct {
+								NameWithOwner ghv4.String
+							}
+						}
+					} `graphql:"dependencies(first: $dependencies)"`
+				}
+			} `graphql:"dependencyGraphManifests(first: $manifests)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	vars := map[string]interface{}{
+		"owner":        ghv4.String(owner),
+		"name":         ghv4.String(repo),
+		"manifests":    ghv4.Int(maxDependencyGraphManifests),
+		"dependencies": ghv4.Int(maxManifestDependencies),
+	}
+	if err := graphQLQuery(ctx, client, "dependencyGraphManifests", &q, vars); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+	if q.Repository == nil {
+		return nil, fmt.Errorf("repository %s/%s not found", owner, repo)
+	}
+
+	manifests := q.Repository.DependencyGraphManifests
+	out := &DependencyGraphOutput{
+		TotalManifests: int(manifests.TotalCount),
+		Manifests:      make([]DependencyManifest, 0, len(manifests.Nodes)),
+		Truncated:      bool(manifests.PageInfo.HasNextPage),
+	}
+	for _, m := range manifests.Nodes {
+		manifest := DependencyManifest{
+			Path:              string(m.Filename),
+			DependenciesCount: int(m.DependenciesCount),
+			Parseable:         bool(m.Parseable),
+			ExceedsMaxSize:    bool(m.ExceedsMaxSize),
+			Dependencies:      make([]ManifestDependency, 0, len(m.Dependencies.Nodes)),
+			Truncated:         bool(m.Dependencies.PageInfo.HasNextPage),
+		}
+		for _, d := range m.Dependencies.Nodes {
+			dependency := ManifestDependency{
+				PackageName:     string(d.PackageName),
+				PackageManager:  string(d.PackageManager),
+				Requirements:    string(d.Requirements),
+				HasDependencies: bool(d.HasDependencies),
+			}
+			if d.Repository != nil {
+				dependency.Repository = string(d.Repository.NameWithOwner)
+			}
+			manifest.Dependencies = append(manifest.Dependencies, dependency)
+		}
+		out.Manifests = append(out.Manifests, manifest)
+	}
+	return out, nil
+}
+
+// sbomLicenses maps the packages of an SBOM to their licenses. GitHub names
+// SBOM packages "<ecosystem>:<name>", so keys are lower-cased that way; the
+// bare name is also kept for ecosystems whose prefix differs from the
+// dependency graph's package manager.
+func sbomLicenses(sbom *github.SBOM) map[string]string {
+	licenses := make(map[string]string)
+	for _, p := range sbom.GetSBOM().Packages {
+		license := p.GetLicenseConcluded()
+		if license == "" || license == "NOASSERTION" {
+			license = p.GetLicenseDeclared()
+		}
+		if license == "" || license == "NOASSERTION" {
+			continue
+		}
+		name := strings.ToLower(p.GetName())
+		licenses[name] = license
+		if _, bare, ok := strings.Cut(name, ":"); ok {
+			licenses[bare] = license
+		}
+	}
+	return licenses
+}
+
+// GetDependencyGraph creates a tool to get the manifests and dependencies of
+// a repository from its dependency graph.
+func GetDependencyGraph(getClient GetClientFn, getGraphQLClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_dependency_graph",
+			mcp.WithDescription(t("TOOL_GET_DEPENDENCY_GRAPH_DESCRIPTION", "Get the dependency graph of a repository: its manifests and the dependencies each declares, with version requirements and detected licenses")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("manifest",
+				mcp.Description("Only the manifest at this path, such as package-lock.json or services/api/go.mod"),
+			),
+			mcp.WithBoolean("includeLicenses",
+				mcp.Description("Look up each dependency's license in the repository SBOM, defaults to true"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			manifestPath, err := OptionalParam[string](request, "manifest")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includeLicenses := true
+			if value, ok, err := OptionalParamOK[bool](request, "includeLicenses"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				includeLicenses = value
+			}
+
+			gqlClient, err := getGraphQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+			out, err := dependencyGraphManifests(ctx, gqlClient, owner, repo)
+			if err != nil {
+				return nil, err
+			}
+			if manifestPath != "" {
+				var filtered []DependencyManifest
+				for _, m := range out.Manifests {
+					if m.Path == manifestPath {
+						filtered = append(filtered, m)
+					}
+				}
+				if len(filtered) == 0 {
+					return mcp.NewToolResultError(fmt.Sprintf("no manifest %s in the dependency graph of %s/%s", manifestPath, owner, repo)), nil
+				}
+				out.Manifests = filtered
+			}
+
+			if includeLicenses {
+				client, err := getClient(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+				}
+				sbom, resp, err := client.DependencyGraph.GetSBOM(ctx, owner, repo)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get SBOM: %w", err)
+				}
+				_ = resp.Body.Close()
+				licenses := sbomLicenses(sbom)
+				for i := range out.Manifests {
+					for j := range out.Manifests[i].Dependencies {
+						d := &out.Manifests[i].Dependencies[j]
+						name := strings.ToLower(d.PackageName)
+						if license, ok := licenses[strings.ToLower(d.PackageManager)+":"+name]; ok {
+							d.License = license
+						} else {
+							d.License = licenses[name]
+						}
+					}
+				}
+			}
+
+			r, err := json.Marshal(out)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ExportSBOM creates a tool to export the SPDX SBOM of a repository.
+func ExportSBOM(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("export_sbom",
+			mcp.WithDescription(t("TOOL_EXPORT_SBOM_DESCRIPTION", "Export the software bill of materials of a repository as an SPDX 2.3 JSON document, built from its dependency graph")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			// github.SBOM drops fields such as externalRefs and relationships,
+			// so decode the document as-is to export it whole.
+			req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("repos/%s/%s/dependency-graph/sbom", owner, repo), nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+			var sbom struct {
+				SBOM json.RawMessage `json:"sbom"`
+			}
+			resp, err := client.Do(ctx, req, &sbom)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get SBOM: %w", err)
+			}
+			_ = resp.Body.Close()
+
+			return mcp.NewToolResultText(string(sbom.SBOM)), nil
+		}
+}
@@ -0,0 +1,162 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const mockSBOM = `{"sbom":{
+	"SPDXID":"SPDXRef-DOCUMENT",
+	"spdxVersion":"SPDX-2.3",
+	"name":"com.github.owner/repo",
+	"packages":[
+		{"SPDXID":"SPDXRef-npm-lodash-4.17.21","name":"npm:lodash","versionInfo":"4.17.21","licenseConcluded":"MIT",
+		 "externalRefs":[{"referenceCategory":"PACKAGE-MANAGER","referenceType":"purl","referenceLocator":"pkg:npm/lodash@4.17.21"}]},
+		{"SPDXID":"SPDXRef-go-golang.org-x-text","name":"go:golang.org/x/text","versionInfo":"0.23.0","licenseConcluded":"NOASSERTION","licenseDeclared":"BSD-3-Clause"},
+		{"SPDXID":"SPDXRef-npm-left-pad","name":"npm:left-pad","versionInfo":"1.3.0","licenseConcluded":"NOASSERTION"}
+	],
+	"relationships":[{"spdxElementId":"SPDXRef-DOCUMENT","relatedSpdxElement":"SPDXRef-npm-lodash-4.17.21","relationshipType":"DESCRIBES"}]
+}}`
+
+func Test_GetDependencyGraph(t *testing.T) {
+	restClient := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposDependencyGraphSbomByOwnerByRepo,
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(mockSBOM))
+			}),
+		),
+	))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, vars := decodeGraphQLRequest(t, r)
+		assert.Contains(t, query, "dependencyGraphManifests(first: $manifests)")
+		assert.Equal(t, "owner", vars["owner"])
+		assert.Equal(t, "repo", vars["name"])
+		_, _ = w.Write([]byte(`{"data":{"repository":{"dependencyGraphManifests":{
+			"totalCount":2,
+			"pageInfo":{"hasNextPage":false},
+			"nodes":[
+				{"filename":"package-lock.json","dependenciesCount":2,"exceedsMaxSize":false,"parseable":true,
+				 "dependencies":{"pageInfo":{"hasNextPage":false},"nodes":[
+					{"packageName":"lodash","packageManager":"NPM","requirements":"= 4.17.21","hasDependencies":false,"repository":{"nameWithOwner":"lodash/lodash"}},
+					{"packageName":"left-pad","packageManager":"NPM","requirements":"= 1.3.0","hasDependencies":false,"repository":null}
+				 ]}},
+				{"filename":"go.mod","dependenciesCount":1,"exceedsMaxSize":false,"parseable":true,
+				 "dependencies":{"pageInfo":{"hasNextPage":true},"nodes":[
+					{"packageName":"golang.org/x/text","packageManager":"GO","requirements":"= v0.23.0","hasDependencies":false,"repository":null}
+				 ]}}
+			]
+		}}}}`))
+	}))
+	defer server.Close()
+	gqlClient := stubGetGraphQLClientFn(githubv4.NewEnterpriseClient(server.URL, server.Client()))
+
+	tool, handler := GetDependencyGraph(stubGetClientFn(restClient), gqlClient, translations.NullTranslationHelper)
+	assert.Equal(t, "get_dependency_graph", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "manifest")
+	assert.Contains(t, tool.InputSchema.Properties, "includeLicenses")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var graph DependencyGraphOutput
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &graph))
+	assert.Equal(t, DependencyGraphOutput{
+		TotalManifests: 2,
+		Manifests: []DependencyManifest{
+			{
+				Path:              "package-lock.json",
+				DependenciesCount: 2,
+				Parseable:         true,
+				Dependencies: []ManifestDependency{
+					{PackageName: "lodash", PackageManager: "NPM", Requirements: "= 4.17.21", License: "MIT", Repository: "lodash/lodash"},
+					{PackageName: "left-pad", PackageManager: "NPM", Requirements: "= 1.3.0"},
+				},
+			},
+			{
+				Path:              "go.mod",
+				DependenciesCount: 1,
+				Parseable:         true,
+				Dependencies: []ManifestDependency{
+					{PackageName: "golang.org/x/text", PackageManager: "GO", Requirements: "= v0.23.0", License: "BSD-3-Clause"},
+				},
+				Truncated: true,
+			},
+		},
+	}, graph)
+
+	result, err = handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":           "owner",
+		"repo":            "repo",
+		"manifest":        "go.mod",
+		"includeLicenses": false,
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	var filtered DependencyGraphOutput
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &filtered))
+	require.Len(t, filtered.Manifests, 1)
+	assert.Equal(t, "go.mod", filtered.Manifests[0].Path)
+	assert.Empty(t, filtered.Manifests[0].Dependencies[0].License)
+
+	result, err = handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":    "owner",
+		"repo":     "repo",
+		"manifest": "requirements.txt",
+	}))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, getTextResult(t, result).Text, "no manifest requirements.txt")
+}
+
+func Test_ExportSBOM(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ExportSBOM(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "export_sbom", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposDependencyGraphSbomByOwnerByRepo,
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(mockSBOM))
+			}),
+		),
+	))
+	_, handler := ExportSBOM(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	// The document is exported whole, including the fields github.SBOM drops.
+	var document map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &document))
+	assert.Equal(t, "SPDX-2.3", document["spdxVersion"])
+	assert.Len(t, document["packages"], 3)
+	assert.Contains(t, document, "relationships")
+	lodash := document["packages"].([]interface{})[0].(map[string]interface{})
+	assert.Contains(t, lodash, "externalRefs")
+}
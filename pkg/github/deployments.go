@@ -0,0 +1,597 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// DeploymentSummary is one deployment in a list_deployments result.
+type DeploymentSummary struct {
+	ID          int64      `json:"id"`
+	Ref         string     `json:"ref"`
+	SHA         string     `json:"sha"`
+	Task        string     `json:"task"`
+	Environment string     `json:"environment"`
+	Description string     `json:"description,omitempty"`
+	Creator     string     `json:"creator"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+}
+
+// ListDeploymentsOutput is the result of list_deployments. The API does
+// not report a total, so only the paging fields are returned.
+type ListDeploymentsOutput struct {
+	Deployments []DeploymentSummary `json:"deployments"`
+	NextPage    int                 `json:"next_page,omitempty"`
+	HasNextPage bool                `json:"has_next_page"`
+}
+
+// EnvironmentSummary is one environment in a list_environments result.
+// Reviewers holds user logins and team slugs.
+type EnvironmentSummary struct {
+	ID              int64    `json:"id"`
+	Name            string   `json:"name"`
+	HTMLURL         string   `json:"html_url"`
+	WaitTimer       int      `json:"wait_timer,omitempty"`
+	Reviewers       []string `json:"reviewers,omitempty"`
+	ProtectionRules []string `json:"protection_rules,omitempty"`
+	CanAdminsBypass bool     `json:"can_admins_bypass"`
+}
+
+// ListEnvironmentsOutput is the result of list_environments.
+type ListEnvironmentsOutput struct {
+	TotalCount   int                  `json:"total_count"`
+	Environments []EnvironmentSummary `json:"environments"`
+	NextPage     int                  `json:"next_page,omitempty"`
+	HasNextPage  bool                 `json:"has_next_page"`
+}
+
+func environmentSummary(env *github.Environment) EnvironmentSummary {
+	summary := EnvironmentSummary{
+		ID:              env.GetID(),
+		Name:            env.GetName(),
+		HTMLURL:         env.GetHTMLURL(),
+		CanAdminsBypass: env.GetCanAdminsBypass(),
+	}
+	for _, rule := range env.ProtectionRules {
+		summary.ProtectionRules = append(summary.ProtectionRules, rule.GetType())
+		if rule.GetWaitTimer() > 0 {
+			summary.WaitTimer = rule.GetWaitTimer()
+		}
+		for _, r := range rule.Reviewers {
+			switch reviewer := r.Reviewer.(type) {
+			case *github.User:
+				summary.Reviewers = append(summary.Reviewers, reviewer.GetLogin())
+			case *github.Team:
+				summary.Reviewers = append(summary.Reviewers, reviewer.GetSlug())
+			}
+		}
+	}
+	return summary
+}
+
+// ListDeployments creates a tool to list the deployments of a repository.
+func ListDeployments(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_deployments",
+			mcp.WithDescription(t("TOOL_LIST_DEPLOYMENTS_DESCRIPTION", "List the deployments of a repository, newest first")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("environment",
+				mcp.Description("Only deployments to this environment"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Only deployments of this branch, tag or SHA"),
+			),
+			mcp.WithString("sha",
+				mcp.Description("Only deployments of this commit SHA"),
+			),
+			mcp.WithString("task",
+				mcp.Description("Only deployments with this task, such as deploy or deploy:migrations"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts := &github.DeploymentsListOptions{
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
+			}
+			for param, field := range map[string]*string{
+				"environment": &opts.Environment,
+				"ref":         &opts.Ref,
+				"sha":         &opts.SHA,
+				"task":        &opts.Task,
+			} {
+				if *field, err = OptionalParam[string](request, param); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			deployments, resp, err := client.Repositories.ListDeployments(ctx, owner, repo, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list deployments: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list deployments: %s", string(body))), nil
+			}
+
+			out := ListDeploymentsOutput{
+				Deployments: make([]DeploymentSummary, 0, len(deployments)),
+				NextPage:    resp.NextPage,
+				HasNextPage: resp.NextPage != 0,
+			}
+			for _, d := range deployments {
+				summary := DeploymentSummary{
+					ID:          d.GetID(),
+					Ref:         d.GetRef(),
+					SHA:         d.GetSHA(),
+					Task:        d.GetTask(),
+					Environment: d.GetEnvironment(),
+					Description: d.GetDescription(),
+					Creator:     d.GetCreator().GetLogin(),
+				}
+				if d.CreatedAt != nil {
+					summary.CreatedAt = &d.CreatedAt.Time
+				}
+				out.Deployments = append(out.Deployments, summary)
+			}
+
+			r, err := json.Marshal(out)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateDeployment creates a tool to create a deployment of a ref.
+func CreateDeployment(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_deployment",
+			mcp.WithDescription(t("TOOL_CREATE_DEPLOYMENT_DESCRIPTION", "Create a deployment of a branch, tag or SHA to an environment")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ref",
+				mcp.Required(),
+				mcp.Description("Branch, tag or SHA to deploy"),
+			),
+			mcp.WithString("environment",
+				mcp.Description("Environment to deploy to, defaults to production"),
+			),
+			mcp.WithString("task",
+				mcp.Description("Task to run, defaults to deploy"),
+			),
+			mcp.WithString("description",
+				mcp.Description("Short description of the deployment"),
+			),
+			mcp.WithObject("payload",
+				mcp.Description("Extra data for the systems that carry out the deployment"),
+			),
+			mcp.WithArray("requiredContexts",
+				mcp.Description("Status check contexts that must pass on ref; an empty list skips the checks. Defaults to all of them"),
+				mcp.Items(map[string]interface{}{"type": "string"}),
+			),
+			mcp.WithBoolean("autoMerge",
+				mcp.Description("Merge the default branch into ref first if it is behind, defaults to true"),
+			),
+			mcp.WithBoolean("productionEnvironment",
+				mcp.Description("Whether the environment is one end users use"),
+			),
+			mcp.WithBoolean("transientEnvironment",
+				mcp.Description("Whether the environment will be torn down, such as a preview environment"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := requiredParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			deploymentRequest := &github.DeploymentRequest{Ref: github.Ptr(ref)}
+			for param, field := range map[string]**string{
+				"environment": &deploymentRequest.Environment,
+				"task":        &deploymentRequest.Task,
+				"description": &deploymentRequest.Description,
+			} {
+				value, err := OptionalParam[string](request, param)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if value != "" {
+					*field = github.Ptr(value)
+				}
+			}
+			for param, field := range map[string]**bool{
+				"autoMerge":             &deploymentRequest.AutoMerge,
+				"productionEnvironment": &deploymentRequest.ProductionEnvironment,
+				"transientEnvironment":  &deploymentRequest.TransientEnvironment,
+			} {
+				value, ok, err := OptionalParamOK[bool](request, param)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if ok {
+					*field = github.Ptr(value)
+				}
+			}
+			payload, err := OptionalParam[map[string]interface{}](request, "payload")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if payload != nil {
+				deploymentRequest.Payload = payload
+			}
+			if _, ok := request.GetArguments()["requiredContexts"]; ok {
+				contexts, err := OptionalStringArrayParam(request, "requiredContexts")
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				deploymentRequest.RequiredContexts = &contexts
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			deployment, resp, err := client.Repositories.CreateDeployment(ctx, owner, repo, deploymentRequest)
+			if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
+				_ = resp.Body.Close()
+				return mcp.NewToolResultText(fmt.Sprintf("Merged the default branch into %s; create the deployment again once its checks pass", ref)), nil
+			}
+			if resp != nil && resp.StatusCode == http.StatusConflict {
+				_ = resp.Body.Close()
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create deployment: %s", err)), nil
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to create deployment: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create deployment: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(deployment)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// SetDeploymentStatus creates a tool to add a status to a deployment.
+func SetDeploymentStatus(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("set_deployment_status",
+			mcp.WithDescription(t("TOOL_SET_DEPLOYMENT_STATUS_DESCRIPTION", "Set the status of a deployment, such as in_progress while it rolls out and success or failure when it finishes")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("deploymentId",
+				mcp.Required(),
+				mcp.Description("Deployment ID"),
+			),
+			mcp.WithString("state",
+				mcp.Required(),
+				mcp.Description("Deployment state"),
+				mcp.Enum("queued", "pending", "in_progress", "success", "failure", "error", "inactive"),
+			),
+			mcp.WithString("description",
+				mcp.Description("Short description of the status"),
+			),
+			mcp.WithString("logUrl",
+				mcp.Description("URL of the deployment output"),
+			),
+			mcp.WithString("environmentUrl",
+				mcp.Description("URL to access the deployed environment"),
+			),
+			mcp.WithString("environment",
+				mcp.Description("Move the deployment to this environment"),
+			),
+			mcp.WithBoolean("autoInactive",
+				mcp.Description("With success, mark earlier deployments to the environment inactive; defaults to true"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			deploymentID, err := RequiredInt(request, "deploymentId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			state, err := requiredParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			statusRequest := &github.DeploymentStatusRequest{State: github.Ptr(state)}
+			for param, field := range map[string]**string{
+				"description":    &statusRequest.Description,
+				"logUrl":         &statusRequest.LogURL,
+				"environmentUrl": &statusRequest.EnvironmentURL,
+				"environment":    &statusRequest.Environment,
+			} {
+				value, err := OptionalParam[string](request, param)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if value != "" {
+					*field = github.Ptr(value)
+				}
+			}
+			if autoInactive, ok, err := OptionalParamOK[bool](request, "autoInactive"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				statusRequest.AutoInactive = github.Ptr(autoInactive)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			status, resp, err := client.Repositories.CreateDeploymentStatus(ctx, owner, repo, int64(deploymentID), statusRequest)
+			if err != nil {
+				return nil, fmt.Errorf("failed to set deployment status: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to set deployment status: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(status)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListEnvironments creates a tool to list the deployment environments of a
+// repository with their protection rules.
+func ListEnvironments(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_environments",
+			mcp.WithDescription(t("TOOL_LIST_ENVIRONMENTS_DESCRIPTION", "List the deployment environments of a repository with their required reviewers, wait timers and other protection rules")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts := &github.EnvironmentListOptions{
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			environments, resp, err := client.Repositories.ListEnvironments(ctx, owner, repo, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list environments: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list environments: %s", string(body))), nil
+			}
+
+			out := ListEnvironmentsOutput{
+				TotalCount:   environments.GetTotalCount(),
+				Environments: make([]EnvironmentSummary, 0, len(environments.Environments)),
+				NextPage:     resp.NextPage,
+				HasNextPage:  resp.NextPage != 0,
+			}
+			for _, env := range environments.Environments {
+				out.Environments = append(out.Environments, environmentSummary(env))
+			}
+
+			r, err := json.Marshal(out)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ApprovePendingDeployment creates a tool to approve or reject the
+// deployments of a workflow run that wait for a required reviewer.
+func ApprovePendingDeployment(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("approve_pending_deployment",
+			mcp.WithDescription(t("TOOL_APPROVE_PENDING_DEPLOYMENT_DESCRIPTION", "Approve or reject the deployments of a workflow run that are waiting for a required reviewer")),
+			withWorkflowRunParams(),
+			mcp.WithArray("environments",
+				mcp.Description("Names of the environments to review, defaults to every pending environment you can approve"),
+				mcp.Items(map[string]interface{}{"type": "string"}),
+			),
+			mcp.WithString("state",
+				mcp.Description("Review decision, defaults to approved"),
+				mcp.Enum("approved", "rejected"),
+			),
+			mcp.WithString("comment",
+				mcp.Description("Comment to record with the review"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runID, err := RequiredInt(request, "runId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			environments, err := OptionalStringArrayParam(request, "environments")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			state, err := OptionalParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if state == "" {
+				state = "approved"
+			}
+			comment, err := OptionalParam[string](request, "comment")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			pending, resp, err := client.Actions.GetPendingDeployments(ctx, owner, repo, int64(runID))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get pending deployments: %w", err)
+			}
+			_ = resp.Body.Close()
+
+			reviewRequest := &github.PendingDeploymentsRequest{State: state, Comment: comment}
+			var names, waiting []string
+			for _, p := range pending {
+				name := p.GetEnvironment().GetName()
+				waiting = append(waiting, name)
+				if len(environments) > 0 && !slices.Contains(environments, name) {
+					continue
+				}
+				if !p.GetCurrentUserCanApprove() {
+					if len(environments) > 0 {
+						return mcp.NewToolResultError(fmt.Sprintf("you cannot review deployments to %s", name)), nil
+					}
+					continue
+				}
+				reviewRequest.EnvironmentIDs = append(reviewRequest.EnvironmentIDs, p.GetEnvironment().GetID())
+				names = append(names, name)
+			}
+			for _, name := range environments {
+				if !slices.Contains(waiting, name) {
+					return mcp.NewToolResultError(fmt.Sprintf("workflow run %d has no deployment to %s waiting for review", runID, name)), nil
+				}
+			}
+			if len(reviewRequest.EnvironmentIDs) == 0 {
+				if len(waiting) == 0 {
+					return mcp.NewToolResultError(fmt.Sprintf("workflow run %d has no deployments waiting for review", runID)), nil
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("you cannot review the deployments to %s", strings.Join(waiting, ", "))), nil
+			}
+
+			_, resp, err = client.Actions.PendingDeployments(ctx, owner, repo, int64(runID), reviewRequest)
+			if err != nil {
+				return nil, fmt.Errorf("failed to review pending deployments: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to review pending deployments: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Deployments of workflow run %d to %s were %s", runID, strings.Join(names, ", "), state)), nil
+		}
+}
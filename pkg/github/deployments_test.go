@@ -0,0 +1,340 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListDeployments(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListDeployments(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_deployments", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "environment")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposDeploymentsByOwnerByRepo,
+			expectQueryParams(t, map[string]string{
+				"environment": "staging",
+				"page":        "1",
+				"per_page":    "30",
+			}).andThen(
+				mockResponse(t, http.StatusOK, []*github.Deployment{
+					{
+						ID:          github.Ptr(int64(1201)),
+						Ref:         github.Ptr("main"),
+						SHA:         github.Ptr("a84d88e7554fc1fa21bcbc4efae3c782a70d2b9d"),
+						Task:        github.Ptr("deploy"),
+						Environment: github.Ptr("staging"),
+						Creator:     &github.User{Login: github.Ptr("release-bot")},
+					},
+				}),
+			),
+		),
+	))
+	_, handler := ListDeployments(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":       "owner",
+		"repo":        "repo",
+		"environment": "staging",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned ListDeploymentsOutput
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, ListDeploymentsOutput{
+		Deployments: []DeploymentSummary{
+			{
+				ID:          1201,
+				Ref:         "main",
+				SHA:         "a84d88e7554fc1fa21bcbc4efae3c782a70d2b9d",
+				Task:        "deploy",
+				Environment: "staging",
+				Creator:     "release-bot",
+			},
+		},
+	}, returned)
+}
+
+func Test_CreateDeployment(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateDeployment(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_deployment", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "payload")
+	assert.Contains(t, tool.InputSchema.Properties, "requiredContexts")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "ref"})
+
+	tests := []struct {
+		name          string
+		mockedClient  *http.Client
+		requestArgs   map[string]interface{}
+		expectToolErr bool
+		expectedText  string
+	}{
+		{
+			name: "created",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposDeploymentsByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"ref":               "v1.2.0",
+						"environment":       "production",
+						"auto_merge":        false,
+						"required_contexts": []interface{}{},
+						"payload":           map[string]interface{}{"migrate": true},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.Deployment{
+							ID:          github.Ptr(int64(1202)),
+							Ref:         github.Ptr("v1.2.0"),
+							Environment: github.Ptr("production"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"environment":      "production",
+				"autoMerge":        false,
+				"requiredContexts": []interface{}{},
+				"payload":          map[string]interface{}{"migrate": true},
+			},
+			expectedText: `"id":1202`,
+		},
+		{
+			name: "default branch merged first",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposDeploymentsByOwnerByRepo,
+					mockResponse(t, http.StatusAccepted, map[string]string{"message": "Auto-merged main into v1.2.0 on deployment."}),
+				),
+			),
+			requestArgs:  map[string]interface{}{},
+			expectedText: "Merged the default branch into v1.2.0",
+		},
+		{
+			name: "merge conflict",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposDeploymentsByOwnerByRepo,
+					mockResponse(t, http.StatusConflict, map[string]string{"message": "Conflict merging main into v1.2.0."}),
+				),
+			),
+			requestArgs:   map[string]interface{}{},
+			expectToolErr: true,
+			expectedText:  "Conflict merging main into v1.2.0.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateDeployment(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			tc.requestArgs["owner"] = "owner"
+			tc.requestArgs["repo"] = "repo"
+			tc.requestArgs["ref"] = "v1.2.0"
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectToolErr, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
+
+func Test_SetDeploymentStatus(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := SetDeploymentStatus(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "set_deployment_status", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "environmentUrl")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "deploymentId", "state"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostReposDeploymentsStatusesByOwnerByRepoByDeploymentId,
+			expectRequestBody(t, map[string]interface{}{
+				"state":           "success",
+				"environment_url": "https://app.example.com",
+				"auto_inactive":   true,
+			}).andThen(
+				mockResponse(t, http.StatusCreated, &github.DeploymentStatus{
+					ID:    github.Ptr(int64(1)),
+					State: github.Ptr("success"),
+				}),
+			),
+		),
+	))
+	_, handler := SetDeploymentStatus(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":          "owner",
+		"repo":           "repo",
+		"deploymentId":   float64(1202),
+		"state":          "success",
+		"environmentUrl": "https://app.example.com",
+		"autoInactive":   true,
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned github.DeploymentStatus
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, "success", returned.GetState())
+}
+
+func Test_ListEnvironments(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListEnvironments(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_environments", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposEnvironmentsByOwnerByRepo,
+			&github.EnvResponse{
+				TotalCount: github.Ptr(1),
+				Environments: []*github.Environment{
+					{
+						ID:      github.Ptr(int64(161088068)),
+						Name:    github.Ptr("production"),
+						HTMLURL: github.Ptr("https://github.com/owner/repo/deployments/activity_log?environments_filter=production"),
+						ProtectionRules: []*github.ProtectionRule{
+							{Type: github.Ptr("wait_timer"), WaitTimer: github.Ptr(30)},
+							{
+								Type: github.Ptr("required_reviewers"),
+								Reviewers: []*github.RequiredReviewer{
+									{Type: github.Ptr("User"), Reviewer: &github.User{Login: github.Ptr("octocat")}},
+									{Type: github.Ptr("Team"), Reviewer: &github.Team{Slug: github.Ptr("release-managers")}},
+								},
+							},
+						},
+					},
+				},
+			},
+		),
+	))
+	_, handler := ListEnvironments(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned ListEnvironmentsOutput
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, ListEnvironmentsOutput{
+		TotalCount: 1,
+		Environments: []EnvironmentSummary{
+			{
+				ID:              161088068,
+				Name:            "production",
+				HTMLURL:         "https://github.com/owner/repo/deployments/activity_log?environments_filter=production",
+				WaitTimer:       30,
+				Reviewers:       []string{"octocat", "release-managers"},
+				ProtectionRules: []string{"wait_timer", "required_reviewers"},
+			},
+		},
+	}, returned)
+}
+
+func Test_ApprovePendingDeployment(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ApprovePendingDeployment(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "approve_pending_deployment", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "environments")
+	assert.Contains(t, tool.InputSchema.Properties, "state")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "runId"})
+
+	pending := []*github.PendingDeployment{
+		{
+			Environment:           &github.PendingDeploymentEnvironment{ID: github.Ptr(int64(11)), Name: github.Ptr("staging")},
+			CurrentUserCanApprove: github.Ptr(true),
+		},
+		{
+			Environment:           &github.PendingDeploymentEnvironment{ID: github.Ptr(int64(12)), Name: github.Ptr("production")},
+			CurrentUserCanApprove: github.Ptr(false),
+		},
+	}
+
+	tests := []struct {
+		name          string
+		mockedClient  *http.Client
+		requestArgs   map[string]interface{}
+		expectToolErr bool
+		expectedText  string
+	}{
+		{
+			name: "approve everything the user can",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposActionsRunsPendingDeploymentsByOwnerByRepoByRunId, pending),
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsRunsPendingDeploymentsByOwnerByRepoByRunId,
+					expectRequestBody(t, map[string]interface{}{
+						"environment_ids": []interface{}{float64(11)},
+						"state":           "approved",
+						"comment":         "Ship it",
+					}).andThen(
+						mockResponse(t, http.StatusOK, []*github.Deployment{{ID: github.Ptr(int64(1203))}}),
+					),
+				),
+			),
+			requestArgs:  map[string]interface{}{"comment": "Ship it"},
+			expectedText: "Deployments of workflow run 30433642 to staging were approved",
+		},
+		{
+			name: "environment the user cannot review",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposActionsRunsPendingDeploymentsByOwnerByRepoByRunId, pending),
+			),
+			requestArgs:   map[string]interface{}{"environments": []interface{}{"production"}, "state": "rejected"},
+			expectToolErr: true,
+			expectedText:  "you cannot review deployments to production",
+		},
+		{
+			name: "environment not waiting",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposActionsRunsPendingDeploymentsByOwnerByRepoByRunId, pending),
+			),
+			requestArgs:   map[string]interface{}{"environments": []interface{}{"qa"}},
+			expectToolErr: true,
+			expectedText:  "workflow run 30433642 has no deployment to qa waiting for review",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ApprovePendingDeployment(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			tc.requestArgs["owner"] = "owner"
+			tc.requestArgs["repo"] = "repo"
+			tc.requestArgs["runId"] = float64(30433642)
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectToolErr, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/toolsets"
+	"github.com/google/go-github/v69/github"
+)
+
+// APIHost holds the endpoints of the GitHub instance the server talks to.
+type APIHost struct {
+	RESTURL    string
+	UploadURL  string
+	GraphQLURL string
+	// Enterprise is true for GitHub Enterprise Server instances.
+	Enterprise bool
+}
+
+// NewAPIHost derives the endpoints of a GitHub instance from its hostname or
+// URL. An empty host, "github.com" and "api.github.com" mean github.com. For
+// GitHub Enterprise Server, a trailing /api/v3, /api/graphql or /api path is
+// accepted and stripped, and https is assumed when no scheme is given.
+func NewAPIHost(host string) (APIHost, error) {
+	host = strings.TrimSpace(host)
+	if host == "" {
+		return APIHost{
+			RESTURL:    "https://api.github.com/",
+			UploadURL:  "https://uploads.github.com/",
+			GraphQLURL: "https://api.github.com/graphql",
+		}, nil
+	}
+	if !strings.Contains(host, "://") {
+		host = "https://" + host
+	}
+	u, err := url.Parse(host)
+	if err != nil {
+		return APIHost{}, fmt.Errorf("invalid GitHub host %q: %w", host, err)
+	}
+	if u.Host == "" {
+		return APIHost{}, fmt.Errorf("invalid GitHub host %q: no hostname", host)
+	}
+	if u.Host == "github.com" || u.Host == "api.github.com" {
+		return NewAPIHost("")
+	}
+
+	path := strings.TrimSuffix(u.Path, "/")
+	for _, suffix := range []string{"/api/v3", "/api/graphql", "/api"} {
+		if strings.HasSuffix(path, suffix) {
+			path = strings.TrimSuffix(path, suffix)
+			break
+		}
+	}
+	base := u.Scheme + "://" + u.Host + path
+	return APIHost{
+		RESTURL:    base + "/api/v3/",
+		UploadURL:  base + "/api/uploads/",
+		GraphQLURL: base + "/api/graphql",
+		Enterprise: true,
+	}, nil
+}
+
+// supportedAPIVersion is the REST API version the tools are written against.
+const supportedAPIVersion = "2022-11-28"
+
+// NegotiateAPIVersion returns the X-GitHub-Api-Version to send to the
+// instance behind client: supportedAPIVersion when the instance lists it, or
+// "" when it predates API versioning or no longer supports that version, in
+// which case the header should be left out so it applies its default.
+func NegotiateAPIVersion(ctx context.Context, client *github.Client) (string, error) {
+	req, err := client.NewRequest(http.MethodGet, "versions", nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	var versions []string
+	resp, err := client.Do(ctx, req, &versions)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to list API versions: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if slices.Contains(versions, supportedAPIVersion) {
+		return supportedAPIVersion, nil
+	}
+	return "", nil
+}
+
+// APIVersionTransport sets the X-GitHub-Api-Version header negotiated with
+// NegotiateAPIVersion on every request, or removes it when Version is empty.
+type APIVersionTransport struct {
+	Version string
+	Base    http.RoundTripper
+}
+
+func (t *APIVersionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	if t.Version == "" {
+		req.Header.Del("X-GitHub-Api-Version")
+	} else {
+		req.Header.Set("X-GitHub-Api-Version", t.Version)
+	}
+	return t.Base.RoundTrip(req)
+}
+
+// EnterpriseVersion returns the release of the GitHub Enterprise Server
+// instance behind client, such as "3.14.2".
+func EnterpriseVersion(ctx context.Context, client *github.Client) (string, error) {
+	req, err := client.NewRequest(http.MethodGet, "meta", nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	var meta struct {
+		InstalledVersion string `json:"installed_version"`
+	}
+	resp, err := client.Do(ctx, req, &meta)
+	if err != nil {
+		return "", fmt.Errorf("failed to get meta: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if meta.InstalledVersion == "" {
+		return "", fmt.Errorf("instance did not report its version")
+	}
+	return meta.InstalledVersion, nil
+}
+
+// notOnEnterprise marks features GitHub Enterprise Server does not offer.
+const notOnEnterprise = ""
+
+// enterpriseToolsetVersions is the oldest GitHub Enterprise Server release
+// each toolset works on.
+var enterpriseToolsetVersions = map[string]string{
+	"projects": "3.7",
+}
+
+// enterpriseToolVersions is the oldest GitHub Enterprise Server release
+// individual tools work on, for tools whose toolset is otherwise supported.
+var enterpriseToolVersions = map[string]string{
+	"assign_copilot_to_issue":    notOnEnterprise,
+	"get_copilot_session_status": notOnEnterprise,
+}
+
+// RemoveUnsupportedTools drops the toolsets and tools that the GitHub
+// Enterprise Server release version does not support, and returns their
+// names.
+func RemoveUnsupportedTools(tsg *toolsets.ToolsetGroup, version string) []string {
+	var removed []string
+	for name, minVersion := range enterpriseToolsetVersions {
+		if _, ok := tsg.Toolsets[name]; ok && !versionAtLeast(version, minVersion) {
+			tsg.RemoveToolset(name)
+			removed = append(removed, name)
+		}
+	}
+	var tools []string
+	for name, minVersion := range enterpriseToolVersions {
+		if !versionAtLeast(version, minVersion) {
+			tools = append(tools, name)
+		}
+	}
+	for _, toolset := range tsg.Toolsets {
+		for _, tool := range toolset.GetAvailableTools() {
+			if slices.Contains(tools, tool.Tool.Name) {
+				removed = append(removed, tool.Tool.Name)
+			}
+		}
+		toolset.RemoveTools(tools...)
+	}
+	slices.Sort(removed)
+	return removed
+}
+
+// versionAtLeast reports whether the dotted release version is at least
+// minVersion, comparing numerically component by component. An unparsable
+// version is treated as recent, and notOnEnterprise is never reached.
+func versionAtLeast(version, minVersion string) bool {
+	if minVersion == notOnEnterprise {
+		return false
+	}
+	have := strings.Split(version, ".")
+	want := strings.Split(minVersion, ".")
+	for i, w := range want {
+		wn, _ := strconv.Atoi(w)
+		if i >= len(have) {
+			return wn == 0
+		}
+		hn, err := strconv.Atoi(have[i])
+		if err != nil {
+			return true
+		}
+		if hn != wn {
+			return hn > wn
+		}
+	}
+	return true
+}
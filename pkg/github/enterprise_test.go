@@ -0,0 +1,174 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/toolsets"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewAPIHost(t *testing.T) {
+	dotcom := APIHost{
+		RESTURL:    "https://api.github.com/",
+		UploadURL:  "https://uploads.github.com/",
+		GraphQLURL: "https://api.github.com/graphql",
+	}
+	ghes := APIHost{
+		RESTURL:    "https://ghes.example.com/api/v3/",
+		UploadURL:  "https://ghes.example.com/api/uploads/",
+		GraphQLURL: "https://ghes.example.com/api/graphql",
+		Enterprise: true,
+	}
+
+	tests := []struct {
+		host      string
+		expected  APIHost
+		expectErr bool
+	}{
+		{host: "", expected: dotcom},
+		{host: "github.com", expected: dotcom},
+		{host: "https://api.github.com/", expected: dotcom},
+		{host: "ghes.example.com", expected: ghes},
+		{host: "https://ghes.example.com/", expected: ghes},
+		{host: "https://ghes.example.com/api/v3", expected: ghes},
+		{host: "https://ghes.example.com/api/graphql", expected: ghes},
+		{
+			host: "http://ghes.internal:8080/github/api",
+			expected: APIHost{
+				RESTURL:    "http://ghes.internal:8080/github/api/v3/",
+				UploadURL:  "http://ghes.internal:8080/github/api/uploads/",
+				GraphQLURL: "http://ghes.internal:8080/github/api/graphql",
+				Enterprise: true,
+			},
+		},
+		{host: "https://", expectErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.host, func(t *testing.T) {
+			host, err := NewAPIHost(tc.host)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, host)
+		})
+	}
+}
+
+func Test_versionAtLeast(t *testing.T) {
+	assert.True(t, versionAtLeast("3.14.2", "3.7"))
+	assert.True(t, versionAtLeast("3.7.0", "3.7"))
+	assert.True(t, versionAtLeast("4.0", "3.7"))
+	assert.False(t, versionAtLeast("3.6.9", "3.7"))
+	assert.False(t, versionAtLeast("2.22.0", "3.7"))
+	assert.False(t, versionAtLeast("3.14.2", notOnEnterprise))
+}
+
+func Test_NegotiateAPIVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		handler  http.HandlerFunc
+		expected string
+	}{
+		{
+			name:     "supported",
+			handler:  mockResponse(t, http.StatusOK, []string{"2022-11-28", "2026-03-10"}),
+			expected: "2022-11-28",
+		},
+		{
+			name:     "no longer supported",
+			handler:  mockResponse(t, http.StatusOK, []string{"2026-03-10"}),
+			expected: "",
+		},
+		{
+			name:     "predates versioning",
+			handler:  mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+			expected: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetVersions, tc.handler),
+			))
+			version, err := NegotiateAPIVersion(context.Background(), client)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, version)
+		})
+	}
+}
+
+func Test_APIVersionTransport(t *testing.T) {
+	var got []string
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("X-GitHub-Api-Version"))
+	}))
+	defer server.Close()
+
+	for _, version := range []string{"2022-11-28", ""} {
+		client := github.NewClient(&http.Client{Transport: &APIVersionTransport{Version: version, Base: http.DefaultTransport}})
+		client, err := client.WithEnterpriseURLs(server.URL, server.URL)
+		require.NoError(t, err)
+		req, err := client.NewRequest(http.MethodGet, "meta", nil)
+		require.NoError(t, err)
+		_, err = client.Do(context.Background(), req, nil)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []string{"2022-11-28", ""}, got)
+}
+
+func Test_EnterpriseVersion(t *testing.T) {
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(mock.GetMeta, map[string]interface{}{
+			"verifiable_password_authentication": true,
+			"installed_version":                  "3.6.4",
+		}),
+	))
+	version, err := EnterpriseVersion(context.Background(), client)
+	require.NoError(t, err)
+	assert.Equal(t, "3.6.4", version)
+
+	client = github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(mock.GetMeta, map[string]interface{}{"verifiable_password_authentication": true}),
+	))
+	_, err = EnterpriseVersion(context.Background(), client)
+	assert.ErrorContains(t, err, "did not report its version")
+}
+
+func Test_RemoveUnsupportedTools(t *testing.T) {
+	newGroup := func() *toolsets.ToolsetGroup {
+		tsg := toolsets.NewToolsetGroup(false)
+		tsg.AddToolset(toolsets.NewToolset("issues", "Issues").
+			AddReadTools(
+				toolsets.NewServerTool(mcp.NewTool("get_issue"), nil),
+				toolsets.NewServerTool(mcp.NewTool("get_copilot_session_status"), nil),
+			).
+			AddWriteTools(toolsets.NewServerTool(mcp.NewTool("assign_copilot_to_issue"), nil)))
+		tsg.AddToolset(toolsets.NewToolset("projects", "Projects").
+			AddReadTools(toolsets.NewServerTool(mcp.NewTool("list_projects"), nil)))
+		return tsg
+	}
+
+	tsg := newGroup()
+	removed := RemoveUnsupportedTools(tsg, "3.6.4")
+	assert.Equal(t, []string{"assign_copilot_to_issue", "get_copilot_session_status", "projects"}, removed)
+	assert.NotContains(t, tsg.Toolsets, "projects")
+	tools := tsg.Toolsets["issues"].GetAvailableTools()
+	require.Len(t, tools, 1)
+	assert.Equal(t, "get_issue", tools[0].Tool.Name)
+
+	tsg = newGroup()
+	removed = RemoveUnsupportedTools(tsg, "3.14.0")
+	assert.Equal(t, []string{"assign_copilot_to_issue", "get_copilot_session_status"}, removed)
+	assert.Contains(t, tsg.Toolsets, "projects")
+}
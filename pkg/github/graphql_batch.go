@@ -0,0 +1,92 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// queryBatch combines independent GraphQL lookups into a single request. Each
+// lookup is a pointer to a struct in the githubv4 query shape whose fields are
+// root fields, each with a graphql tag; callers declare only the fields they
+// need. A root field whose response key is already taken by an earlier lookup
+// is aliased, so two lookups may select, say, organization(login: $owner)
+// with different selections. Variables are shared by all lookups.
+type queryBatch struct {
+	fields  []reflect.StructField
+	targets []reflect.Value
+	keys    map[string]bool
+	err     error
+}
+
+func newQueryBatch() *queryBatch {
+	return &queryBatch{keys: make(map[string]bool)}
+}
+
+// add queues the lookup q and returns b.
+func (b *queryBatch) add(q interface{}) *queryBatch {
+	if b.err != nil {
+		return b
+	}
+	v := reflect.ValueOf(q)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		b.err = fmt.Errorf("batched lookup must be a pointer to a struct, got %T", q)
+		return b
+	}
+	v = v.Elem()
+	n := len(b.targets)
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		tag, ok := f.Tag.Lookup("graphql")
+		if !ok || !f.IsExported() || strings.HasPrefix(tag, "...") {
+			b.err = fmt.Errorf("batched lookup %T: field %s must be exported and select a root field", q, f.Name)
+			return b
+		}
+		key, field := splitAlias(tag)
+		if b.keys[key] {
+			key = fmt.Sprintf("%s%d", key, n)
+			tag = key + ": " + field
+		}
+		b.keys[key] = true
+		b.fields = append(b.fields, reflect.StructField{
+			Name: fmt.Sprintf("Q%d_%s", n, f.Name),
+			Type: f.Type,
+			Tag:  reflect.StructTag(fmt.Sprintf("graphql:%q", tag)),
+		})
+	}
+	b.targets = append(b.targets, v)
+	return b
+}
+
+// run sends the batched lookups as one query and fills in each of them. Like
+// client.Query, it fills in whatever data GitHub returned even when it also
+// returns an error, so callers can inspect partial results.
+func (b *queryBatch) run(ctx context.Context, client GraphQLClient, op string, vars map[string]interface{}) error {
+	if b.err != nil {
+		return b.err
+	}
+	q := reflect.New(reflect.StructOf(b.fields)).Elem()
+	err := graphQLQuery(ctx, client, op, q.Addr().Interface(), vars)
+	k := 0
+	for _, target := range b.targets {
+		for i := 0; i < target.NumField(); i++ {
+			target.Field(i).Set(q.Field(k))
+			k++
+		}
+	}
+	return err
+}
+
+// splitAlias returns the response key of a root field tag and the field
+// without its alias.
+func splitAlias(tag string) (key, field string) {
+	name := tag
+	if i := strings.IndexAny(name, "({"); i != -1 {
+		name = name[:i]
+	}
+	if i := strings.Index(name, ":"); i != -1 {
+		return strings.TrimSpace(name[:i]), strings.TrimSpace(tag[i+1:])
+	}
+	return strings.TrimSpace(name), tag
+}
@@ -0,0 +1,66 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_queryBatch(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, _ := decodeGraphQLRequest(t, r)
+		queries = append(queries, query)
+		w.WriteHeader(200)
+		w.Write([]byte(`{"data":{"organization":{"name":"Acme"},"organization1":{"id":"O_1"},"user":null},
+			"errors":[{"message":"Could not resolve to a User with the login of 'acme'."}]}`))
+	}))
+	defer server.Close()
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+
+	var org struct {
+		Organization *struct{ Name githubv4.String } `graphql:"organization(login: $owner)"`
+	}
+	var owner ownerLookup
+	err := newQueryBatch().add(&org).add(&owner).run(context.Background(), client, "test", map[string]interface{}{
+		"owner": githubv4.String("acme"),
+	})
+
+	// Partial data is filled in along with the error.
+	assert.True(t, isGraphQLNotFound(err))
+	require.Len(t, queries, 1)
+	assert.Equal(t, `query($owner:String!){organization(login: $owner){name},organization1: organization(login: $owner){id},user(login: $owner){id}}`, queries[0])
+	require.NotNil(t, org.Organization)
+	assert.Equal(t, githubv4.String("Acme"), org.Organization.Name)
+	id, kind, ok := owner.resolved()
+	assert.True(t, ok)
+	assert.Equal(t, OwnerOrg, kind)
+	assert.Equal(t, "O_1", id)
+
+	t.Run("invalid lookup", func(t *testing.T) {
+		var untagged struct {
+			Viewer struct{ Login githubv4.String }
+		}
+		err := newQueryBatch().add(&untagged).run(context.Background(), client, "test", nil)
+		assert.Error(t, err)
+		err = newQueryBatch().add(owner).run(context.Background(), client, "test", nil)
+		assert.Error(t, err)
+		assert.Len(t, queries, 1)
+	})
+}
+
+func Test_splitAlias(t *testing.T) {
+	for tag, expected := range map[string][2]string{
+		"viewer":                           {"viewer", "viewer"},
+		"organization(login: $owner)":      {"organization", "organization(login: $owner)"},
+		"org: organization(login: $owner)": {"org", "organization(login: $owner)"},
+	} {
+		key, field := splitAlias(tag)
+		assert.Equal(t, expected, [2]string{key, field}, tag)
+	}
+}
@@ -0,0 +1,58 @@
+package github
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	ghv4 "github.com/shurcooL/githubv4"
+)
+
+// RequestLogger is invoked after every GraphQL query or mutation issued by the
+// Projects V2 functions. op names the operation, vars holds the GraphQL
+// variables (mutations pass their input under "input"), dur is the round-trip
+// time and err is the result of the call.
+type RequestLogger func(op string, vars map[string]interface{}, dur time.Duration, err error)
+
+var (
+	requestLoggerMu sync.RWMutex
+	requestLogger   RequestLogger = func(string, map[string]interface{}, time.Duration, error) {}
+)
+
+// SetRequestLogger installs fn as the GraphQL request hook. Passing nil
+// restores the default no-op hook.
+func SetRequestLogger(fn RequestLogger) {
+	if fn == nil {
+		fn = func(string, map[string]interface{}, time.Duration, error) {}
+	}
+	requestLoggerMu.Lock()
+	defer requestLoggerMu.Unlock()
+	requestLogger = fn
+}
+
+func logRequest(op string, vars map[string]interface{}, start time.Time, err error) {
+	requestLoggerMu.RLock()
+	fn := requestLogger
+	requestLoggerMu.RUnlock()
+	fn(op, vars, time.Since(start), err)
+}
+
+// graphQLQuery runs client.Query and reports it to the request logger under op.
+func graphQLQuery(ctx context.Context, client GraphQLClient, op string, q interface{}, vars map[string]interface{}) error {
+	start := time.Now()
+	err := client.Query(ctx, q, vars)
+	logRequest(op, vars, start, err)
+	return err
+}
+
+// graphQLMutate runs client.Mutate and reports it to the request logger under op.
+func graphQLMutate(ctx context.Context, client GraphQLClient, op string, m interface{}, input ghv4.Input, vars map[string]interface{}) error {
+	start := time.Now()
+	err := client.Mutate(ctx, m, input, vars)
+	logged := map[string]interface{}{"input": input}
+	for k, v := range vars {
+		logged[k] = v
+	}
+	logRequest(op, logged, start, err)
+	return err
+}
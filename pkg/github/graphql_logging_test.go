@@ -0,0 +1,77 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type loggedRequest struct {
+	op   string
+	vars map[string]interface{}
+	err  error
+}
+
+func captureRequests(t *testing.T) *[]loggedRequest {
+	t.Helper()
+	var calls []loggedRequest
+	SetRequestLogger(func(op string, vars map[string]interface{}, _ time.Duration, err error) {
+		calls = append(calls, loggedRequest{op: op, vars: vars, err: err})
+	})
+	t.Cleanup(func() { SetRequestLogger(nil) })
+	return &calls
+}
+
+func TestRequestLogger(t *testing.T) {
+	t.Run("query", func(t *testing.T) {
+		calls := captureRequests(t)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(200)
+			w.Write([]byte(`{"data":{"organization":{"projectsV2":{"nodes":[],"pageInfo":{"endCursor":"","hasNextPage":false}}}}}`))
+		}))
+		defer server.Close()
+
+		client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+		_, err := ListOrganizationProjects(context.Background(), &ListOrganizationProjectsInput{Organization: "test-org"}, client)
+		require.NoError(t, err)
+
+		require.Len(t, *calls, 1)
+		assert.Equal(t, "ListOrganizationProjects", (*calls)[0].op)
+		assert.Equal(t, githubv4.String("test-org"), (*calls)[0].vars["owner"])
+		assert.NoError(t, (*calls)[0].err)
+	})
+
+	t.Run("mutation error", func(t *testing.T) {
+		calls := captureRequests(t)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(500)
+		}))
+		defer server.Close()
+
+		client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+		_, err := AddProjectItem(context.Background(), &AddProjectItemInput{ProjectID: "proj1", ContentID: "c1"}, client)
+		require.Error(t, err)
+
+		require.Len(t, *calls, 1)
+		assert.Equal(t, "AddProjectItem", (*calls)[0].op)
+		assert.Contains(t, (*calls)[0].vars, "input")
+		assert.Error(t, (*calls)[0].err)
+	})
+
+	t.Run("owner resolution", func(t *testing.T) {
+		calls := captureRequests(t)
+		client := &fakeGraphQLClient{err: errors.New("Could not resolve to an Organization"), userID: "USERID"}
+		_, _, err := resolveOwnerID(context.Background(), client, "someone")
+		require.NoError(t, err)
+
+		require.Len(t, *calls, 1)
+		assert.Equal(t, "resolveOwnerID", (*calls)[0].op)
+	})
+}
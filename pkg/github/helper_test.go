@@ -71,13 +71,7 @@ func mockResponse(t *testing.T, code int, body interface{}) http.HandlerFunc {
 // createMCPRequest is a helper function to create a MCP request with the given arguments.
 func createMCPRequest(args map[string]interface{}) mcp.CallToolRequest {
 	return mcp.CallToolRequest{
-		Params: struct {
-			Name      string                 `json:"name"`
-			Arguments map[string]interface{} `json:"arguments,omitempty"`
-			Meta      *struct {
-				ProgressToken mcp.ProgressToken `json:"progressToken,omitempty"`
-			} `json:"_meta,omitempty"`
-		}{
+		Params: mcp.CallToolParams{
 			Arguments: args,
 		},
 	}
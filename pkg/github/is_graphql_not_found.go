@@ -8,8 +8,10 @@ func isGraphQLNotFound(err error) bool {
 		return false
 	}
 	msg := err.Error()
-	return strings.Contains(msg, "could not resolve to a User") ||
-		strings.Contains(msg, "could not resolve to an Organization") ||
+	// GitHub capitalizes the message: "Could not resolve to a User with ...".
+	lower := strings.ToLower(msg)
+	return strings.Contains(lower, "could not resolve to a user") ||
+		strings.Contains(lower, "could not resolve to an organization") ||
 		strings.Contains(msg, "non-200 OK status code: 400") ||
 		strings.Contains(msg, "non-200 OK status code: 404")
 }
@@ -15,6 +15,7 @@ func TestIsGraphQLNotFound(t *testing.T) {
 		{"empty error", errors.New(""), false},
 		{"user not found", errors.New("could not resolve to a User"), true},
 		{"org not found", errors.New("could not resolve to an Organization"), true},
+		{"github message", errors.New("Could not resolve to an Organization with the login of 'octocat'."), true},
 		{"400 code", errors.New("non-200 OK status code: 400"), true},
 		{"404 code", errors.New("non-200 OK status code: 404"), true},
 		{"other error", errors.New("some other error"), false},
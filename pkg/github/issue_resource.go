@@ -0,0 +1,118 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// GetIssueResource defines the resource template and handler for getting an issue with its comments.
+func GetIssueResource(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.ResourceTemplate, server.ResourceTemplateHandlerFunc) {
+	return mcp.NewResourceTemplate(
+			"issue://{owner}/{repo}/{issueNumber}", // Resource template
+			t("RESOURCE_ISSUE_DESCRIPTION", "Issue with its comments"),
+			mcp.WithTemplateMIMEType("text/markdown"),
+		),
+		IssueResourceHandler(getClient)
+}
+
+// IssueResourceHandler returns a handler function for issue requests. The
+// issue and its comments are rendered as one markdown document, which is
+// what a client attaching it as context wants to read.
+func IssueResourceHandler(getClient GetClientFn) server.ResourceTemplateHandlerFunc {
+	return func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		o, ok := request.Params.Arguments["owner"].([]string)
+		if !ok || len(o) == 0 {
+			return nil, errors.New("owner is required")
+		}
+		owner := o[0]
+
+		r, ok := request.Params.Arguments["repo"].([]string)
+		if !ok || len(r) == 0 {
+			return nil, errors.New("repo is required")
+		}
+		repo := r[0]
+
+		n, ok := request.Params.Arguments["issueNumber"].([]string)
+		if !ok || len(n) == 0 {
+			return nil, errors.New("issueNumber is required")
+		}
+		issueNumber, err := strconv.Atoi(n[0])
+		if err != nil {
+			return nil, fmt.Errorf("issueNumber must be a number, is %q", n[0])
+		}
+
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+		}
+		issue, _, err := client.Issues.Get(ctx, owner, repo, issueNumber)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get issue: %w", err)
+		}
+		comments, truncated, err := fetchAllPages(ctx, defaultMaxPages, func(ctx context.Context, page int) ([]*github.IssueComment, *github.Response, error) {
+			return client.Issues.ListComments(ctx, owner, repo, issueNumber, &github.IssueListCommentsOptions{
+				ListOptions: github.ListOptions{Page: page, PerPage: 100},
+			})
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get issue comments: %w", err)
+		}
+
+		return []mcp.ResourceContents{
+			mcp.TextResourceContents{
+				URI:      request.Params.URI,
+				MIMEType: "text/markdown",
+				Text:     issueMarkdown(issue, comments, truncated),
+			},
+		}, nil
+	}
+}
+
+// issueMarkdown renders the issue and its comments, oldest first.
+func issueMarkdown(issue *github.Issue, comments []*github.IssueComment, truncated bool) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s (#%d)\n\n", issue.GetTitle(), issue.GetNumber())
+
+	details := []string{
+		"State: " + issue.GetState(),
+		"Author: @" + issue.GetUser().GetLogin(),
+		"Created: " + issue.GetCreatedAt().Format("2006-01-02"),
+	}
+	if len(issue.Labels) > 0 {
+		labels := make([]string, 0, len(issue.Labels))
+		for _, label := range issue.Labels {
+			labels = append(labels, label.GetName())
+		}
+		details = append(details, "Labels: "+strings.Join(labels, ", "))
+	}
+	if len(issue.Assignees) > 0 {
+		assignees := make([]string, 0, len(issue.Assignees))
+		for _, assignee := range issue.Assignees {
+			assignees = append(assignees, "@"+assignee.GetLogin())
+		}
+		details = append(details, "Assignees: "+strings.Join(assignees, ", "))
+	}
+	fmt.Fprintf(&b, "%s\n%s\n", strings.Join(details, " · "), issue.GetHTMLURL())
+	if body := strings.TrimSpace(issue.GetBody()); body != "" {
+		fmt.Fprintf(&b, "\n%s\n", body)
+	}
+
+	if len(comments) > 0 {
+		b.WriteString("\n## Comments\n")
+		for _, comment := range comments {
+			fmt.Fprintf(&b, "\n### @%s on %s\n\n%s\n", comment.GetUser().GetLogin(), comment.GetCreatedAt().Format("2006-01-02"), strings.TrimSpace(comment.GetBody()))
+		}
+	}
+	if truncated {
+		fmt.Fprintf(&b, "\nOnly the first %d comments are shown; use get_issue_comments for the rest.\n", len(comments))
+	}
+	return b.String()
+}
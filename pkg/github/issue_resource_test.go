@@ -0,0 +1,146 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetIssueResource(t *testing.T) {
+	tmpl, _ := GetIssueResource(nil, translations.NullTranslationHelper)
+	require.Equal(t, "issue://{owner}/{repo}/{issueNumber}", tmpl.URITemplate.Raw())
+	assert.Equal(t, "text/markdown", tmpl.MIMEType)
+}
+
+func Test_IssueResourceHandler(t *testing.T) {
+	created := github.Timestamp{Time: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)}
+	mockIssue := &github.Issue{
+		Number:    github.Ptr(42),
+		Title:     github.Ptr("Crash on startup"),
+		Body:      github.Ptr("It crashes.\n"),
+		State:     github.Ptr("open"),
+		HTMLURL:   github.Ptr("https://github.com/owner/repo/issues/42"),
+		User:      &github.User{Login: github.Ptr("alice")},
+		Labels:    []*github.Label{{Name: github.Ptr("bug")}, {Name: github.Ptr("p1")}},
+		CreatedAt: &created,
+	}
+	mockComments := []*github.IssueComment{
+		{
+			Body:      github.Ptr("Can reproduce."),
+			User:      &github.User{Login: github.Ptr("bob")},
+			CreatedAt: &created,
+		},
+	}
+
+	tests := []struct {
+		name         string
+		mockedClient *http.Client
+		requestArgs  map[string]any
+		expectError  string
+		expectedText string
+	}{
+		{
+			name:         "missing issue number",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner": []string{"owner"},
+				"repo":  []string{"repo"},
+			},
+			expectError: "issueNumber is required",
+		},
+		{
+			name:         "invalid issue number",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":       []string{"owner"},
+				"repo":        []string{"repo"},
+				"issueNumber": []string{"abc"},
+			},
+			expectError: `issueNumber must be a number, is "abc"`,
+		},
+		{
+			name: "issue with comments",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+					mockIssue,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber,
+					mockComments,
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":       []string{"owner"},
+				"repo":        []string{"repo"},
+				"issueNumber": []string{"42"},
+			},
+			expectedText: "# Crash on startup (#42)\n\n" +
+				"State: open · Author: @alice · Created: 2025-03-01 · Labels: bug, p1\n" +
+				"https://github.com/owner/repo/issues/42\n\n" +
+				"It crashes.\n\n" +
+				"## Comments\n\n" +
+				"### @bob on 2025-03-01\n\n" +
+				"Can reproduce.\n",
+		},
+		{
+			name: "issue not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":       []string{"owner"},
+				"repo":        []string{"repo"},
+				"issueNumber": []string{"999"},
+			},
+			expectError: "failed to get issue",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			handler := IssueResourceHandler(stubGetClientFn(client))
+
+			request := mcp.ReadResourceRequest{
+				Params: struct {
+					URI       string         `json:"uri"`
+					Arguments map[string]any `json:"arguments,omitempty"`
+				}{
+					URI:       "issue://owner/repo/42",
+					Arguments: tc.requestArgs,
+				},
+			}
+
+			resp, err := handler(context.Background(), request)
+
+			if tc.expectError != "" {
+				require.ErrorContains(t, err, tc.expectError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, []mcp.ResourceContents{
+				mcp.TextResourceContents{
+					URI:      "issue://owner/repo/42",
+					MIMEType: "text/markdown",
+					Text:     tc.expectedText,
+				},
+			}, resp)
+		})
+	}
+}
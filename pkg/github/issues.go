@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"time"
 
@@ -142,6 +143,27 @@ func AddIssueComment(getClient GetClientFn, t translations.TranslationHelperFunc
 		}
 }
 
+// ListIssuesOutput is the result of list_issues. While HasNextPage is true,
+// NextPage can be passed back as page to fetch the following results. With
+// all, Truncated reports that max_pages or the rate limit cut the listing
+// short.
+type ListIssuesOutput struct {
+	Issues      []*github.Issue `json:"issues"`
+	NextPage    int             `json:"next_page,omitempty"`
+	HasNextPage bool            `json:"has_next_page"`
+	Truncated   bool            `json:"truncated,omitempty"`
+}
+
+// SearchIssuesOutput is the result of search_issues, with the same paging
+// fields as ListIssuesOutput.
+type SearchIssuesOutput struct {
+	TotalCount        int             `json:"total_count"`
+	IncompleteResults bool            `json:"incomplete_results"`
+	Issues            []*github.Issue `json:"issues"`
+	NextPage          int             `json:"next_page,omitempty"`
+	HasNextPage       bool            `json:"has_next_page"`
+}
+
 // SearchIssues creates a tool to search for issues and pull requests.
 func SearchIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("search_issues",
@@ -217,7 +239,14 @@ func SearchIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (
 				return mcp.NewToolResultError(fmt.Sprintf("failed to search issues: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(result)
+			out := SearchIssuesOutput{
+				TotalCount:        result.GetTotal(),
+				IncompleteResults: result.GetIncompleteResults(),
+				Issues:            result.Issues,
+				NextPage:          resp.NextPage,
+				HasNextPage:       resp.NextPage != 0,
+			}
+			r, err := json.Marshal(out)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -264,6 +293,9 @@ func CreateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			mcp.WithNumber("milestone",
 				mcp.Description("Milestone number"),
 			),
+			mcp.WithString("issue_type",
+				mcp.Description("Name of the organization issue type to set, see list_issue_types"),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -308,6 +340,11 @@ func CreateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 				milestoneNum = &milestone
 			}
 
+			issueType, err := OptionalParam[string](request, "issue_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
 			// Create the issue request
 			issueRequest := &github.IssueRequest{
 				Title:     github.Ptr(title),
@@ -321,7 +358,8 @@ func CreateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			issue, resp, err := client.Issues.Create(ctx, owner, repo, issueRequest)
+			u := fmt.Sprintf("repos/%s/%s/issues", owner, repo)
+			issue, resp, err := sendIssueRequest(ctx, client, http.MethodPost, u, issueRequest, issueType)
 			if err != nil {
 				return nil, fmt.Errorf("failed to create issue: %w", err)
 			}
@@ -368,6 +406,12 @@ func ListIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 					},
 				),
 			),
+			mcp.WithString("assignee",
+				mcp.Description("Filter by assignee login, '*' for any assignee or 'none' for unassigned issues"),
+			),
+			mcp.WithString("milestone",
+				mcp.Description("Filter by milestone number, '*' for any milestone or 'none' for issues without one"),
+			),
 			mcp.WithString("sort",
 				mcp.Description("Sort order"),
 				mcp.Enum("created", "updated", "comments"),
@@ -380,6 +424,7 @@ func ListIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				mcp.Description("Filter by date (ISO 8601 timestamp)"),
 			),
 			WithPagination(),
+			withAllRESTPages("issues"),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -405,6 +450,16 @@ func ListIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
+			opts.Assignee, err = OptionalParam[string](request, "assignee")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts.Milestone, err = OptionalParam[string](request, "milestone")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
 			opts.Sort, err = OptionalParam[string](request, "sort")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -427,18 +482,38 @@ func ListIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				opts.Since = timestamp
 			}
 
-			if page, ok := request.Params.Arguments["page"].(float64); ok {
-				opts.Page = int(page)
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
 			}
-
-			if perPage, ok := request.Params.Arguments["perPage"].(float64); ok {
-				opts.PerPage = int(perPage)
+			opts.Page = pagination.page
+			opts.PerPage = pagination.perPage
+			all, maxPages, err := allRESTPagesParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
 			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
+
+			if all {
+				issues, truncated, err := fetchAllPages(ctx, maxPages, func(ctx context.Context, page int) ([]*github.Issue, *github.Response, error) {
+					pageOpts := *opts
+					pageOpts.ListOptions = github.ListOptions{Page: page, PerPage: 100}
+					return client.Issues.ListByRepo(ctx, owner, repo, &pageOpts)
+				})
+				if err != nil {
+					return nil, fmt.Errorf("failed to list issues: %w", err)
+				}
+				r, err := json.Marshal(ListIssuesOutput{Issues: issues, Truncated: truncated})
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal issues: %w", err)
+				}
+				return mcp.NewToolResultText(string(r)), nil
+			}
+
 			issues, resp, err := client.Issues.ListByRepo(ctx, owner, repo, opts)
 			if err != nil {
 				return nil, fmt.Errorf("failed to list issues: %w", err)
@@ -453,7 +528,12 @@ func ListIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list issues: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(issues)
+			out := ListIssuesOutput{
+				Issues:      issues,
+				NextPage:    resp.NextPage,
+				HasNextPage: resp.NextPage != 0,
+			}
+			r, err := json.Marshal(out)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal issues: %w", err)
 			}
@@ -507,6 +587,9 @@ func UpdateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			mcp.WithNumber("milestone",
 				mcp.Description("New milestone number"),
 			),
+			mcp.WithString("issue_type",
+				mcp.Description("Name of the organization issue type to set, see list_issue_types"),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -577,11 +660,17 @@ func UpdateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 				issueRequest.Milestone = &milestoneNum
 			}
 
+			issueType, err := OptionalParam[string](request, "issue_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			updatedIssue, resp, err := client.Issues.Edit(ctx, owner, repo, issueNumber, issueRequest)
+			u := fmt.Sprintf("repos/%s/%s/issues/%d", owner, repo, issueNumber)
+			updatedIssue, resp, err := sendIssueRequest(ctx, client, http.MethodPatch, u, issueRequest, issueType)
 			if err != nil {
 				return nil, fmt.Errorf("failed to update issue: %w", err)
 			}
@@ -622,9 +711,12 @@ func GetIssueComments(getClient GetClientFn, t translations.TranslationHelperFun
 			),
 			mcp.WithNumber("page",
 				mcp.Description("Page number"),
+				mcp.Min(1),
 			),
 			mcp.WithNumber("per_page",
-				mcp.Description("Number of records per page"),
+				mcp.Description("Number of records per page (max 100)"),
+				mcp.Min(1),
+				mcp.Max(100),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -640,11 +732,11 @@ func GetIssueComments(getClient GetClientFn, t translations.TranslationHelperFun
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			page, err := OptionalIntParamWithDefault(request, "page", 1)
+			page, err := OptionalIntParamInRange(request, "page", 1, 1, math.MaxInt32)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			perPage, err := OptionalIntParamWithDefault(request, "per_page", 30)
+			perPage, err := OptionalIntParamInRange(request, "per_page", 30, 1, 100)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -706,3 +798,173 @@ func parseISOTimestamp(timestamp string) (time.Time, error) {
 	// Return error with supported formats
 	return time.Time{}, fmt.Errorf("invalid ISO 8601 timestamp: %s (supported formats: YYYY-MM-DDThh:mm:ssZ or YYYY-MM-DD)", timestamp)
 }
+
+// issueRequestWithType extends github.IssueRequest with the issue type field,
+// which go-github v69 does not support yet.
+type issueRequestWithType struct {
+	*github.IssueRequest
+	Type *string `json:"type,omitempty"`
+}
+
+// sendIssueRequest creates or edits an issue at u, setting its type when
+// issueType is not empty.
+func sendIssueRequest(ctx context.Context, client *github.Client, method, u string, issueRequest *github.IssueRequest, issueType string) (*github.Issue, *github.Response, error) {
+	body := &issueRequestWithType{IssueRequest: issueRequest}
+	if issueType != "" {
+		body.Type = github.Ptr(issueType)
+	}
+	req, err := client.NewRequest(method, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	issue := new(github.Issue)
+	resp, err := client.Do(ctx, req, issue)
+	if err != nil {
+		return nil, resp, err
+	}
+	return issue, resp, nil
+}
+
+// ListIssueTypes creates a tool to list the issue types defined for an organization.
+func ListIssueTypes(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_issue_types",
+			mcp.WithDescription(t("TOOL_LIST_ISSUE_TYPES_DESCRIPTION", "List the issue types available for issues in an organization's repositories")),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("orgs/%s/issue-types", org), nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+			var issueTypes []*github.IssueType
+			resp, err := client.Do(ctx, req, &issueTypes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list issue types: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list issue types: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(issueTypes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// AddAssignees creates a tool to add assignees to an issue or pull request.
+func AddAssignees(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return issueAssigneesTool("add_assignees",
+		t("TOOL_ADD_ASSIGNEES_DESCRIPTION", "Add assignees to an issue or pull request, keeping its existing assignees"),
+		getClient,
+		func(ctx context.Context, client *github.Client, owner, repo string, number int, assignees []string) (*github.Issue, *github.Response, error) {
+			return client.Issues.AddAssignees(ctx, owner, repo, number, assignees)
+		})
+}
+
+// RemoveAssignees creates a tool to remove assignees from an issue or pull request.
+func RemoveAssignees(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return issueAssigneesTool("remove_assignees",
+		t("TOOL_REMOVE_ASSIGNEES_DESCRIPTION", "Remove assignees from an issue or pull request"),
+		getClient,
+		func(ctx context.Context, client *github.Client, owner, repo string, number int, assignees []string) (*github.Issue, *github.Response, error) {
+			return client.Issues.RemoveAssignees(ctx, owner, repo, number, assignees)
+		})
+}
+
+// issueAssigneesTool builds the add/remove assignee tools, which share their
+// parameters and return the updated issue.
+func issueAssigneesTool(name, description string, getClient GetClientFn,
+	apply func(ctx context.Context, client *github.Client, owner, repo string, number int, assignees []string) (*github.Issue, *github.Response, error),
+) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool(name,
+			mcp.WithDescription(description),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue or pull request number"),
+			),
+			mcp.WithArray("assignees",
+				mcp.Required(),
+				mcp.Description("User logins"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			assignees, err := OptionalStringArrayParam(request, "assignees")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(assignees) == 0 {
+				return mcp.NewToolResultError("missing required parameter: assignees"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			issue, resp, err := apply(ctx, client, owner, repo, issueNumber, assignees)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update assignees: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to update assignees: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(issue)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
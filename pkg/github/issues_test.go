@@ -3,6 +3,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"testing"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v69/github"
 	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
 	"github.com/migueleliasweb/go-github-mock/src/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -189,13 +191,7 @@ func Test_AddIssueComment(t *testing.T) {
 
 			// Create call request
 			request := mcp.CallToolRequest{
-				Params: struct {
-					Name      string                 `json:"name"`
-					Arguments map[string]interface{} `json:"arguments,omitempty"`
-					Meta      *struct {
-						ProgressToken mcp.ProgressToken `json:"progressToken,omitempty"`
-					} `json:"_meta,omitempty"`
-				}{
+				Params: mcp.CallToolParams{
 					Arguments: tc.requestArgs,
 				},
 			}
@@ -373,11 +369,12 @@ func Test_SearchIssues(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedResult github.IssuesSearchResult
+			var returnedResult SearchIssuesOutput
 			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
 			require.NoError(t, err)
-			assert.Equal(t, *tc.expectedResult.Total, *returnedResult.Total)
-			assert.Equal(t, *tc.expectedResult.IncompleteResults, *returnedResult.IncompleteResults)
+			assert.Equal(t, *tc.expectedResult.Total, returnedResult.TotalCount)
+			assert.Equal(t, *tc.expectedResult.IncompleteResults, returnedResult.IncompleteResults)
+			assert.False(t, returnedResult.HasNextPage)
 			assert.Len(t, returnedResult.Issues, len(tc.expectedResult.Issues))
 			for i, issue := range returnedResult.Issues {
 				assert.Equal(t, *tc.expectedResult.Issues[i].Number, *issue.Number)
@@ -404,6 +401,7 @@ func Test_CreateIssue(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "assignees")
 	assert.Contains(t, tool.InputSchema.Properties, "labels")
 	assert.Contains(t, tool.InputSchema.Properties, "milestone")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_type")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "title"})
 
 	// Setup mock issue for success case
@@ -437,19 +435,21 @@ func Test_CreateIssue(t *testing.T) {
 						"labels":    []any{"bug", "help wanted"},
 						"assignees": []any{"user1", "user2"},
 						"milestone": float64(5),
+						"type":      "Bug",
 					}).andThen(
 						mockResponse(t, http.StatusCreated, mockIssue),
 					),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":     "owner",
-				"repo":      "repo",
-				"title":     "Test Issue",
-				"body":      "This is a test issue",
-				"assignees": []any{"user1", "user2"},
-				"labels":    []any{"bug", "help wanted"},
-				"milestone": float64(5),
+				"owner":      "owner",
+				"repo":       "repo",
+				"title":      "Test Issue",
+				"body":       "This is a test issue",
+				"assignees":  []any{"user1", "user2"},
+				"labels":     []any{"bug", "help wanted"},
+				"milestone":  float64(5),
+				"issue_type": "Bug",
 			},
 			expectError:   false,
 			expectedIssue: mockIssue,
@@ -575,6 +575,8 @@ func Test_ListIssues(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "state")
 	assert.Contains(t, tool.InputSchema.Properties, "labels")
+	assert.Contains(t, tool.InputSchema.Properties, "assignee")
+	assert.Contains(t, tool.InputSchema.Properties, "milestone")
 	assert.Contains(t, tool.InputSchema.Properties, "sort")
 	assert.Contains(t, tool.InputSchema.Properties, "direction")
 	assert.Contains(t, tool.InputSchema.Properties, "since")
@@ -609,6 +611,7 @@ func Test_ListIssues(t *testing.T) {
 		requestArgs    map[string]interface{}
 		expectError    bool
 		expectedIssues []*github.Issue
+		expectedNext   int
 		expectedErrMsg string
 	}{
 		{
@@ -634,6 +637,8 @@ func Test_ListIssues(t *testing.T) {
 					expectQueryParams(t, map[string]string{
 						"state":     "open",
 						"labels":    "bug,enhancement",
+						"assignee":  "octocat",
+						"milestone": "3",
 						"sort":      "created",
 						"direction": "desc",
 						"since":     "2023-01-01T00:00:00Z",
@@ -649,6 +654,8 @@ func Test_ListIssues(t *testing.T) {
 				"repo":      "repo",
 				"state":     "open",
 				"labels":    []any{"bug", "enhancement"},
+				"assignee":  "octocat",
+				"milestone": "3",
 				"sort":      "created",
 				"direction": "desc",
 				"since":     "2023-01-01T00:00:00Z",
@@ -658,6 +665,27 @@ func Test_ListIssues(t *testing.T) {
 			expectError:    false,
 			expectedIssues: mockIssues,
 		},
+		{
+			name: "list issues reports next page",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.Header().Set("Link", fmt.Sprintf(`<https://api.github.com%s?page=3>; rel="next"`, r.URL.Path))
+						w.WriteHeader(http.StatusOK)
+						_ = json.NewEncoder(w).Encode(mockIssues)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"page":  float64(2),
+			},
+			expectError:    false,
+			expectedIssues: mockIssues,
+			expectedNext:   3,
+		},
 		{
 			name: "invalid since parameter",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -725,12 +753,14 @@ func Test_ListIssues(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedIssues []*github.Issue
-			err = json.Unmarshal([]byte(textContent.Text), &returnedIssues)
+			var returned ListIssuesOutput
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
 			require.NoError(t, err)
 
-			assert.Len(t, returnedIssues, len(tc.expectedIssues))
-			for i, issue := range returnedIssues {
+			assert.Equal(t, tc.expectedNext, returned.NextPage)
+			assert.Equal(t, tc.expectedNext != 0, returned.HasNextPage)
+			assert.Len(t, returned.Issues, len(tc.expectedIssues))
+			for i, issue := range returned.Issues {
 				assert.Equal(t, *tc.expectedIssues[i].Number, *issue.Number)
 				assert.Equal(t, *tc.expectedIssues[i].Title, *issue.Title)
 				assert.Equal(t, *tc.expectedIssues[i].State, *issue.State)
@@ -790,6 +820,7 @@ func Test_UpdateIssue(t *testing.T) {
 						"labels":    []any{"bug", "priority"},
 						"assignees": []any{"assignee1", "assignee2"},
 						"milestone": float64(5),
+						"type":      "Feature",
 					}).andThen(
 						mockResponse(t, http.StatusOK, mockIssue),
 					),
@@ -805,6 +836,7 @@ func Test_UpdateIssue(t *testing.T) {
 				"labels":       []any{"bug", "priority"},
 				"assignees":    []any{"assignee1", "assignee2"},
 				"milestone":    float64(5),
+				"issue_type":   "Feature",
 			},
 			expectError:   false,
 			expectedIssue: mockIssue,
@@ -1130,3 +1162,97 @@ func Test_GetIssueComments(t *testing.T) {
 		})
 	}
 }
+
+func Test_ListIssueTypes(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListIssueTypes(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_issue_types", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	mockTypes := []*github.IssueType{
+		{ID: github.Ptr(int64(1)), Name: github.Ptr("Bug"), Description: github.Ptr("An unexpected problem")},
+		{ID: github.Ptr(int64(2)), Name: github.Ptr("Feature")},
+	}
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.EndpointPattern{Pattern: "/orgs/{org}/issue-types", Method: "GET"},
+			mockTypes,
+		),
+	))
+	_, handler := ListIssueTypes(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"org": "octo-org",
+	}))
+	require.NoError(t, err)
+
+	var returned []*github.IssueType
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	require.Len(t, returned, 2)
+	assert.Equal(t, "Bug", returned[0].GetName())
+	assert.Equal(t, "Feature", returned[1].GetName())
+}
+
+func Test_IssueAssigneesTools(t *testing.T) {
+	mockIssue := &github.Issue{
+		Number:    github.Ptr(42),
+		Assignees: []*github.User{{Login: github.Ptr("octocat")}},
+	}
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostReposIssuesAssigneesByOwnerByRepoByIssueNumber,
+			expectRequestBody(t, map[string]any{
+				"assignees": []any{"octocat"},
+			}).andThen(
+				mockResponse(t, http.StatusCreated, mockIssue),
+			),
+		),
+		mock.WithRequestMatchHandler(
+			mock.DeleteReposIssuesAssigneesByOwnerByRepoByIssueNumber,
+			expectRequestBody(t, map[string]any{
+				"assignees": []any{"hubot"},
+			}).andThen(
+				mockResponse(t, http.StatusOK, mockIssue),
+			),
+		),
+	))
+
+	addTool, add := AddAssignees(stubGetClientFn(client), translations.NullTranslationHelper)
+	assert.Equal(t, "add_assignees", addTool.Name)
+	assert.ElementsMatch(t, addTool.InputSchema.Required, []string{"owner", "repo", "issue_number", "assignees"})
+	removeTool, remove := RemoveAssignees(stubGetClientFn(client), translations.NullTranslationHelper)
+	assert.Equal(t, "remove_assignees", removeTool.Name)
+
+	for _, tc := range []struct {
+		handler server.ToolHandlerFunc
+		login   string
+	}{
+		{add, "octocat"},
+		{remove, "hubot"},
+	} {
+		result, err := tc.handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner":        "owner",
+			"repo":         "repo",
+			"issue_number": float64(42),
+			"assignees":    []any{tc.login},
+		}))
+		require.NoError(t, err)
+
+		var returned github.Issue
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+		assert.Equal(t, 42, returned.GetNumber())
+	}
+
+	result, err := add(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":        "owner",
+		"repo":         "repo",
+		"issue_number": float64(42),
+		"assignees":    []any{},
+	}))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, getTextResult(t, result).Text, "missing required parameter: assignees")
+}
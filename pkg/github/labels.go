@@ -0,0 +1,268 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ListLabels creates a tool to list the labels defined in a repository.
+func ListLabels(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_labels",
+			mcp.WithDescription(t("TOOL_LIST_LABELS_DESCRIPTION", "List the labels defined in a GitHub repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			labels, resp, err := client.Issues.ListLabels(ctx, owner, repo, &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list labels: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list labels: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(labels)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateLabel creates a tool to create a label in a repository.
+func CreateLabel(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_label",
+			mcp.WithDescription(t("TOOL_CREATE_LABEL_DESCRIPTION", "Create a label in a GitHub repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("Label name"),
+			),
+			mcp.WithString("color",
+				mcp.Required(),
+				mcp.Description("Hexadecimal color code without the leading #, e.g. d73a4a"),
+			),
+			mcp.WithString("description",
+				mcp.Description("Short description of the label"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := requiredParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			color, err := requiredParam[string](request, "color")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			description, err := OptionalParam[string](request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			label := &github.Label{
+				Name:  github.Ptr(name),
+				Color: github.Ptr(color),
+			}
+			if description != "" {
+				label.Description = github.Ptr(description)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			created, resp, err := client.Issues.CreateLabel(ctx, owner, repo, label)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create label: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create label: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(created)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// AddLabels creates a tool to add labels to an issue or pull request.
+func AddLabels(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return issueLabelsTool("add_labels",
+		t("TOOL_ADD_LABELS_DESCRIPTION", "Add labels to an issue or pull request, keeping its existing labels"),
+		getClient,
+		func(ctx context.Context, client *github.Client, owner, repo string, number int, labels []string) ([]*github.Label, *github.Response, error) {
+			return client.Issues.AddLabelsToIssue(ctx, owner, repo, number, labels)
+		})
+}
+
+// RemoveLabels creates a tool to remove labels from an issue or pull request.
+func RemoveLabels(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return issueLabelsTool("remove_labels",
+		t("TOOL_REMOVE_LABELS_DESCRIPTION", "Remove labels from an issue or pull request"),
+		getClient,
+		func(ctx context.Context, client *github.Client, owner, repo string, number int, labels []string) ([]*github.Label, *github.Response, error) {
+			// The API removes one label per request.
+			for _, label := range labels {
+				resp, err := client.Issues.RemoveLabelForIssue(ctx, owner, repo, number, label)
+				if err != nil {
+					return nil, resp, fmt.Errorf("label %q: %w", label, err)
+				}
+				_ = resp.Body.Close()
+			}
+			return client.Issues.ListLabelsByIssue(ctx, owner, repo, number, &github.ListOptions{PerPage: 100})
+		})
+}
+
+// SetLabels creates a tool to replace all labels on an issue or pull request.
+func SetLabels(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return issueLabelsTool("set_labels",
+		t("TOOL_SET_LABELS_DESCRIPTION", "Replace all labels on an issue or pull request. An empty list removes every label"),
+		getClient,
+		func(ctx context.Context, client *github.Client, owner, repo string, number int, labels []string) ([]*github.Label, *github.Response, error) {
+			return client.Issues.ReplaceLabelsForIssue(ctx, owner, repo, number, labels)
+		})
+}
+
+// issueLabelsTool builds the add/remove/set label tools, which share their
+// parameters and return the labels left on the issue after apply runs.
+func issueLabelsTool(name, description string, getClient GetClientFn,
+	apply func(ctx context.Context, client *github.Client, owner, repo string, number int, labels []string) ([]*github.Label, *github.Response, error),
+) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool(name,
+			mcp.WithDescription(description),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue or pull request number"),
+			),
+			mcp.WithArray("labels",
+				mcp.Required(),
+				mcp.Description("Label names"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if _, ok := request.GetArguments()["labels"]; !ok {
+				return mcp.NewToolResultError("missing required parameter: labels"), nil
+			}
+			labels, err := OptionalStringArrayParam(request, "labels")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			result, resp, err := apply(ctx, client, owner, repo, issueNumber, labels)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update labels: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to update labels: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,167 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListLabels(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListLabels(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_labels", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockLabels := []*github.Label{
+		{Name: github.Ptr("bug"), Color: github.Ptr("d73a4a")},
+		{Name: github.Ptr("enhancement"), Color: github.Ptr("a2eeef")},
+	}
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposLabelsByOwnerByRepo,
+			expectQueryParams(t, map[string]string{
+				"page":     "1",
+				"per_page": "30",
+			}).andThen(
+				mockResponse(t, http.StatusOK, mockLabels),
+			),
+		),
+	))
+	_, handler := ListLabels(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+	}))
+	require.NoError(t, err)
+
+	var returned []*github.Label
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	require.Len(t, returned, 2)
+	assert.Equal(t, "enhancement", returned[1].GetName())
+}
+
+func Test_CreateLabel(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateLabel(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_label", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "name", "color"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostReposLabelsByOwnerByRepo,
+			expectRequestBody(t, map[string]any{
+				"name":        "triage",
+				"color":       "fbca04",
+				"description": "Needs triage",
+			}).andThen(
+				mockResponse(t, http.StatusCreated, &github.Label{
+					Name:  github.Ptr("triage"),
+					Color: github.Ptr("fbca04"),
+				}),
+			),
+		),
+	))
+	_, handler := CreateLabel(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":       "owner",
+		"repo":        "repo",
+		"name":        "triage",
+		"color":       "fbca04",
+		"description": "Needs triage",
+	}))
+	require.NoError(t, err)
+
+	var returned github.Label
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, "triage", returned.GetName())
+}
+
+func Test_IssueLabelsTools(t *testing.T) {
+	remaining := []*github.Label{{Name: github.Ptr("bug")}}
+
+	tests := []struct {
+		name         string
+		tool         func(GetClientFn, translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc)
+		mockedClient *http.Client
+		labels       []any
+	}{
+		{
+			name: "add_labels",
+			tool: AddLabels,
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesLabelsByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, []any{"bug"}).andThen(
+						mockResponse(t, http.StatusOK, remaining),
+					),
+				),
+			),
+			labels: []any{"bug"},
+		},
+		{
+			name: "remove_labels",
+			tool: RemoveLabels,
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.DeleteReposIssuesLabelsByOwnerByRepoByIssueNumberByName,
+					remaining,
+					remaining,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposIssuesLabelsByOwnerByRepoByIssueNumber,
+					remaining,
+				),
+			),
+			labels: []any{"wontfix", "duplicate"},
+		},
+		{
+			name: "set_labels",
+			tool: SetLabels,
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposIssuesLabelsByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, []any{"bug"}).andThen(
+						mockResponse(t, http.StatusOK, remaining),
+					),
+				),
+			),
+			labels: []any{"bug"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			tool, handler := tc.tool(stubGetClientFn(client), translations.NullTranslationHelper)
+			assert.Equal(t, tc.name, tool.Name)
+			assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number", "labels"})
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"labels":       tc.labels,
+			}))
+			require.NoError(t, err)
+
+			var returned []*github.Label
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			require.Len(t, returned, 1)
+			assert.Equal(t, "bug", returned[0].GetName())
+		})
+	}
+}
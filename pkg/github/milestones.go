@@ -0,0 +1,387 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ListMilestones creates a tool to list the milestones of a repository.
+func ListMilestones(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_milestones",
+			mcp.WithDescription(t("TOOL_LIST_MILESTONES_DESCRIPTION", "List milestones in a GitHub repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("state",
+				mcp.Description("Filter by state, defaults to open"),
+				mcp.Enum("open", "closed", "all"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort field, defaults to due_on"),
+				mcp.Enum("due_on", "completeness"),
+			),
+			mcp.WithString("direction",
+				mcp.Description("Sort direction"),
+				mcp.Enum("asc", "desc"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts := &github.MilestoneListOptions{}
+			if opts.State, err = OptionalParam[string](request, "state"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if opts.Sort, err = OptionalParam[string](request, "sort"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if opts.Direction, err = OptionalParam[string](request, "direction"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts.Page = pagination.page
+			opts.PerPage = pagination.perPage
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			milestones, resp, err := client.Issues.ListMilestones(ctx, owner, repo, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list milestones: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list milestones: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(milestones)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateMilestone creates a tool to create a milestone in a repository.
+func CreateMilestone(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_milestone",
+			mcp.WithDescription(t("TOOL_CREATE_MILESTONE_DESCRIPTION", "Create a milestone in a GitHub repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("title",
+				mcp.Required(),
+				mcp.Description("Milestone title"),
+			),
+			withMilestoneFields(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			milestone, _, err := milestoneFromRequest(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if milestone.Title == nil {
+				return mcp.NewToolResultError("missing required parameter: title"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			created, resp, err := client.Issues.CreateMilestone(ctx, owner, repo, milestone)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create milestone: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create milestone: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(created)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// UpdateMilestone creates a tool to update a milestone in a repository.
+func UpdateMilestone(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_milestone",
+			mcp.WithDescription(t("TOOL_UPDATE_MILESTONE_DESCRIPTION", "Update the title, description, due date or state of a milestone")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("milestone_number",
+				mcp.Required(),
+				mcp.Description("Milestone number"),
+			),
+			mcp.WithString("title",
+				mcp.Description("New title"),
+			),
+			withMilestoneFields(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			milestone, updateNeeded, err := milestoneFromRequest(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !updateNeeded {
+				return mcp.NewToolResultError("No update parameters provided."), nil
+			}
+			return editMilestone(ctx, getClient, request, milestone)
+		}
+}
+
+// CloseMilestone creates a tool to close a milestone in a repository.
+func CloseMilestone(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("close_milestone",
+			mcp.WithDescription(t("TOOL_CLOSE_MILESTONE_DESCRIPTION", "Close a milestone. Its issues and pull requests keep the milestone")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("milestone_number",
+				mcp.Required(),
+				mcp.Description("Milestone number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return editMilestone(ctx, getClient, request, &github.Milestone{State: github.Ptr("closed")})
+		}
+}
+
+// SetMilestone creates a tool to set or clear the milestone of an issue or pull request.
+func SetMilestone(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("set_milestone",
+			mcp.WithDescription(t("TOOL_SET_MILESTONE_DESCRIPTION", "Set or clear the milestone of an issue or pull request")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue or pull request number"),
+			),
+			mcp.WithNumber("milestone_number",
+				mcp.Required(),
+				mcp.Description("Milestone number, or 0 to remove the current milestone"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if _, ok := request.GetArguments()["milestone_number"]; !ok {
+				return mcp.NewToolResultError("missing required parameter: milestone_number"), nil
+			}
+			milestoneNumber, err := OptionalIntParam(request, "milestone_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			var issue *github.Issue
+			var resp *github.Response
+			if milestoneNumber == 0 {
+				issue, resp, err = client.Issues.RemoveMilestone(ctx, owner, repo, issueNumber)
+			} else {
+				issue, resp, err = client.Issues.Edit(ctx, owner, repo, issueNumber, &github.IssueRequest{
+					Milestone: github.Ptr(milestoneNumber),
+				})
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to set milestone: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to set milestone: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(issue)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// withMilestoneFields adds the optional description, due_on and state
+// parameters shared by create_milestone and update_milestone.
+func withMilestoneFields() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("description",
+			mcp.Description("Milestone description"),
+		)(tool)
+		mcp.WithString("due_on",
+			mcp.Description("Due date (ISO 8601 timestamp or YYYY-MM-DD)"),
+		)(tool)
+		mcp.WithString("state",
+			mcp.Description("Milestone state"),
+			mcp.Enum("open", "closed"),
+		)(tool)
+	}
+}
+
+// milestoneFromRequest builds a milestone from the title parameter and the
+// parameters added by withMilestoneFields, reporting whether any were set.
+func milestoneFromRequest(request mcp.CallToolRequest) (*github.Milestone, bool, error) {
+	milestone := &github.Milestone{}
+	set := false
+
+	if title, ok, err := OptionalParamOK[string](request, "title"); err != nil {
+		return nil, false, err
+	} else if ok {
+		milestone.Title = github.Ptr(title)
+		set = true
+	}
+
+	if description, ok, err := OptionalParamOK[string](request, "description"); err != nil {
+		return nil, false, err
+	} else if ok {
+		milestone.Description = github.Ptr(description)
+		set = true
+	}
+
+	if dueOn, ok, err := OptionalParamOK[string](request, "due_on"); err != nil {
+		return nil, false, err
+	} else if ok {
+		due, err := parseISOTimestamp(dueOn)
+		if err != nil {
+			return nil, false, err
+		}
+		milestone.DueOn = &github.Timestamp{Time: due}
+		set = true
+	}
+
+	if state, ok, err := OptionalParamOK[string](request, "state"); err != nil {
+		return nil, false, err
+	} else if ok {
+		milestone.State = github.Ptr(state)
+		set = true
+	}
+
+	return milestone, set, nil
+}
+
+// editMilestone applies milestone to the milestone_number of the requested
+// repository and returns the updated milestone.
+func editMilestone(ctx context.Context, getClient GetClientFn, request mcp.CallToolRequest, milestone *github.Milestone) (*mcp.CallToolResult, error) {
+	owner, err := requiredParam[string](request, "owner")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	repo, err := requiredParam[string](request, "repo")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	number, err := RequiredInt(request, "milestone_number")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	client, err := getClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+	}
+	updated, resp, err := client.Issues.EditMilestone(ctx, owner, repo, number, milestone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update milestone: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+		return mcp.NewToolResultError(fmt.Sprintf("failed to update milestone: %s", string(body))), nil
+	}
+
+	r, err := json.Marshal(updated)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(r)), nil
+}
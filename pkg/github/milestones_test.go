@@ -0,0 +1,185 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListMilestones(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListMilestones(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_milestones", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "state")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockMilestones := []*github.Milestone{
+		{Number: github.Ptr(1), Title: github.Ptr("v1.0"), State: github.Ptr("closed")},
+		{Number: github.Ptr(2), Title: github.Ptr("v1.1"), State: github.Ptr("open")},
+	}
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposMilestonesByOwnerByRepo,
+			expectQueryParams(t, map[string]string{
+				"state":     "all",
+				"sort":      "completeness",
+				"direction": "desc",
+				"page":      "1",
+				"per_page":  "30",
+			}).andThen(
+				mockResponse(t, http.StatusOK, mockMilestones),
+			),
+		),
+	))
+	_, handler := ListMilestones(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":     "owner",
+		"repo":      "repo",
+		"state":     "all",
+		"sort":      "completeness",
+		"direction": "desc",
+	}))
+	require.NoError(t, err)
+
+	var returned []*github.Milestone
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	require.Len(t, returned, 2)
+	assert.Equal(t, "v1.1", returned[1].GetTitle())
+}
+
+func Test_CreateMilestone(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateMilestone(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_milestone", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "title"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostReposMilestonesByOwnerByRepo,
+			expectRequestBody(t, map[string]any{
+				"title":       "v2.0",
+				"description": "Next major",
+				"due_on":      "2025-07-01T00:00:00Z",
+			}).andThen(
+				mockResponse(t, http.StatusCreated, &github.Milestone{
+					Number: github.Ptr(3),
+					Title:  github.Ptr("v2.0"),
+				}),
+			),
+		),
+	))
+	_, handler := CreateMilestone(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":       "owner",
+		"repo":        "repo",
+		"title":       "v2.0",
+		"description": "Next major",
+		"due_on":      "2025-07-01",
+	}))
+	require.NoError(t, err)
+
+	var returned github.Milestone
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, 3, returned.GetNumber())
+
+	result, err = handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":  "owner",
+		"repo":   "repo",
+		"title":  "v2.0",
+		"due_on": "next week",
+	}))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, getTextResult(t, result).Text, "invalid ISO 8601 timestamp")
+}
+
+func Test_UpdateAndCloseMilestone(t *testing.T) {
+	closed := &github.Milestone{Number: github.Ptr(2), Title: github.Ptr("v1.1"), State: github.Ptr("closed")}
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PatchReposMilestonesByOwnerByRepoByMilestoneNumber,
+			expectRequestBody(t, map[string]any{
+				"state": "closed",
+			}).andThen(
+				mockResponse(t, http.StatusOK, closed),
+			),
+		),
+	))
+
+	tool, closeHandler := CloseMilestone(stubGetClientFn(client), translations.NullTranslationHelper)
+	assert.Equal(t, "close_milestone", tool.Name)
+	result, err := closeHandler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":            "owner",
+		"repo":             "repo",
+		"milestone_number": float64(2),
+	}))
+	require.NoError(t, err)
+	assert.Contains(t, getTextResult(t, result).Text, `"state":"closed"`)
+
+	tool, updateHandler := UpdateMilestone(stubGetClientFn(client), translations.NullTranslationHelper)
+	assert.Equal(t, "update_milestone", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "milestone_number"})
+	result, err = updateHandler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":            "owner",
+		"repo":             "repo",
+		"milestone_number": float64(2),
+	}))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, getTextResult(t, result).Text, "No update parameters provided.")
+}
+
+func Test_SetMilestone(t *testing.T) {
+	mockIssue := &github.Issue{Number: github.Ptr(42), Milestone: &github.Milestone{Number: github.Ptr(2)}}
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]any
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				if body["milestone"] == nil {
+					mockResponse(t, http.StatusOK, &github.Issue{Number: github.Ptr(42)})(w, r)
+					return
+				}
+				assert.Equal(t, float64(2), body["milestone"])
+				mockResponse(t, http.StatusOK, mockIssue)(w, r)
+			}),
+		),
+	))
+	tool, handler := SetMilestone(stubGetClientFn(client), translations.NullTranslationHelper)
+	assert.Equal(t, "set_milestone", tool.Name)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":            "owner",
+		"repo":             "repo",
+		"issue_number":     float64(42),
+		"milestone_number": float64(2),
+	}))
+	require.NoError(t, err)
+	var returned github.Issue
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, 2, returned.GetMilestone().GetNumber())
+
+	result, err = handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":            "owner",
+		"repo":             "repo",
+		"issue_number":     float64(42),
+		"milestone_number": float64(0),
+	}))
+	require.NoError(t, err)
+	returned = github.Issue{}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Nil(t, returned.Milestone)
+}
@@ -0,0 +1,70 @@
+package github
+
+import (
+	"fmt"
+	"strings"
+)
+
+// nodeKind describes which node ID prefixes (the part before the first "_")
+// identify a kind of node.
+type nodeKind struct {
+	name     string
+	prefixes []string
+}
+
+var (
+	projectNode             = nodeKind{"project", []string{"PVT"}}
+	projectItemNode         = nodeKind{"project item", []string{"PVTI"}}
+	projectFieldNode        = nodeKind{"project field", []string{"PVTF", "PVTSSF", "PVTIF"}}
+	itemContentNode         = nodeKind{"issue or pull request", []string{"I", "PR"}}
+	draftIssueNode          = nodeKind{"draft issue", []string{"DI"}}
+	ownerNode               = nodeKind{"organization or user", []string{"O", "U"}}
+	projectStatusUpdateNode = nodeKind{"project status update", []string{"PVTSU"}}
+)
+
+// knownNodePrefixes are the prefixes validate treats as clearly identifying
+// some other kind of node.
+var knownNodePrefixes = map[string]bool{}
+
+func init() {
+	for _, k := range []nodeKind{projectNode, projectItemNode, projectFieldNode, itemContentNode, draftIssueNode, ownerNode, projectStatusUpdateNode} {
+		for _, p := range k.prefixes {
+			knownNodePrefixes[p] = true
+		}
+	}
+}
+
+// validate rejects id only when its prefix is known to belong to a different
+// kind of node. Empty IDs, legacy IDs without a prefix and unfamiliar prefixes
+// are let through for GitHub to judge.
+func (k nodeKind) validate(id string) error {
+	prefix, _, ok := strings.Cut(id, "_")
+	if !ok || !knownNodePrefixes[prefix] {
+		return nil
+	}
+	for _, p := range k.prefixes {
+		if p == prefix {
+			return nil
+		}
+	}
+	want := make([]string, len(k.prefixes))
+	for i, p := range k.prefixes {
+		want[i] = p + "_..."
+	}
+	return fmt.Errorf("expected a %s node ID (%s) but got %q", k.name, strings.Join(want, ", "), id)
+}
+
+// validateNodeIDs runs each check in order and returns the first failure.
+func validateNodeIDs(checks ...nodeIDCheck) error {
+	for _, c := range checks {
+		if err := c.kind.validate(c.id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type nodeIDCheck struct {
+	kind nodeKind
+	id   string
+}
@@ -0,0 +1,63 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeKindValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		kind    nodeKind
+		id      string
+		wantErr string
+	}{
+		{name: "project", kind: projectNode, id: "PVT_kwDOABCD"},
+		{name: "item where project expected", kind: projectNode, id: "PVTI_lADOABCD", wantErr: `expected a project node ID (PVT_...) but got "PVTI_lADOABCD"`},
+		{name: "project where item expected", kind: projectItemNode, id: "PVT_kwDOABCD", wantErr: `expected a project item node ID (PVTI_...) but got "PVT_kwDOABCD"`},
+		{name: "single select field", kind: projectFieldNode, id: "PVTSSF_lADOABCD"},
+		{name: "issue where field expected", kind: projectFieldNode, id: "I_kwDOABCD", wantErr: `expected a project field node ID (PVTF_..., PVTSSF_..., PVTIF_...) but got "I_kwDOABCD"`},
+		{name: "pull request content", kind: itemContentNode, id: "PR_kwDOABCD"},
+		{name: "empty", kind: projectNode, id: ""},
+		{name: "legacy ID", kind: projectNode, id: "MDExOlByb2plY3ROZXh0MQ=="},
+		{name: "unknown prefix", kind: projectNode, id: "PVTX_kwDOABCD"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.kind.validate(tc.id)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestAddProjectItemRejectsMismatchedID(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		called = true
+		w.WriteHeader(200)
+		w.Write([]byte(`{"data":{"addProjectV2ItemById":{"item":{"id":"PVTI_1","content":null}}}}`))
+	}))
+	defer server.Close()
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+
+	out, err := AddProjectItem(context.Background(), &AddProjectItemInput{ProjectID: "PVTI_1", ContentID: "I_1"}, client)
+	assert.EqualError(t, err, `expected a project node ID (PVT_...) but got "PVTI_1"`)
+	assert.Nil(t, out)
+	assert.False(t, called, "no request should be sent for a mismatched ID")
+
+	out, err = AddProjectItem(context.Background(), &AddProjectItemInput{ProjectID: "PVT_1", ContentID: "I_1"}, client)
+	require.NoError(t, err)
+	assert.True(t, called)
+	assert.Equal(t, "PVTI_1", out.Item.ID)
+}
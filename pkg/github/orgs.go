@@ -0,0 +1,502 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	ghv4 "github.com/shurcooL/githubv4"
+)
+
+// MemberSummary is one user in a list_org_members or list_team_members
+// result.
+type MemberSummary struct {
+	Login     string `json:"login"`
+	ID        int64  `json:"id"`
+	Type      string `json:"type"`
+	SiteAdmin bool   `json:"site_admin,omitempty"`
+	HTMLURL   string `json:"html_url"`
+}
+
+// ListMembersOutput is the result of list_org_members and list_team_members.
+type ListMembersOutput struct {
+	Members     []MemberSummary `json:"members"`
+	NextPage    int             `json:"next_page,omitempty"`
+	HasNextPage bool            `json:"has_next_page"`
+}
+
+// TeamSummary is a team of an organization. Privacy is "secret" or
+// "closed"; counts are only filled in by get_team.
+type TeamSummary struct {
+	ID           int64  `json:"id"`
+	Slug         string `json:"slug"`
+	Name         string `json:"name"`
+	Description  string `json:"description,omitempty"`
+	Privacy      string `json:"privacy"`
+	Parent       string `json:"parent,omitempty"`
+	MembersCount int    `json:"members_count,omitempty"`
+	ReposCount   int    `json:"repos_count,omitempty"`
+	HTMLURL      string `json:"html_url"`
+}
+
+// ListTeamsOutput is the result of list_teams_for_user, paged by cursor.
+type ListTeamsOutput struct {
+	TotalCount  int           `json:"total_count"`
+	Teams       []TeamSummary `json:"teams"`
+	EndCursor   string        `json:"end_cursor,omitempty"`
+	HasNextPage bool          `json:"has_next_page"`
+}
+
+func memberSummaries(users []*github.User, resp *github.Response) ListMembersOutput {
+	out := ListMembersOutput{
+		Members:     make([]MemberSummary, 0, len(users)),
+		NextPage:    resp.NextPage,
+		HasNextPage: resp.NextPage != 0,
+	}
+	for _, u := range users {
+		out.Members = append(out.Members, MemberSummary{
+			Login:     u.GetLogin(),
+			ID:        u.GetID(),
+			Type:      u.GetType(),
+			SiteAdmin: u.GetSiteAdmin(),
+			HTMLURL:   u.GetHTMLURL(),
+		})
+	}
+	return out
+}
+
+// ListOrgMembers creates a tool to list the members of an organization.
+func ListOrgMembers(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_org_members",
+			mcp.WithDescription(t("TOOL_LIST_ORG_MEMBERS_DESCRIPTION", "List the members of an organization. Members with private membership are only included if you are a member too")),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+			mcp.WithString("role",
+				mcp.Description("Only members with this role, defaults to all"),
+				mcp.Enum("all", "admin", "member"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			role, err := OptionalParam[string](request, "role")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			members, resp, err := client.Organizations.ListMembers(ctx, org, &github.ListMembersOptions{
+				Role: role,
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list organization members: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list organization members: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(memberSummaries(members, resp))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetTeam creates a tool to get a team of an organization by its slug.
+func GetTeam(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_team",
+			mcp.WithDescription(t("TOOL_GET_TEAM_DESCRIPTION", "Get a team of an organization with its parent team and member and repository counts")),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+			mcp.WithString("teamSlug",
+				mcp.Required(),
+				mcp.Description("Team slug, such as platform-engineering"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamSlug, err := requiredParam[string](request, "teamSlug")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			team, resp, err := client.Teams.GetTeamBySlug(ctx, org, teamSlug)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get team: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get team: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(TeamSummary{
+				ID:           team.GetID(),
+				Slug:         team.GetSlug(),
+				Name:         team.GetName(),
+				Description:  team.GetDescription(),
+				Privacy:      team.GetPrivacy(),
+				Parent:       team.GetParent().GetSlug(),
+				MembersCount: team.GetMembersCount(),
+				ReposCount:   team.GetReposCount(),
+				HTMLURL:      team.GetHTMLURL(),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListTeamMembers creates a tool to list the members of a team.
+func ListTeamMembers(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_team_members",
+			mcp.WithDescription(t("TOOL_LIST_TEAM_MEMBERS_DESCRIPTION", "List the members of a team, including members of its child teams")),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+			mcp.WithString("teamSlug",
+				mcp.Required(),
+				mcp.Description("Team slug"),
+			),
+			mcp.WithString("role",
+				mcp.Description("Only members with this team role, defaults to all"),
+				mcp.Enum("all", "member", "maintainer"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamSlug, err := requiredParam[string](request, "teamSlug")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			role, err := OptionalParam[string](request, "role")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			members, resp, err := client.Teams.ListTeamMembersBySlug(ctx, org, teamSlug, &github.TeamListTeamMembersOptions{
+				Role: role,
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list team members: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list team members: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(memberSummaries(members, resp))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListTeamsForUser creates a tool to list the teams of an organization that
+// a user belongs to. The REST API can only list the teams of the
+// authenticated user, so this uses the userLogins filter of GraphQL.
+func ListTeamsForUser(getGraphQLClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_teams_for_user",
+			mcp.WithDescription(t("TOOL_LIST_TEAMS_FOR_USER_DESCRIPTION", "List the teams of an organization that a user belongs to, including through child teams")),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("Login of the user"),
+			),
+			mcp.WithNumber("perPage",
+				mcp.Description("Results per page (max 100)"),
+				mcp.Min(1),
+				mcp.Max(100),
+			),
+			mcp.WithString("after",
+				mcp.Description("Cursor from a previous end_cursor to get the next page"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := requiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			perPage, err := OptionalIntParamInRange(request, "perPage", 30, 1, 100)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			after, err := OptionalParam[string](request, "after")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGraphQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+			var q struct {
+				Organization *struct {
+					Teams struct {
+						TotalCount ghv4.Int
+						PageInfo   struct {
+							EndCursor   ghv4.String
+							HasNextPage bool
+						}
+						Nodes []struct {
+							DatabaseID  ghv4.Int
+							Slug        ghv4.String
+							Name        ghv4.String
+							Description ghv4.String
+							Privacy     ghv4.String
+							URL         ghv4.String
+							ParentTeam  *struct {
+								Slug ghv4.String
+							}
+						}
+					} `graphql:"teams(first: $first, after: $after, userLogins: $logins)"`
+				} `graphql:"organization(login: $org)"`
+			}
+			vars := map[string]interface{}{
+				"org":    ghv4.String(org),
+				"logins": []ghv4.String{ghv4.String(username)},
+				"first":  ghv4.Int(perPage),
+				"after":  ghv4.String(after),
+			}
+			if err := graphQLQuery(ctx, client, "ListTeamsForUser", &q, vars); err != nil {
+				return nil, fmt.Errorf("github graphql error: %w", err)
+			}
+			if q.Organization == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("organization %s not found", org)), nil
+			}
+
+			teams := q.Organization.Teams
+			out := ListTeamsOutput{
+				TotalCount:  int(teams.TotalCount),
+				Teams:       make([]TeamSummary, 0, len(teams.Nodes)),
+				EndCursor:   string(teams.PageInfo.EndCursor),
+				HasNextPage: teams.PageInfo.HasNextPage,
+			}
+			for _, n := range teams.Nodes {
+				// GraphQL calls the REST "closed" privacy VISIBLE.
+				privacy := strings.ToLower(string(n.Privacy))
+				if privacy == "visible" {
+					privacy = "closed"
+				}
+				team := TeamSummary{
+					ID:          int64(n.DatabaseID),
+					Slug:        string(n.Slug),
+					Name:        string(n.Name),
+					Description: string(n.Description),
+					Privacy:     privacy,
+					HTMLURL:     string(n.URL),
+				}
+				if n.ParentTeam != nil {
+					team.Parent = string(n.ParentTeam.Slug)
+				}
+				out.Teams = append(out.Teams, team)
+			}
+
+			r, err := json.Marshal(out)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// AddTeamMember creates a tool to add a user to a team or change their role
+// on it.
+func AddTeamMember(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_team_member",
+			mcp.WithDescription(t("TOOL_ADD_TEAM_MEMBER_DESCRIPTION", "Add a user to a team or change their role on it. Users outside the organization are invited to it first and join the team once they accept")),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+			mcp.WithString("teamSlug",
+				mcp.Required(),
+				mcp.Description("Team slug"),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("Login of the user to add"),
+			),
+			mcp.WithString("role",
+				mcp.Description("Role on the team, defaults to member"),
+				mcp.Enum("member", "maintainer"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamSlug, err := requiredParam[string](request, "teamSlug")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := requiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			role, err := OptionalParam[string](request, "role")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if role == "" {
+				role = "member"
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			membership, resp, err := client.Teams.AddTeamMembershipBySlug(ctx, org, teamSlug, username, &github.TeamAddTeamMembershipOptions{
+				Role: role,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to add team member: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to add team member: %s", string(body))), nil
+			}
+
+			if membership.GetState() == "pending" {
+				return mcp.NewToolResultText(fmt.Sprintf("Invited %s to %s; they join %s/%s as %s once they accept", username, org, org, teamSlug, membership.GetRole())), nil
+			}
+			return mcp.NewToolResultText(fmt.Sprintf("%s is now a %s of %s/%s", username, membership.GetRole(), org, teamSlug)), nil
+		}
+}
+
+// RemoveTeamMember creates a tool to remove a user from a team.
+func RemoveTeamMember(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("remove_team_member",
+			mcp.WithDescription(t("TOOL_REMOVE_TEAM_MEMBER_DESCRIPTION", "Remove a user from a team. They stay a member of the organization")),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+			mcp.WithString("teamSlug",
+				mcp.Required(),
+				mcp.Description("Team slug"),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("Login of the user to remove"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamSlug, err := requiredParam[string](request, "teamSlug")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := requiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			resp, err := client.Teams.RemoveTeamMembershipBySlug(ctx, org, teamSlug, username)
+			if err != nil {
+				return nil, fmt.Errorf("failed to remove team member: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to remove team member: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Removed %s from %s/%s", username, org, teamSlug)), nil
+		}
+}
@@ -0,0 +1,309 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var mockMembers = []*github.User{
+	{Login: github.Ptr("octocat"), ID: github.Ptr(int64(1)), Type: github.Ptr("User"), HTMLURL: github.Ptr("https://github.com/octocat")},
+	{Login: github.Ptr("hubot"), ID: github.Ptr(int64(2)), Type: github.Ptr("User"), HTMLURL: github.Ptr("https://github.com/hubot")},
+}
+
+var mockMemberSummaries = []MemberSummary{
+	{Login: "octocat", ID: 1, Type: "User", HTMLURL: "https://github.com/octocat"},
+	{Login: "hubot", ID: 2, Type: "User", HTMLURL: "https://github.com/hubot"},
+}
+
+func Test_ListOrgMembers(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListOrgMembers(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_org_members", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "role")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetOrgsMembersByOrg,
+			expectQueryParams(t, map[string]string{
+				"role":     "admin",
+				"page":     "1",
+				"per_page": "30",
+			}).andThen(
+				func(w http.ResponseWriter, _ *http.Request) {
+					w.Header().Set("Link", `<https://api.github.com/orgs/acme/members?role=admin&page=2&per_page=30>; rel="next"`)
+					mockResponse(t, http.StatusOK, mockMembers)(w, nil)
+				},
+			),
+		),
+	))
+	_, handler := ListOrgMembers(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"org":  "acme",
+		"role": "admin",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned ListMembersOutput
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, ListMembersOutput{
+		Members:     mockMemberSummaries,
+		NextPage:    2,
+		HasNextPage: true,
+	}, returned)
+}
+
+func Test_GetTeam(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := GetTeam(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_team", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "teamSlug"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetOrgsTeamsByOrgByTeamSlug,
+			&github.Team{
+				ID:           github.Ptr(int64(11)),
+				Slug:         github.Ptr("platform"),
+				Name:         github.Ptr("Platform"),
+				Description:  github.Ptr("Owns CI and infrastructure"),
+				Privacy:      github.Ptr("closed"),
+				Parent:       &github.Team{Slug: github.Ptr("engineering")},
+				MembersCount: github.Ptr(8),
+				ReposCount:   github.Ptr(23),
+				HTMLURL:      github.Ptr("https://github.com/orgs/acme/teams/platform"),
+			},
+		),
+	))
+	_, handler := GetTeam(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"org":      "acme",
+		"teamSlug": "platform",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned TeamSummary
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, TeamSummary{
+		ID:           11,
+		Slug:         "platform",
+		Name:         "Platform",
+		Description:  "Owns CI and infrastructure",
+		Privacy:      "closed",
+		Parent:       "engineering",
+		MembersCount: 8,
+		ReposCount:   23,
+		HTMLURL:      "https://github.com/orgs/acme/teams/platform",
+	}, returned)
+}
+
+func Test_ListTeamMembers(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListTeamMembers(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_team_members", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "teamSlug"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetOrgsTeamsMembersByOrgByTeamSlug,
+			expectQueryParams(t, map[string]string{
+				"role":     "maintainer",
+				"page":     "1",
+				"per_page": "30",
+			}).andThen(
+				mockResponse(t, http.StatusOK, mockMembers[:1]),
+			),
+		),
+	))
+	_, handler := ListTeamMembers(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"org":      "acme",
+		"teamSlug": "platform",
+		"role":     "maintainer",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned ListMembersOutput
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, ListMembersOutput{Members: mockMemberSummaries[:1]}, returned)
+}
+
+func Test_ListTeamsForUser(t *testing.T) {
+	tool, _ := ListTeamsForUser(stubGetGraphQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_teams_for_user", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "after")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "username"})
+
+	tests := []struct {
+		name          string
+		response      string
+		expectToolErr bool
+		expected      ListTeamsOutput
+		expectedText  string
+	}{
+		{
+			name: "teams found",
+			response: `{"data":{"organization":{"teams":{
+				"totalCount":2,
+				"pageInfo":{"endCursor":"Y3Vyc29yOjI","hasNextPage":true},
+				"nodes":[
+					{"databaseId":11,"slug":"platform","name":"Platform","description":"Owns CI","privacy":"VISIBLE","url":"https://github.com/orgs/acme/teams/platform","parentTeam":{"slug":"engineering"}},
+					{"databaseId":12,"slug":"security","name":"Security","description":"","privacy":"SECRET","url":"https://github.com/orgs/acme/teams/security","parentTeam":null}
+				]
+			}}}}`,
+			expected: ListTeamsOutput{
+				TotalCount: 2,
+				Teams: []TeamSummary{
+					{ID: 11, Slug: "platform", Name: "Platform", Description: "Owns CI", Privacy: "closed", Parent: "engineering", HTMLURL: "https://github.com/orgs/acme/teams/platform"},
+					{ID: 12, Slug: "security", Name: "Security", Privacy: "secret", HTMLURL: "https://github.com/orgs/acme/teams/security"},
+				},
+				EndCursor:   "Y3Vyc29yOjI",
+				HasNextPage: true,
+			},
+		},
+		{
+			name:          "organization not found",
+			response:      `{"data":{"organization":null}}`,
+			expectToolErr: true,
+			expectedText:  "organization acme not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query, vars := decodeGraphQLRequest(t, r)
+				assert.Contains(t, query, "userLogins: $logins")
+				assert.Equal(t, "acme", vars["org"])
+				assert.Equal(t, []interface{}{"octocat"}, vars["logins"])
+				_, _ = w.Write([]byte(tc.response))
+			}))
+			defer server.Close()
+			_, handler := ListTeamsForUser(stubGetGraphQLClientFn(githubv4.NewEnterpriseClient(server.URL, server.Client())), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"org":      "acme",
+				"username": "octocat",
+			}))
+			require.NoError(t, err)
+			require.Equal(t, tc.expectToolErr, result.IsError)
+			if tc.expectToolErr {
+				assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+				return
+			}
+
+			var returned ListTeamsOutput
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
+
+func Test_AddTeamMember(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := AddTeamMember(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "add_team_member", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "role")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "teamSlug", "username"})
+
+	tests := []struct {
+		name         string
+		requestArgs  map[string]interface{}
+		expectedRole string
+		membership   *github.Membership
+		expectedText string
+	}{
+		{
+			name:         "existing member",
+			requestArgs:  map[string]interface{}{},
+			expectedRole: "member",
+			membership:   &github.Membership{State: github.Ptr("active"), Role: github.Ptr("member")},
+			expectedText: "octocat is now a member of acme/platform",
+		},
+		{
+			name:         "outside collaborator as maintainer",
+			requestArgs:  map[string]interface{}{"role": "maintainer"},
+			expectedRole: "maintainer",
+			membership:   &github.Membership{State: github.Ptr("pending"), Role: github.Ptr("maintainer")},
+			expectedText: "Invited octocat to acme; they join acme/platform as maintainer once they accept",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutOrgsTeamsMembershipsByOrgByTeamSlugByUsername,
+					expectRequestBody(t, map[string]interface{}{
+						"role": tc.expectedRole,
+					}).andThen(
+						mockResponse(t, http.StatusOK, tc.membership),
+					),
+				),
+			))
+			_, handler := AddTeamMember(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			tc.requestArgs["org"] = "acme"
+			tc.requestArgs["teamSlug"] = "platform"
+			tc.requestArgs["username"] = "octocat"
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
+
+func Test_RemoveTeamMember(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := RemoveTeamMember(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "remove_team_member", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "teamSlug", "username"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.DeleteOrgsTeamsMembershipsByOrgByTeamSlugByUsername,
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		),
+	))
+	_, handler := RemoveTeamMember(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"org":      "acme",
+		"teamSlug": "platform",
+		"username": "octocat",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Equal(t, "Removed octocat from acme/platform", getTextResult(t, result).Text)
+}
@@ -8,40 +8,65 @@ import (
 	ghv4 "github.com/shurcooL/githubv4"
 )
 
-// resolveOwnerID resolves an owner login (org or user) to a GraphQL ID, preferring org if both exist.
-// Returns the ID or an error ("owner not found" if neither found).
+// GraphQLClient is the subset of githubv4.Client used by the Projects V2
+// functions. *githubv4.Client satisfies it; tests and callers can supply fakes.
 type GraphQLClient interface {
 	Query(ctx context.Context, q interface{}, vars map[string]interface{}) error
+	Mutate(ctx context.Context, m interface{}, input ghv4.Input, vars map[string]interface{}) error
 }
 
-func resolveOwnerID(ctx context.Context, client GraphQLClient, owner string) (ghv4.ID, error) {
-	var orgQ struct {
-		Organization *struct{ ID ghv4.ID } `graphql:"organization(login: $login)"`
-	}
-	orgVars := map[string]interface{}{"login": ghv4.String(owner)}
-	orgErr := client.Query(ctx, &orgQ, orgVars)
-	orgNotFound := orgErr != nil && isGraphQLNotFound(orgErr)
-	if orgErr != nil && !orgNotFound {
-		return "", fmt.Errorf("organization lookup failed: %w", orgErr)
-	}
-	if orgQ.Organization != nil {
-		return orgQ.Organization.ID, nil
-	}
+// OwnerKind reports whether a login belongs to an organization or a user.
+type OwnerKind int
 
-	var userQ struct {
-		User *struct{ ID ghv4.ID } `graphql:"user(login: $login)"`
+const (
+	OwnerOrg OwnerKind = iota + 1
+	OwnerUser
+)
+
+func (k OwnerKind) String() string {
+	switch k {
+	case OwnerOrg:
+		return "organization"
+	case OwnerUser:
+		return "user"
+	default:
+		return "unknown"
 	}
-	userVars := map[string]interface{}{"login": ghv4.String(owner)}
-	userErr := client.Query(ctx, &userQ, userVars)
-	userNotFound := userErr != nil && isGraphQLNotFound(userErr)
-	if userErr != nil && !userNotFound {
-		return "", fmt.Errorf("user lookup failed: %w", userErr)
+}
+
+// ownerLookup selects just enough of an owner login to tell its kind and ID.
+// It can be batched with a query for the owner's data; see queryBatch.
+type ownerLookup struct {
+	Organization *struct{ ID ghv4.ID } `graphql:"organization(login: $owner)"`
+	User         *struct{ ID ghv4.ID } `graphql:"user(login: $owner)"`
+}
+
+// resolved returns the owner GitHub found, preferring the organization.
+func (l *ownerLookup) resolved() (ghv4.ID, OwnerKind, bool) {
+	switch {
+	case l.Organization != nil:
+		return l.Organization.ID, OwnerOrg, true
+	case l.User != nil:
+		return l.User.ID, OwnerUser, true
+	default:
+		return "", 0, false
 	}
-	if userQ.User != nil {
-		return userQ.User.ID, nil
+}
+
+// resolveOwnerID resolves an owner login (org or user) to a GraphQL ID, preferring org if both exist.
+// Returns the ID and the kind of owner it belongs to, or an error ("owner not found" if neither found).
+// Both kinds are looked up in one request.
+func resolveOwnerID(ctx context.Context, client GraphQLClient, owner string) (ghv4.ID, OwnerKind, error) {
+	var q ownerLookup
+	vars := map[string]interface{}{"owner": ghv4.String(owner)}
+	// GitHub answers with the kind that exists plus a "Could not resolve"
+	// error for the other, so the decoded result is inspected first.
+	err := graphQLQuery(ctx, client, "resolveOwnerID", &q, vars)
+	if id, kind, ok := q.resolved(); ok {
+		return id, kind, nil
 	}
-	if orgNotFound && userNotFound {
-		return "", errors.New("owner not found")
+	if err != nil && !isGraphQLNotFound(err) {
+		return "", 0, fmt.Errorf("owner lookup failed: %w", err)
 	}
-	return "", errors.New("owner not found") // Defensive fallback
+	return "", 0, errors.New("owner not found")
 }
@@ -9,45 +9,29 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-
+// fakeGraphQLClient answers owner lookups the way GitHub does: with the kinds
+// of owner that exist, plus err when set.
 type fakeGraphQLClient struct {
-	orgID      ghv4.ID
-	userID     ghv4.ID
-	orgErr     error
-	userErr    error
+	orgID  ghv4.ID
+	userID ghv4.ID
+	err    error
 }
 
 func (f *fakeGraphQLClient) Query(ctx context.Context, q interface{}, vars map[string]interface{}) error {
-	if oq, ok := q.(*struct {
-		Organization *struct{ ID ghv4.ID } `graphql:"organization(login: $login)"`
-	}); ok {
-		if f.orgErr != nil {
-			return f.orgErr
-		}
-		if f.orgID != "" {
-			*oq = struct {
-				Organization *struct{ ID ghv4.ID } `graphql:"organization(login: $login)"`
-			}{Organization: &struct{ ID ghv4.ID }{ID: f.orgID}}
-		}
-		return nil
+	lookup, ok := q.(*ownerLookup)
+	if !ok {
+		return errors.New("unexpected query type")
+	}
+	if f.orgID != nil {
+		lookup.Organization = &struct{ ID ghv4.ID }{ID: f.orgID}
 	}
-	if uq, ok := q.(*struct {
-		User *struct{ ID ghv4.ID } `graphql:"user(login: $login)"`
-	}); ok {
-		if f.userErr != nil {
-			return f.userErr
-		}
-		if f.userID != "" {
-			*uq = struct {
-				User *struct{ ID ghv4.ID } `graphql:"user(login: $login)"`
-			}{User: &struct{ ID ghv4.ID }{ID: f.userID}}
-		}
-		return nil
+	if f.userID != nil {
+		lookup.User = &struct{ ID ghv4.ID }{ID: f.userID}
 	}
-	return errors.New("unexpected query type")
+	return f.err
 }
 
-func (f *fakeGraphQLClient) Mutate(ctx context.Context, m interface{}, input interface{}, v map[string]interface{}) error {
+func (f *fakeGraphQLClient) Mutate(ctx context.Context, m interface{}, input ghv4.Input, v map[string]interface{}) error {
 	return errors.New("not implemented")
 }
 
@@ -56,72 +40,58 @@ func TestResolveOwnerID(t *testing.T) {
 	owner := "testowner"
 
 	tests := []struct {
-		name      string
-		orgID     ghv4.ID
-		userID    ghv4.ID
-		orgErr    error
-		userErr   error
-		expectID  ghv4.ID
-		expectErr string
+		name       string
+		orgID      ghv4.ID
+		userID     ghv4.ID
+		err        error
+		expectID   ghv4.ID
+		expectKind OwnerKind
+		expectErr  string
 	}{
 		{
-			name:     "org exists",
-			orgID:    "ORGID",
-			userID:   "",
-			expectID:  "ORGID",
+			name:       "org exists",
+			orgID:      "ORGID",
+			err:        errors.New("Could not resolve to a User with the login of 'testowner'."),
+			expectID:   "ORGID",
+			expectKind: OwnerOrg,
 		},
 		{
-			name:     "user exists",
-			orgID:    "",
-			userID:   "USERID",
-			expectID:  "USERID",
+			name:       "user exists",
+			userID:     "USERID",
+			err:        errors.New("Could not resolve to an Organization with the login of 'testowner'."),
+			expectID:   "USERID",
+			expectKind: OwnerUser,
 		},
 		{
-			name:     "both org and user exist (prefer org)",
-			orgID:    "ORGID",
-			userID:   "USERID",
-			expectID:  "ORGID",
+			name:       "both org and user exist (prefer org)",
+			orgID:      "ORGID",
+			userID:     "USERID",
+			expectID:   "ORGID",
+			expectKind: OwnerOrg,
 		},
 		{
-			name:     "neither org nor user exist",
-			orgID:    "",
-			userID:   "",
-			orgErr:   errors.New("non-200 OK status code: 404"),
-			userErr:  errors.New("non-200 OK status code: 404"),
+			name:      "neither org nor user exist",
+			err:       errors.New("Could not resolve to an Organization with the login of 'testowner'."),
 			expectErr: "owner not found",
 		},
 		{
-			name:     "org fatal error",
-			orgID:    "",
-			userID:   "USERID",
-			orgErr:   errors.New("fatal org error"),
-			expectErr: "organization lookup failed",
-		},
-		{
-			name:     "user fatal error",
-			orgID:    "",
-			userID:   "",
-			orgErr:   errors.New("non-200 OK status code: 404"),
-			userErr:  errors.New("fatal user error"),
-			expectErr: "user lookup failed",
+			name:      "fatal error",
+			err:       errors.New("non-200 OK status code: 502"),
+			expectErr: "owner lookup failed",
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			client := &fakeGraphQLClient{
-				orgID:   tc.orgID,
-				userID:  tc.userID,
-				orgErr:  tc.orgErr,
-				userErr: tc.userErr,
-			}
-			id, err := resolveOwnerID(ctx, client, owner)
+			client := &fakeGraphQLClient{orgID: tc.orgID, userID: tc.userID, err: tc.err}
+			id, kind, err := resolveOwnerID(ctx, client, owner)
 			if tc.expectErr != "" {
 				assert.Error(t, err)
 				assert.Contains(t, err.Error(), tc.expectErr)
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tc.expectID, id)
+				assert.Equal(t, tc.expectKind, kind)
 			}
 		})
 	}
@@ -0,0 +1,146 @@
+package github
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	// pageWorkers bounds the pages fetchAllPages requests at once.
+	pageWorkers = 4
+	// defaultMaxPages bounds the listings of tools with an all parameter.
+	defaultMaxPages = 10
+	// rateLimitReserve is the part of the rate limit fetchAllPages leaves
+	// for other calls; it fetches fewer pages rather than eat into it.
+	rateLimitReserve = 100
+)
+
+// pageFunc fetches one page of a REST listing. Pages are numbered from 1.
+type pageFunc[T any] func(ctx context.Context, page int) ([]T, *github.Response, error)
+
+// fetchAllPages returns the items of every page of a REST listing, in order,
+// up to maxPages pages when maxPages is positive. The first page tells how
+// many there are; the rest are fetched concurrently by up to pageWorkers
+// workers. When the remaining rate limit is low, it fetches one page at a
+// time, and it stops early rather than leave less than rateLimitReserve
+// requests. Listings that do not report their last page are followed one
+// page after another. truncated reports whether pages were left out.
+func fetchAllPages[T any](ctx context.Context, maxPages int, fetch pageFunc[T]) (items []T, truncated bool, err error) {
+	first, resp, err := fetch(ctx, 1)
+	if err != nil {
+		return nil, false, err
+	}
+	_ = resp.Body.Close()
+	items = append(items, first...)
+	if resp.NextPage == 0 {
+		return items, false, nil
+	}
+
+	if resp.LastPage == 0 {
+		return fetchPagesInSequence(ctx, resp, maxPages, items, fetch)
+	}
+
+	last := resp.LastPage
+	if maxPages > 0 && last > maxPages {
+		last, truncated = maxPages, true
+	}
+	workers := pageWorkers
+	if rate := resp.Rate; rate.Limit > 0 {
+		if budget := rate.Remaining - rateLimitReserve; last-1 > budget {
+			last, truncated = 1+max(budget, 0), true
+		}
+		if rate.Remaining < rate.Limit/10 {
+			workers = 1
+		}
+	}
+
+	pages := make([][]T, last+1)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var fail sync.Once
+	var failErr error
+
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < min(workers, last-1); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for page := range next {
+				got, resp, err := fetch(ctx, page)
+				if err != nil {
+					// The first failure cancels the other pages.
+					fail.Do(func() {
+						failErr = err
+						cancel()
+					})
+					continue
+				}
+				_ = resp.Body.Close()
+				pages[page] = got
+			}
+		}()
+	}
+	for page := 2; page <= last && ctx.Err() == nil; page++ {
+		next <- page
+	}
+	close(next)
+	wg.Wait()
+
+	if failErr != nil {
+		return nil, false, failErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, false, err
+	}
+	for _, page := range pages[2:] {
+		items = append(items, page...)
+	}
+	return items, truncated, nil
+}
+
+// fetchPagesInSequence follows the NextPage of resp and its successors.
+func fetchPagesInSequence[T any](ctx context.Context, resp *github.Response, maxPages int, items []T, fetch pageFunc[T]) ([]T, bool, error) {
+	for fetched := 1; resp.NextPage != 0; fetched++ {
+		if maxPages > 0 && fetched == maxPages {
+			return items, true, nil
+		}
+		if resp.Rate.Limit > 0 && resp.Rate.Remaining <= rateLimitReserve {
+			return items, true, nil
+		}
+		var page []T
+		var err error
+		page, resp, err = fetch(ctx, resp.NextPage)
+		if err != nil {
+			return nil, false, err
+		}
+		_ = resp.Body.Close()
+		items = append(items, page...)
+	}
+	return items, false, nil
+}
+
+// withAllRESTPages adds the all and max_pages parameters, which make a REST
+// listing tool fetch every page itself.
+func withAllRESTPages(noun string) mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithBoolean("all", mcp.Description("Return all "+noun+" instead of a single page; page and perPage are ignored"))(tool)
+		mcp.WithNumber("max_pages", mcp.Description("With all, stop after this many pages of 100 "+noun+" (default 10)"), mcp.Min(1))(tool)
+	}
+}
+
+// allRESTPagesParams reads the parameters added by withAllRESTPages.
+func allRESTPagesParams(r mcp.CallToolRequest) (bool, int, error) {
+	all, err := OptionalParam[bool](r, "all")
+	if err != nil {
+		return false, 0, err
+	}
+	maxPages, err := OptionalIntParamInRange(r, "max_pages", defaultMaxPages, 1, 1000)
+	if err != nil {
+		return false, 0, err
+	}
+	return all, maxPages, nil
+}
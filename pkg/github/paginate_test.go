@@ -0,0 +1,188 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pagedIssuesServer serves the issues of owner/repo as pages of one issue
+// each, numbered by page.
+type pagedIssuesServer struct {
+	pages     int
+	remaining int
+	// noLastLink leaves the last relation out of the Link header, as cursor
+	// paginated endpoints do.
+	noLastLink bool
+	failPage   int
+
+	mu       sync.Mutex
+	served   []int
+	inFlight atomic.Int32
+	maxSeen  atomic.Int32
+}
+
+func (s *pagedIssuesServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	n := s.inFlight.Add(1)
+	defer s.inFlight.Add(-1)
+	if n > s.maxSeen.Load() {
+		s.maxSeen.Store(n)
+	}
+	// Give concurrent requests a chance to overlap.
+	time.Sleep(5 * time.Millisecond)
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page == 0 {
+		page = 1
+	}
+	s.mu.Lock()
+	s.served = append(s.served, page)
+	s.mu.Unlock()
+
+	if page == s.failPage {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"message":"boom"}`))
+		return
+	}
+
+	link := func(rel string, p int) string {
+		u := *r.URL
+		q := u.Query()
+		q.Set("page", strconv.Itoa(p))
+		u.RawQuery = q.Encode()
+		return fmt.Sprintf(`<http://%s%s>; rel="%s"`, r.Host, u.String(), rel)
+	}
+	if page < s.pages {
+		links := link("next", page+1)
+		if !s.noLastLink {
+			links += ", " + link("last", s.pages)
+		}
+		w.Header().Set("Link", links)
+	}
+	if s.remaining > 0 {
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(s.remaining))
+	}
+	_ = json.NewEncoder(w).Encode([]*github.Issue{{Number: github.Ptr(page)}})
+}
+
+func newPagedClient(t *testing.T, s *pagedIssuesServer) *github.Client {
+	t.Helper()
+	server := httptest.NewServer(s)
+	t.Cleanup(server.Close)
+	client := github.NewClient(nil)
+	baseURL, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+	return client
+}
+
+func issueNumbers(issues []*github.Issue) []int {
+	numbers := make([]int, len(issues))
+	for i, issue := range issues {
+		numbers[i] = issue.GetNumber()
+	}
+	return numbers
+}
+
+func Test_fetchAllPages(t *testing.T) {
+	tests := []struct {
+		name              string
+		server            *pagedIssuesServer
+		maxPages          int
+		expected          []int
+		expectedTruncated bool
+		expectConcurrency bool
+		expectError       bool
+	}{
+		{
+			name:              "concurrent",
+			server:            &pagedIssuesServer{pages: 8},
+			expected:          []int{1, 2, 3, 4, 5, 6, 7, 8},
+			expectConcurrency: true,
+		},
+		{
+			name:              "single page",
+			server:            &pagedIssuesServer{pages: 1},
+			expected:          []int{1},
+			expectConcurrency: false,
+		},
+		{
+			name:              "max pages",
+			server:            &pagedIssuesServer{pages: 8},
+			maxPages:          3,
+			expected:          []int{1, 2, 3},
+			expectedTruncated: true,
+			expectConcurrency: true,
+		},
+		{
+			name:              "rate limit reserve",
+			server:            &pagedIssuesServer{pages: 8, remaining: rateLimitReserve + 2},
+			expected:          []int{1, 2, 3},
+			expectedTruncated: true,
+			expectConcurrency: false,
+		},
+		{
+			name:              "no last page",
+			server:            &pagedIssuesServer{pages: 4, noLastLink: true},
+			expected:          []int{1, 2, 3, 4},
+			expectConcurrency: false,
+		},
+		{
+			name:        "failed page",
+			server:      &pagedIssuesServer{pages: 8, failPage: 5},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newPagedClient(t, tc.server)
+			issues, truncated, err := fetchAllPages(context.Background(), tc.maxPages, func(ctx context.Context, page int) ([]*github.Issue, *github.Response, error) {
+				return client.Issues.ListByRepo(ctx, "owner", "repo", &github.IssueListByRepoOptions{ListOptions: github.ListOptions{Page: page}})
+			})
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, issueNumbers(issues))
+			assert.Equal(t, tc.expectedTruncated, truncated)
+			assert.Equal(t, tc.expectConcurrency, tc.server.maxSeen.Load() > 1)
+		})
+	}
+}
+
+func Test_ListIssues_AllPages(t *testing.T) {
+	server := &pagedIssuesServer{pages: 5}
+	client := newPagedClient(t, server)
+	_, handler := ListIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":     "owner",
+		"repo":      "repo",
+		"all":       true,
+		"max_pages": float64(4),
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned ListIssuesOutput
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, []int{1, 2, 3, 4}, issueNumbers(returned.Issues))
+	assert.True(t, returned.Truncated)
+	assert.False(t, returned.HasNextPage)
+}
@@ -5,17 +5,48 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	ghv4 "github.com/shurcooL/githubv4"
 )
 
+var (
+	// ErrOwnerNotFound is returned when a login resolves to neither an
+	// organization nor a user.
+	ErrOwnerNotFound = errors.New("owner not found")
+	// ErrProjectNotFound is returned when the owner exists but has no project
+	// with the requested number.
+	ErrProjectNotFound = errors.New("project not found")
+)
+
+// isUnresolvedError reports whether err is GitHub's GraphQL error for a login
+// or project number that does not exist.
+func isUnresolvedError(err error) bool {
+	return strings.Contains(err.Error(), "Could not resolve to")
+}
+
 // --- Struct definitions (colocated, per codebase convention) ---
 
+// ListOrganizationProjectsInput selects a page of an organization's projects.
+// When All is set, First is ignored and pages are followed from After until
+// the last one or until MaxPages pages (default defaultMaxItemRequests) have
+// been fetched. State is open, closed or all (the default); projects in the
+// other state are dropped from the page, so a page can hold fewer than First
+// projects even when HasNextPage is true.
 type ListOrganizationProjectsInput struct {
 	Organization string `json:"organization"`
 	First        int    `json:"first,omitempty"`
 	After        string `json:"after,omitempty"`
+	All          bool   `json:"all,omitempty"`
+	MaxPages     int    `json:"max_pages,omitempty"`
+	State        string `json:"state,omitempty"`
 }
 
 type Project struct {
@@ -23,490 +54,4580 @@ type Project struct {
 	Number int    `json:"number"`
 	Title  string `json:"title"`
 	URL    string `json:"url"`
+	// Closed, Public and UpdatedAt are set by the listing and lookup
+	// functions; mutation results leave them empty.
+	Closed    bool   `json:"closed,omitempty"`
+	Public    bool   `json:"public,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+	// Readme is only set by GetProject.
+	Readme string `json:"readme,omitempty"`
+	// ClientMutationID echoes the caller-supplied ID on mutation responses.
+	ClientMutationID string `json:"client_mutation_id,omitempty"`
 }
 
+// ListOrganizationProjectsOutput is a page of projects, or with All every
+// page fetched. Truncated reports that MaxPages stopped an All listing while
+// HasNextPage was still true; EndCursor can be passed as After to resume.
 type ListOrganizationProjectsOutput struct {
 	Projects    []Project `json:"projects"`
 	EndCursor   string    `json:"end_cursor,omitempty"`
 	HasNextPage bool      `json:"has_next_page"`
+	Truncated   bool      `json:"truncated,omitempty"`
 }
 
+// ListUserProjectsInput selects a page of a user's projects; All, MaxPages
+// and State behave as in ListOrganizationProjectsInput.
 type ListUserProjectsInput struct {
-	User  string `json:"user"`
+	User     string `json:"user"`
+	First    int    `json:"first,omitempty"`
+	After    string `json:"after,omitempty"`
+	All      bool   `json:"all,omitempty"`
+	MaxPages int    `json:"max_pages,omitempty"`
+	State    string `json:"state,omitempty"`
+}
+
+type ListRepositoryProjectsInput struct {
+	Owner string `json:"owner"`
+	Repo  string `json:"repo"`
 	First int    `json:"first,omitempty"`
 	After string `json:"after,omitempty"`
 }
 
-type GetProjectInput struct {
-	Owner  string `json:"owner"`
-	Number int    `json:"number"`
+// ProjectRepositoryLinkInput names a project and the repository to link it
+// to or unlink it from.
+type ProjectRepositoryLinkInput struct {
+	ProjectID        string `json:"project_id"`
+	Owner            string `json:"owner"`
+	Repo             string `json:"repo"`
+	ClientMutationID string `json:"client_mutation_id,omitempty"`
 }
 
-type GetProjectItemsInput struct {
-	ProjectID string `json:"project_id"`
-	First     int    `json:"first,omitempty"`
-	After     string `json:"after,omitempty"`
+type ProjectRepositoryLinkOutput struct {
+	ProjectID        string `json:"project_id"`
+	RepositoryID     string `json:"repository_id"`
+	ClientMutationID string `json:"client_mutation_id,omitempty"`
 }
 
-type ProjectItem struct {
-	ID          string `json:"id"`
-	ContentID   string `json:"content_id"`
-	ContentType string `json:"content_type"`
-	Title       string `json:"title"`
-	State       string `json:"state"`
-	URL         string `json:"url"`
+type ListTeamProjectsInput struct {
+	Organization string `json:"organization"`
+	Team         string `json:"team"`
+	First        int    `json:"first,omitempty"`
+	After        string `json:"after,omitempty"`
 }
 
-type GetProjectItemsOutput struct {
-	Items       []ProjectItem `json:"items"`
-	EndCursor   string        `json:"end_cursor,omitempty"`
-	HasNextPage bool          `json:"has_next_page"`
+// ProjectTeamLinkInput names a project and the team, by organization login
+// and team slug, to link it to or unlink it from.
+type ProjectTeamLinkInput struct {
+	ProjectID        string `json:"project_id"`
+	Organization     string `json:"organization"`
+	Team             string `json:"team"`
+	ClientMutationID string `json:"client_mutation_id,omitempty"`
 }
 
-type CreateProjectInput struct {
-	Owner       string `json:"owner"`
-	Title       string `json:"title"`
-	Description string `json:"description,omitempty"`
+type ProjectTeamLinkOutput struct {
+	ProjectID        string `json:"project_id"`
+	TeamID           string `json:"team_id"`
+	ClientMutationID string `json:"client_mutation_id,omitempty"`
 }
 
-type AddProjectItemInput struct {
+type ListProjectCollaboratorsInput struct {
 	ProjectID string `json:"project_id"`
-	ContentID string `json:"content_id"`
+	First     int    `json:"first,omitempty"`
+	After     string `json:"after,omitempty"`
 }
 
-type AddProjectItemOutput struct {
-	Item ProjectItem `json:"item"`
+// ProjectCollaborator is a user or team with direct access to a project.
+// Login is the user's login or, for a team, its org/slug. Role is only set on
+// UpdateProjectCollaborators results; GitHub does not report the role of
+// listed collaborators.
+type ProjectCollaborator struct {
+	ID    string `json:"id"`
+	Type  string `json:"type"`
+	Login string `json:"login"`
+	Role  string `json:"role,omitempty"`
 }
 
-type UpdateProjectItemFieldInput struct {
-	ProjectID string `json:"project_id"`
-	ItemID    string `json:"item_id"`
-	FieldID   string `json:"field_id"`
-	Value     string `json:"value"`
+type ListProjectCollaboratorsOutput struct {
+	Collaborators []ProjectCollaborator `json:"collaborators"`
+	EndCursor     string                `json:"end_cursor,omitempty"`
+	HasNextPage   bool                  `json:"has_next_page"`
 }
 
-type UpdateProjectItemFieldOutput struct {
-	Item ProjectItem `json:"item"`
+// UpdateProjectCollaboratorsInput grants Role on a project to Users, by login,
+// and Teams, as org/slug. Role is reader, writer, admin or none, which removes
+// the collaborator's direct access.
+type UpdateProjectCollaboratorsInput struct {
+	ProjectID        string   `json:"project_id"`
+	Users            []string `json:"users,omitempty"`
+	Teams            []string `json:"teams,omitempty"`
+	Role             string   `json:"role"`
+	ClientMutationID string   `json:"client_mutation_id,omitempty"`
 }
 
-// --- Handler scaffolds (not implemented yet; return errors) ---
-
-// ListOrganizationProjects lists projects for an organization using the provided githubv4.Client.
-// If client is nil, a default client is created using GITHUB_TOKEN from environment.
-func ListOrganizationProjects(ctx context.Context, in *ListOrganizationProjectsInput, client *ghv4.Client) (*ListOrganizationProjectsOutput, error) {
-	if in.Organization == "" {
-		return nil, errors.New("organization is required")
-	}
-
-	if client == nil {
-		token := os.Getenv("GITHUB_PERSONAL_ACCESS_TOKEN")
-		if token == "" {
-			return nil, errors.New("GITHUB_PERSONAL_ACCESS_TOKEN not set")
-		}
-		client = ghv4.NewClient(&http.Client{Transport: &authTransport{token: token}})
-	}
+type UpdateProjectCollaboratorsOutput struct {
+	Collaborators    []ProjectCollaborator `json:"collaborators"`
+	ClientMutationID string                `json:"client_mutation_id,omitempty"`
+}
 
-	var q struct {
-		Organization struct {
-			ProjectsV2 struct {
-				Nodes []struct {
-					ID          ghv4.ID
-					Number      ghv4.Int
-					Title       ghv4.String
-					URL         ghv4.URI
-				} `graphql:"nodes"`
-				PageInfo struct {
-					EndCursor   ghv4.String
-					HasNextPage bool
-				}
-			} `graphql:"projectsV2(first: $first, after: $after)"`
-		} `graphql:"organization(login: $org)"`
-	}
-	vars := map[string]interface{}{
-		"org":   ghv4.String(in.Organization),
-		"first": ghv4.Int(in.First),
-		"after": ghv4.String(in.After),
-	}
+// ListTemplateProjectsInput lists an organization's template projects. A zero
+// MaxRequests means defaultMaxItemRequests pages of projects are scanned.
+type ListTemplateProjectsInput struct {
+	Organization string `json:"organization"`
+	MaxRequests  int    `json:"max_requests,omitempty"`
+}
 
-	err := client.Query(ctx, &q, vars)
-	if err != nil {
-		return nil, fmt.Errorf("github graphql error: %w", err)
-	}
+// ListTemplateProjectsOutput holds the templates found. Truncated reports
+// that the request budget ran out before every project was scanned.
+type ListTemplateProjectsOutput struct {
+	Projects  []Project `json:"projects"`
+	Truncated bool      `json:"truncated"`
+}
 
-	out := &ListOrganizationProjectsOutput{
-		Projects:    []Project{},
-		EndCursor:   string(q.Organization.ProjectsV2.PageInfo.EndCursor),
-		HasNextPage: q.Organization.ProjectsV2.PageInfo.HasNextPage,
-	}
-	for _, n := range q.Organization.ProjectsV2.Nodes {
-		out.Projects = append(out.Projects, Project{
-			ID:          fmt.Sprint(n.ID),
-			Number:      int(n.Number),
-			Title:       string(n.Title),
-			URL:         n.URL.String(),
-		})
-	}
-	return out, nil
+// GetProjectInput identifies a project either by Owner and Number or by its
+// web URL, which is parsed into the two.
+type GetProjectInput struct {
+	Owner  string `json:"owner,omitempty"`
+	Number int    `json:"number,omitempty"`
+	URL    string `json:"url,omitempty"`
 }
 
-// authTransport is a simple http.RoundTripper that injects the GitHub token
-// (matches patterns used in other MCP Go codebases)
-type authTransport struct {
-	token string
+// GetProjectItemsInput selects a page of a project's items. When Filter is
+// set, items that don't match are dropped from the page, so a page can hold
+// fewer than First items even when HasNextPage is true. OrderBy and GroupBy
+// likewise apply to the fetched items only. When All is set, First is
+// ignored and pages are followed as GetAllProjectItems does, with MaxPages as
+// its request budget.
+type GetProjectItemsInput struct {
+	ProjectID string             `json:"project_id"`
+	First     int                `json:"first,omitempty"`
+	After     string             `json:"after,omitempty"`
+	All       bool               `json:"all,omitempty"`
+	MaxPages  int                `json:"max_pages,omitempty"`
+	Filter    *ProjectItemFilter `json:"filter,omitempty"`
+	OrderBy   *ProjectItemOrder  `json:"order_by,omitempty"`
+	// GroupBy is the name of a project field, e.g. Status. When set, the
+	// items are returned in GetProjectItemsOutput.Groups instead of Items.
+	GroupBy string `json:"group_by,omitempty"`
 }
 
-func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	req.Header.Set("Authorization", "Bearer "+t.token)
-	return http.DefaultTransport.RoundTrip(req)
+// ProjectItemOrder sorts items by the value of a project field. Direction is
+// asc (the default) or desc; items without a value sort last either way.
+type ProjectItemOrder struct {
+	Field     string `json:"field"`
+	Direction string `json:"direction,omitempty"`
 }
 
+// ProjectItemFilter narrows a list of project items. Empty members match
+// everything; set members must all match. Comparisons ignore case.
+type ProjectItemFilter struct {
+	// ContentType is one of issue, pull_request or draft_issue.
+	ContentType string `json:"content_type,omitempty"`
+	// State is open or closed; merged pull requests count as closed.
+	State    string `json:"state,omitempty"`
+	Assignee string `json:"assignee,omitempty"`
+	Label    string `json:"label,omitempty"`
+	// FieldName and FieldValue match an entry in ProjectItem.FieldValues,
+	// e.g. Status = In Progress. An iteration matches on its title.
+	FieldName  string `json:"field_name,omitempty"`
+	FieldValue string `json:"field_value,omitempty"`
+}
 
-// ListUserProjects lists projects for a user using the provided githubv4.Client.
-// If client is nil, a default client is created using GITHUB_TOKEN from environment.
-func ListUserProjects(ctx context.Context, in *ListUserProjectsInput, client *ghv4.Client) (*ListOrganizationProjectsOutput, error) {
-	if in.User == "" {
-		return nil, errors.New("user is required")
-	}
+type ProjectItem struct {
+	ID          string `json:"id"`
+	ContentID   string `json:"content_id"`
+	ContentType string `json:"content_type"`
+	Title       string `json:"title"`
+	State       string `json:"state"`
+	URL         string `json:"url"`
+	// Assignees, Labels and Repository are only populated for issues and
+	// pull requests; draft issues have none of them.
+	Assignees  []string `json:"assignees,omitempty"`
+	Labels     []string `json:"labels,omitempty"`
+	Repository string   `json:"repository,omitempty"`
+	// Body is only populated for draft issues.
+	Body string `json:"body,omitempty"`
+	// FieldValues maps project field names to the item's value: a string for
+	// text, date (YYYY-MM-DD) and single select fields, a float64 for number
+	// fields and a ProjectIteration for iteration fields. Fields without a
+	// value, and value types such as labels and assignees, are omitted.
+	FieldValues map[string]interface{} `json:"field_values,omitempty"`
+	// FieldValuesTruncated reports that the item has more field values than
+	// maxItemFieldValues, so some are missing from FieldValues.
+	FieldValuesTruncated bool `json:"field_values_truncated,omitempty"`
+}
 
-	if client == nil {
-		token := os.Getenv("GITHUB_PERSONAL_ACCESS_TOKEN")
-		if token == "" {
-			return nil, errors.New("GITHUB_PERSONAL_ACCESS_TOKEN not set")
+// projectItemIssueFields is the selection shared by the Issue and PullRequest
+// members of the ProjectV2ItemContent union. state is not included because its
+// enum type differs between the two, so each fragment selects it under an alias.
+type projectItemIssueFields struct {
+	ID        ghv4.ID
+	Title     ghv4.String
+	URL       ghv4.URI
+	Assignees struct {
+		Nodes []struct {
+			Login ghv4.String
 		}
-		client = ghv4.NewClient(&http.Client{Transport: &authTransport{token: token}})
+	} `graphql:"assignees(first: 10)"`
+	Labels struct {
+		Nodes []struct {
+			Name ghv4.String
+		}
+	} `graphql:"labels(first: 20)"`
+	Repository struct {
+		NameWithOwner ghv4.String
 	}
+}
 
-	var q struct {
-		User struct {
-			ProjectsV2 struct {
-				Nodes []struct {
-					ID          ghv4.ID
-					Number      ghv4.Int
-					Title       ghv4.String
-					URL         ghv4.URI
-				} `graphql:"nodes"`
-				PageInfo struct {
-					EndCursor   ghv4.String
-					HasNextPage bool
-				}
-			} `graphql:"projectsV2(first: $first, after: $after)"`
-		} `graphql:"user(login: $login)"`
-	}
-	vars := map[string]interface{}{
-		"login": ghv4.String(in.User),
-		"first": ghv4.Int(in.First),
-		"after": ghv4.String(in.After),
+// projectItemContent selects the content of a project item. Content is a
+// union, so each member's fields are requested through an inline fragment.
+type projectItemContent struct {
+	Typename string `graphql:"__typename"`
+	Issue    struct {
+		projectItemIssueFields
+		State ghv4.String `graphql:"issueState: state"`
+	} `graphql:"... on Issue"`
+	PullRequest struct {
+		projectItemIssueFields
+		State ghv4.String `graphql:"pullRequestState: state"`
+	} `graphql:"... on PullRequest"`
+	DraftIssue struct {
+		ID    ghv4.ID
+		Title ghv4.String
+		Body  ghv4.String
+	} `graphql:"... on DraftIssue"`
+}
+
+// projectItemFromContent converts a project item node into a ProjectItem.
+func projectItemFromContent(id ghv4.ID, c *projectItemContent) ProjectItem {
+	item := ProjectItem{ID: fmt.Sprint(id)}
+	if c == nil {
+		return item
 	}
+	item.ContentType = c.Typename
 
-	err := client.Query(ctx, &q, vars)
-	if err != nil {
-		return nil, fmt.Errorf("github graphql error: %w", err)
+	var f *projectItemIssueFields
+	switch c.Typename {
+	case "Issue":
+		f = &c.Issue.projectItemIssueFields
+		item.State = string(c.Issue.State)
+	case "PullRequest":
+		f = &c.PullRequest.projectItemIssueFields
+		item.State = string(c.PullRequest.State)
+	case "DraftIssue":
+		item.ContentID = fmt.Sprint(c.DraftIssue.ID)
+		item.Title = string(c.DraftIssue.Title)
+		item.Body = string(c.DraftIssue.Body)
+		return item
+	default:
+		return item
 	}
 
-	out := &ListOrganizationProjectsOutput{
-		Projects:    []Project{},
-		EndCursor:   string(q.User.ProjectsV2.PageInfo.EndCursor),
-		HasNextPage: q.User.ProjectsV2.PageInfo.HasNextPage,
+	item.ContentID = fmt.Sprint(f.ID)
+	item.Title = string(f.Title)
+	item.URL = f.URL.String()
+	item.Repository = string(f.Repository.NameWithOwner)
+	for _, a := range f.Assignees.Nodes {
+		item.Assignees = append(item.Assignees, string(a.Login))
 	}
-	for _, n := range q.User.ProjectsV2.Nodes {
-		out.Projects = append(out.Projects, Project{
-			ID:          fmt.Sprint(n.ID),
-			Number:      int(n.Number),
-			Title:       string(n.Title),
-			URL:         n.URL.String(),
-		})
+	for _, l := range f.Labels.Nodes {
+		item.Labels = append(item.Labels, string(l.Name))
 	}
-	return out, nil
+	return item
 }
 
+// projectFieldName selects the name of the field a value belongs to.
+type projectFieldName struct {
+	Common struct {
+		Name ghv4.String
+	} `graphql:"... on ProjectV2FieldCommon"`
+}
 
-// GetProject fetches a project by owner and number using the provided githubv4.Client.
-// If client is nil, a default client is created using GITHUB_TOKEN from environment.
-func GetProject(ctx context.Context, in *GetProjectInput, client *ghv4.Client) (*Project, error) {
-	if in.Owner == "" || in.Number == 0 {
-		return nil, errors.New("owner and number are required")
-	}
+// maxItemFieldValues is how many field values are fetched per item. Built-in
+// fields such as title, assignees and labels count toward it too.
+const maxItemFieldValues = 100
 
-	if client == nil {
-		token := os.Getenv("GITHUB_PERSONAL_ACCESS_TOKEN")
-		if token == "" {
-			return nil, errors.New("GITHUB_PERSONAL_ACCESS_TOKEN not set")
-		}
-		client = ghv4.NewClient(&http.Client{Transport: &authTransport{token: token}})
+// projectItemFieldValues is the selection for an item's fieldValues
+// connection. Each member of the ProjectV2ItemFieldValue union selects its
+// value under a distinct key so the fragments don't conflict.
+type projectItemFieldValues struct {
+	Nodes []struct {
+		Typename  string `graphql:"__typename"`
+		TextValue struct {
+			Text  ghv4.String
+			Field projectFieldName
+		} `graphql:"... on ProjectV2ItemFieldTextValue"`
+		NumberValue struct {
+			Number ghv4.Float
+			Field  projectFieldName
+		} `graphql:"... on ProjectV2ItemFieldNumberValue"`
+		DateValue struct {
+			Date  ghv4.String
+			Field projectFieldName
+		} `graphql:"... on ProjectV2ItemFieldDateValue"`
+		SingleSelectValue struct {
+			Name  ghv4.String
+			Field projectFieldName
+		} `graphql:"... on ProjectV2ItemFieldSingleSelectValue"`
+		IterationValue struct {
+			IterationID ghv4.String
+			Title       ghv4.String
+			StartDate   ghv4.String
+			Duration    ghv4.Int
+			Field       projectFieldName
+		} `graphql:"... on ProjectV2ItemFieldIterationValue"`
 	}
+	PageInfo struct {
+		EndCursor   ghv4.String
+		HasNextPage bool
+	}
+}
 
-	var q struct {
-		Organization *struct {
-			ProjectV2 *struct {
-				ID          ghv4.ID
-				Number      ghv4.Int
-				Title       ghv4.String
-				URL         ghv4.URI
-			} `graphql:"projectV2(number: $number)"`
-		} `graphql:"organization(login: $owner)"`
-		User *struct {
-			ProjectV2 *struct {
-				ID          ghv4.ID
-				Number      ghv4.Int
-				Title       ghv4.String
-				URL         ghv4.URI
-			} `graphql:"projectV2(number: $number)"`
-		} `graphql:"user(login: $owner)"`
+// values converts the selected field values into ProjectItem.FieldValues,
+// skipping value types it does not select.
+func (v *projectItemFieldValues) values() map[string]interface{} {
+	out := map[string]interface{}{}
+	for _, n := range v.Nodes {
+		switch n.Typename {
+		case "ProjectV2ItemFieldTextValue":
+			out[string(n.TextValue.Field.Common.Name)] = string(n.TextValue.Text)
+		case "ProjectV2ItemFieldNumberValue":
+			out[string(n.NumberValue.Field.Common.Name)] = float64(n.NumberValue.Number)
+		case "ProjectV2ItemFieldDateValue":
+			out[string(n.DateValue.Field.Common.Name)] = string(n.DateValue.Date)
+		case "ProjectV2ItemFieldSingleSelectValue":
+			out[string(n.SingleSelectValue.Field.Common.Name)] = string(n.SingleSelectValue.Name)
+		case "ProjectV2ItemFieldIterationValue":
+			it := n.IterationValue
+			out[string(it.Field.Common.Name)] = ProjectIteration{
+				ID:        string(it.IterationID),
+				Title:     string(it.Title),
+				StartDate: string(it.StartDate),
+				Duration:  int(it.Duration),
+			}
+		}
 	}
-	vars := map[string]interface{}{
-		"owner":  ghv4.String(in.Owner),
-		"number": ghv4.Int(in.Number),
+	if len(out) == 0 {
+		return nil
 	}
+	return out
+}
 
-	err := client.Query(ctx, &q, vars)
-	if err != nil {
-		return nil, fmt.Errorf("github graphql error: %w", err)
-	}
+// projectItemContentTypes maps ProjectItemFilter.ContentType to the
+// __typename it selects.
+var projectItemContentTypes = map[string]string{
+	"issue":        "Issue",
+	"pull_request": "PullRequest",
+	"draft_issue":  "DraftIssue",
+}
 
-	var p *struct {
-		ID     ghv4.ID
-		Number ghv4.Int
-		Title  ghv4.String
-		URL    ghv4.URI
+func (f *ProjectItemFilter) validate() error {
+	if f.ContentType != "" {
+		if _, ok := projectItemContentTypes[strings.ToLower(f.ContentType)]; !ok {
+			return fmt.Errorf("invalid content type %q: expected issue, pull_request or draft_issue", f.ContentType)
+		}
 	}
-	if q.Organization != nil && q.Organization.ProjectV2 != nil {
-		p = q.Organization.ProjectV2
-	} else if q.User != nil && q.User.ProjectV2 != nil {
-		p = q.User.ProjectV2
-	} else {
-		return nil, errors.New("project not found")
+	if f.State != "" && !strings.EqualFold(f.State, "open") && !strings.EqualFold(f.State, "closed") {
+		return fmt.Errorf("invalid state %q: expected open or closed", f.State)
 	}
-
-	return &Project{
-		ID:     fmt.Sprint(p.ID),
-		Number: int(p.Number),
-		Title:  string(p.Title),
-		URL:    p.URL.String(),
-	}, nil
+	if (f.FieldName == "") != (f.FieldValue == "") {
+		return errors.New("field name and field value must be set together")
+	}
+	return nil
 }
 
-
-// GetProjectItems fetches project items using the provided githubv4.Client.
-// If client is nil, a default client is created using GITHUB_TOKEN from environment.
-func GetProjectItems(ctx context.Context, in *GetProjectItemsInput, client *ghv4.Client) (*GetProjectItemsOutput, error) {
-	if in.ProjectID == "" {
-		return nil, errors.New("projectID is required")
+func (f *ProjectItemFilter) matches(item ProjectItem) bool {
+	if f.ContentType != "" && item.ContentType != projectItemContentTypes[strings.ToLower(f.ContentType)] {
+		return false
 	}
-
-	if client == nil {
-		token := os.Getenv("GITHUB_PERSONAL_ACCESS_TOKEN")
-		if token == "" {
-			return nil, errors.New("GITHUB_PERSONAL_ACCESS_TOKEN not set")
+	if f.State != "" {
+		// Draft issues have no state and never match a state filter.
+		open := strings.EqualFold(item.State, "OPEN")
+		if item.State == "" || open != strings.EqualFold(f.State, "open") {
+			return false
 		}
-		client = ghv4.NewClient(&http.Client{Transport: &authTransport{token: token}})
-	}
-
-	var q struct {
-		Node struct {
-			ProjectV2 struct {
-				Items struct {
-					Nodes []struct {
-						ID      ghv4.ID
-						Content struct {
-							Typename string     `graphql:"__typename"`
-							ID       ghv4.ID    `graphql:"id"`
-							Title    ghv4.String `graphql:"title"`
-							State    ghv4.String `graphql:"state"`
-							URL      ghv4.URI   `graphql:"url"`
-						} `graphql:"content"`
-					} `graphql:"nodes"`
-					PageInfo struct {
-						EndCursor   ghv4.String
-						HasNextPage bool
-					}
-				} `graphql:"items(first: $first, after: $after)"`
-			} `graphql:"... on ProjectV2"`
-		} `graphql:"node(id: $id)"`
 	}
-	vars := map[string]interface{}{
-		"id":    ghv4.ID(in.ProjectID),
-		"first": ghv4.Int(in.First),
-		"after": ghv4.String(in.After),
+	if f.Assignee != "" && !containsFold(item.Assignees, f.Assignee) {
+		return false
 	}
-
-	err := client.Query(ctx, &q, vars)
-	if err != nil {
-		return nil, fmt.Errorf("github graphql error: %w", err)
+	if f.Label != "" && !containsFold(item.Labels, f.Label) {
+		return false
 	}
-
-	out := &GetProjectItemsOutput{
-		Items:      []ProjectItem{},
-		EndCursor:  string(q.Node.ProjectV2.Items.PageInfo.EndCursor),
-		HasNextPage: q.Node.ProjectV2.Items.PageInfo.HasNextPage,
-	}
-	for _, n := range q.Node.ProjectV2.Items.Nodes {
-		out.Items = append(out.Items, ProjectItem{
-			ID:          fmt.Sprint(n.ID),
-			ContentID:   fmt.Sprint(n.Content.ID),
-			ContentType: n.Content.Typename,
-			Title:       string(n.Content.Title),
-			URL:         n.Content.URL.String(),
-		})
+	if f.FieldName != "" {
+		v, ok := item.fieldValue(f.FieldName)
+		if !ok || !strings.EqualFold(fieldValueString(v), f.FieldValue) {
+			return false
+		}
 	}
-	return out, nil
+	return true
 }
 
-
-// CreateProject creates a new project using the provided githubv4.Client.
-// If client is nil, a default client is created using GITHUB_TOKEN from environment.
-// CreateProject creates a new project using the provided githubv4.Client.
-// Resolves the owner (organization or user) to a GraphQL ID and uses it in the mutation input.
-func CreateProject(ctx context.Context, in *CreateProjectInput, client *ghv4.Client) (*Project, error) {
-	if in.Owner == "" || in.Title == "" {
-		return nil, errors.New("owner and title are required")
+// fieldValue looks up the item's value for the named field, preferring an
+// exact match of the name and falling back to one that ignores case.
+func (item ProjectItem) fieldValue(name string) (interface{}, bool) {
+	if v, ok := item.FieldValues[name]; ok {
+		return v, true
 	}
-
-	if client == nil {
-		token := os.Getenv("GITHUB_PERSONAL_ACCESS_TOKEN")
-		if token == "" {
-			return nil, errors.New("GITHUB_PERSONAL_ACCESS_TOKEN not set")
+	for n, v := range item.FieldValues {
+		if strings.EqualFold(n, name) {
+			return v, true
 		}
-		client = ghv4.NewClient(&http.Client{Transport: &authTransport{token: token}})
-	}
-
-	// Always resolve the owner to a GraphQL ID (works for both orgs and users)
-	ownerID, err := resolveOwnerID(ctx, client, in.Owner)
-	if err != nil {
-		return nil, err
 	}
+	return nil, false
+}
 
-	type createProjectInput struct {
-		OwnerID          ghv4.ID     `json:"ownerId"`
-		Title            ghv4.String `json:"title"`
-		ShortDescription ghv4.String `json:"shortDescription,omitempty"`
-	}
-	input := createProjectInput{
-		OwnerID: ownerID,
-		Title:   ghv4.String(in.Title),
-	}
-	if in.Description != "" {
-		input.ShortDescription = ghv4.String(in.Description)
+// fieldValueString formats a FieldValues entry for matching and grouping; an
+// iteration is represented by its title.
+func fieldValueString(v interface{}) string {
+	if it, ok := v.(ProjectIteration); ok {
+		return it.Title
 	}
+	return fmt.Sprint(v)
+}
 
-	var m struct {
-		CreateProjectV2 struct {
-			ProjectV2 struct {
-				ID     ghv4.ID
-				Number ghv4.Int
-				Title  ghv4.String
-				URL    ghv4.URI
-			}
-		} `graphql:"createProjectV2(input: $input)"`
+func (o *ProjectItemOrder) validate() error {
+	if o.Field == "" {
+		return errors.New("order by field is required")
 	}
-	if err := client.Mutate(ctx, &m, input, nil); err != nil {
-		return nil, fmt.Errorf("github graphql error: %w", err)
+	if o.Direction != "" && !strings.EqualFold(o.Direction, "asc") && !strings.EqualFold(o.Direction, "desc") {
+		return fmt.Errorf("invalid order direction %q: expected asc or desc", o.Direction)
 	}
-	p := m.CreateProjectV2.ProjectV2
-	return &Project{
-		ID:     fmt.Sprint(p.ID),
-		Number: int(p.Number),
-		Title:  string(p.Title),
-		URL:    p.URL.String(),
-	}, nil
+	return nil
 }
 
+// sort orders items in place. Numbers compare numerically, iterations by
+// start date and everything else as case-insensitive text, which also orders
+// YYYY-MM-DD dates. The sort is stable, so ties keep the project's order.
+func (o *ProjectItemOrder) sort(items []ProjectItem) {
+	desc := strings.EqualFold(o.Direction, "desc")
+	sort.SliceStable(items, func(i, j int) bool {
+		a, aok := items[i].fieldValue(o.Field)
+		b, bok := items[j].fieldValue(o.Field)
+		if !aok || !bok {
+			return aok && !bok
+		}
+		c := compareFieldValues(a, b)
+		if desc {
+			return c > 0
+		}
+		return c < 0
+	})
+}
 
-// AddProjectItem adds an item to a project using the provided githubv4.Client.
-// If client is nil, a default client is created using GITHUB_TOKEN from environment.
-func AddProjectItem(ctx context.Context, in *AddProjectItemInput, client *ghv4.Client) (*AddProjectItemOutput, error) {
-	if in.ProjectID == "" || in.ContentID == "" {
-		return nil, errors.New("projectID and contentID are required")
+func compareFieldValues(a, b interface{}) int {
+	switch a := a.(type) {
+	case float64:
+		if b, ok := b.(float64); ok {
+			switch {
+			case a < b:
+				return -1
+			case a > b:
+				return 1
+			}
+			return 0
+		}
+	case ProjectIteration:
+		if b, ok := b.(ProjectIteration); ok {
+			return strings.Compare(a.StartDate, b.StartDate)
+		}
 	}
+	return strings.Compare(strings.ToLower(fmt.Sprint(a)), strings.ToLower(fmt.Sprint(b)))
+}
 
-	if client == nil {
-		token := os.Getenv("GITHUB_PERSONAL_ACCESS_TOKEN")
-		if token == "" {
-			return nil, errors.New("GITHUB_PERSONAL_ACCESS_TOKEN not set")
+// groupProjectItems splits items by their value for field, keeping groups in
+// the order their first item appears and the group without a value last.
+func groupProjectItems(items []ProjectItem, field string) []ProjectItemGroup {
+	groups := []ProjectItemGroup{}
+	index := map[string]int{}
+	var none *ProjectItemGroup
+	for _, item := range items {
+		v, ok := item.fieldValue(field)
+		if !ok {
+			if none == nil {
+				none = &ProjectItemGroup{Items: []ProjectItem{}}
+			}
+			none.Items = append(none.Items, item)
+			continue
+		}
+		key := fieldValueString(v)
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, ProjectItemGroup{Value: key, Items: []ProjectItem{}})
 		}
-		client = ghv4.NewClient(&http.Client{Transport: &authTransport{token: token}})
+		groups[i].Items = append(groups[i].Items, item)
 	}
-
-	type addItemInput struct {
-		ProjectID ghv4.ID `json:"projectId"`
-		ContentID ghv4.ID `json:"contentId"`
+	if none != nil {
+		groups = append(groups, *none)
 	}
-	input := addItemInput{
-		ProjectID: ghv4.ID(in.ProjectID),
-		ContentID: ghv4.ID(in.ContentID),
+	for i := range groups {
+		groups[i].Count = len(groups[i].Items)
 	}
+	return groups
+}
 
-	var m struct {
-		AddProjectV2ItemById struct {
-			Item struct {
-				ID      ghv4.ID
-				Content *struct {
-					Typename string     `graphql:"__typename"`
-					ID       ghv4.ID    `graphql:"id"`
-					Title    ghv4.String `graphql:"title"`
-					State    ghv4.String `graphql:"state"`
-					URL      ghv4.URI   `graphql:"url"`
-				} `graphql:"content"`
-			}
-		} `graphql:"addProjectV2ItemById(input: $input)"`
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
 	}
-	if err := client.Mutate(ctx, &m, input, nil); err != nil {
-		return nil, fmt.Errorf("github graphql error: %w", err)
+	return false
+}
+
+// projectItemsConnection is a page of a project's items connection.
+type projectItemsConnection struct {
+	Nodes []struct {
+		ID          ghv4.ID
+		Content     *projectItemContent    `graphql:"content"`
+		FieldValues projectItemFieldValues `graphql:"fieldValues(first: 100)"`
+	} `graphql:"nodes"`
+	PageInfo struct {
+		EndCursor   ghv4.String
+		HasNextPage bool
 	}
+}
 
-	item := ProjectItem{
-		ID: fmt.Sprint(m.AddProjectV2ItemById.Item.ID),
+func (c *projectItemsConnection) output() *GetProjectItemsOutput {
+	out := &GetProjectItemsOutput{
+		Items:       []ProjectItem{},
+		EndCursor:   string(c.PageInfo.EndCursor),
+		HasNextPage: c.PageInfo.HasNextPage,
 	}
-	if m.AddProjectV2ItemById.Item.Content != nil {
-		item.ContentID = fmt.Sprint(m.AddProjectV2ItemById.Item.Content.ID)
-		item.ContentType = m.AddProjectV2ItemById.Item.Content.Typename
-		item.Title = string(m.AddProjectV2ItemById.Item.Content.Title)
-		item.State = string(m.AddProjectV2ItemById.Item.Content.State)
-		item.URL = m.AddProjectV2ItemById.Item.Content.URL.String()
+	for _, n := range c.Nodes {
+		item := projectItemFromContent(n.ID, n.Content)
+		item.FieldValues = n.FieldValues.values()
+		item.FieldValuesTruncated = n.FieldValues.PageInfo.HasNextPage
+		out.Items = append(out.Items, item)
 	}
-	return &AddProjectItemOutput{Item: item}, nil
+	return out
 }
 
+type GetProjectItemsOutput struct {
+	Items       []ProjectItem      `json:"items"`
+	Groups      []ProjectItemGroup `json:"groups,omitempty"`
+	EndCursor   string             `json:"end_cursor,omitempty"`
+	HasNextPage bool               `json:"has_next_page"`
+	// Truncated is only set with All, as in GetAllProjectItemsOutput.
+	Truncated bool `json:"truncated,omitempty"`
+}
 
-// UpdateProjectItemField updates a project item field using the provided githubv4.Client.
-// If client is nil, a default client is created using GITHUB_TOKEN from environment.
-func UpdateProjectItemField(ctx context.Context, in *UpdateProjectItemFieldInput, client *ghv4.Client) (*UpdateProjectItemFieldOutput, error) {
-	if in.ItemID == "" || in.FieldID == "" || in.Value == "" {
+// ProjectItemGroup holds the items sharing a value of the GroupBy field.
+// Items without a value are grouped under an empty Value.
+type ProjectItemGroup struct {
+	Value string        `json:"value"`
+	Count int           `json:"count"`
+	Items []ProjectItem `json:"items"`
+}
+
+// CreateProjectInput identifies the owner by login (Owner) or, when the node ID
+// is already known, by OwnerID. Exactly one of the two must be set.
+type CreateProjectInput struct {
+	Owner       string `json:"owner,omitempty"`
+	OwnerID     string `json:"owner_id,omitempty"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	// ClientMutationID is echoed back by GitHub for correlating requests.
+	ClientMutationID string `json:"client_mutation_id,omitempty"`
+}
+
+// AddProjectItemInput adds an issue or pull request to a project. ContentID
+// may be a node ID, a web URL such as
+// https://github.com/octo-org/octo-repo/issues/42, or an owner/repo#number
+// reference; the latter two are resolved to a node ID before the item is added.
+type AddProjectItemInput struct {
+	ProjectID        string `json:"project_id"`
+	ContentID        string `json:"content_id"`
+	ClientMutationID string `json:"client_mutation_id,omitempty"`
+}
+
+type AddProjectItemOutput struct {
+	Item             ProjectItem `json:"item"`
+	ClientMutationID string      `json:"client_mutation_id,omitempty"`
+}
+
+// ProjectFieldType selects how UpdateProjectItemField interprets its Value
+// and which kind of field CreateProjectField creates.
+type ProjectFieldType string
+
+const (
+	FieldTypeText         ProjectFieldType = "text"
+	FieldTypeNumber       ProjectFieldType = "number"
+	FieldTypeDate         ProjectFieldType = "date"
+	FieldTypeSingleSelect ProjectFieldType = "single_select"
+	FieldTypeIteration    ProjectFieldType = "iteration"
+)
+
+// UpdateProjectItemFieldInput sets one field on an item. Value is parsed
+// according to FieldType, which defaults to text: a number, a YYYY-MM-DD date,
+// a single select option ID or name, or an iteration ID.
+type UpdateProjectItemFieldInput struct {
+	ProjectID        string           `json:"project_id"`
+	ItemID           string           `json:"item_id"`
+	FieldID          string           `json:"field_id"`
+	FieldType        ProjectFieldType `json:"field_type,omitempty"`
+	Value            string           `json:"value"`
+	ClientMutationID string           `json:"client_mutation_id,omitempty"`
+}
+
+type UpdateProjectItemFieldOutput struct {
+	Item             ProjectItem `json:"item"`
+	ClientMutationID string      `json:"client_mutation_id,omitempty"`
+}
+
+// ClearProjectItemFieldInput unsets one field on an item. Title, assignees,
+// labels, milestone and repository are not project fields and cannot be
+// cleared this way.
+type ClearProjectItemFieldInput struct {
+	ProjectID        string `json:"project_id"`
+	ItemID           string `json:"item_id"`
+	FieldID          string `json:"field_id"`
+	ClientMutationID string `json:"client_mutation_id,omitempty"`
+}
+
+// CopyProjectInput copies a project, typically a template, to a new project
+// owned by Owner or OwnerID. Fields, views, workflows and insights are always
+// copied; items are not, except draft issues when IncludeDraftIssues is set.
+type CopyProjectInput struct {
+	ProjectID          string `json:"project_id"`
+	Owner              string `json:"owner,omitempty"`
+	OwnerID            string `json:"owner_id,omitempty"`
+	Title              string `json:"title"`
+	IncludeDraftIssues bool   `json:"include_draft_issues,omitempty"`
+	ClientMutationID   string `json:"client_mutation_id,omitempty"`
+}
+
+// UpdateProjectInput changes a project's settings. Nil fields are left unchanged.
+type UpdateProjectInput struct {
+	ProjectID        string  `json:"project_id"`
+	Title            *string `json:"title,omitempty"`
+	ShortDescription *string `json:"short_description,omitempty"`
+	Readme           *string `json:"readme,omitempty"`
+	Public           *bool   `json:"public,omitempty"`
+	Closed           *bool   `json:"closed,omitempty"`
+	ClientMutationID string  `json:"client_mutation_id,omitempty"`
+}
+
+// UpdateProjectOutput is the project as it stands after the update.
+type UpdateProjectOutput struct {
+	Project
+	ShortDescription string `json:"short_description"`
+	Readme           string `json:"readme"`
+	Public           bool   `json:"public"`
+	Closed           bool   `json:"closed"`
+}
+
+type DeleteProjectInput struct {
+	ProjectID        string `json:"project_id"`
+	ClientMutationID string `json:"client_mutation_id,omitempty"`
+}
+
+type DeleteProjectOutput struct {
+	DeletedProjectID string `json:"deleted_project_id"`
+	ClientMutationID string `json:"client_mutation_id,omitempty"`
+}
+
+type ListProjectStatusUpdatesInput struct {
+	ProjectID string `json:"project_id"`
+	First     int    `json:"first,omitempty"`
+	After     string `json:"after,omitempty"`
+}
+
+// ProjectStatusUpdate is a status report posted on a project. Status is one of
+// INACTIVE, ON_TRACK, AT_RISK, OFF_TRACK or COMPLETE; dates are YYYY-MM-DD.
+type ProjectStatusUpdate struct {
+	ID         string `json:"id"`
+	Status     string `json:"status,omitempty"`
+	StartDate  string `json:"start_date,omitempty"`
+	TargetDate string `json:"target_date,omitempty"`
+	Body       string `json:"body,omitempty"`
+	Creator    string `json:"creator,omitempty"`
+	CreatedAt  string `json:"created_at"`
+}
+
+type ListProjectStatusUpdatesOutput struct {
+	StatusUpdates []ProjectStatusUpdate `json:"status_updates"`
+	EndCursor     string                `json:"end_cursor,omitempty"`
+	HasNextPage   bool                  `json:"has_next_page"`
+}
+
+// CreateProjectStatusUpdateInput posts a status update; every field other
+// than ProjectID is optional.
+type CreateProjectStatusUpdateInput struct {
+	ProjectID        string `json:"project_id"`
+	Status           string `json:"status,omitempty"`
+	StartDate        string `json:"start_date,omitempty"`
+	TargetDate       string `json:"target_date,omitempty"`
+	Body             string `json:"body,omitempty"`
+	ClientMutationID string `json:"client_mutation_id,omitempty"`
+}
+
+type CreateProjectStatusUpdateOutput struct {
+	ProjectStatusUpdate
+	ClientMutationID string `json:"client_mutation_id,omitempty"`
+}
+
+type DeleteProjectStatusUpdateInput struct {
+	StatusUpdateID   string `json:"status_update_id"`
+	ClientMutationID string `json:"client_mutation_id,omitempty"`
+}
+
+type DeleteProjectStatusUpdateOutput struct {
+	DeletedStatusUpdateID string `json:"deleted_status_update_id"`
+	ClientMutationID      string `json:"client_mutation_id,omitempty"`
+}
+
+// AddProjectDraftIssueInput creates a draft issue directly on a project.
+type AddProjectDraftIssueInput struct {
+	ProjectID        string `json:"project_id"`
+	Title            string `json:"title"`
+	Body             string `json:"body,omitempty"`
+	ClientMutationID string `json:"client_mutation_id,omitempty"`
+}
+
+// DeleteProjectItemInput removes ItemID from ProjectID. Both are required so a
+// stray item ID cannot delete from an unintended project.
+type DeleteProjectItemInput struct {
+	ProjectID        string `json:"project_id"`
+	ItemID           string `json:"item_id"`
+	ClientMutationID string `json:"client_mutation_id,omitempty"`
+}
+
+type DeleteProjectItemOutput struct {
+	DeletedItemID    string `json:"deleted_item_id"`
+	ClientMutationID string `json:"client_mutation_id,omitempty"`
+}
+
+// ProjectFieldValue is the value to set on a project field. Exactly one member
+// must be set, matching the field's data type; Date is formatted YYYY-MM-DD.
+type ProjectFieldValue struct {
+	Text                 *string  `json:"text,omitempty"`
+	Number               *float64 `json:"number,omitempty"`
+	Date                 string   `json:"date,omitempty"`
+	SingleSelectOptionID string   `json:"single_select_option_id,omitempty"`
+	IterationID          string   `json:"iteration_id,omitempty"`
+}
+
+// UpdateProjectItemFieldBulkInput sets the same field value on every item in ItemIDs.
+type UpdateProjectItemFieldBulkInput struct {
+	ProjectID string            `json:"project_id"`
+	FieldID   string            `json:"field_id"`
+	Value     ProjectFieldValue `json:"value"`
+	ItemIDs   []string          `json:"item_ids"`
+}
+
+// BulkItemResult reports the outcome for a single item of a bulk operation.
+// Error is empty when the item was updated.
+type BulkItemResult struct {
+	ItemID string `json:"item_id"`
+	Error  string `json:"error,omitempty"`
+}
+
+// UpdateProjectItemFieldBulkOutput holds one result per requested item, in
+// request order.
+type UpdateProjectItemFieldBulkOutput struct {
+	Results   []BulkItemResult `json:"results"`
+	Succeeded int              `json:"succeeded"`
+	Failed    int              `json:"failed"`
+}
+
+// BulkAddProjectItemsInput adds every entry of Items to a project. Each entry
+// is an issue or pull request node ID or web URL, e.g.
+// https://github.com/octo-org/octo-repo/issues/42.
+type BulkAddProjectItemsInput struct {
+	ProjectID string   `json:"project_id"`
+	Items     []string `json:"items"`
+}
+
+// BulkAddItemResult reports the outcome for one entry of a bulk add. ContentID
+// is set once the entry resolved to an issue or pull request and ItemID once
+// it was added; Error is empty on success.
+type BulkAddItemResult struct {
+	Item      string `json:"item"`
+	ContentID string `json:"content_id,omitempty"`
+	ItemID    string `json:"item_id,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// BulkAddProjectItemsOutput holds one result per requested entry, in request
+// order.
+type BulkAddProjectItemsOutput struct {
+	Results   []BulkAddItemResult `json:"results"`
+	Succeeded int                 `json:"succeeded"`
+	Failed    int                 `json:"failed"`
+}
+
+// MoveProjectItemInput moves the issue or pull request behind ItemID from
+// SourceProjectID to TargetProjectID. The item is only deleted from the
+// source when RemoveFromSource is set.
+type MoveProjectItemInput struct {
+	SourceProjectID  string `json:"source_project_id"`
+	ItemID           string `json:"item_id"`
+	TargetProjectID  string `json:"target_project_id"`
+	RemoveFromSource bool   `json:"remove_from_source,omitempty"`
+}
+
+// MoveProjectItemOutput describes the item in the target project. A source
+// field value is copied when the target has a field with the same name and
+// data type and, for single select and iteration fields, an option or
+// iteration with the same name; otherwise it is listed in SkippedFields.
+type MoveProjectItemOutput struct {
+	Item              ProjectItem         `json:"item"`
+	CopiedFields      []string            `json:"copied_fields"`
+	SkippedFields     []SkippedFieldValue `json:"skipped_fields,omitempty"`
+	RemovedFromSource bool                `json:"removed_from_source"`
+}
+
+type SkippedFieldValue struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+}
+
+// GetProjectByURLInput identifies a project by its web URL, e.g.
+// https://github.com/orgs/acme/projects/12 or https://github.com/users/octocat/projects/3.
+type GetProjectByURLInput struct {
+	URL string `json:"url"`
+}
+
+// ReorderProjectItemInput moves ItemID to sit after AfterItemID. An empty
+// AfterItemID moves the item to the top.
+type ReorderProjectItemInput struct {
+	ProjectID        string `json:"project_id"`
+	ItemID           string `json:"item_id"`
+	AfterItemID      string `json:"after_item_id,omitempty"`
+	ClientMutationID string `json:"client_mutation_id,omitempty"`
+}
+
+type ReorderProjectItemOutput struct {
+	ItemID           string `json:"item_id"`
+	ClientMutationID string `json:"client_mutation_id,omitempty"`
+}
+
+// SetProjectTemplateInput marks (IsTemplate true) or unmarks a project as a template.
+type SetProjectTemplateInput struct {
+	ProjectID        string `json:"project_id"`
+	IsTemplate       bool   `json:"is_template"`
+	ClientMutationID string `json:"client_mutation_id,omitempty"`
+}
+
+type SetProjectTemplateOutput struct {
+	ProjectID        string `json:"project_id"`
+	IsTemplate       bool   `json:"is_template"`
+	ClientMutationID string `json:"client_mutation_id,omitempty"`
+}
+
+type ListProjectFieldsInput struct {
+	ProjectID string `json:"project_id"`
+	First     int    `json:"first,omitempty"`
+	After     string `json:"after,omitempty"`
+}
+
+// ProjectField describes a project field. Options is only set for single
+// select fields and Iterations only for iteration fields.
+type ProjectField struct {
+	ID         string               `json:"id"`
+	Name       string               `json:"name"`
+	DataType   string               `json:"data_type"`
+	Options    []ProjectFieldOption `json:"options,omitempty"`
+	Iterations []ProjectIteration   `json:"iterations,omitempty"`
+}
+
+type ProjectFieldOption struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type ProjectIteration struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	StartDate string `json:"start_date"`
+	Duration  int    `json:"duration"`
+}
+
+type ListProjectFieldsOutput struct {
+	Fields      []ProjectField `json:"fields"`
+	EndCursor   string         `json:"end_cursor,omitempty"`
+	HasNextPage bool           `json:"has_next_page"`
+}
+
+type ListProjectViewsInput struct {
+	ProjectID string `json:"project_id"`
+	First     int    `json:"first,omitempty"`
+	After     string `json:"after,omitempty"`
+}
+
+type GetProjectViewInput struct {
+	ProjectID string `json:"project_id"`
+	Number    int    `json:"number"`
+}
+
+// ProjectView describes a saved view of a project. Filter is the query string
+// typed into the view's filter bar; GroupBy, VerticalGroupBy and SortBy name
+// project fields.
+type ProjectView struct {
+	ID              string            `json:"id"`
+	Number          int               `json:"number"`
+	Name            string            `json:"name"`
+	Layout          string            `json:"layout"`
+	Filter          string            `json:"filter,omitempty"`
+	GroupBy         []string          `json:"group_by,omitempty"`
+	VerticalGroupBy []string          `json:"vertical_group_by,omitempty"`
+	SortBy          []ProjectViewSort `json:"sort_by,omitempty"`
+}
+
+type ProjectViewSort struct {
+	Field     string `json:"field"`
+	Direction string `json:"direction"`
+}
+
+type ListProjectViewsOutput struct {
+	Views       []ProjectView `json:"views"`
+	EndCursor   string        `json:"end_cursor,omitempty"`
+	HasNextPage bool          `json:"has_next_page"`
+}
+
+// ProjectFieldOptionInput describes a single select option to create. Color
+// is one of GitHub's option colors (GRAY, BLUE, GREEN, YELLOW, ORANGE, RED,
+// PINK or PURPLE) and defaults to GRAY.
+type ProjectFieldOptionInput struct {
+	Name        string `json:"name"`
+	Color       string `json:"color,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// CreateProjectFieldInput adds a custom field to a project. DataType may be
+// text, number, date or single_select; Options is required for, and only
+// allowed on, single select fields.
+type CreateProjectFieldInput struct {
+	ProjectID        string                    `json:"project_id"`
+	Name             string                    `json:"name"`
+	DataType         ProjectFieldType          `json:"data_type"`
+	Options          []ProjectFieldOptionInput `json:"options,omitempty"`
+	ClientMutationID string                    `json:"client_mutation_id,omitempty"`
+}
+
+// UpdateProjectFieldInput renames a field and/or replaces the options of a
+// single select field. Replacing options discards the values items had for
+// options that are not kept, and gives every option a new ID.
+type UpdateProjectFieldInput struct {
+	FieldID          string                    `json:"field_id"`
+	Name             string                    `json:"name,omitempty"`
+	Options          []ProjectFieldOptionInput `json:"options,omitempty"`
+	ClientMutationID string                    `json:"client_mutation_id,omitempty"`
+}
+
+type ProjectFieldMutationOutput struct {
+	Field            ProjectField `json:"field"`
+	ClientMutationID string       `json:"client_mutation_id,omitempty"`
+}
+
+type DeleteProjectFieldInput struct {
+	FieldID          string `json:"field_id"`
+	ClientMutationID string `json:"client_mutation_id,omitempty"`
+}
+
+type DeleteProjectFieldOutput struct {
+	DeletedFieldID   string `json:"deleted_field_id"`
+	ClientMutationID string `json:"client_mutation_id,omitempty"`
+}
+
+// GetAllProjectItemsInput bounds a fetch of every item in a project, starting
+// after the After cursor when one is given. A zero MaxItems means no item cap;
+// a zero MaxRequests means defaultMaxItemRequests. MaxItems counts items that
+// pass Filter.
+type GetAllProjectItemsInput struct {
+	ProjectID   string             `json:"project_id"`
+	After       string             `json:"after,omitempty"`
+	MaxItems    int                `json:"max_items,omitempty"`
+	MaxRequests int                `json:"max_requests,omitempty"`
+	Filter      *ProjectItemFilter `json:"filter,omitempty"`
+}
+
+// GetProjectSummaryInput selects the items to summarize. StatusField names
+// the field counted in ByStatus and defaults to Status; Filter and
+// MaxRequests behave as in GetAllProjectItemsInput.
+type GetProjectSummaryInput struct {
+	ProjectID   string             `json:"project_id"`
+	StatusField string             `json:"status_field,omitempty"`
+	Filter      *ProjectItemFilter `json:"filter,omitempty"`
+	MaxRequests int                `json:"max_requests,omitempty"`
+}
+
+// ProjectSummary counts a project's items. Merged pull requests count as
+// closed; draft issues are neither open nor closed. Truncated reports that
+// the request budget ran out, so the counts cover only part of the project.
+// FieldValuesTruncated counts items with more field values than were
+// fetched; those lacking a status or iteration are not counted as NoStatus
+// or NoIteration, since theirs may be among the missing values.
+type ProjectSummary struct {
+	TotalItems           int            `json:"total_items"`
+	Open                 int            `json:"open"`
+	Closed               int            `json:"closed"`
+	Drafts               int            `json:"drafts"`
+	ByStatus             map[string]int `json:"by_status"`
+	NoStatus             int            `json:"no_status"`
+	ByAssignee           map[string]int `json:"by_assignee"`
+	Unassigned           int            `json:"unassigned"`
+	ByLabel              map[string]int `json:"by_label"`
+	NoIteration          int            `json:"no_iteration"`
+	Truncated            bool           `json:"truncated"`
+	FieldValuesTruncated int            `json:"field_values_truncated,omitempty"`
+}
+
+// GetProjectActivityInput asks for a project's activity since Since, an
+// RFC 3339 timestamp or a YYYY-MM-DD date, defaulting to seven days ago. A
+// zero MaxRequests means defaultMaxItemRequests pages of items are scanned.
+type GetProjectActivityInput struct {
+	ProjectID   string `json:"project_id"`
+	Since       string `json:"since,omitempty"`
+	MaxRequests int    `json:"max_requests,omitempty"`
+}
+
+// ProjectActivity is something that happened to a project item. Event is
+// added, updated, archived, closed or merged; updated means the item or its
+// content changed, such as a field value, but GitHub does not say which.
+// FieldValues holds the item's current values, as in ProjectItem.
+type ProjectActivity struct {
+	Event                string                 `json:"event"`
+	At                   string                 `json:"at"`
+	ItemID               string                 `json:"item_id"`
+	ContentType          string                 `json:"content_type,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	URL                  string                 `json:"url,omitempty"`
+	FieldValues          map[string]interface{} `json:"field_values,omitempty"`
+	FieldValuesTruncated bool                   `json:"field_values_truncated,omitempty"`
+}
+
+// GetProjectActivityOutput lists activity newest first. Truncated reports
+// that the request budget ran out before every item was scanned.
+type GetProjectActivityOutput struct {
+	Since      string            `json:"since"`
+	Activities []ProjectActivity `json:"activities"`
+	Truncated  bool              `json:"truncated"`
+}
+
+// GetAllProjectItemsOutput holds the items gathered so far. Truncated reports
+// that a budget stopped the fetch while HasNextPage was still true; EndCursor
+// can be passed to GetProjectItems to resume.
+type GetAllProjectItemsOutput struct {
+	Items       []ProjectItem `json:"items"`
+	EndCursor   string        `json:"end_cursor,omitempty"`
+	HasNextPage bool          `json:"has_next_page"`
+	Truncated   bool          `json:"truncated"`
+}
+
+// GetProjectWithItemsInput identifies a project by owner and number; First is
+// the size of the item page returned alongside it.
+type GetProjectWithItemsInput struct {
+	Owner  string `json:"owner"`
+	Number int    `json:"number"`
+	First  int    `json:"first,omitempty"`
+}
+
+type GetProjectWithItemsOutput struct {
+	Project Project               `json:"project"`
+	Items   GetProjectItemsOutput `json:"items"`
+}
+
+// UpdateDraftIssueInput edits a draft issue identified by exactly one of its
+// project ItemID or its DraftIssueID. A nil Title or Body is left unchanged.
+type UpdateDraftIssueInput struct {
+	ItemID           string  `json:"item_id,omitempty"`
+	DraftIssueID     string  `json:"draft_issue_id,omitempty"`
+	Title            *string `json:"title,omitempty"`
+	Body             *string `json:"body,omitempty"`
+	ClientMutationID string  `json:"client_mutation_id,omitempty"`
+}
+
+type UpdateDraftIssueOutput struct {
+	DraftIssueID     string `json:"draft_issue_id"`
+	Title            string `json:"title"`
+	Body             string `json:"body"`
+	ClientMutationID string `json:"client_mutation_id,omitempty"`
+}
+
+// RateLimitStatus is the caller's GraphQL rate limit budget. Cost is the
+// point cost of the rateLimit query itself.
+type RateLimitStatus struct {
+	Limit     int    `json:"limit"`
+	Cost      int    `json:"cost"`
+	Remaining int    `json:"remaining"`
+	ResetAt   string `json:"reset_at"`
+}
+
+// Page size bounds for Projects V2 connections. GitHub rejects first: 0 and
+// anything above 100.
+const (
+	defaultProjectsPageSize = 30
+	maxProjectsPageSize     = 100
+)
+
+// projectsPageSize coerces a requested page size into the range GitHub accepts,
+// substituting the default for unset (zero or negative) values.
+func projectsPageSize(first int) int {
+	if first <= 0 {
+		return defaultProjectsPageSize
+	}
+	if first > maxProjectsPageSize {
+		return maxProjectsPageSize
+	}
+	return first
+}
+
+// clientMutationID converts an optional caller-supplied mutation ID into the
+// nullable GraphQL input value, omitting it when empty.
+func clientMutationID(id string) *ghv4.String {
+	if id == "" {
+		return nil
+	}
+	return ghv4.NewString(ghv4.String(id))
+}
+
+// --- Handlers (GraphQL queries and mutations behind the project tools) ---
+
+// ListOrganizationProjects lists projects for an organization using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func ListOrganizationProjects(ctx context.Context, in *ListOrganizationProjectsInput, client GraphQLClient) (*ListOrganizationProjectsOutput, error) {
+	if in.Organization == "" {
+		return nil, errors.New("organization is required")
+	}
+	if err := validateProjectState(in.State); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	if in.All {
+		return listAllProjects(in.MaxPages, in.After, func(after string) (*ListOrganizationProjectsOutput, error) {
+			return ListOrganizationProjects(ctx, &ListOrganizationProjectsInput{
+				Organization: in.Organization,
+				First:        maxProjectsPageSize,
+				After:        after,
+				State:        in.State,
+			}, client)
+		})
+	}
+
+	var q struct {
+		Organization *struct {
+			ProjectsV2 projectsPage `graphql:"projectsV2(first: $first, after: $after)"`
+		} `graphql:"organization(login: $owner)"`
+	}
+	var owner ownerLookup
+	vars := map[string]interface{}{
+		"owner": ghv4.String(in.Organization),
+		"first": ghv4.Int(projectsPageSize(in.First)),
+		"after": ghv4.String(in.After),
+	}
+
+	// The owner lookup rides along so that a user login is reported as
+	// such without a second request.
+	err := newQueryBatch().add(&q).add(&owner).run(ctx, client, "ListOrganizationProjects", vars)
+	if q.Organization == nil {
+		return nil, ownerKindError(err, &owner, in.Organization, OwnerOrg)
+	}
+
+	out := &ListOrganizationProjectsOutput{
+		Projects:    []Project{},
+		EndCursor:   string(q.Organization.ProjectsV2.PageInfo.EndCursor),
+		HasNextPage: q.Organization.ProjectsV2.PageInfo.HasNextPage,
+	}
+	for _, n := range q.Organization.ProjectsV2.Nodes {
+		if p := n.output(); projectMatchesState(p, in.State) {
+			out.Projects = append(out.Projects, p)
+		}
+	}
+	return out, nil
+}
+
+// projectsPage is the selection for a page of an owner's projects.
+type projectsPage struct {
+	Nodes    []projectFields `graphql:"nodes"`
+	PageInfo struct {
+		EndCursor   ghv4.String
+		HasNextPage bool
+	}
+}
+
+// ownerKindError explains why the projects of login could not be listed as
+// an owner of kind want, given the owner lookup batched with the listing.
+func ownerKindError(err error, owner *ownerLookup, login string, want OwnerKind) error {
+	_, kind, _ := owner.resolved()
+	switch {
+	case want == OwnerOrg && kind == OwnerUser:
+		return fmt.Errorf("%s is a user, not an organization; use list_user_projects instead", login)
+	case want == OwnerUser && kind == OwnerOrg:
+		return fmt.Errorf("%s is an organization, not a user; use list_organization_projects instead", login)
+	case err != nil && !isGraphQLNotFound(err):
+		return fmt.Errorf("github graphql error: %w", err)
+	default:
+		return fmt.Errorf("%w: %s", ErrOwnerNotFound, login)
+	}
+}
+
+// projectFields is the selection for a ProjectV2 shared by the project
+// listings and lookups.
+type projectFields struct {
+	ID        ghv4.ID
+	Number    ghv4.Int
+	Title     ghv4.String
+	URL       ghv4.URI
+	Closed    bool
+	Public    bool
+	UpdatedAt ghv4.DateTime
+}
+
+func (p *projectFields) output() Project {
+	out := Project{
+		ID:     fmt.Sprint(p.ID),
+		Number: int(p.Number),
+		Title:  string(p.Title),
+		URL:    p.URL.String(),
+		Closed: p.Closed,
+		Public: p.Public,
+	}
+	if !p.UpdatedAt.IsZero() {
+		out.UpdatedAt = p.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+	return out
+}
+
+func validateProjectState(state string) error {
+	switch strings.ToLower(state) {
+	case "", "all", "open", "closed":
+		return nil
+	}
+	return fmt.Errorf("invalid state %q: expected open, closed or all", state)
+}
+
+// projectMatchesState reports whether p passes a state filter accepted by
+// validateProjectState.
+func projectMatchesState(p Project, state string) bool {
+	switch strings.ToLower(state) {
+	case "open":
+		return !p.Closed
+	case "closed":
+		return p.Closed
+	}
+	return true
+}
+
+// authTransport is a simple http.RoundTripper that injects the GitHub token
+// (matches patterns used in other MCP Go codebases)
+type authTransport struct {
+	token string
+	base  http.RoundTripper
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(req)
+}
+
+var (
+	defaultHTTPClientMu sync.RWMutex
+	defaultHTTPClient   *http.Client
+)
+
+// SetDefaultHTTPClient sets the HTTP client used when a Projects V2 function is
+// called with a nil client, e.g. to route through a proxy or apply
+// custom TLS settings and timeouts. The token transport wraps c's Transport.
+// Passing nil restores the default.
+func SetDefaultHTTPClient(c *http.Client) {
+	defaultHTTPClientMu.Lock()
+	defer defaultHTTPClientMu.Unlock()
+	defaultHTTPClient = c
+}
+
+// isNilGraphQLClient reports whether client is unset, treating a nil
+// *githubv4.Client the same as a nil interface so callers passing one still
+// get the default client.
+func isNilGraphQLClient(client GraphQLClient) bool {
+	c, ok := client.(*ghv4.Client)
+	return client == nil || ok && c == nil
+}
+
+// defaultGraphQLClient builds a githubv4.Client authenticated with
+// GITHUB_PERSONAL_ACCESS_TOKEN, on top of the client set by SetDefaultHTTPClient.
+func defaultGraphQLClient() (*ghv4.Client, error) {
+	token := os.Getenv("GITHUB_PERSONAL_ACCESS_TOKEN")
+	if token == "" {
+		return nil, errors.New("GITHUB_PERSONAL_ACCESS_TOKEN not set")
+	}
+
+	defaultHTTPClientMu.RLock()
+	base := defaultHTTPClient
+	defaultHTTPClientMu.RUnlock()
+
+	httpClient := &http.Client{}
+	if base != nil {
+		c := *base
+		httpClient = &c
+	}
+	httpClient.Transport = &authTransport{token: token, base: httpClient.Transport}
+	return ghv4.NewClient(httpClient), nil
+}
+
+// ListUserProjects lists projects for a user using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func ListUserProjects(ctx context.Context, in *ListUserProjectsInput, client GraphQLClient) (*ListOrganizationProjectsOutput, error) {
+	if in.User == "" {
+		return nil, errors.New("user is required")
+	}
+	if err := validateProjectState(in.State); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	if in.All {
+		return listAllProjects(in.MaxPages, in.After, func(after string) (*ListOrganizationProjectsOutput, error) {
+			return ListUserProjects(ctx, &ListUserProjectsInput{
+				User:  in.User,
+				First: maxProjectsPageSize,
+				After: after,
+				State: in.State,
+			}, client)
+		})
+	}
+
+	var q struct {
+		User *struct {
+			ProjectsV2 projectsPage `graphql:"projectsV2(first: $first, after: $after)"`
+		} `graphql:"user(login: $owner)"`
+	}
+	var owner ownerLookup
+	vars := map[string]interface{}{
+		"owner": ghv4.String(in.User),
+		"first": ghv4.Int(projectsPageSize(in.First)),
+		"after": ghv4.String(in.After),
+	}
+
+	err := newQueryBatch().add(&q).add(&owner).run(ctx, client, "ListUserProjects", vars)
+	if q.User == nil {
+		return nil, ownerKindError(err, &owner, in.User, OwnerUser)
+	}
+
+	out := &ListOrganizationProjectsOutput{
+		Projects:    []Project{},
+		EndCursor:   string(q.User.ProjectsV2.PageInfo.EndCursor),
+		HasNextPage: q.User.ProjectsV2.PageInfo.HasNextPage,
+	}
+	for _, n := range q.User.ProjectsV2.Nodes {
+		if p := n.output(); projectMatchesState(p, in.State) {
+			out.Projects = append(out.Projects, p)
+		}
+	}
+	return out, nil
+}
+
+// listAllProjects calls page with successive cursors, starting from after,
+// until the last page or until maxPages pages have been fetched.
+func listAllProjects(maxPages int, after string, page func(after string) (*ListOrganizationProjectsOutput, error)) (*ListOrganizationProjectsOutput, error) {
+	if maxPages < 0 {
+		return nil, errors.New("maxPages must not be negative")
+	}
+	if maxPages == 0 {
+		maxPages = defaultMaxItemRequests
+	}
+
+	out := &ListOrganizationProjectsOutput{Projects: []Project{}, EndCursor: after}
+	for pages := 0; ; pages++ {
+		if pages == maxPages {
+			out.Truncated = true
+			return out, nil
+		}
+		p, err := page(out.EndCursor)
+		if err != nil {
+			return nil, err
+		}
+		out.Projects = append(out.Projects, p.Projects...)
+		out.EndCursor = p.EndCursor
+		out.HasNextPage = p.HasNextPage
+		if !p.HasNextPage {
+			return out, nil
+		}
+	}
+}
+
+// ListTemplateProjects returns an organization's template projects using the
+// provided GraphQLClient. GitHub cannot filter projectsV2 by template, so the
+// organization's projects are paged through and filtered here.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func ListTemplateProjects(ctx context.Context, in *ListTemplateProjectsInput, client GraphQLClient) (*ListTemplateProjectsOutput, error) {
+	if in.Organization == "" {
+		return nil, errors.New("organization is required")
+	}
+	if in.MaxRequests < 0 {
+		return nil, errors.New("maxRequests must not be negative")
+	}
+	maxRequests := in.MaxRequests
+	if maxRequests == 0 {
+		maxRequests = defaultMaxItemRequests
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	type templatesPage struct {
+		Organization struct {
+			ProjectsV2 struct {
+				Nodes []struct {
+					projectFields
+					Template ghv4.Boolean
+				} `graphql:"nodes"`
+				PageInfo struct {
+					EndCursor   ghv4.String
+					HasNextPage bool
+				}
+			} `graphql:"projectsV2(first: $first, after: $after)"`
+		} `graphql:"organization(login: $org)"`
+	}
+	vars := map[string]interface{}{
+		"org":   ghv4.String(in.Organization),
+		"first": ghv4.Int(maxProjectsPageSize),
+		"after": ghv4.String(""),
+	}
+
+	out := &ListTemplateProjectsOutput{Projects: []Project{}}
+	for requests := 0; ; requests++ {
+		if requests == maxRequests {
+			out.Truncated = true
+			return out, nil
+		}
+		var q templatesPage
+		if err := graphQLQuery(ctx, client, "ListTemplateProjects", &q, vars); err != nil {
+			return nil, fmt.Errorf("github graphql error: %w", err)
+		}
+		for _, n := range q.Organization.ProjectsV2.Nodes {
+			if !n.Template {
+				continue
+			}
+			out.Projects = append(out.Projects, n.output())
+		}
+		if !q.Organization.ProjectsV2.PageInfo.HasNextPage {
+			return out, nil
+		}
+		vars["after"] = q.Organization.ProjectsV2.PageInfo.EndCursor
+	}
+}
+
+// ListRepositoryProjects lists the projects linked to a repository using the
+// provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func ListRepositoryProjects(ctx context.Context, in *ListRepositoryProjectsInput, client GraphQLClient) (*ListOrganizationProjectsOutput, error) {
+	if in.Owner == "" || in.Repo == "" {
+		return nil, errors.New("owner and repo are required")
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	var q struct {
+		Repository struct {
+			ProjectsV2 struct {
+				Nodes    []projectFields `graphql:"nodes"`
+				PageInfo struct {
+					EndCursor   ghv4.String
+					HasNextPage bool
+				}
+			} `graphql:"projectsV2(first: $first, after: $after)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	vars := map[string]interface{}{
+		"owner": ghv4.String(in.Owner),
+		"name":  ghv4.String(in.Repo),
+		"first": ghv4.Int(projectsPageSize(in.First)),
+		"after": ghv4.String(in.After),
+	}
+
+	err := graphQLQuery(ctx, client, "ListRepositoryProjects", &q, vars)
+	if err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+
+	out := &ListOrganizationProjectsOutput{
+		Projects:    []Project{},
+		EndCursor:   string(q.Repository.ProjectsV2.PageInfo.EndCursor),
+		HasNextPage: q.Repository.ProjectsV2.PageInfo.HasNextPage,
+	}
+	for _, n := range q.Repository.ProjectsV2.Nodes {
+		out.Projects = append(out.Projects, n.output())
+	}
+	return out, nil
+}
+
+// repositoryID looks up the node ID of owner/repo.
+func repositoryID(ctx context.Context, client GraphQLClient, owner, repo string) (string, error) {
+	var q struct {
+		Repository *struct {
+			ID ghv4.ID
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	vars := map[string]interface{}{
+		"owner": ghv4.String(owner),
+		"name":  ghv4.String(repo),
+	}
+	err := graphQLQuery(ctx, client, "repositoryID", &q, vars)
+	switch {
+	case q.Repository != nil:
+		return fmt.Sprint(q.Repository.ID), nil
+	case err != nil && !isUnresolvedError(err):
+		return "", fmt.Errorf("github graphql error: %w", err)
+	}
+	return "", fmt.Errorf("repository %s/%s not found", owner, repo)
+}
+
+// LinkProjectToRepository links a project to a repository, so it is listed
+// under the repository's Projects tab, using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func LinkProjectToRepository(ctx context.Context, in *ProjectRepositoryLinkInput, client GraphQLClient) (*ProjectRepositoryLinkOutput, error) {
+	if in.ProjectID == "" || in.Owner == "" || in.Repo == "" {
+		return nil, errors.New("projectID, owner and repo are required")
+	}
+	if err := projectNode.validate(in.ProjectID); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	repoID, err := repositoryID(ctx, client, in.Owner, in.Repo)
+	if err != nil {
+		return nil, err
+	}
+
+	input := ghv4.LinkProjectV2ToRepositoryInput{
+		ProjectID:        ghv4.ID(in.ProjectID),
+		RepositoryID:     ghv4.ID(repoID),
+		ClientMutationID: clientMutationID(in.ClientMutationID),
+	}
+	var m struct {
+		LinkProjectV2ToRepository struct {
+			Repository struct {
+				ID ghv4.ID
+			}
+			ClientMutationID ghv4.String
+		} `graphql:"linkProjectV2ToRepository(input: $input)"`
+	}
+	if err := graphQLMutate(ctx, client, "LinkProjectToRepository", &m, input, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+
+	return &ProjectRepositoryLinkOutput{
+		ProjectID:        in.ProjectID,
+		RepositoryID:     fmt.Sprint(m.LinkProjectV2ToRepository.Repository.ID),
+		ClientMutationID: string(m.LinkProjectV2ToRepository.ClientMutationID),
+	}, nil
+}
+
+// UnlinkProjectFromRepository removes the link between a project and a
+// repository using the provided GraphQLClient. The project and its items are
+// not affected.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func UnlinkProjectFromRepository(ctx context.Context, in *ProjectRepositoryLinkInput, client GraphQLClient) (*ProjectRepositoryLinkOutput, error) {
+	if in.ProjectID == "" || in.Owner == "" || in.Repo == "" {
+		return nil, errors.New("projectID, owner and repo are required")
+	}
+	if err := projectNode.validate(in.ProjectID); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	repoID, err := repositoryID(ctx, client, in.Owner, in.Repo)
+	if err != nil {
+		return nil, err
+	}
+
+	input := ghv4.UnlinkProjectV2FromRepositoryInput{
+		ProjectID:        ghv4.ID(in.ProjectID),
+		RepositoryID:     ghv4.ID(repoID),
+		ClientMutationID: clientMutationID(in.ClientMutationID),
+	}
+	var m struct {
+		UnlinkProjectV2FromRepository struct {
+			Repository struct {
+				ID ghv4.ID
+			}
+			ClientMutationID ghv4.String
+		} `graphql:"unlinkProjectV2FromRepository(input: $input)"`
+	}
+	if err := graphQLMutate(ctx, client, "UnlinkProjectFromRepository", &m, input, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+
+	return &ProjectRepositoryLinkOutput{
+		ProjectID:        in.ProjectID,
+		RepositoryID:     fmt.Sprint(m.UnlinkProjectV2FromRepository.Repository.ID),
+		ClientMutationID: string(m.UnlinkProjectV2FromRepository.ClientMutationID),
+	}, nil
+}
+
+// ListTeamProjects lists the projects linked to a team using the provided
+// GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func ListTeamProjects(ctx context.Context, in *ListTeamProjectsInput, client GraphQLClient) (*ListOrganizationProjectsOutput, error) {
+	if in.Organization == "" || in.Team == "" {
+		return nil, errors.New("organization and team are required")
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	var q struct {
+		Organization struct {
+			Team *struct {
+				ProjectsV2 struct {
+					Nodes    []projectFields `graphql:"nodes"`
+					PageInfo struct {
+						EndCursor   ghv4.String
+						HasNextPage bool
+					}
+				} `graphql:"projectsV2(first: $first, after: $after)"`
+			} `graphql:"team(slug: $slug)"`
+		} `graphql:"organization(login: $org)"`
+	}
+	vars := map[string]interface{}{
+		"org":   ghv4.String(in.Organization),
+		"slug":  ghv4.String(in.Team),
+		"first": ghv4.Int(projectsPageSize(in.First)),
+		"after": ghv4.String(in.After),
+	}
+
+	err := graphQLQuery(ctx, client, "ListTeamProjects", &q, vars)
+	if err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+	team := q.Organization.Team
+	if team == nil {
+		return nil, fmt.Errorf("team %s/%s not found", in.Organization, in.Team)
+	}
+
+	out := &ListOrganizationProjectsOutput{
+		Projects:    []Project{},
+		EndCursor:   string(team.ProjectsV2.PageInfo.EndCursor),
+		HasNextPage: team.ProjectsV2.PageInfo.HasNextPage,
+	}
+	for _, n := range team.ProjectsV2.Nodes {
+		out.Projects = append(out.Projects, n.output())
+	}
+	return out, nil
+}
+
+// teamID looks up the node ID of the team with the given slug in org.
+func teamID(ctx context.Context, client GraphQLClient, org, slug string) (string, error) {
+	var q struct {
+		Organization *struct {
+			Team *struct {
+				ID ghv4.ID
+			} `graphql:"team(slug: $slug)"`
+		} `graphql:"organization(login: $org)"`
+	}
+	vars := map[string]interface{}{
+		"org":  ghv4.String(org),
+		"slug": ghv4.String(slug),
+	}
+	err := graphQLQuery(ctx, client, "teamID", &q, vars)
+	switch {
+	case q.Organization != nil && q.Organization.Team != nil:
+		return fmt.Sprint(q.Organization.Team.ID), nil
+	case err != nil && !isUnresolvedError(err):
+		return "", fmt.Errorf("github graphql error: %w", err)
+	}
+	return "", fmt.Errorf("team %s/%s not found", org, slug)
+}
+
+// LinkProjectToTeam links a project to a team, granting the team access to
+// it, using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func LinkProjectToTeam(ctx context.Context, in *ProjectTeamLinkInput, client GraphQLClient) (*ProjectTeamLinkOutput, error) {
+	if in.ProjectID == "" || in.Organization == "" || in.Team == "" {
+		return nil, errors.New("projectID, organization and team are required")
+	}
+	if err := projectNode.validate(in.ProjectID); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	id, err := teamID(ctx, client, in.Organization, in.Team)
+	if err != nil {
+		return nil, err
+	}
+
+	input := ghv4.LinkProjectV2ToTeamInput{
+		ProjectID:        ghv4.ID(in.ProjectID),
+		TeamID:           ghv4.ID(id),
+		ClientMutationID: clientMutationID(in.ClientMutationID),
+	}
+	var m struct {
+		LinkProjectV2ToTeam struct {
+			Team struct {
+				ID ghv4.ID
+			}
+			ClientMutationID ghv4.String
+		} `graphql:"linkProjectV2ToTeam(input: $input)"`
+	}
+	if err := graphQLMutate(ctx, client, "LinkProjectToTeam", &m, input, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+
+	return &ProjectTeamLinkOutput{
+		ProjectID:        in.ProjectID,
+		TeamID:           fmt.Sprint(m.LinkProjectV2ToTeam.Team.ID),
+		ClientMutationID: string(m.LinkProjectV2ToTeam.ClientMutationID),
+	}, nil
+}
+
+// UnlinkProjectFromTeam removes the link between a project and a team using
+// the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func UnlinkProjectFromTeam(ctx context.Context, in *ProjectTeamLinkInput, client GraphQLClient) (*ProjectTeamLinkOutput, error) {
+	if in.ProjectID == "" || in.Organization == "" || in.Team == "" {
+		return nil, errors.New("projectID, organization and team are required")
+	}
+	if err := projectNode.validate(in.ProjectID); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	id, err := teamID(ctx, client, in.Organization, in.Team)
+	if err != nil {
+		return nil, err
+	}
+
+	input := ghv4.UnlinkProjectV2FromTeamInput{
+		ProjectID:        ghv4.ID(in.ProjectID),
+		TeamID:           ghv4.ID(id),
+		ClientMutationID: clientMutationID(in.ClientMutationID),
+	}
+	var m struct {
+		UnlinkProjectV2FromTeam struct {
+			Team struct {
+				ID ghv4.ID
+			}
+			ClientMutationID ghv4.String
+		} `graphql:"unlinkProjectV2FromTeam(input: $input)"`
+	}
+	if err := graphQLMutate(ctx, client, "UnlinkProjectFromTeam", &m, input, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+
+	return &ProjectTeamLinkOutput{
+		ProjectID:        in.ProjectID,
+		TeamID:           fmt.Sprint(m.UnlinkProjectV2FromTeam.Team.ID),
+		ClientMutationID: string(m.UnlinkProjectV2FromTeam.ClientMutationID),
+	}, nil
+}
+
+// ListProjectCollaborators lists the users and teams with direct access to a
+// project using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func ListProjectCollaborators(ctx context.Context, in *ListProjectCollaboratorsInput, client GraphQLClient) (*ListProjectCollaboratorsOutput, error) {
+	if in.ProjectID == "" {
+		return nil, errors.New("projectID is required")
+	}
+	if err := projectNode.validate(in.ProjectID); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	var q struct {
+		Node struct {
+			ProjectV2 struct {
+				Collaborators struct {
+					Nodes []struct {
+						Typename string `graphql:"__typename"`
+						User     struct {
+							ID    ghv4.ID
+							Login ghv4.String
+						} `graphql:"... on User"`
+						Team struct {
+							ID           ghv4.ID
+							CombinedSlug ghv4.String
+						} `graphql:"... on Team"`
+					}
+					PageInfo struct {
+						EndCursor   ghv4.String
+						HasNextPage bool
+					}
+				} `graphql:"collaborators(first: $first, after: $after)"`
+			} `graphql:"... on ProjectV2"`
+		} `graphql:"node(id: $id)"`
+	}
+	vars := map[string]interface{}{
+		"id":    ghv4.ID(in.ProjectID),
+		"first": ghv4.Int(projectsPageSize(in.First)),
+		"after": ghv4.String(in.After),
+	}
+	if err := graphQLQuery(ctx, client, "ListProjectCollaborators", &q, vars); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+
+	c := q.Node.ProjectV2.Collaborators
+	out := &ListProjectCollaboratorsOutput{
+		Collaborators: []ProjectCollaborator{},
+		EndCursor:     string(c.PageInfo.EndCursor),
+		HasNextPage:   c.PageInfo.HasNextPage,
+	}
+	for _, n := range c.Nodes {
+		switch n.Typename {
+		case "User":
+			out.Collaborators = append(out.Collaborators, ProjectCollaborator{
+				ID:    fmt.Sprint(n.User.ID),
+				Type:  n.Typename,
+				Login: string(n.User.Login),
+			})
+		case "Team":
+			out.Collaborators = append(out.Collaborators, ProjectCollaborator{
+				ID:    fmt.Sprint(n.Team.ID),
+				Type:  n.Typename,
+				Login: string(n.Team.CombinedSlug),
+			})
+		}
+	}
+	return out, nil
+}
+
+// projectCollaboratorRoles maps UpdateProjectCollaboratorsInput.Role to the
+// ProjectV2Roles value it grants.
+var projectCollaboratorRoles = map[string]ghv4.ProjectV2Roles{
+	"reader": ghv4.ProjectV2RolesReader,
+	"writer": ghv4.ProjectV2RolesWriter,
+	"admin":  ghv4.ProjectV2RolesAdmin,
+	"none":   ghv4.ProjectV2RolesNone,
+}
+
+// userID resolves a user login to its node ID.
+func userID(ctx context.Context, client GraphQLClient, login string) (string, error) {
+	var q struct {
+		User *struct {
+			ID ghv4.ID
+		} `graphql:"user(login: $login)"`
+	}
+	vars := map[string]interface{}{
+		"login": ghv4.String(login),
+	}
+	err := graphQLQuery(ctx, client, "userID", &q, vars)
+	switch {
+	case q.User != nil:
+		return fmt.Sprint(q.User.ID), nil
+	case err != nil && !isUnresolvedError(err):
+		return "", fmt.Errorf("github graphql error: %w", err)
+	}
+	return "", fmt.Errorf("user %s not found", login)
+}
+
+// UpdateProjectCollaborators grants, changes or removes the direct access of
+// users and teams to a project using the provided GraphQLClient. Logins and
+// team slugs are resolved to node IDs first, so an unknown name fails the
+// whole update before anything is changed.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func UpdateProjectCollaborators(ctx context.Context, in *UpdateProjectCollaboratorsInput, client GraphQLClient) (*UpdateProjectCollaboratorsOutput, error) {
+	if in.ProjectID == "" || len(in.Users)+len(in.Teams) == 0 {
+		return nil, errors.New("projectID and at least one user or team are required")
+	}
+	if err := projectNode.validate(in.ProjectID); err != nil {
+		return nil, err
+	}
+	role, ok := projectCollaboratorRoles[strings.ToLower(in.Role)]
+	if !ok {
+		return nil, fmt.Errorf("invalid role %q: expected reader, writer, admin or none", in.Role)
+	}
+	for _, team := range in.Teams {
+		if org, slug, ok := strings.Cut(team, "/"); !ok || org == "" || slug == "" {
+			return nil, fmt.Errorf("invalid team %q: expected org/slug", team)
+		}
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	out := &UpdateProjectCollaboratorsOutput{Collaborators: []ProjectCollaborator{}}
+	var collaborators []ghv4.ProjectV2Collaborator
+	for _, login := range in.Users {
+		id, err := userID(ctx, client, login)
+		if err != nil {
+			return nil, err
+		}
+		collaborators = append(collaborators, ghv4.ProjectV2Collaborator{Role: role, UserID: ghv4.NewID(ghv4.ID(id))})
+		out.Collaborators = append(out.Collaborators, ProjectCollaborator{ID: id, Type: "User", Login: login, Role: string(role)})
+	}
+	for _, team := range in.Teams {
+		org, slug, _ := strings.Cut(team, "/")
+		id, err := teamID(ctx, client, org, slug)
+		if err != nil {
+			return nil, err
+		}
+		collaborators = append(collaborators, ghv4.ProjectV2Collaborator{Role: role, TeamID: ghv4.NewID(ghv4.ID(id))})
+		out.Collaborators = append(out.Collaborators, ProjectCollaborator{ID: id, Type: "Team", Login: team, Role: string(role)})
+	}
+
+	input := ghv4.UpdateProjectV2CollaboratorsInput{
+		ProjectID:        ghv4.ID(in.ProjectID),
+		Collaborators:    collaborators,
+		ClientMutationID: clientMutationID(in.ClientMutationID),
+	}
+	var m struct {
+		UpdateProjectV2Collaborators struct {
+			ClientMutationID ghv4.String
+		} `graphql:"updateProjectV2Collaborators(input: $input)"`
+	}
+	if err := graphQLMutate(ctx, client, "UpdateProjectCollaborators", &m, input, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+
+	out.ClientMutationID = string(m.UpdateProjectV2Collaborators.ClientMutationID)
+	return out, nil
+}
+
+// projectLookupError explains why an owner/number lookup returned no project:
+// a genuine API failure, a missing project under an existing owner, or an
+// owner that is neither an organization nor a user.
+func projectLookupError(err error, ownerFound bool, owner string, number int) error {
+	switch {
+	case err != nil && !isUnresolvedError(err):
+		return fmt.Errorf("github graphql error: %w", err)
+	case ownerFound:
+		return fmt.Errorf("%w: no project %d under %s", ErrProjectNotFound, number, owner)
+	default:
+		return fmt.Errorf("%w: %s", ErrOwnerNotFound, owner)
+	}
+}
+
+// GetProject fetches a project by owner and number using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func GetProject(ctx context.Context, in *GetProjectInput, client GraphQLClient) (*Project, error) {
+	if in.URL != "" {
+		if in.Owner != "" || in.Number != 0 {
+			return nil, errors.New("url cannot be combined with owner and number")
+		}
+		owner, number, err := parseProjectURL(in.URL)
+		if err != nil {
+			return nil, err
+		}
+		in = &GetProjectInput{Owner: owner, Number: number}
+	}
+	if in.Owner == "" || in.Number == 0 {
+		return nil, errors.New("owner and number, or url, are required")
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	type projectWithReadme struct {
+		projectFields
+		Readme ghv4.String
+	}
+	var q struct {
+		Organization *struct {
+			ProjectV2 *projectWithReadme `graphql:"projectV2(number: $number)"`
+		} `graphql:"organization(login: $owner)"`
+		User *struct {
+			ProjectV2 *projectWithReadme `graphql:"projectV2(number: $number)"`
+		} `graphql:"user(login: $owner)"`
+	}
+	vars := map[string]interface{}{
+		"owner":  ghv4.String(in.Owner),
+		"number": ghv4.Int(in.Number),
+	}
+
+	// GitHub answers a login that is only one of organization or user with
+	// partial data plus a "Could not resolve" error for the other branch, so
+	// the decoded result is inspected before the error.
+	err := graphQLQuery(ctx, client, "GetProject", &q, vars)
+
+	var p *projectWithReadme
+	switch {
+	case q.Organization != nil && q.Organization.ProjectV2 != nil:
+		p = q.Organization.ProjectV2
+	case q.User != nil && q.User.ProjectV2 != nil:
+		p = q.User.ProjectV2
+	default:
+		return nil, projectLookupError(err, q.Organization != nil || q.User != nil, in.Owner, in.Number)
+	}
+
+	out := p.output()
+	out.Readme = string(p.Readme)
+	return &out, nil
+}
+
+// GetProjectWithItems fetches a project by owner and number together with its
+// first page of items in a single query, using the provided GraphQLClient.
+// Use GetProjectItems with the returned end cursor for further pages.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func GetProjectWithItems(ctx context.Context, in *GetProjectWithItemsInput, client GraphQLClient) (*GetProjectWithItemsOutput, error) {
+	if in.Owner == "" || in.Number == 0 {
+		return nil, errors.New("owner and number are required")
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	type projectWithItems struct {
+		ID     ghv4.ID
+		Number ghv4.Int
+		Title  ghv4.String
+		URL    ghv4.URI
+		Items  projectItemsConnection `graphql:"items(first: $first)"`
+	}
+	var q struct {
+		Organization *struct {
+			ProjectV2 *projectWithItems `graphql:"projectV2(number: $number)"`
+		} `graphql:"organization(login: $owner)"`
+		User *struct {
+			ProjectV2 *projectWithItems `graphql:"projectV2(number: $number)"`
+		} `graphql:"user(login: $owner)"`
+	}
+	vars := map[string]interface{}{
+		"owner":  ghv4.String(in.Owner),
+		"number": ghv4.Int(in.Number),
+		"first":  ghv4.Int(projectsPageSize(in.First)),
+	}
+
+	// As in GetProject, partial data is inspected before the error.
+	err := graphQLQuery(ctx, client, "GetProjectWithItems", &q, vars)
+
+	var p *projectWithItems
+	switch {
+	case q.Organization != nil && q.Organization.ProjectV2 != nil:
+		p = q.Organization.ProjectV2
+	case q.User != nil && q.User.ProjectV2 != nil:
+		p = q.User.ProjectV2
+	default:
+		return nil, projectLookupError(err, q.Organization != nil || q.User != nil, in.Owner, in.Number)
+	}
+
+	return &GetProjectWithItemsOutput{
+		Project: Project{
+			ID:     fmt.Sprint(p.ID),
+			Number: int(p.Number),
+			Title:  string(p.Title),
+			URL:    p.URL.String(),
+		},
+		Items: *p.Items.output(),
+	}, nil
+}
+
+// GetProjectItems fetches project items using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func GetProjectItems(ctx context.Context, in *GetProjectItemsInput, client GraphQLClient) (*GetProjectItemsOutput, error) {
+	if in.ProjectID == "" {
+		return nil, errors.New("projectID is required")
+	}
+	if err := projectNode.validate(in.ProjectID); err != nil {
+		return nil, err
+	}
+	if in.Filter != nil {
+		if err := in.Filter.validate(); err != nil {
+			return nil, err
+		}
+	}
+	if in.OrderBy != nil {
+		if err := in.OrderBy.validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	var out *GetProjectItemsOutput
+	if in.All {
+		all, err := GetAllProjectItems(ctx, &GetAllProjectItemsInput{
+			ProjectID:   in.ProjectID,
+			After:       in.After,
+			MaxRequests: in.MaxPages,
+			Filter:      in.Filter,
+		}, client)
+		if err != nil {
+			return nil, err
+		}
+		out = &GetProjectItemsOutput{
+			Items:       all.Items,
+			EndCursor:   all.EndCursor,
+			HasNextPage: all.HasNextPage,
+			Truncated:   all.Truncated,
+		}
+	} else {
+		var q struct {
+			Node struct {
+				ProjectV2 struct {
+					Items projectItemsConnection `graphql:"items(first: $first, after: $after)"`
+				} `graphql:"... on ProjectV2"`
+			} `graphql:"node(id: $id)"`
+		}
+		vars := map[string]interface{}{
+			"id":    ghv4.ID(in.ProjectID),
+			"first": ghv4.Int(projectsPageSize(in.First)),
+			"after": ghv4.String(in.After),
+		}
+
+		err := graphQLQuery(ctx, client, "GetProjectItems", &q, vars)
+		if err != nil {
+			return nil, fmt.Errorf("github graphql error: %w", err)
+		}
+
+		out = q.Node.ProjectV2.Items.output()
+		if in.Filter != nil {
+			matched := []ProjectItem{}
+			for _, item := range out.Items {
+				if in.Filter.matches(item) {
+					matched = append(matched, item)
+				}
+			}
+			out.Items = matched
+		}
+	}
+	if in.OrderBy != nil {
+		in.OrderBy.sort(out.Items)
+	}
+	if in.GroupBy != "" {
+		out.Groups = groupProjectItems(out.Items, in.GroupBy)
+		out.Items = []ProjectItem{}
+	}
+	return out, nil
+}
+
+// projectViewFieldNames selects the names of the fields a view groups by.
+type projectViewFieldNames struct {
+	Nodes []projectFieldName
+}
+
+func (c projectViewFieldNames) names() []string {
+	var names []string
+	for _, n := range c.Nodes {
+		names = append(names, string(n.Common.Name))
+	}
+	return names
+}
+
+// projectViewFields is the selection shared by ListProjectViews and
+// GetProjectView.
+type projectViewFields struct {
+	ID                    ghv4.ID
+	Number                ghv4.Int
+	Name                  ghv4.String
+	Layout                ghv4.String
+	Filter                ghv4.String
+	GroupByFields         projectViewFieldNames `graphql:"groupByFields(first: 10)"`
+	VerticalGroupByFields projectViewFieldNames `graphql:"verticalGroupByFields(first: 10)"`
+	SortByFields          struct {
+		Nodes []struct {
+			Direction ghv4.String
+			Field     projectFieldName
+		}
+	} `graphql:"sortByFields(first: 10)"`
+}
+
+func (v *projectViewFields) output() ProjectView {
+	view := ProjectView{
+		ID:              fmt.Sprint(v.ID),
+		Number:          int(v.Number),
+		Name:            string(v.Name),
+		Layout:          string(v.Layout),
+		Filter:          string(v.Filter),
+		GroupBy:         v.GroupByFields.names(),
+		VerticalGroupBy: v.VerticalGroupByFields.names(),
+	}
+	for _, n := range v.SortByFields.Nodes {
+		view.SortBy = append(view.SortBy, ProjectViewSort{
+			Field:     string(n.Field.Common.Name),
+			Direction: string(n.Direction),
+		})
+	}
+	return view
+}
+
+// ListProjectViews lists a project's saved views using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func ListProjectViews(ctx context.Context, in *ListProjectViewsInput, client GraphQLClient) (*ListProjectViewsOutput, error) {
+	if in.ProjectID == "" {
+		return nil, errors.New("projectID is required")
+	}
+	if err := projectNode.validate(in.ProjectID); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	var q struct {
+		Node struct {
+			ProjectV2 struct {
+				Views struct {
+					Nodes    []projectViewFields
+					PageInfo struct {
+						EndCursor   ghv4.String
+						HasNextPage bool
+					}
+				} `graphql:"views(first: $first, after: $after)"`
+			} `graphql:"... on ProjectV2"`
+		} `graphql:"node(id: $id)"`
+	}
+	vars := map[string]interface{}{
+		"id":    ghv4.ID(in.ProjectID),
+		"first": ghv4.Int(projectsPageSize(in.First)),
+		"after": ghv4.String(in.After),
+	}
+
+	err := graphQLQuery(ctx, client, "ListProjectViews", &q, vars)
+	if err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+
+	views := q.Node.ProjectV2.Views
+	out := &ListProjectViewsOutput{
+		Views:       []ProjectView{},
+		EndCursor:   string(views.PageInfo.EndCursor),
+		HasNextPage: views.PageInfo.HasNextPage,
+	}
+	for i := range views.Nodes {
+		out.Views = append(out.Views, views.Nodes[i].output())
+	}
+	return out, nil
+}
+
+// GetProjectView fetches a single project view by its number using the
+// provided GraphQLClient. The number is the one shown in the view's URL.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func GetProjectView(ctx context.Context, in *GetProjectViewInput, client GraphQLClient) (*ProjectView, error) {
+	if in.ProjectID == "" {
+		return nil, errors.New("projectID is required")
+	}
+	if in.Number <= 0 {
+		return nil, errors.New("number must be positive")
+	}
+	if err := projectNode.validate(in.ProjectID); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	var q struct {
+		Node struct {
+			ProjectV2 struct {
+				View *projectViewFields `graphql:"view(number: $number)"`
+			} `graphql:"... on ProjectV2"`
+		} `graphql:"node(id: $id)"`
+	}
+	vars := map[string]interface{}{
+		"id":     ghv4.ID(in.ProjectID),
+		"number": ghv4.Int(in.Number),
+	}
+
+	err := graphQLQuery(ctx, client, "GetProjectView", &q, vars)
+	if err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+	if q.Node.ProjectV2.View == nil {
+		return nil, fmt.Errorf("view %d not found in project %s", in.Number, in.ProjectID)
+	}
+
+	view := q.Node.ProjectV2.View.output()
+	return &view, nil
+}
+
+// defaultMaxItemRequests caps the pages GetAllProjectItems fetches when the
+// caller sets no request budget.
+const defaultMaxItemRequests = 20
+
+// projectFieldFields is the selection for a ProjectV2FieldConfiguration,
+// shared by ListProjectFields and the field mutations.
+type projectFieldFields struct {
+	Common struct {
+		ID       ghv4.ID
+		Name     ghv4.String
+		DataType ghv4.String
+	} `graphql:"... on ProjectV2FieldCommon"`
+	SingleSelectField struct {
+		Options []struct {
+			ID   ghv4.String
+			Name ghv4.String
+		}
+	} `graphql:"... on ProjectV2SingleSelectField"`
+	IterationField struct {
+		Configuration struct {
+			Iterations []struct {
+				ID        ghv4.String
+				Title     ghv4.String
+				StartDate ghv4.String
+				Duration  ghv4.Int
+			}
+		}
+	} `graphql:"... on ProjectV2IterationField"`
+}
+
+func (n *projectFieldFields) output() ProjectField {
+	f := ProjectField{
+		ID:       fmt.Sprint(n.Common.ID),
+		Name:     string(n.Common.Name),
+		DataType: string(n.Common.DataType),
+	}
+	for _, o := range n.SingleSelectField.Options {
+		f.Options = append(f.Options, ProjectFieldOption{ID: string(o.ID), Name: string(o.Name)})
+	}
+	for _, it := range n.IterationField.Configuration.Iterations {
+		f.Iterations = append(f.Iterations, ProjectIteration{
+			ID:        string(it.ID),
+			Title:     string(it.Title),
+			StartDate: string(it.StartDate),
+			Duration:  int(it.Duration),
+		})
+	}
+	return f
+}
+
+// ListProjectFields lists a project's fields using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func ListProjectFields(ctx context.Context, in *ListProjectFieldsInput, client GraphQLClient) (*ListProjectFieldsOutput, error) {
+	if in.ProjectID == "" {
+		return nil, errors.New("projectID is required")
+	}
+	if err := projectNode.validate(in.ProjectID); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	var q struct {
+		Node struct {
+			ProjectV2 struct {
+				Fields struct {
+					Nodes    []projectFieldFields
+					PageInfo struct {
+						EndCursor   ghv4.String
+						HasNextPage bool
+					}
+				} `graphql:"fields(first: $first, after: $after)"`
+			} `graphql:"... on ProjectV2"`
+		} `graphql:"node(id: $id)"`
+	}
+	vars := map[string]interface{}{
+		"id":    ghv4.ID(in.ProjectID),
+		"first": ghv4.Int(projectsPageSize(in.First)),
+		"after": ghv4.String(in.After),
+	}
+
+	err := graphQLQuery(ctx, client, "ListProjectFields", &q, vars)
+	if err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+
+	fields := q.Node.ProjectV2.Fields
+	out := &ListProjectFieldsOutput{
+		Fields:      []ProjectField{},
+		EndCursor:   string(fields.PageInfo.EndCursor),
+		HasNextPage: fields.PageInfo.HasNextPage,
+	}
+	for i := range fields.Nodes {
+		out.Fields = append(out.Fields, fields.Nodes[i].output())
+	}
+	return out, nil
+}
+
+// projectCustomFieldTypes maps the field types CreateProjectField accepts to
+// GitHub's ProjectV2CustomFieldType. Iteration fields cannot be created
+// through the API.
+var projectCustomFieldTypes = map[ProjectFieldType]ghv4.ProjectV2CustomFieldType{
+	FieldTypeText:         ghv4.ProjectV2CustomFieldTypeText,
+	FieldTypeNumber:       ghv4.ProjectV2CustomFieldTypeNumber,
+	FieldTypeDate:         ghv4.ProjectV2CustomFieldTypeDate,
+	FieldTypeSingleSelect: ghv4.ProjectV2CustomFieldTypeSingleSelect,
+}
+
+// projectFieldOptionColors are the colors GitHub accepts for single select
+// options.
+var projectFieldOptionColors = map[string]ghv4.ProjectV2SingleSelectFieldOptionColor{
+	"GRAY":   ghv4.ProjectV2SingleSelectFieldOptionColorGray,
+	"BLUE":   ghv4.ProjectV2SingleSelectFieldOptionColorBlue,
+	"GREEN":  ghv4.ProjectV2SingleSelectFieldOptionColorGreen,
+	"YELLOW": ghv4.ProjectV2SingleSelectFieldOptionColorYellow,
+	"ORANGE": ghv4.ProjectV2SingleSelectFieldOptionColorOrange,
+	"RED":    ghv4.ProjectV2SingleSelectFieldOptionColorRed,
+	"PINK":   ghv4.ProjectV2SingleSelectFieldOptionColorPink,
+	"PURPLE": ghv4.ProjectV2SingleSelectFieldOptionColorPurple,
+}
+
+// singleSelectOptionInputs converts options to GitHub's input type, defaulting
+// the color to GRAY.
+func singleSelectOptionInputs(options []ProjectFieldOptionInput) ([]ghv4.ProjectV2SingleSelectFieldOptionInput, error) {
+	out := make([]ghv4.ProjectV2SingleSelectFieldOptionInput, 0, len(options))
+	for _, o := range options {
+		if o.Name == "" {
+			return nil, errors.New("option name is required")
+		}
+		color := ghv4.ProjectV2SingleSelectFieldOptionColorGray
+		if o.Color != "" {
+			c, ok := projectFieldOptionColors[strings.ToUpper(o.Color)]
+			if !ok {
+				return nil, fmt.Errorf("invalid color %q for option %q", o.Color, o.Name)
+			}
+			color = c
+		}
+		out = append(out, ghv4.ProjectV2SingleSelectFieldOptionInput{
+			Name:        ghv4.String(o.Name),
+			Color:       color,
+			Description: ghv4.String(o.Description),
+		})
+	}
+	return out, nil
+}
+
+// CreateProjectField adds a custom field to a project using the provided
+// GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func CreateProjectField(ctx context.Context, in *CreateProjectFieldInput, client GraphQLClient) (*ProjectFieldMutationOutput, error) {
+	if in.ProjectID == "" || in.Name == "" {
+		return nil, errors.New("projectID and name are required")
+	}
+	if err := projectNode.validate(in.ProjectID); err != nil {
+		return nil, err
+	}
+	dataType, ok := projectCustomFieldTypes[in.DataType]
+	if !ok {
+		return nil, fmt.Errorf("invalid data type %q: expected text, number, date or single_select", in.DataType)
+	}
+
+	input := ghv4.CreateProjectV2FieldInput{
+		ProjectID:        ghv4.ID(in.ProjectID),
+		DataType:         dataType,
+		Name:             ghv4.String(in.Name),
+		ClientMutationID: clientMutationID(in.ClientMutationID),
+	}
+	switch {
+	case in.DataType == FieldTypeSingleSelect && len(in.Options) == 0:
+		return nil, errors.New("single_select fields need at least one option")
+	case in.DataType != FieldTypeSingleSelect && len(in.Options) > 0:
+		return nil, errors.New("options are only allowed on single_select fields")
+	case len(in.Options) > 0:
+		options, err := singleSelectOptionInputs(in.Options)
+		if err != nil {
+			return nil, err
+		}
+		input.SingleSelectOptions = &options
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	var m struct {
+		CreateProjectV2Field struct {
+			ProjectV2Field   projectFieldFields `graphql:"projectV2Field"`
+			ClientMutationID ghv4.String
+		} `graphql:"createProjectV2Field(input: $input)"`
+	}
+	if err := graphQLMutate(ctx, client, "CreateProjectField", &m, input, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+
+	return &ProjectFieldMutationOutput{
+		Field:            m.CreateProjectV2Field.ProjectV2Field.output(),
+		ClientMutationID: string(m.CreateProjectV2Field.ClientMutationID),
+	}, nil
+}
+
+// UpdateProjectV2FieldInput is the input of the updateProjectV2Field mutation,
+// which the pinned githubv4 release predates. The type name must match the
+// GraphQL input type.
+type UpdateProjectV2FieldInput struct {
+	FieldID             ghv4.ID                                       `json:"fieldId"`
+	Name                *ghv4.String                                  `json:"name,omitempty"`
+	SingleSelectOptions *[]ghv4.ProjectV2SingleSelectFieldOptionInput `json:"singleSelectOptions,omitempty"`
+	ClientMutationID    *ghv4.String                                  `json:"clientMutationId,omitempty"`
+}
+
+// UpdateProjectField renames a project field and/or replaces its single
+// select options using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func UpdateProjectField(ctx context.Context, in *UpdateProjectFieldInput, client GraphQLClient) (*ProjectFieldMutationOutput, error) {
+	if in.FieldID == "" {
+		return nil, errors.New("fieldID is required")
+	}
+	if in.Name == "" && len(in.Options) == 0 {
+		return nil, errors.New("at least one of name or options is required")
+	}
+	if err := projectFieldNode.validate(in.FieldID); err != nil {
+		return nil, err
+	}
+
+	input := UpdateProjectV2FieldInput{
+		FieldID:          ghv4.ID(in.FieldID),
+		ClientMutationID: clientMutationID(in.ClientMutationID),
+	}
+	if in.Name != "" {
+		input.Name = ghv4.NewString(ghv4.String(in.Name))
+	}
+	if len(in.Options) > 0 {
+		options, err := singleSelectOptionInputs(in.Options)
+		if err != nil {
+			return nil, err
+		}
+		input.SingleSelectOptions = &options
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	var m struct {
+		UpdateProjectV2Field struct {
+			ProjectV2Field   projectFieldFields `graphql:"projectV2Field"`
+			ClientMutationID ghv4.String
+		} `graphql:"updateProjectV2Field(input: $input)"`
+	}
+	if err := graphQLMutate(ctx, client, "UpdateProjectField", &m, input, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+
+	return &ProjectFieldMutationOutput{
+		Field:            m.UpdateProjectV2Field.ProjectV2Field.output(),
+		ClientMutationID: string(m.UpdateProjectV2Field.ClientMutationID),
+	}, nil
+}
+
+// DeleteProjectField deletes a custom project field, and every item's value
+// for it, using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func DeleteProjectField(ctx context.Context, in *DeleteProjectFieldInput, client GraphQLClient) (*DeleteProjectFieldOutput, error) {
+	if in.FieldID == "" {
+		return nil, errors.New("fieldID is required")
+	}
+	if err := projectFieldNode.validate(in.FieldID); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	input := ghv4.DeleteProjectV2FieldInput{
+		FieldID:          ghv4.ID(in.FieldID),
+		ClientMutationID: clientMutationID(in.ClientMutationID),
+	}
+	var m struct {
+		DeleteProjectV2Field struct {
+			ProjectV2Field struct {
+				Common struct {
+					ID ghv4.ID
+				} `graphql:"... on ProjectV2FieldCommon"`
+			} `graphql:"projectV2Field"`
+			ClientMutationID ghv4.String
+		} `graphql:"deleteProjectV2Field(input: $input)"`
+	}
+	if err := graphQLMutate(ctx, client, "DeleteProjectField", &m, input, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+
+	return &DeleteProjectFieldOutput{
+		DeletedFieldID:   fmt.Sprint(m.DeleteProjectV2Field.ProjectV2Field.Common.ID),
+		ClientMutationID: string(m.DeleteProjectV2Field.ClientMutationID),
+	}, nil
+}
+
+// GetAllProjectItems pages through a project's items using the provided
+// GraphQLClient until the last page or a budget is reached. Pages are
+// fetched one after another because each cursor comes from the previous
+// page; assignees and labels arrive inline with each page, so there are no
+// follow-up queries to run concurrently.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func GetAllProjectItems(ctx context.Context, in *GetAllProjectItemsInput, client GraphQLClient) (*GetAllProjectItemsOutput, error) {
+	if in.ProjectID == "" {
+		return nil, errors.New("projectID is required")
+	}
+	if in.MaxItems < 0 || in.MaxRequests < 0 {
+		return nil, errors.New("maxItems and maxRequests must not be negative")
+	}
+	if in.Filter != nil {
+		if err := in.Filter.validate(); err != nil {
+			return nil, err
+		}
+	}
+	maxRequests := in.MaxRequests
+	if maxRequests == 0 {
+		maxRequests = defaultMaxItemRequests
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	out := &GetAllProjectItemsOutput{Items: []ProjectItem{}, EndCursor: in.After}
+	for requests := 0; ; requests++ {
+		first := maxProjectsPageSize
+		if in.MaxItems > 0 {
+			first = min(first, in.MaxItems-len(out.Items))
+		}
+		if requests == maxRequests || first == 0 {
+			out.Truncated = true
+			return out, nil
+		}
+
+		page, err := GetProjectItems(ctx, &GetProjectItemsInput{
+			ProjectID: in.ProjectID,
+			First:     first,
+			After:     out.EndCursor,
+			Filter:    in.Filter,
+		}, client)
+		if err != nil {
+			return nil, err
+		}
+		out.Items = append(out.Items, page.Items...)
+		out.EndCursor = page.EndCursor
+		out.HasNextPage = page.HasNextPage
+		if !page.HasNextPage {
+			return out, nil
+		}
+	}
+}
+
+// GetProjectSummary counts a project's items by status, assignee, label,
+// state and iteration using the provided GraphQLClient. The items are fetched
+// with GetAllProjectItems, so the same request budget applies.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func GetProjectSummary(ctx context.Context, in *GetProjectSummaryInput, client GraphQLClient) (*ProjectSummary, error) {
+	if in.ProjectID == "" {
+		return nil, errors.New("projectID is required")
+	}
+	statusField := in.StatusField
+	if statusField == "" {
+		statusField = "Status"
+	}
+
+	items, err := GetAllProjectItems(ctx, &GetAllProjectItemsInput{
+		ProjectID:   in.ProjectID,
+		MaxRequests: in.MaxRequests,
+		Filter:      in.Filter,
+	}, client)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &ProjectSummary{
+		TotalItems: len(items.Items),
+		ByStatus:   map[string]int{},
+		ByAssignee: map[string]int{},
+		ByLabel:    map[string]int{},
+		Truncated:  items.Truncated,
+	}
+	for _, item := range items.Items {
+		switch {
+		case item.ContentType == "DraftIssue":
+			out.Drafts++
+		case strings.EqualFold(item.State, "OPEN"):
+			out.Open++
+		case item.State != "":
+			out.Closed++
+		}
+		if item.FieldValuesTruncated {
+			out.FieldValuesTruncated++
+		}
+		if v, ok := item.fieldValue(statusField); ok {
+			out.ByStatus[fieldValueString(v)]++
+		} else if !item.FieldValuesTruncated {
+			out.NoStatus++
+		}
+		for _, a := range item.Assignees {
+			out.ByAssignee[a]++
+		}
+		if len(item.Assignees) == 0 {
+			out.Unassigned++
+		}
+		for _, l := range item.Labels {
+			out.ByLabel[l]++
+		}
+		hasIteration := false
+		for _, v := range item.FieldValues {
+			if _, ok := v.(ProjectIteration); ok {
+				hasIteration = true
+				break
+			}
+		}
+		if !hasIteration && !item.FieldValuesTruncated {
+			out.NoIteration++
+		}
+	}
+	return out, nil
+}
+
+// parseActivitySince parses GetProjectActivityInput.Since.
+func parseActivitySince(since string, now time.Time) (time.Time, error) {
+	if since == "" {
+		return now.AddDate(0, 0, -7), nil
+	}
+	if t, err := time.Parse(time.RFC3339, since); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("2006-01-02", since); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid since %q: expected an RFC 3339 timestamp or YYYY-MM-DD", since)
+}
+
+// GetProjectActivity reports what changed on a project's items since a point
+// in time using the provided GraphQLClient. Projects have no activity log in
+// the API, so every item is scanned and its timestamps compared: createdAt
+// for additions, updatedAt for changes and archivals, and the closedAt and
+// mergedAt of issue and pull request content.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func GetProjectActivity(ctx context.Context, in *GetProjectActivityInput, client GraphQLClient) (*GetProjectActivityOutput, error) {
+	if in.ProjectID == "" {
+		return nil, errors.New("projectID is required")
+	}
+	if err := projectNode.validate(in.ProjectID); err != nil {
+		return nil, err
+	}
+	if in.MaxRequests < 0 {
+		return nil, errors.New("maxRequests must not be negative")
+	}
+	since, err := parseActivitySince(in.Since, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	maxRequests := in.MaxRequests
+	if maxRequests == 0 {
+		maxRequests = defaultMaxItemRequests
+	}
+
+	if isNilGraphQLClient(client) {
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	type activityPage struct {
+		Node struct {
+			ProjectV2 struct {
+				Items struct {
+					Nodes []struct {
+						ID         ghv4.ID
+						CreatedAt  ghv4.DateTime
+						UpdatedAt  ghv4.DateTime
+						IsArchived bool
+						Content    *struct {
+							Typename string `graphql:"__typename"`
+							Issue    struct {
+								Title    ghv4.String
+								URL      ghv4.URI
+								ClosedAt *ghv4.DateTime
+							} `graphql:"... on Issue"`
+							PullRequest struct {
+								Title    ghv4.String
+								URL      ghv4.URI
+								ClosedAt *ghv4.DateTime
+								MergedAt *ghv4.DateTime
+							} `graphql:"... on PullRequest"`
+							DraftIssue struct {
+								Title ghv4.String
+							} `graphql:"... on DraftIssue"`
+						} `graphql:"content"`
+						FieldValues projectItemFieldValues `graphql:"fieldValues(first: 100)"`
+					}
+					PageInfo struct {
+						EndCursor   ghv4.String
+						HasNextPage bool
+					}
+				} `graphql:"items(first: $first, after: $after)"`
+			} `graphql:"... on ProjectV2"`
+		} `graphql:"node(id: $id)"`
+	}
+	vars := map[string]interface{}{
+		"id":    ghv4.ID(in.ProjectID),
+		"first": ghv4.Int(maxProjectsPageSize),
+		"after": ghv4.String(""),
+	}
+
+	out := &GetProjectActivityOutput{
+		Since:      since.UTC().Format(time.RFC3339),
+		Activities: []ProjectActivity{},
+	}
+	for requests := 0; ; requests++ {
+		if requests == maxRequests {
+			out.Truncated = true
+			break
+		}
+
+		var q activityPage
+		if err := graphQLQuery(ctx, client, "GetProjectActivity", &q, vars); err != nil {
+			return nil, fmt.Errorf("github graphql error: %w", err)
+		}
+		items := q.Node.ProjectV2.Items
+		for _, n := range items.Nodes {
+			base := ProjectActivity{ItemID: fmt.Sprint(n.ID)}
+			var closedAt, mergedAt *ghv4.DateTime
+			if c := n.Content; c != nil {
+				base.ContentType = c.Typename
+				switch c.Typename {
+				case "Issue":
+					base.Title, base.URL = string(c.Issue.Title), c.Issue.URL.String()
+					closedAt = c.Issue.ClosedAt
+				case "PullRequest":
+					base.Title, base.URL = string(c.PullRequest.Title), c.PullRequest.URL.String()
+					closedAt, mergedAt = c.PullRequest.ClosedAt, c.PullRequest.MergedAt
+					if mergedAt != nil {
+						closedAt = nil
+					}
+				case "DraftIssue":
+					base.Title = string(c.DraftIssue.Title)
+				}
+			}
+			base.FieldValues = n.FieldValues.values()
+			base.FieldValuesTruncated = n.FieldValues.PageInfo.HasNextPage
+
+			add := func(event string, at time.Time) {
+				a := base
+				a.Event = event
+				a.At = at.UTC().Format(time.RFC3339)
+				out.Activities = append(out.Activities, a)
+			}
+			added := !n.CreatedAt.Before(since)
+			if added {
+				add("added", n.CreatedAt.Time)
+			}
+			if !n.UpdatedAt.Before(since) {
+				switch {
+				case n.IsArchived:
+					add("archived", n.UpdatedAt.Time)
+				case !added || n.UpdatedAt.After(n.CreatedAt.Time):
+					add("updated", n.UpdatedAt.Time)
+				}
+			}
+			if closedAt != nil && !closedAt.Before(since) {
+				add("closed", closedAt.Time)
+			}
+			if mergedAt != nil && !mergedAt.Before(since) {
+				add("merged", mergedAt.Time)
+			}
+		}
+		if !items.PageInfo.HasNextPage {
+			break
+		}
+		vars["after"] = items.PageInfo.EndCursor
+	}
+
+	sort.SliceStable(out.Activities, func(i, j int) bool {
+		return out.Activities[i].At > out.Activities[j].At
+	})
+	return out, nil
+}
+
+// CreateProject creates a new project using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+// The owner login (organization or user) is resolved to a GraphQL ID unless
+// in.OwnerID is supplied, in which case it is used as-is.
+func CreateProject(ctx context.Context, in *CreateProjectInput, client GraphQLClient) (*Project, error) {
+	if (in.Owner == "") == (in.OwnerID == "") {
+		return nil, errors.New("exactly one of owner or owner_id is required")
+	}
+	if in.Title == "" {
+		return nil, errors.New("title is required")
+	}
+	if err := ownerNode.validate(in.OwnerID); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Resolve the owner to a GraphQL ID (works for both orgs and users) unless
+	// the caller already has it.
+	ownerID := ghv4.ID(in.OwnerID)
+	if ownerID == "" {
+		var err error
+		// The mutation accepts either kind of owner ID, so the kind is not needed here.
+		ownerID, _, err = resolveOwnerID(ctx, client, in.Owner)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	type createProjectInput struct {
+		OwnerID          ghv4.ID      `json:"ownerId"`
+		Title            ghv4.String  `json:"title"`
+		ShortDescription ghv4.String  `json:"shortDescription,omitempty"`
+		ClientMutationID *ghv4.String `json:"clientMutationId,omitempty"`
+	}
+	input := createProjectInput{
+		OwnerID:          ownerID,
+		Title:            ghv4.String(in.Title),
+		ClientMutationID: clientMutationID(in.ClientMutationID),
+	}
+	if in.Description != "" {
+		input.ShortDescription = ghv4.String(in.Description)
+	}
+
+	var m struct {
+		CreateProjectV2 struct {
+			ProjectV2 struct {
+				ID     ghv4.ID
+				Number ghv4.Int
+				Title  ghv4.String
+				URL    ghv4.URI
+			}
+			ClientMutationID ghv4.String
+		} `graphql:"createProjectV2(input: $input)"`
+	}
+	if err := graphQLMutate(ctx, client, "CreateProject", &m, input, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+	p := m.CreateProjectV2.ProjectV2
+	return &Project{
+		ID:               fmt.Sprint(p.ID),
+		Number:           int(p.Number),
+		Title:            string(p.Title),
+		URL:              p.URL.String(),
+		ClientMutationID: string(m.CreateProjectV2.ClientMutationID),
+	}, nil
+}
+
+// CopyProject creates a new project from an existing one using the provided
+// GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func CopyProject(ctx context.Context, in *CopyProjectInput, client GraphQLClient) (*Project, error) {
+	if in.ProjectID == "" || in.Title == "" {
+		return nil, errors.New("projectID and title are required")
+	}
+	if (in.Owner == "") == (in.OwnerID == "") {
+		return nil, errors.New("exactly one of owner or owner_id is required")
+	}
+	if err := validateNodeIDs(
+		nodeIDCheck{projectNode, in.ProjectID},
+		nodeIDCheck{ownerNode, in.OwnerID},
+	); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	ownerID := ghv4.ID(in.OwnerID)
+	if ownerID == "" {
+		var err error
+		ownerID, _, err = resolveOwnerID(ctx, client, in.Owner)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	input := ghv4.CopyProjectV2Input{
+		ProjectID:        ghv4.ID(in.ProjectID),
+		OwnerID:          ownerID,
+		Title:            ghv4.String(in.Title),
+		ClientMutationID: clientMutationID(in.ClientMutationID),
+	}
+	if in.IncludeDraftIssues {
+		input.IncludeDraftIssues = ghv4.NewBoolean(true)
+	}
+
+	var m struct {
+		CopyProjectV2 struct {
+			ProjectV2 struct {
+				ID     ghv4.ID
+				Number ghv4.Int
+				Title  ghv4.String
+				URL    ghv4.URI
+			}
+			ClientMutationID ghv4.String
+		} `graphql:"copyProjectV2(input: $input)"`
+	}
+	if err := graphQLMutate(ctx, client, "CopyProject", &m, input, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+	p := m.CopyProjectV2.ProjectV2
+	return &Project{
+		ID:               fmt.Sprint(p.ID),
+		Number:           int(p.Number),
+		Title:            string(p.Title),
+		URL:              p.URL.String(),
+		ClientMutationID: string(m.CopyProjectV2.ClientMutationID),
+	}, nil
+}
+
+// UpdateProject changes a project's title, description, readme, visibility or
+// open/closed state using the provided GraphQLClient. Only the fields set in
+// in are sent.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func UpdateProject(ctx context.Context, in *UpdateProjectInput, client GraphQLClient) (*UpdateProjectOutput, error) {
+	if in.ProjectID == "" {
+		return nil, errors.New("projectID is required")
+	}
+	if in.Title == nil && in.ShortDescription == nil && in.Readme == nil && in.Public == nil && in.Closed == nil {
+		return nil, errors.New("at least one of title, shortDescription, readme, public or closed is required")
+	}
+	if err := projectNode.validate(in.ProjectID); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	input := ghv4.UpdateProjectV2Input{
+		ProjectID:        ghv4.ID(in.ProjectID),
+		ClientMutationID: clientMutationID(in.ClientMutationID),
+	}
+	if in.Title != nil {
+		input.Title = ghv4.NewString(ghv4.String(*in.Title))
+	}
+	if in.ShortDescription != nil {
+		input.ShortDescription = ghv4.NewString(ghv4.String(*in.ShortDescription))
+	}
+	if in.Readme != nil {
+		input.Readme = ghv4.NewString(ghv4.String(*in.Readme))
+	}
+	if in.Public != nil {
+		input.Public = ghv4.NewBoolean(ghv4.Boolean(*in.Public))
+	}
+	if in.Closed != nil {
+		input.Closed = ghv4.NewBoolean(ghv4.Boolean(*in.Closed))
+	}
+
+	var m struct {
+		UpdateProjectV2 struct {
+			ProjectV2 struct {
+				ID               ghv4.ID
+				Number           ghv4.Int
+				Title            ghv4.String
+				URL              ghv4.URI
+				ShortDescription ghv4.String
+				Readme           ghv4.String
+				Public           ghv4.Boolean
+				Closed           ghv4.Boolean
+			}
+			ClientMutationID ghv4.String
+		} `graphql:"updateProjectV2(input: $input)"`
+	}
+	if err := graphQLMutate(ctx, client, "UpdateProject", &m, input, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+
+	p := m.UpdateProjectV2.ProjectV2
+	return &UpdateProjectOutput{
+		Project: Project{
+			ID:               fmt.Sprint(p.ID),
+			Number:           int(p.Number),
+			Title:            string(p.Title),
+			URL:              p.URL.String(),
+			ClientMutationID: string(m.UpdateProjectV2.ClientMutationID),
+		},
+		ShortDescription: string(p.ShortDescription),
+		Readme:           string(p.Readme),
+		Public:           bool(p.Public),
+		Closed:           bool(p.Closed),
+	}, nil
+}
+
+// DeleteProject permanently deletes a project using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func DeleteProject(ctx context.Context, in *DeleteProjectInput, client GraphQLClient) (*DeleteProjectOutput, error) {
+	if in.ProjectID == "" {
+		return nil, errors.New("projectID is required")
+	}
+	if err := projectNode.validate(in.ProjectID); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	input := ghv4.DeleteProjectV2Input{
+		ProjectID:        ghv4.ID(in.ProjectID),
+		ClientMutationID: clientMutationID(in.ClientMutationID),
+	}
+	var m struct {
+		DeleteProjectV2 struct {
+			ProjectV2 struct {
+				ID ghv4.ID
+			}
+			ClientMutationID ghv4.String
+		} `graphql:"deleteProjectV2(input: $input)"`
+	}
+	if err := graphQLMutate(ctx, client, "DeleteProject", &m, input, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+
+	return &DeleteProjectOutput{
+		DeletedProjectID: fmt.Sprint(m.DeleteProjectV2.ProjectV2.ID),
+		ClientMutationID: string(m.DeleteProjectV2.ClientMutationID),
+	}, nil
+}
+
+// projectStatusUpdateFields is the selection shared by the status update
+// functions.
+type projectStatusUpdateFields struct {
+	ID         ghv4.ID
+	Status     ghv4.String
+	StartDate  ghv4.String
+	TargetDate ghv4.String
+	Body       ghv4.String
+	CreatedAt  ghv4.DateTime
+	Creator    struct {
+		Login ghv4.String
+	}
+}
+
+func (u *projectStatusUpdateFields) output() ProjectStatusUpdate {
+	return ProjectStatusUpdate{
+		ID:         fmt.Sprint(u.ID),
+		Status:     string(u.Status),
+		StartDate:  string(u.StartDate),
+		TargetDate: string(u.TargetDate),
+		Body:       string(u.Body),
+		Creator:    string(u.Creator.Login),
+		CreatedAt:  u.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// projectStatusUpdateStatuses are the values GitHub accepts for a status
+// update's status.
+var projectStatusUpdateStatuses = map[string]ghv4.ProjectV2StatusUpdateStatus{
+	"INACTIVE":  ghv4.ProjectV2StatusUpdateStatusInactive,
+	"ON_TRACK":  ghv4.ProjectV2StatusUpdateStatusOnTrack,
+	"AT_RISK":   ghv4.ProjectV2StatusUpdateStatusAtRisk,
+	"OFF_TRACK": ghv4.ProjectV2StatusUpdateStatusOffTrack,
+	"COMPLETE":  ghv4.ProjectV2StatusUpdateStatusComplete,
+}
+
+// ListProjectStatusUpdates lists a project's status updates, newest first,
+// using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func ListProjectStatusUpdates(ctx context.Context, in *ListProjectStatusUpdatesInput, client GraphQLClient) (*ListProjectStatusUpdatesOutput, error) {
+	if in.ProjectID == "" {
+		return nil, errors.New("projectID is required")
+	}
+	if err := projectNode.validate(in.ProjectID); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	var q struct {
+		Node struct {
+			ProjectV2 struct {
+				StatusUpdates struct {
+					Nodes    []projectStatusUpdateFields
+					PageInfo struct {
+						EndCursor   ghv4.String
+						HasNextPage bool
+					}
+				} `graphql:"statusUpdates(first: $first, after: $after, orderBy: {field: CREATED_AT, direction: DESC})"`
+			} `graphql:"... on ProjectV2"`
+		} `graphql:"node(id: $id)"`
+	}
+	vars := map[string]interface{}{
+		"id":    ghv4.ID(in.ProjectID),
+		"first": ghv4.Int(projectsPageSize(in.First)),
+		"after": ghv4.String(in.After),
+	}
+
+	err := graphQLQuery(ctx, client, "ListProjectStatusUpdates", &q, vars)
+	if err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+
+	updates := q.Node.ProjectV2.StatusUpdates
+	out := &ListProjectStatusUpdatesOutput{
+		StatusUpdates: []ProjectStatusUpdate{},
+		EndCursor:     string(updates.PageInfo.EndCursor),
+		HasNextPage:   updates.PageInfo.HasNextPage,
+	}
+	for i := range updates.Nodes {
+		out.StatusUpdates = append(out.StatusUpdates, updates.Nodes[i].output())
+	}
+	return out, nil
+}
+
+// CreateProjectStatusUpdate posts a status update on a project using the
+// provided GraphQLClient. Status is matched case-insensitively.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func CreateProjectStatusUpdate(ctx context.Context, in *CreateProjectStatusUpdateInput, client GraphQLClient) (*CreateProjectStatusUpdateOutput, error) {
+	if in.ProjectID == "" {
+		return nil, errors.New("projectID is required")
+	}
+	if err := projectNode.validate(in.ProjectID); err != nil {
+		return nil, err
+	}
+
+	input := ghv4.CreateProjectV2StatusUpdateInput{
+		ProjectID:        ghv4.ID(in.ProjectID),
+		ClientMutationID: clientMutationID(in.ClientMutationID),
+	}
+	if in.Status != "" {
+		status, ok := projectStatusUpdateStatuses[strings.ToUpper(in.Status)]
+		if !ok {
+			return nil, fmt.Errorf("invalid status %q: expected inactive, on_track, at_risk, off_track or complete", in.Status)
+		}
+		input.Status = &status
+	}
+	if in.StartDate != "" {
+		d, err := parseProjectDate(in.StartDate)
+		if err != nil {
+			return nil, err
+		}
+		input.StartDate = d
+	}
+	if in.TargetDate != "" {
+		d, err := parseProjectDate(in.TargetDate)
+		if err != nil {
+			return nil, err
+		}
+		input.TargetDate = d
+	}
+	if in.Body != "" {
+		input.Body = ghv4.NewString(ghv4.String(in.Body))
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	var m struct {
+		CreateProjectV2StatusUpdate struct {
+			StatusUpdate     projectStatusUpdateFields
+			ClientMutationID ghv4.String
+		} `graphql:"createProjectV2StatusUpdate(input: $input)"`
+	}
+	if err := graphQLMutate(ctx, client, "CreateProjectStatusUpdate", &m, input, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+
+	return &CreateProjectStatusUpdateOutput{
+		ProjectStatusUpdate: m.CreateProjectV2StatusUpdate.StatusUpdate.output(),
+		ClientMutationID:    string(m.CreateProjectV2StatusUpdate.ClientMutationID),
+	}, nil
+}
+
+// DeleteProjectStatusUpdate deletes a project status update using the
+// provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func DeleteProjectStatusUpdate(ctx context.Context, in *DeleteProjectStatusUpdateInput, client GraphQLClient) (*DeleteProjectStatusUpdateOutput, error) {
+	if in.StatusUpdateID == "" {
+		return nil, errors.New("statusUpdateID is required")
+	}
+	if err := projectStatusUpdateNode.validate(in.StatusUpdateID); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	input := ghv4.DeleteProjectV2StatusUpdateInput{
+		StatusUpdateID:   ghv4.ID(in.StatusUpdateID),
+		ClientMutationID: clientMutationID(in.ClientMutationID),
+	}
+	var m struct {
+		DeleteProjectV2StatusUpdate struct {
+			DeletedStatusUpdateID ghv4.ID
+			ClientMutationID      ghv4.String
+		} `graphql:"deleteProjectV2StatusUpdate(input: $input)"`
+	}
+	if err := graphQLMutate(ctx, client, "DeleteProjectStatusUpdate", &m, input, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+
+	return &DeleteProjectStatusUpdateOutput{
+		DeletedStatusUpdateID: fmt.Sprint(m.DeleteProjectV2StatusUpdate.DeletedStatusUpdateID),
+		ClientMutationID:      string(m.DeleteProjectV2StatusUpdate.ClientMutationID),
+	}, nil
+}
+
+// AddProjectItem adds an item to a project using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func AddProjectItem(ctx context.Context, in *AddProjectItemInput, client GraphQLClient) (*AddProjectItemOutput, error) {
+	if in.ProjectID == "" || in.ContentID == "" {
+		return nil, errors.New("projectID and contentID are required")
+	}
+	if err := projectNode.validate(in.ProjectID); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	contentID, err := resolveContentID(ctx, client, in.ContentID)
+	if err != nil {
+		return nil, err
+	}
+
+	input := ghv4.AddProjectV2ItemByIdInput{
+		ProjectID:        ghv4.ID(in.ProjectID),
+		ContentID:        ghv4.ID(contentID),
+		ClientMutationID: clientMutationID(in.ClientMutationID),
+	}
+
+	var m struct {
+		AddProjectV2ItemById struct {
+			Item struct {
+				ID      ghv4.ID
+				Content *projectItemContent `graphql:"content"`
+			}
+			ClientMutationID ghv4.String
+		} `graphql:"addProjectV2ItemById(input: $input)"`
+	}
+	if err := graphQLMutate(ctx, client, "AddProjectItem", &m, input, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+
+	item := projectItemFromContent(m.AddProjectV2ItemById.Item.ID, m.AddProjectV2ItemById.Item.Content)
+	return &AddProjectItemOutput{
+		Item:             item,
+		ClientMutationID: string(m.AddProjectV2ItemById.ClientMutationID),
+	}, nil
+}
+
+// isWebURL reports whether s looks like an http(s) URL rather than a node ID.
+func isWebURL(s string) bool {
+	return strings.HasPrefix(s, "https://") || strings.HasPrefix(s, "http://")
+}
+
+// parseIssueReference splits an owner/repo#number reference. ok is false when
+// ref is not in that form.
+func parseIssueReference(ref string) (owner, repo string, number int, ok bool) {
+	path, num, found := strings.Cut(ref, "#")
+	if !found {
+		return "", "", 0, false
+	}
+	owner, repo, found = strings.Cut(path, "/")
+	if !found || owner == "" || repo == "" || strings.Contains(repo, "/") {
+		return "", "", 0, false
+	}
+	number, err := strconv.Atoi(num)
+	if err != nil || number <= 0 {
+		return "", "", 0, false
+	}
+	return owner, repo, number, true
+}
+
+// resolveContentID returns the node ID of the issue or pull request ref names.
+// ref may be a web URL, an owner/repo#number reference or a node ID, which is
+// returned as is after a prefix check.
+func resolveContentID(ctx context.Context, client GraphQLClient, ref string) (string, error) {
+	if owner, repo, number, ok := parseIssueReference(ref); ok {
+		var q struct {
+			Repository struct {
+				IssueOrPullRequest *resourceContent `graphql:"issueOrPullRequest(number: $number)"`
+			} `graphql:"repository(owner: $owner, name: $name)"`
+		}
+		vars := map[string]interface{}{
+			"owner":  ghv4.String(owner),
+			"name":   ghv4.String(repo),
+			"number": ghv4.Int(number),
+		}
+		if err := graphQLQuery(ctx, client, "resolveContentID/reference", &q, vars); err != nil {
+			return "", fmt.Errorf("github graphql error: %w", err)
+		}
+		if id := q.Repository.IssueOrPullRequest.id(); id != "" {
+			return id, nil
+		}
+		return "", fmt.Errorf("could not resolve %q to an issue or pull request", ref)
+	}
+
+	if !isWebURL(ref) {
+		if err := itemContentNode.validate(ref); err != nil {
+			return "", err
+		}
+		return ref, nil
+	}
+
+	u, err := url.Parse(ref)
+	if err != nil || u.Host == "" {
+		return "", fmt.Errorf("invalid URL %q", ref)
+	}
+	var q struct {
+		Resource *resourceContent `graphql:"resource(url: $url)"`
+	}
+	vars := map[string]interface{}{
+		"url": ghv4.URI{URL: u},
+	}
+	if err := graphQLQuery(ctx, client, "resolveContentID/url", &q, vars); err != nil {
+		return "", fmt.Errorf("github graphql error: %w", err)
+	}
+	if id := q.Resource.id(); id != "" {
+		return id, nil
+	}
+	return "", fmt.Errorf("could not resolve %q to an issue or pull request", ref)
+}
+
+// resolveSingleSelectOption returns the ID of the single select option on
+// fieldID whose ID or (case-insensitive) name is value.
+func resolveSingleSelectOption(ctx context.Context, client GraphQLClient, fieldID, value string) (string, error) {
+	var q struct {
+		Node struct {
+			SingleSelectField struct {
+				Name    ghv4.String
+				Options []struct {
+					ID   ghv4.String
+					Name ghv4.String
+				}
+			} `graphql:"... on ProjectV2SingleSelectField"`
+		} `graphql:"node(id: $id)"`
+	}
+	vars := map[string]interface{}{"id": ghv4.ID(fieldID)}
+	if err := graphQLQuery(ctx, client, "UpdateProjectItemField/options", &q, vars); err != nil {
+		return "", fmt.Errorf("github graphql error: %w", err)
+	}
+
+	field := q.Node.SingleSelectField
+	if len(field.Options) == 0 {
+		return "", fmt.Errorf("field %s is not a single select field", fieldID)
+	}
+	names := make([]string, len(field.Options))
+	for i, o := range field.Options {
+		if string(o.ID) == value {
+			return value, nil
+		}
+		names[i] = string(o.Name)
+	}
+	for _, o := range field.Options {
+		if strings.EqualFold(string(o.Name), value) {
+			return string(o.ID), nil
+		}
+	}
+	return "", fmt.Errorf("no option %q on field %s; options are: %s", value, field.Name, strings.Join(names, ", "))
+}
+
+// UpdateProjectItemField updates a project item field using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func UpdateProjectItemField(ctx context.Context, in *UpdateProjectItemFieldInput, client GraphQLClient) (*UpdateProjectItemFieldOutput, error) {
+	if in.ItemID == "" || in.FieldID == "" || in.Value == "" {
 		return nil, errors.New("itemID, fieldID, and value are required")
 	}
+	if err := validateNodeIDs(
+		nodeIDCheck{projectNode, in.ProjectID},
+		nodeIDCheck{projectItemNode, in.ItemID},
+		nodeIDCheck{projectFieldNode, in.FieldID},
+	); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	var fv ProjectFieldValue
+	switch in.FieldType {
+	case "", FieldTypeText:
+		fv.Text = &in.Value
+	case FieldTypeNumber:
+		n, err := strconv.ParseFloat(in.Value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", in.Value)
+		}
+		fv.Number = &n
+	case FieldTypeDate:
+		fv.Date = in.Value
+	case FieldTypeSingleSelect:
+		optionID, err := resolveSingleSelectOption(ctx, client, in.FieldID, in.Value)
+		if err != nil {
+			return nil, err
+		}
+		fv.SingleSelectOptionID = optionID
+	case FieldTypeIteration:
+		fv.IterationID = in.Value
+	default:
+		return nil, fmt.Errorf("unknown field type %q: expected text, number, date, single_select or iteration", in.FieldType)
+	}
+	value, err := fv.graphQLValue()
+	if err != nil {
+		return nil, err
+	}
+
+	input := ghv4.UpdateProjectV2ItemFieldValueInput{
+		ProjectID:        ghv4.ID(in.ProjectID),
+		ItemID:           ghv4.ID(in.ItemID),
+		FieldID:          ghv4.ID(in.FieldID),
+		Value:            value,
+		ClientMutationID: clientMutationID(in.ClientMutationID),
+	}
+
+	var m struct {
+		UpdateProjectV2ItemFieldValue struct {
+			ProjectV2Item struct {
+				ID ghv4.ID
+			} `graphql:"projectV2Item"`
+			ClientMutationID ghv4.String
+		} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+	}
+	if err := graphQLMutate(ctx, client, "UpdateProjectItemField", &m, input, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+
+	item := ProjectItem{ID: fmt.Sprint(m.UpdateProjectV2ItemFieldValue.ProjectV2Item.ID)}
+	return &UpdateProjectItemFieldOutput{
+		Item:             item,
+		ClientMutationID: string(m.UpdateProjectV2ItemFieldValue.ClientMutationID),
+	}, nil
+}
+
+// ClearProjectItemField removes the value of a field on an item using the
+// provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func ClearProjectItemField(ctx context.Context, in *ClearProjectItemFieldInput, client GraphQLClient) (*UpdateProjectItemFieldOutput, error) {
+	if in.ProjectID == "" || in.ItemID == "" || in.FieldID == "" {
+		return nil, errors.New("projectID, itemID, and fieldID are required")
+	}
+	if err := validateNodeIDs(
+		nodeIDCheck{projectNode, in.ProjectID},
+		nodeIDCheck{projectItemNode, in.ItemID},
+		nodeIDCheck{projectFieldNode, in.FieldID},
+	); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	input := ghv4.ClearProjectV2ItemFieldValueInput{
+		ProjectID:        ghv4.ID(in.ProjectID),
+		ItemID:           ghv4.ID(in.ItemID),
+		FieldID:          ghv4.ID(in.FieldID),
+		ClientMutationID: clientMutationID(in.ClientMutationID),
+	}
+
+	var m struct {
+		ClearProjectV2ItemFieldValue struct {
+			ProjectV2Item struct {
+				ID ghv4.ID
+			} `graphql:"projectV2Item"`
+			ClientMutationID ghv4.String
+		} `graphql:"clearProjectV2ItemFieldValue(input: $input)"`
+	}
+	if err := graphQLMutate(ctx, client, "ClearProjectItemField", &m, input, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+
+	return &UpdateProjectItemFieldOutput{
+		Item:             ProjectItem{ID: fmt.Sprint(m.ClearProjectV2ItemFieldValue.ProjectV2Item.ID)},
+		ClientMutationID: string(m.ClearProjectV2ItemFieldValue.ClientMutationID),
+	}, nil
+}
+
+// graphQLValue converts v into the ProjectV2FieldValue input, rejecting values
+// with no member or more than one member set.
+func (v ProjectFieldValue) graphQLValue() (ghv4.ProjectV2FieldValue, error) {
+	var out ghv4.ProjectV2FieldValue
+	set := 0
+	if v.Text != nil {
+		out.Text = ghv4.NewString(ghv4.String(*v.Text))
+		set++
+	}
+	if v.Number != nil {
+		out.Number = ghv4.NewFloat(ghv4.Float(*v.Number))
+		set++
+	}
+	if v.Date != "" {
+		d, err := parseProjectDate(v.Date)
+		if err != nil {
+			return out, err
+		}
+		out.Date = d
+		set++
+	}
+	if v.SingleSelectOptionID != "" {
+		out.SingleSelectOptionID = ghv4.NewString(ghv4.String(v.SingleSelectOptionID))
+		set++
+	}
+	if v.IterationID != "" {
+		out.IterationID = ghv4.NewString(ghv4.String(v.IterationID))
+		set++
+	}
+	if set != 1 {
+		return out, errors.New("exactly one of text, number, date, single_select_option_id or iteration_id is required")
+	}
+	return out, nil
+}
+
+// parseProjectDate parses a YYYY-MM-DD date as used by project date fields
+// and status updates.
+func parseProjectDate(s string) (*ghv4.Date, error) {
+	d, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date %q: expected YYYY-MM-DD", s)
+	}
+	return ghv4.NewDate(ghv4.Date{Time: d}), nil
+}
+
+// bulkUpdateBatchSize caps how many aliased mutations are sent per request.
+const bulkUpdateBatchSize = 25
+
+// UpdateProjectItemFieldBulk sets the same field value on many items using the
+// provided GraphQLClient, sending the updates as aliased mutations in batches
+// of bulkUpdateBatchSize. A failure on one item does not stop the others: each
+// item gets its own entry in Results, and the returned error is reserved for
+// invalid input. If client is nil, a default client is created using
+// GITHUB_TOKEN from environment.
+func UpdateProjectItemFieldBulk(ctx context.Context, in *UpdateProjectItemFieldBulkInput, client GraphQLClient) (*UpdateProjectItemFieldBulkOutput, error) {
+	if in.ProjectID == "" || in.FieldID == "" || len(in.ItemIDs) == 0 {
+		return nil, errors.New("projectID, fieldID, and itemIDs are required")
+	}
+	if err := validateNodeIDs(
+		nodeIDCheck{projectNode, in.ProjectID},
+		nodeIDCheck{projectFieldNode, in.FieldID},
+	); err != nil {
+		return nil, err
+	}
+	value, err := in.Value.graphQLValue()
+	if err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	out := &UpdateProjectItemFieldBulkOutput{Results: make([]BulkItemResult, len(in.ItemIDs))}
+	var pending []int
+	for i, id := range in.ItemIDs {
+		out.Results[i].ItemID = id
+		if id == "" {
+			out.Results[i].Error = "item ID is empty"
+			continue
+		}
+		if err := projectItemNode.validate(id); err != nil {
+			out.Results[i].Error = err.Error()
+			continue
+		}
+		pending = append(pending, i)
+	}
+	for start := 0; start < len(pending); start += bulkUpdateBatchSize {
+		end := min(start+bulkUpdateBatchSize, len(pending))
+		updateFieldBatch(ctx, client, in, value, pending[start:end], out.Results)
+	}
+
+	for _, r := range out.Results {
+		if r.Error == "" {
+			out.Succeeded++
+		} else {
+			out.Failed++
+		}
+	}
+	return out, nil
+}
+
+// aliasedMutation builds a mutation with one pointer-to-payload field per
+// input, aliased item0..n, each calling mutation. graphQLMutate binds the
+// first alias to $input, so that input is returned separately and the rest are
+// passed as $input1..n in vars. A field left nil after the call means that
+// alias failed.
+func aliasedMutation(mutation string, payload reflect.Type, inputs []ghv4.Input) (reflect.Value, ghv4.Input, map[string]interface{}) {
+	var first ghv4.Input
+	vars := map[string]interface{}{}
+	fields := make([]reflect.StructField, len(inputs))
+	for n, input := range inputs {
+		variable := "input"
+		if n == 0 {
+			first = input
+		} else {
+			variable = fmt.Sprintf("input%d", n)
+			vars[variable] = input
+		}
+		fields[n] = reflect.StructField{
+			Name: fmt.Sprintf("Item%d", n),
+			Type: payload,
+			Tag:  reflect.StructTag(fmt.Sprintf(`graphql:"item%d: %s(input: $%s)"`, n, mutation, variable)),
+		}
+	}
+	return reflect.New(reflect.StructOf(fields)), first, vars
+}
+
+// updateFieldBatch issues one request with an aliased
+// updateProjectV2ItemFieldValue mutation per index in batch and records the
+// outcome in results. GitHub executes each alias independently, so an alias
+// that comes back null failed while its siblings may have succeeded. The
+// client only surfaces the first GraphQL error message, so every failed item
+// in the batch is reported with it.
+func updateFieldBatch(ctx context.Context, client GraphQLClient, in *UpdateProjectItemFieldBulkInput, value ghv4.ProjectV2FieldValue, batch []int, results []BulkItemResult) {
+	payload := reflect.TypeOf((*struct {
+		ProjectV2Item struct {
+			ID ghv4.ID
+		} `graphql:"projectV2Item"`
+	})(nil))
+
+	inputs := make([]ghv4.Input, len(batch))
+	for n, i := range batch {
+		inputs[n] = ghv4.UpdateProjectV2ItemFieldValueInput{
+			ProjectID: ghv4.ID(in.ProjectID),
+			ItemID:    ghv4.ID(results[i].ItemID),
+			FieldID:   ghv4.ID(in.FieldID),
+			Value:     value,
+		}
+	}
 
-	if client == nil {
-		token := os.Getenv("GITHUB_PERSONAL_ACCESS_TOKEN")
-		if token == "" {
-			return nil, errors.New("GITHUB_PERSONAL_ACCESS_TOKEN not set")
+	m, first, vars := aliasedMutation("updateProjectV2ItemFieldValue", payload, inputs)
+	err := graphQLMutate(ctx, client, "UpdateProjectItemFieldBulk", m.Interface(), first, vars)
+	for n, i := range batch {
+		if !m.Elem().Field(n).IsNil() {
+			continue
+		}
+		if err != nil {
+			results[i].Error = fmt.Sprintf("github graphql error: %v", err)
+		} else {
+			results[i].Error = "item was not updated"
 		}
-		client = ghv4.NewClient(&http.Client{Transport: &authTransport{token: token}})
 	}
+}
 
-	type updateFieldInput struct {
-		ProjectID ghv4.ID     `json:"projectId"`
-		ItemID    ghv4.ID     `json:"itemId"`
-		FieldID   ghv4.ID     `json:"fieldId"`
-		Value     ghv4.String `json:"value"`
+// BulkAddProjectItems adds many issues and pull requests to a project using
+// the provided GraphQLClient. URLs are resolved to node IDs with aliased
+// resource lookups, then the items are added as aliased mutations, both in
+// batches of bulkUpdateBatchSize. A failure on one entry does not stop the
+// others: each entry gets its own result.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func BulkAddProjectItems(ctx context.Context, in *BulkAddProjectItemsInput, client GraphQLClient) (*BulkAddProjectItemsOutput, error) {
+	if in.ProjectID == "" || len(in.Items) == 0 {
+		return nil, errors.New("projectID and items are required")
 	}
-	input := updateFieldInput{
-		ProjectID: ghv4.ID(in.ProjectID),
-		ItemID:    ghv4.ID(in.ItemID),
-		FieldID:   ghv4.ID(in.FieldID),
-		Value:     ghv4.String(in.Value),
+	if err := projectNode.validate(in.ProjectID); err != nil {
+		return nil, err
 	}
 
-	var m struct {
-		UpdateProjectV2ItemFieldValue struct {
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	out := &BulkAddProjectItemsOutput{Results: make([]BulkAddItemResult, len(in.Items))}
+	var urls []int
+	for i, item := range in.Items {
+		out.Results[i].Item = item
+		switch {
+		case item == "":
+			out.Results[i].Error = "item is empty"
+		case isWebURL(item):
+			urls = append(urls, i)
+		default:
+			if err := itemContentNode.validate(item); err != nil {
+				out.Results[i].Error = err.Error()
+				continue
+			}
+			out.Results[i].ContentID = item
+		}
+	}
+	for start := 0; start < len(urls); start += bulkUpdateBatchSize {
+		end := min(start+bulkUpdateBatchSize, len(urls))
+		resolveContentURLBatch(ctx, client, urls[start:end], out.Results)
+	}
+
+	var pending []int
+	for i, r := range out.Results {
+		if r.Error == "" {
+			pending = append(pending, i)
+		}
+	}
+	for start := 0; start < len(pending); start += bulkUpdateBatchSize {
+		end := min(start+bulkUpdateBatchSize, len(pending))
+		addItemBatch(ctx, client, in.ProjectID, pending[start:end], out.Results)
+	}
+
+	for _, r := range out.Results {
+		if r.Error == "" {
+			out.Succeeded++
+		} else {
+			out.Failed++
+		}
+	}
+	return out, nil
+}
+
+// resourceContent selects the node ID of a resource that is an issue or pull
+// request.
+type resourceContent struct {
+	Issue struct {
+		ID ghv4.ID
+	} `graphql:"... on Issue"`
+	PullRequest struct {
+		ID ghv4.ID
+	} `graphql:"... on PullRequest"`
+}
+
+func (r *resourceContent) id() string {
+	switch {
+	case r == nil:
+		return ""
+	case r.Issue.ID != nil:
+		return fmt.Sprint(r.Issue.ID)
+	case r.PullRequest.ID != nil:
+		return fmt.Sprint(r.PullRequest.ID)
+	}
+	return ""
+}
+
+// resolveContentURLBatch looks up the issue or pull request behind the URL of
+// each index in batch with one aliased resource query and records its node ID,
+// or why it could not be resolved, in results.
+func resolveContentURLBatch(ctx context.Context, client GraphQLClient, batch []int, results []BulkAddItemResult) {
+	node := reflect.TypeOf((*resourceContent)(nil))
+
+	vars := map[string]interface{}{}
+	var fields []reflect.StructField
+	var queried []int
+	for _, i := range batch {
+		u, err := url.Parse(results[i].Item)
+		if err != nil || u.Host == "" {
+			results[i].Error = fmt.Sprintf("invalid URL %q", results[i].Item)
+			continue
+		}
+		n := len(queried)
+		vars[fmt.Sprintf("url%d", n)] = ghv4.URI{URL: u}
+		fields = append(fields, reflect.StructField{
+			Name: fmt.Sprintf("Item%d", n),
+			Type: node,
+			Tag:  reflect.StructTag(fmt.Sprintf(`graphql:"item%d: resource(url: $url%d)"`, n, n)),
+		})
+		queried = append(queried, i)
+	}
+	if len(queried) == 0 {
+		return
+	}
+
+	q := reflect.New(reflect.StructOf(fields))
+	err := graphQLQuery(ctx, client, "BulkAddProjectItems/resolve", q.Interface(), vars)
+	for n, i := range queried {
+		r := q.Elem().Field(n).Interface().(*resourceContent)
+		switch {
+		case r.id() != "":
+			results[i].ContentID = r.id()
+		case err != nil:
+			results[i].Error = fmt.Sprintf("github graphql error: %v", err)
+		default:
+			results[i].Error = "URL does not point to an issue or pull request"
+		}
+	}
+}
+
+// addItemBatch adds the content of each index in batch to the project with one
+// aliased addProjectV2ItemById request and records the new item IDs, or the
+// failure, in results.
+func addItemBatch(ctx context.Context, client GraphQLClient, projectID string, batch []int, results []BulkAddItemResult) {
+	type addedItem struct {
+		Item struct {
+			ID ghv4.ID
+		}
+	}
+	payload := reflect.TypeOf((*addedItem)(nil))
+
+	inputs := make([]ghv4.Input, len(batch))
+	for n, i := range batch {
+		inputs[n] = ghv4.AddProjectV2ItemByIdInput{
+			ProjectID: ghv4.ID(projectID),
+			ContentID: ghv4.ID(results[i].ContentID),
+		}
+	}
+
+	m, first, vars := aliasedMutation("addProjectV2ItemById", payload, inputs)
+	err := graphQLMutate(ctx, client, "BulkAddProjectItems", m.Interface(), first, vars)
+	for n, i := range batch {
+		switch added := m.Elem().Field(n).Interface().(*addedItem); {
+		case added != nil:
+			results[i].ItemID = fmt.Sprint(added.Item.ID)
+		case err != nil:
+			results[i].Error = fmt.Sprintf("github graphql error: %v", err)
+		default:
+			results[i].Error = "item was not added"
+		}
+	}
+}
+
+// MoveProjectItem adds an item's content to another project, copies the field
+// values that carry over, and optionally deletes the item from its source
+// project, using the provided GraphQLClient. The source item is only deleted
+// when every copied value was set, so a failed copy never loses data. Draft
+// issues cannot be moved because their content belongs to a single project.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func MoveProjectItem(ctx context.Context, in *MoveProjectItemInput, client GraphQLClient) (*MoveProjectItemOutput, error) {
+	if in.SourceProjectID == "" || in.ItemID == "" || in.TargetProjectID == "" {
+		return nil, errors.New("sourceProjectID, itemID and targetProjectID are required")
+	}
+	if err := validateNodeIDs(
+		nodeIDCheck{projectNode, in.SourceProjectID},
+		nodeIDCheck{projectItemNode, in.ItemID},
+		nodeIDCheck{projectNode, in.TargetProjectID},
+	); err != nil {
+		return nil, err
+	}
+	if in.SourceProjectID == in.TargetProjectID {
+		return nil, errors.New("source and target project must differ")
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	type sourceItem struct {
+		Project struct {
+			ID ghv4.ID
+		}
+		Content     *projectItemContent    `graphql:"content"`
+		FieldValues projectItemFieldValues `graphql:"fieldValues(first: 100, after: $after)"`
+	}
+	vars := map[string]interface{}{
+		"id":    ghv4.ID(in.ItemID),
+		"after": ghv4.String(""),
+	}
+	// Every field value is fetched, paging if need be: one left behind would
+	// be lost when the source item is deleted.
+	var source sourceItem
+	for {
+		var q struct {
+			Node struct {
+				ProjectV2Item sourceItem `graphql:"... on ProjectV2Item"`
+			} `graphql:"node(id: $id)"`
+		}
+		if err := graphQLQuery(ctx, client, "MoveProjectItem", &q, vars); err != nil {
+			return nil, fmt.Errorf("github graphql error: %w", err)
+		}
+		page := q.Node.ProjectV2Item
+		if vars["after"] == ghv4.String("") {
+			source = page
+		} else {
+			source.FieldValues.Nodes = append(source.FieldValues.Nodes, page.FieldValues.Nodes...)
+		}
+		if !page.FieldValues.PageInfo.HasNextPage {
+			break
+		}
+		vars["after"] = page.FieldValues.PageInfo.EndCursor
+	}
+	if fmt.Sprint(source.Project.ID) != in.SourceProjectID {
+		return nil, fmt.Errorf("item %s does not belong to project %s", in.ItemID, in.SourceProjectID)
+	}
+	item := projectItemFromContent(ghv4.ID(in.ItemID), source.Content)
+	if item.ContentType != "Issue" && item.ContentType != "PullRequest" {
+		return nil, fmt.Errorf("item %s is not an issue or pull request; draft issues cannot be moved between projects", in.ItemID)
+	}
+
+	sourceFields, err := ListProjectFields(ctx, &ListProjectFieldsInput{ProjectID: in.SourceProjectID, First: maxProjectsPageSize}, client)
+	if err != nil {
+		return nil, err
+	}
+	targetFields, err := ListProjectFields(ctx, &ListProjectFieldsInput{ProjectID: in.TargetProjectID, First: maxProjectsPageSize}, client)
+	if err != nil {
+		return nil, err
+	}
+
+	added, err := AddProjectItem(ctx, &AddProjectItemInput{ProjectID: in.TargetProjectID, ContentID: item.ContentID}, client)
+	if err != nil {
+		return nil, err
+	}
+	out := &MoveProjectItemOutput{Item: added.Item, CopiedFields: []string{}}
+
+	var names []string
+	var inputs []ghv4.Input
+	values := source.FieldValues.values()
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var copying []string
+	for _, name := range names {
+		field, value, reason := copyFieldValue(sourceFields.Fields, targetFields.Fields, name, values[name])
+		switch {
+		case field == nil:
+			continue
+		case reason != "":
+			out.SkippedFields = append(out.SkippedFields, SkippedFieldValue{Field: name, Reason: reason})
+			continue
+		}
+		copying = append(copying, name)
+		inputs = append(inputs, ghv4.UpdateProjectV2ItemFieldValueInput{
+			ProjectID: ghv4.ID(in.TargetProjectID),
+			ItemID:    ghv4.ID(added.Item.ID),
+			FieldID:   ghv4.ID(field.ID),
+			Value:     value,
+		})
+	}
+
+	if len(inputs) > 0 {
+		payload := reflect.TypeOf((*struct {
 			ProjectV2Item struct {
 				ID ghv4.ID
+			} `graphql:"projectV2Item"`
+		})(nil))
+		m, first, vars := aliasedMutation("updateProjectV2ItemFieldValue", payload, inputs)
+		err := graphQLMutate(ctx, client, "MoveProjectItem", m.Interface(), first, vars)
+		for n, name := range copying {
+			switch {
+			case !m.Elem().Field(n).IsNil():
+				out.CopiedFields = append(out.CopiedFields, name)
+			case err != nil:
+				out.SkippedFields = append(out.SkippedFields, SkippedFieldValue{Field: name, Reason: fmt.Sprintf("github graphql error: %v", err)})
+			default:
+				out.SkippedFields = append(out.SkippedFields, SkippedFieldValue{Field: name, Reason: "value was not set"})
 			}
-		} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+		}
+		if len(out.CopiedFields) < len(copying) {
+			return out, nil
+		}
+	}
+
+	if in.RemoveFromSource {
+		if _, err := DeleteProjectItem(ctx, &DeleteProjectItemInput{ProjectID: in.SourceProjectID, ItemID: in.ItemID}, client); err != nil {
+			return nil, fmt.Errorf("item added to target project as %s but not removed from source: %w", added.Item.ID, err)
+		}
+		out.RemovedFromSource = true
+	}
+	return out, nil
+}
+
+// copyFieldValue works out how the value of the source field name carries
+// over to target. It returns a nil field for values that are not copied at
+// all, such as built-in fields, and a reason when a custom field's value
+// cannot be copied.
+func copyFieldValue(source, target []ProjectField, name string, value interface{}) (*ProjectField, ghv4.ProjectV2FieldValue, string) {
+	var out ghv4.ProjectV2FieldValue
+	var from *ProjectField
+	for i := range source {
+		if source[i].Name == name {
+			from = &source[i]
+			break
+		}
+	}
+	if from == nil {
+		return nil, out, ""
+	}
+	switch from.DataType {
+	case "TEXT", "NUMBER", "DATE", "SINGLE_SELECT", "ITERATION":
+	default:
+		return nil, out, ""
+	}
+
+	var to *ProjectField
+	for i := range target {
+		if strings.EqualFold(target[i].Name, name) {
+			to = &target[i]
+			break
+		}
+	}
+	switch {
+	case to == nil:
+		return from, out, "target project has no field with this name"
+	case to.DataType != from.DataType:
+		return from, out, fmt.Sprintf("target field is %s, not %s", to.DataType, from.DataType)
+	}
+
+	var err error
+	switch v := value.(type) {
+	case float64:
+		out.Number = ghv4.NewFloat(ghv4.Float(v))
+	case ProjectIteration:
+		for _, it := range to.Iterations {
+			if strings.EqualFold(it.Title, v.Title) {
+				out.IterationID = ghv4.NewString(ghv4.String(it.ID))
+				return to, out, ""
+			}
+		}
+		return to, out, fmt.Sprintf("target field has no iteration %q", v.Title)
+	case string:
+		switch to.DataType {
+		case "DATE":
+			out.Date, err = parseProjectDate(v)
+		case "SINGLE_SELECT":
+			for _, opt := range to.Options {
+				if strings.EqualFold(opt.Name, v) {
+					out.SingleSelectOptionID = ghv4.NewString(ghv4.String(opt.ID))
+					return to, out, ""
+				}
+			}
+			return to, out, fmt.Sprintf("target field has no option %q", v)
+		default:
+			out.Text = ghv4.NewString(ghv4.String(v))
+		}
+	}
+	if err != nil {
+		return to, out, err.Error()
+	}
+	return to, out, ""
+}
+
+// ReorderProjectItem repositions an item within a project using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func ReorderProjectItem(ctx context.Context, in *ReorderProjectItemInput, client GraphQLClient) (*ReorderProjectItemOutput, error) {
+	if in.ProjectID == "" || in.ItemID == "" {
+		return nil, errors.New("projectID and itemID are required")
+	}
+	if err := validateNodeIDs(
+		nodeIDCheck{projectNode, in.ProjectID},
+		nodeIDCheck{projectItemNode, in.ItemID},
+		nodeIDCheck{projectItemNode, in.AfterItemID},
+	); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	type positionInput struct {
+		ProjectID        ghv4.ID      `json:"projectId"`
+		ItemID           ghv4.ID      `json:"itemId"`
+		AfterID          *ghv4.ID     `json:"afterId,omitempty"`
+		ClientMutationID *ghv4.String `json:"clientMutationId,omitempty"`
+	}
+	input := positionInput{
+		ProjectID:        ghv4.ID(in.ProjectID),
+		ItemID:           ghv4.ID(in.ItemID),
+		ClientMutationID: clientMutationID(in.ClientMutationID),
+	}
+	// Omitting afterId moves the item to the front.
+	if in.AfterItemID != "" {
+		input.AfterID = ghv4.NewID(ghv4.ID(in.AfterItemID))
+	}
+
+	var m struct {
+		UpdateProjectV2ItemPosition struct {
+			ClientMutationID ghv4.String
+		} `graphql:"updateProjectV2ItemPosition(input: $input)"`
 	}
-	if err := client.Mutate(ctx, &m, input, nil); err != nil {
+	if err := graphQLMutate(ctx, client, "ReorderProjectItem", &m, input, nil); err != nil {
 		return nil, fmt.Errorf("github graphql error: %w", err)
 	}
 
-	item := ProjectItem{ID: fmt.Sprint(m.UpdateProjectV2ItemFieldValue.ProjectV2Item.ID)}
-	return &UpdateProjectItemFieldOutput{Item: item}, nil
+	return &ReorderProjectItemOutput{
+		ItemID:           in.ItemID,
+		ClientMutationID: string(m.UpdateProjectV2ItemPosition.ClientMutationID),
+	}, nil
+}
+
+// AddProjectDraftIssue creates a draft issue item on a project using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func AddProjectDraftIssue(ctx context.Context, in *AddProjectDraftIssueInput, client GraphQLClient) (*AddProjectItemOutput, error) {
+	if in.ProjectID == "" || in.Title == "" {
+		return nil, errors.New("projectID and title are required")
+	}
+	if err := projectNode.validate(in.ProjectID); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	input := ghv4.AddProjectV2DraftIssueInput{
+		ProjectID:        ghv4.ID(in.ProjectID),
+		Title:            ghv4.String(in.Title),
+		ClientMutationID: clientMutationID(in.ClientMutationID),
+	}
+	if in.Body != "" {
+		input.Body = ghv4.NewString(ghv4.String(in.Body))
+	}
+
+	var m struct {
+		AddProjectV2DraftIssue struct {
+			ProjectItem struct {
+				ID      ghv4.ID
+				Content *projectItemContent `graphql:"content"`
+			}
+			ClientMutationID ghv4.String
+		} `graphql:"addProjectV2DraftIssue(input: $input)"`
+	}
+	if err := graphQLMutate(ctx, client, "AddProjectDraftIssue", &m, input, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+
+	item := projectItemFromContent(m.AddProjectV2DraftIssue.ProjectItem.ID, m.AddProjectV2DraftIssue.ProjectItem.Content)
+	return &AddProjectItemOutput{
+		Item:             item,
+		ClientMutationID: string(m.AddProjectV2DraftIssue.ClientMutationID),
+	}, nil
+}
+
+// DeleteProjectItem removes an item from a project using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func DeleteProjectItem(ctx context.Context, in *DeleteProjectItemInput, client GraphQLClient) (*DeleteProjectItemOutput, error) {
+	if in.ProjectID == "" || in.ItemID == "" {
+		return nil, errors.New("projectID and itemID are required")
+	}
+	if err := validateNodeIDs(
+		nodeIDCheck{projectNode, in.ProjectID},
+		nodeIDCheck{projectItemNode, in.ItemID},
+	); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	input := ghv4.DeleteProjectV2ItemInput{
+		ProjectID:        ghv4.ID(in.ProjectID),
+		ItemID:           ghv4.ID(in.ItemID),
+		ClientMutationID: clientMutationID(in.ClientMutationID),
+	}
+	var m struct {
+		DeleteProjectV2Item struct {
+			DeletedItemID    ghv4.ID
+			ClientMutationID ghv4.String
+		} `graphql:"deleteProjectV2Item(input: $input)"`
+	}
+	if err := graphQLMutate(ctx, client, "DeleteProjectItem", &m, input, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+
+	return &DeleteProjectItemOutput{
+		DeletedItemID:    fmt.Sprint(m.DeleteProjectV2Item.DeletedItemID),
+		ClientMutationID: string(m.DeleteProjectV2Item.ClientMutationID),
+	}, nil
+}
+
+// parseProjectURL extracts the owner login and project number from an
+// organization or user project URL. Anything after the number (such as a
+// /views/N suffix or a trailing slash) is ignored.
+func parseProjectURL(raw string) (string, int, error) {
+	invalid := fmt.Errorf("invalid project URL %q: expected https://github.com/orgs/<login>/projects/<number> or https://github.com/users/<login>/projects/<number>", raw)
+
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Host == "" {
+		return "", 0, invalid
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) < 4 || (parts[0] != "orgs" && parts[0] != "users") || parts[1] == "" || parts[2] != "projects" {
+		return "", 0, invalid
+	}
+	number, err := strconv.Atoi(parts[3])
+	if err != nil || number <= 0 {
+		return "", 0, invalid
+	}
+	return parts[1], number, nil
+}
+
+// GetProjectByURL fetches a project from its web URL using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func GetProjectByURL(ctx context.Context, in *GetProjectByURLInput, client GraphQLClient) (*Project, error) {
+	if in.URL == "" {
+		return nil, errors.New("url is required")
+	}
+	return GetProject(ctx, &GetProjectInput{URL: in.URL}, client)
+}
+
+// SetProjectTemplate marks or unmarks a project as a template using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func SetProjectTemplate(ctx context.Context, in *SetProjectTemplateInput, client GraphQLClient) (*SetProjectTemplateOutput, error) {
+	if in.ProjectID == "" {
+		return nil, errors.New("projectID is required")
+	}
+	if err := projectNode.validate(in.ProjectID); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	type templateProject struct {
+		ID       ghv4.ID
+		Template ghv4.Boolean
+	}
+	var (
+		project  templateProject
+		mutation ghv4.String
+	)
+	if in.IsTemplate {
+		var m struct {
+			MarkProjectV2AsTemplate struct {
+				ProjectV2        templateProject
+				ClientMutationID ghv4.String
+			} `graphql:"markProjectV2AsTemplate(input: $input)"`
+		}
+		input := ghv4.MarkProjectV2AsTemplateInput{
+			ProjectID:        ghv4.ID(in.ProjectID),
+			ClientMutationID: clientMutationID(in.ClientMutationID),
+		}
+		if err := graphQLMutate(ctx, client, "SetProjectTemplate", &m, input, nil); err != nil {
+			return nil, fmt.Errorf("github graphql error: %w", err)
+		}
+		project, mutation = m.MarkProjectV2AsTemplate.ProjectV2, m.MarkProjectV2AsTemplate.ClientMutationID
+	} else {
+		var m struct {
+			UnmarkProjectV2AsTemplate struct {
+				ProjectV2        templateProject
+				ClientMutationID ghv4.String
+			} `graphql:"unmarkProjectV2AsTemplate(input: $input)"`
+		}
+		input := ghv4.UnmarkProjectV2AsTemplateInput{
+			ProjectID:        ghv4.ID(in.ProjectID),
+			ClientMutationID: clientMutationID(in.ClientMutationID),
+		}
+		if err := graphQLMutate(ctx, client, "SetProjectTemplate", &m, input, nil); err != nil {
+			return nil, fmt.Errorf("github graphql error: %w", err)
+		}
+		project, mutation = m.UnmarkProjectV2AsTemplate.ProjectV2, m.UnmarkProjectV2AsTemplate.ClientMutationID
+	}
+
+	return &SetProjectTemplateOutput{
+		ProjectID:        fmt.Sprint(project.ID),
+		IsTemplate:       bool(project.Template),
+		ClientMutationID: string(mutation),
+	}, nil
+}
+
+// draftIssueIDForItem returns the DraftIssue node ID behind a project item.
+func draftIssueIDForItem(ctx context.Context, client GraphQLClient, itemID string) (string, error) {
+	var q struct {
+		Node struct {
+			ProjectV2Item struct {
+				Content struct {
+					DraftIssue struct {
+						ID ghv4.ID
+					} `graphql:"... on DraftIssue"`
+				}
+			} `graphql:"... on ProjectV2Item"`
+		} `graphql:"node(id: $id)"`
+	}
+	vars := map[string]interface{}{"id": ghv4.ID(itemID)}
+	if err := graphQLQuery(ctx, client, "UpdateDraftIssue/item", &q, vars); err != nil {
+		return "", fmt.Errorf("github graphql error: %w", err)
+	}
+	id := q.Node.ProjectV2Item.Content.DraftIssue.ID
+	if id == nil {
+		return "", fmt.Errorf("item %s is not a draft issue", itemID)
+	}
+	return fmt.Sprint(id), nil
+}
+
+// UpdateDraftIssue edits a draft issue's title and/or body using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func UpdateDraftIssue(ctx context.Context, in *UpdateDraftIssueInput, client GraphQLClient) (*UpdateDraftIssueOutput, error) {
+	if (in.ItemID == "") == (in.DraftIssueID == "") {
+		return nil, errors.New("exactly one of itemID or draftIssueID is required")
+	}
+	if in.Title == nil && in.Body == nil {
+		return nil, errors.New("at least one of title or body is required")
+	}
+	if err := validateNodeIDs(
+		nodeIDCheck{projectItemNode, in.ItemID},
+		nodeIDCheck{draftIssueNode, in.DraftIssueID},
+	); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	draftID := in.DraftIssueID
+	if draftID == "" {
+		var err error
+		if draftID, err = draftIssueIDForItem(ctx, client, in.ItemID); err != nil {
+			return nil, err
+		}
+	}
+
+	input := ghv4.UpdateProjectV2DraftIssueInput{
+		DraftIssueID:     ghv4.ID(draftID),
+		ClientMutationID: clientMutationID(in.ClientMutationID),
+	}
+	if in.Title != nil {
+		input.Title = ghv4.NewString(ghv4.String(*in.Title))
+	}
+	if in.Body != nil {
+		input.Body = ghv4.NewString(ghv4.String(*in.Body))
+	}
+
+	var m struct {
+		UpdateProjectV2DraftIssue struct {
+			DraftIssue struct {
+				ID    ghv4.ID
+				Title ghv4.String
+				Body  ghv4.String
+			}
+			ClientMutationID ghv4.String
+		} `graphql:"updateProjectV2DraftIssue(input: $input)"`
+	}
+	if err := graphQLMutate(ctx, client, "UpdateDraftIssue", &m, input, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+
+	d := m.UpdateProjectV2DraftIssue.DraftIssue
+	return &UpdateDraftIssueOutput{
+		DraftIssueID:     fmt.Sprint(d.ID),
+		Title:            string(d.Title),
+		Body:             string(d.Body),
+		ClientMutationID: string(m.UpdateProjectV2DraftIssue.ClientMutationID),
+	}, nil
 }
 
+// GetRateLimit reports the GraphQL rate limit budget shared by the Projects V2
+// functions using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func GetRateLimit(ctx context.Context, client GraphQLClient) (*RateLimitStatus, error) {
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	var q struct {
+		RateLimit struct {
+			Limit     ghv4.Int
+			Cost      ghv4.Int
+			Remaining ghv4.Int
+			ResetAt   ghv4.DateTime
+		}
+	}
+	if err := graphQLQuery(ctx, client, "GetRateLimit", &q, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+
+	return &RateLimitStatus{
+		Limit:     int(q.RateLimit.Limit),
+		Cost:      int(q.RateLimit.Cost),
+		Remaining: int(q.RateLimit.Remaining),
+		ResetAt:   q.RateLimit.ResetAt.UTC().Format(time.RFC3339),
+	}, nil
+}
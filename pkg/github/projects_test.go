@@ -3,16 +3,22 @@ package github
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-
 func TestListOrganizationProjects(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -74,7 +80,6 @@ func TestListOrganizationProjects(t *testing.T) {
 
 // Integration tests (real API) go in a separate section, skipped by default.
 
-
 func TestListUserProjects(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -100,7 +105,6 @@ func TestListUserProjects(t *testing.T) {
 		},
 	}
 
-
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			var server *httptest.Server
@@ -131,13 +135,13 @@ func TestListUserProjects(t *testing.T) {
 	}
 }
 
-
 func TestGetProject(t *testing.T) {
 	tests := []struct {
 		name        string
 		input       *GetProjectInput
 		mockHandler http.HandlerFunc
 		wantErr     bool
+		wantErrIs   error
 		wantID      string
 	}{
 		{
@@ -155,7 +159,44 @@ func TestGetProject(t *testing.T) {
 			wantErr: false,
 			wantID:  "proj123",
 		},
-		// Add more cases: API error, not found, etc.
+		{
+			name:  "valid user project",
+			input: &GetProjectInput{Owner: "octocat", Number: 7},
+			mockHandler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(200)
+				w.Write([]byte(`{"data":{"organization":null,"user":{"projectV2":{"id":"proj7","title":"Mine","number":7,"url":"http://example.com/p7"}}},"errors":[{"message":"Could not resolve to an Organization with the login of 'octocat'."}]}`))
+			},
+			wantID: "proj7",
+		},
+		{
+			name:  "valid org but wrong number",
+			input: &GetProjectInput{Owner: "test-owner", Number: 999},
+			mockHandler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(200)
+				w.Write([]byte(`{"data":{"organization":{"projectV2":null},"user":null},"errors":[{"message":"Could not resolve to a ProjectV2 with the number 999."}]}`))
+			},
+			wantErr:   true,
+			wantErrIs: ErrProjectNotFound,
+		},
+		{
+			name:  "nonexistent owner",
+			input: &GetProjectInput{Owner: "nobody", Number: 1},
+			mockHandler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(200)
+				w.Write([]byte(`{"data":{"organization":null,"user":null},"errors":[{"message":"Could not resolve to an Organization with the login of 'nobody'."}]}`))
+			},
+			wantErr:   true,
+			wantErrIs: ErrOwnerNotFound,
+		},
+		{
+			name:  "api error",
+			input: &GetProjectInput{Owner: "test-owner", Number: 1},
+			mockHandler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(200)
+				w.Write([]byte(`{"data":null,"errors":[{"message":"API rate limit exceeded"}]}`))
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -179,6 +220,12 @@ func TestGetProject(t *testing.T) {
 			if tc.wantErr {
 				assert.Error(t, err)
 				assert.Nil(t, out)
+				if tc.wantErrIs != nil {
+					assert.ErrorIs(t, err, tc.wantErrIs)
+				} else {
+					assert.NotErrorIs(t, err, ErrOwnerNotFound)
+					assert.NotErrorIs(t, err, ErrProjectNotFound)
+				}
 			} else {
 				require.NoError(t, err)
 				assert.NotNil(t, out)
@@ -280,15 +327,15 @@ func TestOwnerResolutionInCreateProject(t *testing.T) {
 				var buf bytes.Buffer
 				_, _ = buf.ReadFrom(r.Body)
 				body := buf.String()
-				if strings.Contains(body, "organization") {
-					w.WriteHeader(200)
-					w.Write([]byte(`{"data":{"organization":null}}`)) // Not an org
-				} else if strings.Contains(body, "user") {
-					w.WriteHeader(200)
-					w.Write([]byte(`{"data":{"user":{"id":"user123"}}}`))
-				} else if strings.Contains(body, "createProjectV2") {
+				// Match the mutation first: its variables carry the resolved
+				// "user123" ID, which would otherwise hit the user lookup branch.
+				if strings.Contains(body, "createProjectV2") {
 					w.WriteHeader(200)
 					w.Write([]byte(`{"data":{"createProjectV2":{"projectV2":{"id":"projUser","title":"Project for User","number":2,"url":"http://example.com/userproject"}}}}`))
+				} else if strings.Contains(body, "organization") {
+					// Organization and user are looked up together.
+					w.WriteHeader(200)
+					w.Write([]byte(`{"data":{"organization":null,"user":{"id":"user123"}},"errors":[{"message":"Could not resolve to an Organization"}]}`))
 				} else {
 					w.WriteHeader(400)
 					w.Write([]byte(`{"error":"unexpected request"}`))
@@ -306,10 +353,7 @@ func TestOwnerResolutionInCreateProject(t *testing.T) {
 				body := buf.String()
 				if strings.Contains(body, "organization") {
 					w.WriteHeader(200)
-					w.Write([]byte(`{"data":{"organization":null}}`))
-				} else if strings.Contains(body, "user") {
-					w.WriteHeader(200)
-					w.Write([]byte(`{"data":{"user":null}}`))
+					w.Write([]byte(`{"data":{"organization":null,"user":null}}`))
 				} else {
 					w.WriteHeader(400)
 					w.Write([]byte(`{"error":"unexpected request"}`))
@@ -383,6 +427,7 @@ func TestCreateProject(t *testing.T) {
 		input       *CreateProjectInput
 		mockHandler http.HandlerFunc
 		wantErr     bool
+		wantErrMsg  string
 		wantID      string
 	}{
 		{
@@ -390,6 +435,37 @@ func TestCreateProject(t *testing.T) {
 			input:   &CreateProjectInput{},
 			wantErr: true,
 		},
+		{
+			name:       "neither owner nor owner_id",
+			input:      &CreateProjectInput{Title: "Test Project"},
+			wantErr:    true,
+			wantErrMsg: "exactly one of owner or owner_id is required",
+		},
+		{
+			name:       "both owner and owner_id",
+			input:      &CreateProjectInput{Owner: "test-owner", OwnerID: "owner123", Title: "Test Project"},
+			wantErr:    true,
+			wantErrMsg: "exactly one of owner or owner_id is required",
+		},
+		{
+			name:  "owner_id skips owner lookup",
+			input: &CreateProjectInput{OwnerID: "owner123", Title: "Test Project"},
+			mockHandler: func(w http.ResponseWriter, r *http.Request) {
+				var buf bytes.Buffer
+				_, _ = buf.ReadFrom(r.Body)
+				body := buf.String()
+				if !strings.Contains(body, "createProjectV2") {
+					t.Errorf("unexpected owner lookup request: %s", body)
+					w.WriteHeader(400)
+					return
+				}
+				assert.Contains(t, body, `"ownerId":"owner123"`)
+				w.WriteHeader(200)
+				w.Write([]byte(`{"data":{"createProjectV2":{"projectV2":{"id":"proj789","title":"Test Project","number":789,"url":"http://example.com/project"}}}}`))
+			},
+			wantErr: false,
+			wantID:  "proj789",
+		},
 		{
 			name:  "success",
 			input: &CreateProjectInput{Owner: "test-owner", Title: "Test Project"},
@@ -434,6 +510,9 @@ func TestCreateProject(t *testing.T) {
 			out, err := CreateProject(context.Background(), tc.input, ghClient)
 			if tc.wantErr {
 				assert.Error(t, err)
+				if tc.wantErrMsg != "" {
+					assert.Contains(t, err.Error(), tc.wantErrMsg)
+				}
 				assert.Nil(t, out)
 			} else {
 				require.NoError(t, err)
@@ -553,3 +632,2320 @@ func TestUpdateProjectItemField(t *testing.T) {
 		})
 	}
 }
+
+func TestProjectsPageSizeClamping(t *testing.T) {
+	tests := []struct {
+		name      string
+		first     int
+		wantFirst float64
+	}{
+		{name: "unset uses default", first: 0, wantFirst: 30},
+		{name: "negative uses default", first: -5, wantFirst: 30},
+		{name: "within bounds is kept", first: 50, wantFirst: 50},
+		{name: "above max is clamped", first: 5000, wantFirst: 100},
+	}
+
+	calls := []struct {
+		name     string
+		response string
+		call     func(ctx context.Context, client *githubv4.Client, first int) error
+	}{
+		{
+			name:     "ListOrganizationProjects",
+			response: `{"data":{"organization":{"projectsV2":{"nodes":[],"pageInfo":{"endCursor":"","hasNextPage":false}}}}}`,
+			call: func(ctx context.Context, client *githubv4.Client, first int) error {
+				_, err := ListOrganizationProjects(ctx, &ListOrganizationProjectsInput{Organization: "test-org", First: first}, client)
+				return err
+			},
+		},
+		{
+			name:     "ListUserProjects",
+			response: `{"data":{"user":{"projectsV2":{"nodes":[],"pageInfo":{"endCursor":"","hasNextPage":false}}}}}`,
+			call: func(ctx context.Context, client *githubv4.Client, first int) error {
+				_, err := ListUserProjects(ctx, &ListUserProjectsInput{User: "test-user", First: first}, client)
+				return err
+			},
+		},
+		{
+			name:     "GetProjectItems",
+			response: `{"data":{"node":{"items":{"nodes":[],"pageInfo":{"endCursor":"","hasNextPage":false}}}}}`,
+			call: func(ctx context.Context, client *githubv4.Client, first int) error {
+				_, err := GetProjectItems(ctx, &GetProjectItemsInput{ProjectID: "proj123", First: first}, client)
+				return err
+			},
+		},
+	}
+
+	for _, c := range calls {
+		for _, tc := range tests {
+			t.Run(c.name+"/"+tc.name, func(t *testing.T) {
+				var gotVars map[string]interface{}
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					var body struct {
+						Variables map[string]interface{} `json:"variables"`
+					}
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					gotVars = body.Variables
+					w.WriteHeader(200)
+					w.Write([]byte(c.response))
+				}))
+				defer server.Close()
+
+				client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+				require.NoError(t, c.call(context.Background(), client, tc.first))
+				assert.Equal(t, tc.wantFirst, gotVars["first"])
+			})
+		}
+	}
+}
+
+func TestCreateProjectClientMutationID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query     string `json:"query"`
+			Variables struct {
+				Input map[string]interface{} `json:"input"`
+			} `json:"variables"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Contains(t, body.Query, "clientMutationId")
+		assert.Equal(t, "req-42", body.Variables.Input["clientMutationId"])
+		w.WriteHeader(200)
+		w.Write([]byte(`{"data":{"createProjectV2":{"projectV2":{"id":"proj1","title":"Test Project","number":1,"url":"http://example.com/project"},"clientMutationId":"req-42"}}}`))
+	}))
+	defer server.Close()
+
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+	out, err := CreateProject(context.Background(), &CreateProjectInput{
+		OwnerID:          "owner123",
+		Title:            "Test Project",
+		ClientMutationID: "req-42",
+	}, client)
+	require.NoError(t, err)
+	assert.Equal(t, "proj1", out.ID)
+	assert.Equal(t, "req-42", out.ClientMutationID)
+}
+
+// decodeGraphQLRequest returns the query document and variables sent to a mock GraphQL server.
+func decodeGraphQLRequest(t *testing.T, r *http.Request) (string, map[string]interface{}) {
+	t.Helper()
+	var body struct {
+		Query     string                 `json:"query"`
+		Variables map[string]interface{} `json:"variables"`
+	}
+	require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+	return body.Query, body.Variables
+}
+
+func TestReorderProjectItem(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       *ReorderProjectItemInput
+		wantAfterID interface{}
+		wantErr     bool
+	}{
+		{
+			name:    "missing project_id/item_id",
+			input:   &ReorderProjectItemInput{},
+			wantErr: true,
+		},
+		{
+			name:        "after another item",
+			input:       &ReorderProjectItemInput{ProjectID: "PVT_1", ItemID: "PVTI_2", AfterItemID: "PVTI_1"},
+			wantAfterID: "PVTI_1",
+		},
+		{
+			name:  "to front",
+			input: &ReorderProjectItemInput{ProjectID: "PVT_1", ItemID: "PVTI_2"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query, vars := decodeGraphQLRequest(t, r)
+				assert.Contains(t, query, "updateProjectV2ItemPosition")
+				input := vars["input"].(map[string]interface{})
+				assert.Equal(t, "PVTI_2", input["itemId"])
+				if tc.wantAfterID == nil {
+					assert.NotContains(t, input, "afterId")
+				} else {
+					assert.Equal(t, tc.wantAfterID, input["afterId"])
+				}
+				w.WriteHeader(200)
+				w.Write([]byte(`{"data":{"updateProjectV2ItemPosition":{"clientMutationId":null}}}`))
+			}))
+			defer server.Close()
+
+			client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+			out, err := ReorderProjectItem(context.Background(), tc.input, client)
+			if tc.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, out)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "PVTI_2", out.ItemID)
+		})
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func TestSetDefaultHTTPClient(t *testing.T) {
+	t.Setenv("GITHUB_PERSONAL_ACCESS_TOKEN", "test-token")
+
+	var gotAuth string
+	calls := 0
+	SetDefaultHTTPClient(&http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		gotAuth = r.Header.Get("Authorization")
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"data":{"user":{"projectsV2":{"nodes":[{"id":"1","number":1,"title":"Proj1","url":"http://example.com/p1"}],"pageInfo":{"endCursor":"","hasNextPage":false}}}}}`)),
+			Request:    r,
+		}, nil
+	})})
+	t.Cleanup(func() { SetDefaultHTTPClient(nil) })
+
+	out, err := ListUserProjects(context.Background(), &ListUserProjectsInput{User: "test-user"}, nil)
+	require.NoError(t, err)
+	assert.Len(t, out.Projects, 1)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, "Bearer test-token", gotAuth)
+}
+
+func TestParseProjectURL(t *testing.T) {
+	tests := []struct {
+		name       string
+		url        string
+		wantOwner  string
+		wantNumber int
+		wantErr    bool
+	}{
+		{name: "org URL", url: "https://github.com/orgs/acme/projects/12", wantOwner: "acme", wantNumber: 12},
+		{name: "user URL", url: "https://github.com/users/octocat/projects/3", wantOwner: "octocat", wantNumber: 3},
+		{name: "trailing slash", url: "https://github.com/orgs/acme/projects/12/", wantOwner: "acme", wantNumber: 12},
+		{name: "view suffix", url: "https://github.com/orgs/acme/projects/12/views/2", wantOwner: "acme", wantNumber: 12},
+		{name: "enterprise host", url: "https://ghe.example.com/orgs/acme/projects/7", wantOwner: "acme", wantNumber: 7},
+		{name: "repository URL", url: "https://github.com/acme/widgets", wantErr: true},
+		{name: "missing number", url: "https://github.com/orgs/acme/projects/", wantErr: true},
+		{name: "non-numeric number", url: "https://github.com/orgs/acme/projects/abc", wantErr: true},
+		{name: "zero number", url: "https://github.com/orgs/acme/projects/0", wantErr: true},
+		{name: "unknown owner kind", url: "https://github.com/teams/acme/projects/1", wantErr: true},
+		{name: "not a URL", url: "acme/projects/1", wantErr: true},
+		{name: "empty", url: "", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			owner, number, err := parseProjectURL(tc.url)
+			if tc.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "invalid project URL")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOwner, owner)
+			assert.Equal(t, tc.wantNumber, number)
+		})
+	}
+}
+
+func TestGetProjectByURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, vars := decodeGraphQLRequest(t, r)
+		assert.Equal(t, "acme", vars["owner"])
+		assert.Equal(t, float64(12), vars["number"])
+		w.WriteHeader(200)
+		w.Write([]byte(`{"data":{"organization":{"projectV2":{"id":"PVT_12","title":"Roadmap","number":12,"url":"https://github.com/orgs/acme/projects/12"}}}}`))
+	}))
+	defer server.Close()
+
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+	out, err := GetProjectByURL(context.Background(), &GetProjectByURLInput{URL: "https://github.com/orgs/acme/projects/12"}, client)
+	require.NoError(t, err)
+	assert.Equal(t, "PVT_12", out.ID)
+
+	_, err = GetProjectByURL(context.Background(), &GetProjectByURLInput{URL: "https://github.com/acme"}, client)
+	assert.ErrorContains(t, err, "invalid project URL")
+
+	t.Run("url in GetProjectInput", func(t *testing.T) {
+		out, err := GetProject(context.Background(), &GetProjectInput{URL: "https://github.com/orgs/acme/projects/12/views/3"}, client)
+		require.NoError(t, err)
+		assert.Equal(t, "PVT_12", out.ID)
+
+		_, err = GetProject(context.Background(), &GetProjectInput{URL: "https://github.com/orgs/acme/projects/12", Owner: "acme"}, client)
+		assert.ErrorContains(t, err, "cannot be combined")
+	})
+}
+
+func TestGetProjectItemsContentDetails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, _ := decodeGraphQLRequest(t, r)
+		assert.Contains(t, query, "... on Issue")
+		assert.Contains(t, query, "assignees(first: 10)")
+		assert.Contains(t, query, "labels(first: 20)")
+		w.WriteHeader(200)
+		w.Write([]byte(`{"data":{"node":{"items":{"nodes":[
+			{"id":"PVTI_1","content":{"__typename":"Issue","id":"I_1","title":"Fix login","issueState":"OPEN","url":"https://github.com/acme/web/issues/1",
+				"assignees":{"nodes":[{"login":"alice"},{"login":"bob"}]},
+				"labels":{"nodes":[{"name":"bug"}]},
+				"repository":{"nameWithOwner":"acme/web"}}},
+			{"id":"PVTI_2","content":{"__typename":"DraftIssue","id":"DI_1","title":"Write docs","body":"Cover the new flags"}}
+		],"pageInfo":{"endCursor":"abc","hasNextPage":false}}}}}`))
+	}))
+	defer server.Close()
+
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+	out, err := GetProjectItems(context.Background(), &GetProjectItemsInput{ProjectID: "PVT_1"}, client)
+	require.NoError(t, err)
+	require.Len(t, out.Items, 2)
+
+	issue := out.Items[0]
+	assert.Equal(t, "I_1", issue.ContentID)
+	assert.Equal(t, "Issue", issue.ContentType)
+	assert.Equal(t, "OPEN", issue.State)
+	assert.Equal(t, []string{"alice", "bob"}, issue.Assignees)
+	assert.Equal(t, []string{"bug"}, issue.Labels)
+	assert.Equal(t, "acme/web", issue.Repository)
+	assert.Empty(t, issue.Body)
+
+	draft := out.Items[1]
+	assert.Equal(t, "DI_1", draft.ContentID)
+	assert.Equal(t, "Write docs", draft.Title)
+	assert.Equal(t, "Cover the new flags", draft.Body)
+	assert.Empty(t, draft.Assignees)
+	assert.Empty(t, draft.Labels)
+	assert.Empty(t, draft.Repository)
+}
+
+func TestSetProjectTemplate(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        *SetProjectTemplateInput
+		wantMutation string
+		response     string
+		wantTemplate bool
+		wantErr      bool
+	}{
+		{
+			name:    "missing project_id",
+			input:   &SetProjectTemplateInput{IsTemplate: true},
+			wantErr: true,
+		},
+		{
+			name:         "mark",
+			input:        &SetProjectTemplateInput{ProjectID: "PVT_1", IsTemplate: true},
+			wantMutation: "markProjectV2AsTemplate(input: $input)",
+			response:     `{"data":{"markProjectV2AsTemplate":{"projectV2":{"id":"PVT_1","template":true}}}}`,
+			wantTemplate: true,
+		},
+		{
+			name:         "unmark",
+			input:        &SetProjectTemplateInput{ProjectID: "PVT_1", IsTemplate: false},
+			wantMutation: "unmarkProjectV2AsTemplate(input: $input)",
+			response:     `{"data":{"unmarkProjectV2AsTemplate":{"projectV2":{"id":"PVT_1","template":false}}}}`,
+			wantTemplate: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query, vars := decodeGraphQLRequest(t, r)
+				assert.Contains(t, query, tc.wantMutation)
+				if tc.input.IsTemplate {
+					assert.NotContains(t, query, "unmarkProjectV2AsTemplate")
+				}
+				assert.Equal(t, "PVT_1", vars["input"].(map[string]interface{})["projectId"])
+				w.WriteHeader(200)
+				w.Write([]byte(tc.response))
+			}))
+			defer server.Close()
+
+			client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+			out, err := SetProjectTemplate(context.Background(), tc.input, client)
+			if tc.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, out)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "PVT_1", out.ProjectID)
+			assert.Equal(t, tc.wantTemplate, out.IsTemplate)
+		})
+	}
+}
+
+func TestListProjectsToolsOwnerKind(t *testing.T) {
+	// Answers the batched listing and owner lookup as if "octocat" were a
+	// user and "acme" an organization.
+	page := `{"projectsV2":{"nodes":[],"pageInfo":{"endCursor":"","hasNextPage":false}}}`
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		query, vars := decodeGraphQLRequest(t, r)
+		listsOrg := strings.Contains(query, "organization(login: $owner){projectsV2")
+		w.WriteHeader(200)
+		switch {
+		case vars["owner"] == "nobody":
+			w.Write([]byte(`{"data":{"organization":null,"organization1":null,"user":null},"errors":[{"message":"Could not resolve to an Organization"}]}`))
+		case vars["owner"] == "acme" && listsOrg:
+			w.Write([]byte(`{"data":{"organization":` + page + `,"organization1":{"id":"O_1"},"user":null},"errors":[{"message":"Could not resolve to a User"}]}`))
+		case vars["owner"] == "acme":
+			w.Write([]byte(`{"data":{"user":null,"organization":{"id":"O_1"},"user1":null},"errors":[{"message":"Could not resolve to a User"}]}`))
+		case listsOrg:
+			w.Write([]byte(`{"data":{"organization":null,"organization1":null,"user":{"id":"U_1"}},"errors":[{"message":"Could not resolve to an Organization"}]}`))
+		default:
+			w.Write([]byte(`{"data":{"user":` + page + `,"organization":null,"user1":{"id":"U_1"}},"errors":[{"message":"Could not resolve to an Organization"}]}`))
+		}
+	}))
+	defer server.Close()
+	getClient := stubGetGraphQLClientFn(githubv4.NewEnterpriseClient(server.URL, server.Client()))
+
+	_, listOrg := ListOrganizationProjectsTool(getClient, translations.NullTranslationHelper)
+	_, err := listOrg(context.Background(), createMCPRequest(map[string]interface{}{"organization": "octocat"}))
+	assert.ErrorContains(t, err, "octocat is a user, not an organization")
+	_, err = listOrg(context.Background(), createMCPRequest(map[string]interface{}{"organization": "acme"}))
+	assert.NoError(t, err)
+
+	_, listUser := ListUserProjectsTool(getClient, translations.NullTranslationHelper)
+	_, err = listUser(context.Background(), createMCPRequest(map[string]interface{}{"user": "acme"}))
+	assert.ErrorContains(t, err, "acme is an organization, not a user")
+	_, err = listUser(context.Background(), createMCPRequest(map[string]interface{}{"user": "octocat"}))
+	assert.NoError(t, err)
+	assert.Equal(t, 4, requests)
+
+	_, err = listOrg(context.Background(), createMCPRequest(map[string]interface{}{"organization": "nobody"}))
+	assert.ErrorIs(t, err, ErrOwnerNotFound)
+}
+
+func TestUpdateProjectItemFieldBulk(t *testing.T) {
+	done := "opt_done"
+
+	t.Run("invalid input", func(t *testing.T) {
+		text := "x"
+		for name, in := range map[string]*UpdateProjectItemFieldBulkInput{
+			"no items":    {ProjectID: "PVT_1", FieldID: "PVTF_1", Value: ProjectFieldValue{SingleSelectOptionID: done}},
+			"no value":    {ProjectID: "PVT_1", FieldID: "PVTF_1", ItemIDs: []string{"PVTI_1"}},
+			"two values":  {ProjectID: "PVT_1", FieldID: "PVTF_1", ItemIDs: []string{"PVTI_1"}, Value: ProjectFieldValue{Text: &text, IterationID: "it1"}},
+			"bad date":    {ProjectID: "PVT_1", FieldID: "PVTF_1", ItemIDs: []string{"PVTI_1"}, Value: ProjectFieldValue{Date: "12/01/2025"}},
+			"no field_id": {ProjectID: "PVT_1", ItemIDs: []string{"PVTI_1"}, Value: ProjectFieldValue{Text: &text}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				out, err := UpdateProjectItemFieldBulk(context.Background(), in, githubv4.NewClient(nil))
+				assert.Error(t, err)
+				assert.Nil(t, out)
+			})
+		}
+	})
+
+	t.Run("partial failure", func(t *testing.T) {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			query, vars := decodeGraphQLRequest(t, r)
+			assert.Contains(t, query, "item0: updateProjectV2ItemFieldValue(input: $input){projectV2Item{id}}")
+			assert.Contains(t, query, "item1: updateProjectV2ItemFieldValue(input: $input1)")
+			assert.Contains(t, query, "item2: updateProjectV2ItemFieldValue(input: $input2)")
+			assert.Contains(t, query, "$input1:UpdateProjectV2ItemFieldValueInput!")
+			assert.Equal(t, "PVTI_1", vars["input"].(map[string]interface{})["itemId"])
+			assert.Equal(t, "PVTI_bad", vars["input1"].(map[string]interface{})["itemId"])
+			assert.Equal(t, "PVTI_3", vars["input2"].(map[string]interface{})["itemId"])
+			value := vars["input2"].(map[string]interface{})["value"].(map[string]interface{})
+			assert.Equal(t, map[string]interface{}{"singleSelectOptionId": done}, value)
+
+			w.WriteHeader(200)
+			w.Write([]byte(`{"data":{"item0":{"projectV2Item":{"id":"PVTI_1"}},"item1":null,"item2":{"projectV2Item":{"id":"PVTI_3"}}},` +
+				`"errors":[{"message":"Could not resolve to a node with the global id of 'PVTI_bad'"}]}`))
+		}))
+		defer server.Close()
+
+		client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+		out, err := UpdateProjectItemFieldBulk(context.Background(), &UpdateProjectItemFieldBulkInput{
+			ProjectID: "PVT_1",
+			FieldID:   "PVTF_1",
+			Value:     ProjectFieldValue{SingleSelectOptionID: done},
+			ItemIDs:   []string{"PVTI_1", "PVTI_bad", "", "PVTI_3"},
+		}, client)
+		require.NoError(t, err)
+		assert.Equal(t, 1, requests)
+		require.Len(t, out.Results, 4)
+		assert.Equal(t, BulkItemResult{ItemID: "PVTI_1"}, out.Results[0])
+		assert.Equal(t, "PVTI_bad", out.Results[1].ItemID)
+		assert.Contains(t, out.Results[1].Error, "PVTI_bad")
+		assert.Equal(t, "item ID is empty", out.Results[2].Error)
+		assert.Equal(t, BulkItemResult{ItemID: "PVTI_3"}, out.Results[3])
+		assert.Equal(t, 2, out.Succeeded)
+		assert.Equal(t, 2, out.Failed)
+	})
+
+	t.Run("batches", func(t *testing.T) {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			_, vars := decodeGraphQLRequest(t, r)
+			data := map[string]interface{}{}
+			for i := 0; i < len(vars); i++ {
+				data["item"+strconv.Itoa(i)] = map[string]interface{}{"projectV2Item": map[string]interface{}{"id": "x"}}
+			}
+			w.WriteHeader(200)
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+		}))
+		defer server.Close()
+
+		ids := make([]string, bulkUpdateBatchSize+1)
+		for i := range ids {
+			ids[i] = "PVTI_" + strconv.Itoa(i)
+		}
+		text := "done"
+		client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+		out, err := UpdateProjectItemFieldBulk(context.Background(), &UpdateProjectItemFieldBulkInput{
+			ProjectID: "PVT_1",
+			FieldID:   "PVTF_1",
+			Value:     ProjectFieldValue{Text: &text},
+			ItemIDs:   ids,
+		}, client)
+		require.NoError(t, err)
+		assert.Equal(t, 2, requests)
+		assert.Equal(t, len(ids), out.Succeeded)
+		assert.Zero(t, out.Failed)
+	})
+}
+
+func TestGetRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, _ := decodeGraphQLRequest(t, r)
+		assert.Contains(t, query, "rateLimit{limit,cost,remaining,resetAt}")
+		w.WriteHeader(200)
+		w.Write([]byte(`{"data":{"rateLimit":{"limit":5000,"cost":1,"remaining":4321,"resetAt":"2025-06-01T12:30:00Z"}}}`))
+	}))
+	defer server.Close()
+
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+	out, err := GetRateLimit(context.Background(), client)
+	require.NoError(t, err)
+	assert.Equal(t, &RateLimitStatus{Limit: 5000, Cost: 1, Remaining: 4321, ResetAt: "2025-06-01T12:30:00Z"}, out)
+
+	t.Run("api error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(500)
+		}))
+		defer server.Close()
+
+		out, err := GetRateLimit(context.Background(), githubv4.NewEnterpriseClient(server.URL, server.Client()))
+		assert.Error(t, err)
+		assert.Nil(t, out)
+	})
+}
+
+func TestUpdateDraftIssue(t *testing.T) {
+	body := "new body"
+
+	t.Run("invalid input", func(t *testing.T) {
+		for name, in := range map[string]*UpdateDraftIssueInput{
+			"no id":         {Body: &body},
+			"both ids":      {ItemID: "PVTI_1", DraftIssueID: "DI_1", Body: &body},
+			"no title/body": {DraftIssueID: "DI_1"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				out, err := UpdateDraftIssue(context.Background(), in, githubv4.NewClient(nil))
+				assert.Error(t, err)
+				assert.Nil(t, out)
+			})
+		}
+	})
+
+	t.Run("body only by item ID", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			query, vars := decodeGraphQLRequest(t, r)
+			w.WriteHeader(200)
+			if strings.Contains(query, "node(id: $id)") {
+				assert.Equal(t, "PVTI_1", vars["id"])
+				w.Write([]byte(`{"data":{"node":{"content":{"id":"DI_1"}}}}`))
+				return
+			}
+			assert.Contains(t, query, "updateProjectV2DraftIssue(input: $input)")
+			input := vars["input"].(map[string]interface{})
+			assert.Equal(t, map[string]interface{}{"draftIssueId": "DI_1", "body": body}, input)
+			w.Write([]byte(`{"data":{"updateProjectV2DraftIssue":{"draftIssue":{"id":"DI_1","title":"Unchanged","body":"new body"},"clientMutationId":""}}}`))
+		}))
+		defer server.Close()
+
+		client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+		out, err := UpdateDraftIssue(context.Background(), &UpdateDraftIssueInput{ItemID: "PVTI_1", Body: &body}, client)
+		require.NoError(t, err)
+		assert.Equal(t, &UpdateDraftIssueOutput{DraftIssueID: "DI_1", Title: "Unchanged", Body: body}, out)
+	})
+
+	t.Run("item is not a draft", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(200)
+			w.Write([]byte(`{"data":{"node":{"content":{}}}}`))
+		}))
+		defer server.Close()
+
+		client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+		out, err := UpdateDraftIssue(context.Background(), &UpdateDraftIssueInput{ItemID: "PVTI_2", Body: &body}, client)
+		assert.EqualError(t, err, "item PVTI_2 is not a draft issue")
+		assert.Nil(t, out)
+	})
+}
+
+func TestGetProjectWithItems(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		query, vars := decodeGraphQLRequest(t, r)
+		assert.Contains(t, query, "items(first: $first)")
+		assert.Equal(t, "test-org", vars["owner"])
+		assert.Equal(t, float64(5), vars["first"])
+		w.WriteHeader(200)
+		w.Write([]byte(`{"data":{"organization":{"projectV2":{"id":"PVT_1","number":3,"title":"Roadmap","url":"https://github.com/orgs/test-org/projects/3",` +
+			`"items":{"nodes":[{"id":"PVTI_1","content":{"__typename":"DraftIssue","id":"DI_1","title":"Idea"}}],"pageInfo":{"endCursor":"c1","hasNextPage":true}}}},` +
+			`"user":null},"errors":[{"message":"Could not resolve to a User with the login of 'test-org'."}]}`))
+	}))
+	defer server.Close()
+
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+	out, err := GetProjectWithItems(context.Background(), &GetProjectWithItemsInput{Owner: "test-org", Number: 3, First: 5}, client)
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+	assert.Equal(t, Project{ID: "PVT_1", Number: 3, Title: "Roadmap", URL: "https://github.com/orgs/test-org/projects/3"}, out.Project)
+	require.Len(t, out.Items.Items, 1)
+	assert.Equal(t, "PVTI_1", out.Items.Items[0].ID)
+	assert.Equal(t, "Idea", out.Items.Items[0].Title)
+	assert.Equal(t, "c1", out.Items.EndCursor)
+	assert.True(t, out.Items.HasNextPage)
+
+	t.Run("missing project", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(200)
+			w.Write([]byte(`{"data":{"organization":{"projectV2":null},"user":null},"errors":[{"message":"Could not resolve to a ProjectV2 with the number 9."}]}`))
+		}))
+		defer server.Close()
+
+		client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+		out, err := GetProjectWithItems(context.Background(), &GetProjectWithItemsInput{Owner: "test-org", Number: 9}, client)
+		assert.ErrorIs(t, err, ErrProjectNotFound)
+		assert.Nil(t, out)
+	})
+}
+
+func TestGetAllProjectItems(t *testing.T) {
+	// Serves three pages of two items each, using the page index as the cursor.
+	newServer := func(t *testing.T, requests *int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, vars := decodeGraphQLRequest(t, r)
+			page := 0
+			if after, _ := vars["after"].(string); after != "" {
+				page, _ = strconv.Atoi(after)
+			}
+			*requests++
+			w.WriteHeader(200)
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"node": map[string]interface{}{"items": map[string]interface{}{
+				"nodes": []map[string]interface{}{
+					{"id": fmt.Sprintf("PVTI_%d", 2*page), "content": nil},
+					{"id": fmt.Sprintf("PVTI_%d", 2*page+1), "content": nil},
+				},
+				"pageInfo": map[string]interface{}{"endCursor": strconv.Itoa(page + 1), "hasNextPage": page < 2},
+			}}}})
+		}))
+	}
+
+	tests := []struct {
+		name          string
+		input         *GetAllProjectItemsInput
+		wantItems     int
+		wantRequests  int
+		wantTruncated bool
+		wantNextPage  bool
+	}{
+		{name: "all pages", input: &GetAllProjectItemsInput{ProjectID: "PVT_1"}, wantItems: 6, wantRequests: 3},
+		{name: "request budget", input: &GetAllProjectItemsInput{ProjectID: "PVT_1", MaxRequests: 1}, wantItems: 2, wantRequests: 1, wantTruncated: true, wantNextPage: true},
+		{name: "item budget", input: &GetAllProjectItemsInput{ProjectID: "PVT_1", MaxItems: 4}, wantItems: 4, wantRequests: 2, wantTruncated: true, wantNextPage: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			requests := 0
+			server := newServer(t, &requests)
+			defer server.Close()
+
+			client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+			out, err := GetAllProjectItems(context.Background(), tc.input, client)
+			require.NoError(t, err)
+			assert.Len(t, out.Items, tc.wantItems)
+			assert.Equal(t, tc.wantRequests, requests)
+			assert.Equal(t, tc.wantTruncated, out.Truncated)
+			assert.Equal(t, tc.wantNextPage, out.HasNextPage)
+		})
+	}
+
+	t.Run("missing project_id", func(t *testing.T) {
+		out, err := GetAllProjectItems(context.Background(), &GetAllProjectItemsInput{}, githubv4.NewClient(nil))
+		assert.Error(t, err)
+		assert.Nil(t, out)
+	})
+}
+
+// stubGraphQLClient is a GraphQLClient that answers from canned JSON without
+// any HTTP, decoding it into the query or mutation struct.
+type stubGraphQLClient struct {
+	response string
+	err      error
+	inputs   []githubv4.Input
+}
+
+func (s *stubGraphQLClient) Query(_ context.Context, q interface{}, _ map[string]interface{}) error {
+	if s.err != nil {
+		return s.err
+	}
+	return json.Unmarshal([]byte(s.response), q)
+}
+
+func (s *stubGraphQLClient) Mutate(_ context.Context, m interface{}, input githubv4.Input, _ map[string]interface{}) error {
+	s.inputs = append(s.inputs, input)
+	if s.err != nil {
+		return s.err
+	}
+	return json.Unmarshal([]byte(s.response), m)
+}
+
+func TestGraphQLClientInterface(t *testing.T) {
+	var _ GraphQLClient = (*githubv4.Client)(nil)
+
+	t.Run("fake mutation", func(t *testing.T) {
+		client := &stubGraphQLClient{response: `{"markProjectV2AsTemplate":{"projectV2":{"id":"PVT_1","template":true}}}`}
+		out, err := SetProjectTemplate(context.Background(), &SetProjectTemplateInput{ProjectID: "PVT_1", IsTemplate: true}, client)
+		require.NoError(t, err)
+		assert.True(t, out.IsTemplate)
+		require.Len(t, client.inputs, 1)
+		assert.Equal(t, githubv4.ID("PVT_1"), client.inputs[0].(githubv4.MarkProjectV2AsTemplateInput).ProjectID)
+	})
+
+	t.Run("fake query", func(t *testing.T) {
+		client := &stubGraphQLClient{response: `{"rateLimit":{"limit":5000,"cost":1,"remaining":10,"resetAt":"2025-06-01T12:30:00Z"}}`}
+		out, err := GetRateLimit(context.Background(), client)
+		require.NoError(t, err)
+		assert.Equal(t, 10, out.Remaining)
+	})
+
+	t.Run("fake error", func(t *testing.T) {
+		client := &stubGraphQLClient{err: errors.New("boom")}
+		out, err := GetProjectItems(context.Background(), &GetProjectItemsInput{ProjectID: "PVT_1"}, client)
+		assert.ErrorContains(t, err, "boom")
+		assert.Nil(t, out)
+	})
+
+	t.Run("nil *githubv4.Client uses the default client", func(t *testing.T) {
+		t.Setenv("GITHUB_PERSONAL_ACCESS_TOKEN", "")
+		var client *githubv4.Client
+		_, err := GetRateLimit(context.Background(), client)
+		assert.EqualError(t, err, "GITHUB_PERSONAL_ACCESS_TOKEN not set")
+	})
+}
+
+func TestUpdateProjectItemFieldTypedValue(t *testing.T) {
+	optionsResponse := `{"data":{"node":{"name":"Status","options":[{"id":"opt_todo","name":"Todo"},{"id":"opt_done","name":"Done"}]}}}`
+
+	tests := []struct {
+		name      string
+		input     *UpdateProjectItemFieldInput
+		wantValue map[string]interface{}
+		wantErr   string
+	}{
+		{
+			name:      "text by default",
+			input:     &UpdateProjectItemFieldInput{ProjectID: "PVT_1", ItemID: "PVTI_1", FieldID: "PVTF_1", Value: "hello"},
+			wantValue: map[string]interface{}{"text": "hello"},
+		},
+		{
+			name:      "number",
+			input:     &UpdateProjectItemFieldInput{ProjectID: "PVT_1", ItemID: "PVTI_1", FieldID: "PVTF_1", FieldType: FieldTypeNumber, Value: "2.5"},
+			wantValue: map[string]interface{}{"number": 2.5},
+		},
+		{
+			name:      "date",
+			input:     &UpdateProjectItemFieldInput{ProjectID: "PVT_1", ItemID: "PVTI_1", FieldID: "PVTF_1", FieldType: FieldTypeDate, Value: "2025-06-01"},
+			wantValue: map[string]interface{}{"date": "2025-06-01T00:00:00Z"},
+		},
+		{
+			name:      "single select by name",
+			input:     &UpdateProjectItemFieldInput{ProjectID: "PVT_1", ItemID: "PVTI_1", FieldID: "PVTSSF_1", FieldType: FieldTypeSingleSelect, Value: "done"},
+			wantValue: map[string]interface{}{"singleSelectOptionId": "opt_done"},
+		},
+		{
+			name:      "single select by ID",
+			input:     &UpdateProjectItemFieldInput{ProjectID: "PVT_1", ItemID: "PVTI_1", FieldID: "PVTSSF_1", FieldType: FieldTypeSingleSelect, Value: "opt_todo"},
+			wantValue: map[string]interface{}{"singleSelectOptionId": "opt_todo"},
+		},
+		{
+			name:      "iteration",
+			input:     &UpdateProjectItemFieldInput{ProjectID: "PVT_1", ItemID: "PVTI_1", FieldID: "PVTIF_1", FieldType: FieldTypeIteration, Value: "it_1"},
+			wantValue: map[string]interface{}{"iterationId": "it_1"},
+		},
+		{
+			name:    "unknown option",
+			input:   &UpdateProjectItemFieldInput{ProjectID: "PVT_1", ItemID: "PVTI_1", FieldID: "PVTSSF_1", FieldType: FieldTypeSingleSelect, Value: "Blocked"},
+			wantErr: `no option "Blocked" on field Status; options are: Todo, Done`,
+		},
+		{
+			name:    "bad number",
+			input:   &UpdateProjectItemFieldInput{ProjectID: "PVT_1", ItemID: "PVTI_1", FieldID: "PVTF_1", FieldType: FieldTypeNumber, Value: "lots"},
+			wantErr: `invalid number "lots"`,
+		},
+		{
+			name:    "unknown type",
+			input:   &UpdateProjectItemFieldInput{ProjectID: "PVT_1", ItemID: "PVTI_1", FieldID: "PVTF_1", FieldType: "color", Value: "red"},
+			wantErr: `unknown field type "color": expected text, number, date, single_select or iteration`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query, vars := decodeGraphQLRequest(t, r)
+				w.WriteHeader(200)
+				if strings.Contains(query, "ProjectV2SingleSelectField") {
+					w.Write([]byte(optionsResponse))
+					return
+				}
+				assert.Contains(t, query, "$input:UpdateProjectV2ItemFieldValueInput!")
+				assert.Contains(t, query, "projectV2Item{id}")
+				assert.Equal(t, tc.wantValue, vars["input"].(map[string]interface{})["value"])
+				w.Write([]byte(`{"data":{"updateProjectV2ItemFieldValue":{"projectV2Item":{"id":"PVTI_1"},"clientMutationId":""}}}`))
+			}))
+			defer server.Close()
+
+			client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+			out, err := UpdateProjectItemField(context.Background(), tc.input, client)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				assert.Nil(t, out)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "PVTI_1", out.Item.ID)
+		})
+	}
+}
+
+func TestListProjectFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, vars := decodeGraphQLRequest(t, r)
+		assert.Contains(t, query, "... on ProjectV2FieldCommon")
+		assert.Contains(t, query, "... on ProjectV2SingleSelectField")
+		assert.Contains(t, query, "... on ProjectV2IterationField")
+		assert.Equal(t, "PVT_1", vars["id"])
+		w.WriteHeader(200)
+		w.Write([]byte(`{"data":{"node":{"fields":{"nodes":[
+			{"id":"PVTF_1","name":"Title","dataType":"TITLE"},
+			{"id":"PVTSSF_1","name":"Status","dataType":"SINGLE_SELECT","options":[{"id":"opt_todo","name":"Todo"},{"id":"opt_done","name":"Done"}]},
+			{"id":"PVTIF_1","name":"Sprint","dataType":"ITERATION","configuration":{"iterations":[{"id":"it_1","title":"Sprint 1","startDate":"2025-06-02","duration":14}]}}
+		],"pageInfo":{"endCursor":"c1","hasNextPage":false}}}}}`))
+	}))
+	defer server.Close()
+
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+	out, err := ListProjectFields(context.Background(), &ListProjectFieldsInput{ProjectID: "PVT_1"}, client)
+	require.NoError(t, err)
+	assert.Equal(t, []ProjectField{
+		{ID: "PVTF_1", Name: "Title", DataType: "TITLE"},
+		{ID: "PVTSSF_1", Name: "Status", DataType: "SINGLE_SELECT", Options: []ProjectFieldOption{{ID: "opt_todo", Name: "Todo"}, {ID: "opt_done", Name: "Done"}}},
+		{ID: "PVTIF_1", Name: "Sprint", DataType: "ITERATION", Iterations: []ProjectIteration{{ID: "it_1", Title: "Sprint 1", StartDate: "2025-06-02", Duration: 14}}},
+	}, out.Fields)
+	assert.Equal(t, "c1", out.EndCursor)
+	assert.False(t, out.HasNextPage)
+
+	t.Run("missing project_id", func(t *testing.T) {
+		out, err := ListProjectFields(context.Background(), &ListProjectFieldsInput{}, client)
+		assert.Error(t, err)
+		assert.Nil(t, out)
+	})
+}
+
+func TestDeleteProjectItem(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   *DeleteProjectItemInput
+		wantErr bool
+	}{
+		{name: "missing project_id", input: &DeleteProjectItemInput{ItemID: "PVTI_1"}, wantErr: true},
+		{name: "missing item_id", input: &DeleteProjectItemInput{ProjectID: "PVT_1"}, wantErr: true},
+		{name: "swapped IDs", input: &DeleteProjectItemInput{ProjectID: "PVTI_1", ItemID: "PVT_1"}, wantErr: true},
+		{name: "success", input: &DeleteProjectItemInput{ProjectID: "PVT_1", ItemID: "PVTI_1", ClientMutationID: "m1"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query, vars := decodeGraphQLRequest(t, r)
+				assert.Contains(t, query, "deleteProjectV2Item(input: $input){deletedItemId,clientMutationId}")
+				assert.Equal(t, map[string]interface{}{"projectId": "PVT_1", "itemId": "PVTI_1", "clientMutationId": "m1"}, vars["input"])
+				w.WriteHeader(200)
+				w.Write([]byte(`{"data":{"deleteProjectV2Item":{"deletedItemId":"PVTI_1","clientMutationId":"m1"}}}`))
+			}))
+			defer server.Close()
+
+			client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+			out, err := DeleteProjectItem(context.Background(), tc.input, client)
+			if tc.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, out)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, &DeleteProjectItemOutput{DeletedItemID: "PVTI_1", ClientMutationID: "m1"}, out)
+		})
+	}
+}
+
+func TestAddProjectDraftIssue(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     *AddProjectDraftIssueInput
+		wantInput map[string]interface{}
+		wantErr   bool
+	}{
+		{name: "missing title", input: &AddProjectDraftIssueInput{ProjectID: "PVT_1"}, wantErr: true},
+		{
+			name:      "title only",
+			input:     &AddProjectDraftIssueInput{ProjectID: "PVT_1", Title: "Idea"},
+			wantInput: map[string]interface{}{"projectId": "PVT_1", "title": "Idea"},
+		},
+		{
+			name:      "title and body",
+			input:     &AddProjectDraftIssueInput{ProjectID: "PVT_1", Title: "Idea", Body: "Details"},
+			wantInput: map[string]interface{}{"projectId": "PVT_1", "title": "Idea", "body": "Details"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query, vars := decodeGraphQLRequest(t, r)
+				assert.Contains(t, query, "$input:AddProjectV2DraftIssueInput!")
+				assert.Equal(t, tc.wantInput, vars["input"])
+				w.WriteHeader(200)
+				w.Write([]byte(`{"data":{"addProjectV2DraftIssue":{"projectItem":{"id":"PVTI_9","content":{"__typename":"DraftIssue","id":"DI_9","title":"Idea","body":"Details"}},"clientMutationId":""}}}`))
+			}))
+			defer server.Close()
+
+			client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+			out, err := AddProjectDraftIssue(context.Background(), tc.input, client)
+			if tc.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, out)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, ProjectItem{ID: "PVTI_9", ContentID: "DI_9", ContentType: "DraftIssue", Title: "Idea", Body: "Details"}, out.Item)
+		})
+	}
+}
+
+func TestUpdateProject(t *testing.T) {
+	title := "Renamed"
+	closed := false
+
+	t.Run("nothing to update", func(t *testing.T) {
+		out, err := UpdateProject(context.Background(), &UpdateProjectInput{ProjectID: "PVT_1"}, githubv4.NewClient(nil))
+		assert.Error(t, err)
+		assert.Nil(t, out)
+	})
+
+	t.Run("sends only set fields", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			query, vars := decodeGraphQLRequest(t, r)
+			assert.Contains(t, query, "updateProjectV2(input: $input)")
+			assert.Equal(t, map[string]interface{}{"projectId": "PVT_1", "title": "Renamed", "closed": false}, vars["input"])
+			w.WriteHeader(200)
+			w.Write([]byte(`{"data":{"updateProjectV2":{"projectV2":{"id":"PVT_1","number":4,"title":"Renamed","url":"https://github.com/orgs/acme/projects/4",` +
+				`"shortDescription":"Q3 work","readme":"","public":true,"closed":false},"clientMutationId":""}}}`))
+		}))
+		defer server.Close()
+
+		client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+		out, err := UpdateProject(context.Background(), &UpdateProjectInput{ProjectID: "PVT_1", Title: &title, Closed: &closed}, client)
+		require.NoError(t, err)
+		assert.Equal(t, "Renamed", out.Title)
+		assert.Equal(t, 4, out.Number)
+		assert.Equal(t, "Q3 work", out.ShortDescription)
+		assert.True(t, out.Public)
+		assert.False(t, out.Closed)
+	})
+}
+
+func TestDeleteProject(t *testing.T) {
+	t.Run("missing project_id", func(t *testing.T) {
+		out, err := DeleteProject(context.Background(), &DeleteProjectInput{}, githubv4.NewClient(nil))
+		assert.Error(t, err)
+		assert.Nil(t, out)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			query, vars := decodeGraphQLRequest(t, r)
+			assert.Contains(t, query, "deleteProjectV2(input: $input)")
+			assert.Equal(t, map[string]interface{}{"projectId": "PVT_1"}, vars["input"])
+			w.WriteHeader(200)
+			w.Write([]byte(`{"data":{"deleteProjectV2":{"projectV2":{"id":"PVT_1"},"clientMutationId":""}}}`))
+		}))
+		defer server.Close()
+
+		client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+		out, err := DeleteProject(context.Background(), &DeleteProjectInput{ProjectID: "PVT_1"}, client)
+		require.NoError(t, err)
+		assert.Equal(t, "PVT_1", out.DeletedProjectID)
+	})
+}
+
+func TestGetProjectItemsFieldValues(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, _ := decodeGraphQLRequest(t, r)
+		assert.Contains(t, query, "fieldValues(first: 100)")
+		assert.Contains(t, query, "... on ProjectV2ItemFieldSingleSelectValue")
+		w.WriteHeader(200)
+		w.Write([]byte(`{"data":{"node":{"items":{"nodes":[
+			{"id":"PVTI_1","content":null,"fieldValues":{"nodes":[
+				{"__typename":"ProjectV2ItemFieldTextValue","text":"Write docs","field":{"name":"Title"}},
+				{"__typename":"ProjectV2ItemFieldSingleSelectValue","name":"In Progress","field":{"name":"Status"}},
+				{"__typename":"ProjectV2ItemFieldNumberValue","number":3,"field":{"name":"Estimate"}},
+				{"__typename":"ProjectV2ItemFieldDateValue","date":"2025-06-30","field":{"name":"Due"}},
+				{"__typename":"ProjectV2ItemFieldIterationValue","iterationId":"it_1","title":"Sprint 1","startDate":"2025-06-02","duration":14,"field":{"name":"Sprint"}},
+				{"__typename":"ProjectV2ItemFieldLabelValue"}
+			]}},
+			{"id":"PVTI_2","content":null,"fieldValues":{"nodes":[]}},
+			{"id":"PVTI_3","content":null,"fieldValues":{"nodes":[
+				{"__typename":"ProjectV2ItemFieldTextValue","text":"Triage","field":{"name":"Title"}}
+			],"pageInfo":{"hasNextPage":true}}}
+		],"pageInfo":{"endCursor":"abc","hasNextPage":false}}}}}`))
+	}))
+	defer server.Close()
+
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+	out, err := GetProjectItems(context.Background(), &GetProjectItemsInput{ProjectID: "PVT_1"}, client)
+	require.NoError(t, err)
+	require.Len(t, out.Items, 3)
+
+	assert.Equal(t, map[string]interface{}{
+		"Title":    "Write docs",
+		"Status":   "In Progress",
+		"Estimate": float64(3),
+		"Due":      "2025-06-30",
+		"Sprint":   ProjectIteration{ID: "it_1", Title: "Sprint 1", StartDate: "2025-06-02", Duration: 14},
+	}, out.Items[0].FieldValues)
+	assert.False(t, out.Items[0].FieldValuesTruncated)
+	assert.Nil(t, out.Items[1].FieldValues)
+	assert.True(t, out.Items[2].FieldValuesTruncated)
+}
+
+func TestProjectItemFilter(t *testing.T) {
+	items := []ProjectItem{
+		{ID: "1", ContentType: "Issue", State: "OPEN", Assignees: []string{"alice"}, Labels: []string{"bug"}, FieldValues: map[string]interface{}{"Status": "In Progress"}},
+		{ID: "2", ContentType: "PullRequest", State: "MERGED", Assignees: []string{"bob"}, FieldValues: map[string]interface{}{"Status": "Done"}},
+		{ID: "3", ContentType: "DraftIssue", FieldValues: map[string]interface{}{"Sprint": ProjectIteration{Title: "Sprint 1"}}},
+		{ID: "4", ContentType: "Issue", State: "CLOSED", Labels: []string{"Bug", "docs"}},
+	}
+
+	tests := []struct {
+		name    string
+		filter  ProjectItemFilter
+		wantIDs []string
+	}{
+		{name: "content type", filter: ProjectItemFilter{ContentType: "issue"}, wantIDs: []string{"1", "4"}},
+		{name: "open", filter: ProjectItemFilter{State: "open"}, wantIDs: []string{"1"}},
+		{name: "closed includes merged", filter: ProjectItemFilter{State: "CLOSED"}, wantIDs: []string{"2", "4"}},
+		{name: "assignee", filter: ProjectItemFilter{Assignee: "Bob"}, wantIDs: []string{"2"}},
+		{name: "label", filter: ProjectItemFilter{Label: "bug"}, wantIDs: []string{"1", "4"}},
+		{name: "field value", filter: ProjectItemFilter{FieldName: "status", FieldValue: "in progress"}, wantIDs: []string{"1"}},
+		{name: "iteration title", filter: ProjectItemFilter{FieldName: "Sprint", FieldValue: "Sprint 1"}, wantIDs: []string{"3"}},
+		{name: "combined", filter: ProjectItemFilter{ContentType: "issue", Label: "bug", State: "closed"}, wantIDs: []string{"4"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.filter.validate())
+			var ids []string
+			for _, item := range items {
+				if tc.filter.matches(item) {
+					ids = append(ids, item.ID)
+				}
+			}
+			assert.Equal(t, tc.wantIDs, ids)
+		})
+	}
+
+	for name, f := range map[string]ProjectItemFilter{
+		"bad content type":    {ContentType: "epic"},
+		"bad state":           {State: "merged"},
+		"field without value": {FieldName: "Status"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, f.validate())
+		})
+	}
+}
+
+func TestGetProjectItemsFiltered(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(200)
+		w.Write([]byte(`{"data":{"node":{"items":{"nodes":[
+			{"id":"PVTI_1","content":{"__typename":"Issue","id":"I_1","title":"A","url":"https://github.com/o/r/issues/1","issueState":"OPEN"}},
+			{"id":"PVTI_2","content":{"__typename":"DraftIssue","id":"DI_1","title":"B"}}
+		],"pageInfo":{"endCursor":"abc","hasNextPage":true}}}}}`))
+	}))
+	defer server.Close()
+
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+	out, err := GetProjectItems(context.Background(), &GetProjectItemsInput{ProjectID: "PVT_1", Filter: &ProjectItemFilter{ContentType: "draft_issue"}}, client)
+	require.NoError(t, err)
+	require.Len(t, out.Items, 1)
+	assert.Equal(t, "PVTI_2", out.Items[0].ID)
+	assert.Equal(t, "abc", out.EndCursor)
+	assert.True(t, out.HasNextPage)
+
+	_, err = GetProjectItems(context.Background(), &GetProjectItemsInput{ProjectID: "PVT_1", Filter: &ProjectItemFilter{State: "merged"}}, client)
+	assert.Error(t, err)
+}
+
+func TestGetProjectItemsOrderedAndGrouped(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(200)
+		w.Write([]byte(`{"data":{"node":{"items":{"nodes":[
+			{"id":"PVTI_1","fieldValues":{"nodes":[
+				{"__typename":"ProjectV2ItemFieldSingleSelectValue","name":"Todo","field":{"name":"Status"}},
+				{"__typename":"ProjectV2ItemFieldNumberValue","number":10,"field":{"name":"Estimate"}}]}},
+			{"id":"PVTI_2","fieldValues":{"nodes":[
+				{"__typename":"ProjectV2ItemFieldSingleSelectValue","name":"Done","field":{"name":"Status"}},
+				{"__typename":"ProjectV2ItemFieldNumberValue","number":2,"field":{"name":"Estimate"}}]}},
+			{"id":"PVTI_3","fieldValues":{"nodes":[]}},
+			{"id":"PVTI_4","fieldValues":{"nodes":[
+				{"__typename":"ProjectV2ItemFieldSingleSelectValue","name":"Todo","field":{"name":"Status"}},
+				{"__typename":"ProjectV2ItemFieldNumberValue","number":3,"field":{"name":"Estimate"}}]}}
+		],"pageInfo":{"endCursor":"abc","hasNextPage":false}}}}}`))
+	}))
+	defer server.Close()
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+
+	ids := func(items []ProjectItem) []string {
+		var out []string
+		for _, item := range items {
+			out = append(out, item.ID)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name    string
+		orderBy *ProjectItemOrder
+		want    []string
+	}{
+		{"ascending", &ProjectItemOrder{Field: "estimate"}, []string{"PVTI_2", "PVTI_4", "PVTI_1", "PVTI_3"}},
+		{"descending", &ProjectItemOrder{Field: "Estimate", Direction: "DESC"}, []string{"PVTI_1", "PVTI_4", "PVTI_2", "PVTI_3"}},
+		{"text", &ProjectItemOrder{Field: "Status"}, []string{"PVTI_2", "PVTI_1", "PVTI_4", "PVTI_3"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := GetProjectItems(context.Background(), &GetProjectItemsInput{ProjectID: "PVT_1", OrderBy: tc.orderBy}, client)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, ids(out.Items))
+			assert.Nil(t, out.Groups)
+		})
+	}
+
+	out, err := GetProjectItems(context.Background(), &GetProjectItemsInput{
+		ProjectID: "PVT_1",
+		OrderBy:   &ProjectItemOrder{Field: "Estimate"},
+		GroupBy:   "Status",
+	}, client)
+	require.NoError(t, err)
+	assert.Empty(t, out.Items)
+	require.Len(t, out.Groups, 3)
+	assert.Equal(t, "Done", out.Groups[0].Value)
+	assert.Equal(t, []string{"PVTI_2"}, ids(out.Groups[0].Items))
+	assert.Equal(t, "Todo", out.Groups[1].Value)
+	assert.Equal(t, 2, out.Groups[1].Count)
+	assert.Equal(t, []string{"PVTI_4", "PVTI_1"}, ids(out.Groups[1].Items))
+	assert.Equal(t, "", out.Groups[2].Value)
+	assert.Equal(t, []string{"PVTI_3"}, ids(out.Groups[2].Items))
+
+	_, err = GetProjectItems(context.Background(), &GetProjectItemsInput{ProjectID: "PVT_1", OrderBy: &ProjectItemOrder{Field: "Estimate", Direction: "up"}}, client)
+	assert.Error(t, err)
+}
+
+func TestListProjectViews(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, vars := decodeGraphQLRequest(t, r)
+		assert.Contains(t, query, "views(first: $first, after: $after)")
+		assert.Contains(t, query, "groupByFields(first: 10)")
+		assert.Contains(t, query, "sortByFields(first: 10)")
+		assert.Equal(t, "PVT_1", vars["id"])
+		w.WriteHeader(200)
+		w.Write([]byte(`{"data":{"node":{"views":{"nodes":[
+			{"id":"PVTV_1","number":1,"name":"Board","layout":"BOARD_LAYOUT","filter":"is:open label:bug",
+			 "groupByFields":{"nodes":[{"name":"Status"}]},
+			 "verticalGroupByFields":{"nodes":[{"name":"Sprint"}]},
+			 "sortByFields":{"nodes":[{"direction":"DESC","field":{"name":"Priority"}}]}},
+			{"id":"PVTV_2","number":2,"name":"Table","layout":"TABLE_LAYOUT","filter":"",
+			 "groupByFields":{"nodes":[]},"verticalGroupByFields":{"nodes":[]},"sortByFields":{"nodes":[]}}
+		],"pageInfo":{"endCursor":"c1","hasNextPage":true}}}}}`))
+	}))
+	defer server.Close()
+
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+	out, err := ListProjectViews(context.Background(), &ListProjectViewsInput{ProjectID: "PVT_1"}, client)
+	require.NoError(t, err)
+	assert.Equal(t, []ProjectView{
+		{
+			ID: "PVTV_1", Number: 1, Name: "Board", Layout: "BOARD_LAYOUT", Filter: "is:open label:bug",
+			GroupBy: []string{"Status"}, VerticalGroupBy: []string{"Sprint"},
+			SortBy: []ProjectViewSort{{Field: "Priority", Direction: "DESC"}},
+		},
+		{ID: "PVTV_2", Number: 2, Name: "Table", Layout: "TABLE_LAYOUT"},
+	}, out.Views)
+	assert.Equal(t, "c1", out.EndCursor)
+	assert.True(t, out.HasNextPage)
+
+	t.Run("missing project_id", func(t *testing.T) {
+		out, err := ListProjectViews(context.Background(), &ListProjectViewsInput{}, client)
+		assert.Error(t, err)
+		assert.Nil(t, out)
+	})
+}
+
+func TestGetProjectView(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, vars := decodeGraphQLRequest(t, r)
+		assert.Contains(t, query, "view(number: $number)")
+		w.WriteHeader(200)
+		if vars["number"] == float64(3) {
+			w.Write([]byte(`{"data":{"node":{"view":{"id":"PVTV_3","number":3,"name":"Roadmap","layout":"ROADMAP_LAYOUT","filter":"assignee:@me",
+				"groupByFields":{"nodes":[]},"verticalGroupByFields":{"nodes":[]},
+				"sortByFields":{"nodes":[{"direction":"ASC","field":{"name":"Start"}}]}}}}}`))
+			return
+		}
+		w.Write([]byte(`{"data":{"node":{"view":null}}}`))
+	}))
+	defer server.Close()
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+
+	view, err := GetProjectView(context.Background(), &GetProjectViewInput{ProjectID: "PVT_1", Number: 3}, client)
+	require.NoError(t, err)
+	assert.Equal(t, &ProjectView{
+		ID: "PVTV_3", Number: 3, Name: "Roadmap", Layout: "ROADMAP_LAYOUT", Filter: "assignee:@me",
+		SortBy: []ProjectViewSort{{Field: "Start", Direction: "ASC"}},
+	}, view)
+
+	_, err = GetProjectView(context.Background(), &GetProjectViewInput{ProjectID: "PVT_1", Number: 9}, client)
+	assert.ErrorContains(t, err, "view 9 not found")
+
+	_, err = GetProjectView(context.Background(), &GetProjectViewInput{ProjectID: "PVT_1"}, client)
+	assert.Error(t, err)
+}
+
+func TestListProjectStatusUpdates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, vars := decodeGraphQLRequest(t, r)
+		assert.Contains(t, query, "statusUpdates(first: $first, after: $after, orderBy: {field: CREATED_AT, direction: DESC})")
+		assert.Equal(t, "PVT_1", vars["id"])
+		w.WriteHeader(200)
+		w.Write([]byte(`{"data":{"node":{"statusUpdates":{"nodes":[
+			{"id":"PVTSU_2","status":"AT_RISK","startDate":"2025-06-02","targetDate":"2025-06-30","body":"Blocked on review","createdAt":"2025-06-09T10:00:00Z","creator":{"login":"octocat"}},
+			{"id":"PVTSU_1","status":null,"startDate":null,"targetDate":null,"body":"Kickoff","createdAt":"2025-06-02T09:00:00Z","creator":{"login":"octocat"}}
+		],"pageInfo":{"endCursor":"c1","hasNextPage":false}}}}}`))
+	}))
+	defer server.Close()
+
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+	out, err := ListProjectStatusUpdates(context.Background(), &ListProjectStatusUpdatesInput{ProjectID: "PVT_1"}, client)
+	require.NoError(t, err)
+	assert.Equal(t, []ProjectStatusUpdate{
+		{ID: "PVTSU_2", Status: "AT_RISK", StartDate: "2025-06-02", TargetDate: "2025-06-30", Body: "Blocked on review", Creator: "octocat", CreatedAt: "2025-06-09T10:00:00Z"},
+		{ID: "PVTSU_1", Body: "Kickoff", Creator: "octocat", CreatedAt: "2025-06-02T09:00:00Z"},
+	}, out.StatusUpdates)
+	assert.Equal(t, "c1", out.EndCursor)
+
+	_, err = ListProjectStatusUpdates(context.Background(), &ListProjectStatusUpdatesInput{}, client)
+	assert.Error(t, err)
+}
+
+func TestCreateProjectStatusUpdate(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     *CreateProjectStatusUpdateInput
+		wantInput map[string]interface{}
+		wantErr   string
+	}{
+		{name: "missing project_id", input: &CreateProjectStatusUpdateInput{Body: "x"}, wantErr: "projectID is required"},
+		{name: "bad status", input: &CreateProjectStatusUpdateInput{ProjectID: "PVT_1", Status: "late"}, wantErr: "invalid status"},
+		{name: "bad date", input: &CreateProjectStatusUpdateInput{ProjectID: "PVT_1", TargetDate: "June 30"}, wantErr: "invalid date"},
+		{
+			name:      "body only",
+			input:     &CreateProjectStatusUpdateInput{ProjectID: "PVT_1", Body: "All good"},
+			wantInput: map[string]interface{}{"projectId": "PVT_1", "body": "All good"},
+		},
+		{
+			name:  "all fields",
+			input: &CreateProjectStatusUpdateInput{ProjectID: "PVT_1", Status: "on_track", StartDate: "2025-06-02", TargetDate: "2025-06-30", Body: "All good", ClientMutationID: "m1"},
+			wantInput: map[string]interface{}{
+				"projectId": "PVT_1", "status": "ON_TRACK", "startDate": "2025-06-02T00:00:00Z", "targetDate": "2025-06-30T00:00:00Z",
+				"body": "All good", "clientMutationId": "m1",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query, vars := decodeGraphQLRequest(t, r)
+				assert.Contains(t, query, "createProjectV2StatusUpdate(input: $input)")
+				assert.Equal(t, tc.wantInput, vars["input"])
+				w.WriteHeader(200)
+				w.Write([]byte(`{"data":{"createProjectV2StatusUpdate":{"statusUpdate":{"id":"PVTSU_1","status":"ON_TRACK","body":"All good","createdAt":"2025-06-09T10:00:00Z","creator":{"login":"octocat"}},"clientMutationId":"m1"}}}`))
+			}))
+			defer server.Close()
+
+			client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+			out, err := CreateProjectStatusUpdate(context.Background(), tc.input, client)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				assert.Nil(t, out)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "PVTSU_1", out.ID)
+			assert.Equal(t, "ON_TRACK", out.Status)
+			assert.Equal(t, "octocat", out.Creator)
+			assert.Equal(t, "m1", out.ClientMutationID)
+		})
+	}
+}
+
+func TestDeleteProjectStatusUpdate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, vars := decodeGraphQLRequest(t, r)
+		assert.Contains(t, query, "deleteProjectV2StatusUpdate(input: $input){deletedStatusUpdateId,clientMutationId}")
+		assert.Equal(t, map[string]interface{}{"statusUpdateId": "PVTSU_1"}, vars["input"])
+		w.WriteHeader(200)
+		w.Write([]byte(`{"data":{"deleteProjectV2StatusUpdate":{"deletedStatusUpdateId":"PVTSU_1","clientMutationId":null}}}`))
+	}))
+	defer server.Close()
+
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+	out, err := DeleteProjectStatusUpdate(context.Background(), &DeleteProjectStatusUpdateInput{StatusUpdateID: "PVTSU_1"}, client)
+	require.NoError(t, err)
+	assert.Equal(t, &DeleteProjectStatusUpdateOutput{DeletedStatusUpdateID: "PVTSU_1"}, out)
+
+	_, err = DeleteProjectStatusUpdate(context.Background(), &DeleteProjectStatusUpdateInput{StatusUpdateID: "PVT_1"}, client)
+	assert.ErrorContains(t, err, "expected a project status update node ID")
+}
+
+func TestCreateProjectField(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     *CreateProjectFieldInput
+		wantInput map[string]interface{}
+		wantErr   string
+	}{
+		{name: "missing name", input: &CreateProjectFieldInput{ProjectID: "PVT_1", DataType: FieldTypeText}, wantErr: "name are required"},
+		{name: "iteration", input: &CreateProjectFieldInput{ProjectID: "PVT_1", Name: "Sprint", DataType: FieldTypeIteration}, wantErr: "invalid data type"},
+		{name: "single select without options", input: &CreateProjectFieldInput{ProjectID: "PVT_1", Name: "Status", DataType: FieldTypeSingleSelect}, wantErr: "at least one option"},
+		{name: "options on text", input: &CreateProjectFieldInput{ProjectID: "PVT_1", Name: "Notes", DataType: FieldTypeText, Options: []ProjectFieldOptionInput{{Name: "a"}}}, wantErr: "only allowed"},
+		{name: "bad color", input: &CreateProjectFieldInput{ProjectID: "PVT_1", Name: "Status", DataType: FieldTypeSingleSelect, Options: []ProjectFieldOptionInput{{Name: "Todo", Color: "teal"}}}, wantErr: "invalid color"},
+		{
+			name:      "text",
+			input:     &CreateProjectFieldInput{ProjectID: "PVT_1", Name: "Notes", DataType: FieldTypeText},
+			wantInput: map[string]interface{}{"projectId": "PVT_1", "dataType": "TEXT", "name": "Notes"},
+		},
+		{
+			name: "single select",
+			input: &CreateProjectFieldInput{ProjectID: "PVT_1", Name: "Status", DataType: FieldTypeSingleSelect, ClientMutationID: "m1", Options: []ProjectFieldOptionInput{
+				{Name: "Todo"},
+				{Name: "Done", Color: "green", Description: "Finished"},
+			}},
+			wantInput: map[string]interface{}{
+				"projectId": "PVT_1", "dataType": "SINGLE_SELECT", "name": "Status", "clientMutationId": "m1",
+				"singleSelectOptions": []interface{}{
+					map[string]interface{}{"name": "Todo", "color": "GRAY", "description": ""},
+					map[string]interface{}{"name": "Done", "color": "GREEN", "description": "Finished"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query, vars := decodeGraphQLRequest(t, r)
+				assert.Contains(t, query, "createProjectV2Field(input: $input){projectV2Field{")
+				assert.Equal(t, tc.wantInput, vars["input"])
+				w.WriteHeader(200)
+				w.Write([]byte(`{"data":{"createProjectV2Field":{"projectV2Field":{"id":"PVTSSF_1","name":"Status","dataType":"SINGLE_SELECT","options":[{"id":"o1","name":"Todo"}]},"clientMutationId":"m1"}}}`))
+			}))
+			defer server.Close()
+
+			client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+			out, err := CreateProjectField(context.Background(), tc.input, client)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				assert.Nil(t, out)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, &ProjectFieldMutationOutput{
+				Field:            ProjectField{ID: "PVTSSF_1", Name: "Status", DataType: "SINGLE_SELECT", Options: []ProjectFieldOption{{ID: "o1", Name: "Todo"}}},
+				ClientMutationID: "m1",
+			}, out)
+		})
+	}
+}
+
+func TestUpdateProjectField(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, vars := decodeGraphQLRequest(t, r)
+		assert.Contains(t, query, "mutation($input:UpdateProjectV2FieldInput!)")
+		assert.Contains(t, query, "updateProjectV2Field(input: $input){projectV2Field{")
+		assert.Equal(t, map[string]interface{}{"fieldId": "PVTF_1", "name": "Notes"}, vars["input"])
+		w.WriteHeader(200)
+		w.Write([]byte(`{"data":{"updateProjectV2Field":{"projectV2Field":{"id":"PVTF_1","name":"Notes","dataType":"TEXT"},"clientMutationId":null}}}`))
+	}))
+	defer server.Close()
+
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+	out, err := UpdateProjectField(context.Background(), &UpdateProjectFieldInput{FieldID: "PVTF_1", Name: "Notes"}, client)
+	require.NoError(t, err)
+	assert.Equal(t, ProjectField{ID: "PVTF_1", Name: "Notes", DataType: "TEXT"}, out.Field)
+
+	_, err = UpdateProjectField(context.Background(), &UpdateProjectFieldInput{FieldID: "PVTF_1"}, client)
+	assert.ErrorContains(t, err, "at least one of name or options")
+
+	_, err = UpdateProjectField(context.Background(), &UpdateProjectFieldInput{FieldID: "PVT_1", Name: "x"}, client)
+	assert.ErrorContains(t, err, "expected a project field node ID")
+}
+
+func TestDeleteProjectField(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, vars := decodeGraphQLRequest(t, r)
+		assert.Contains(t, query, "deleteProjectV2Field(input: $input){projectV2Field{... on ProjectV2FieldCommon{id}},clientMutationId}")
+		assert.Equal(t, map[string]interface{}{"fieldId": "PVTSSF_1"}, vars["input"])
+		w.WriteHeader(200)
+		w.Write([]byte(`{"data":{"deleteProjectV2Field":{"projectV2Field":{"id":"PVTSSF_1"},"clientMutationId":null}}}`))
+	}))
+	defer server.Close()
+
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+	out, err := DeleteProjectField(context.Background(), &DeleteProjectFieldInput{FieldID: "PVTSSF_1"}, client)
+	require.NoError(t, err)
+	assert.Equal(t, &DeleteProjectFieldOutput{DeletedFieldID: "PVTSSF_1"}, out)
+
+	_, err = DeleteProjectField(context.Background(), &DeleteProjectFieldInput{}, client)
+	assert.Error(t, err)
+}
+
+func TestProjectFieldOptionsParam(t *testing.T) {
+	options, err := projectFieldOptionsParam(createMCPRequest(map[string]interface{}{
+		"options": []interface{}{
+			map[string]interface{}{"name": "Todo"},
+			map[string]interface{}{"name": "Done", "color": "GREEN", "description": "Finished"},
+		},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, []ProjectFieldOptionInput{{Name: "Todo"}, {Name: "Done", Color: "GREEN", Description: "Finished"}}, options)
+
+	options, err = projectFieldOptionsParam(createMCPRequest(map[string]interface{}{}))
+	require.NoError(t, err)
+	assert.Nil(t, options)
+
+	_, err = projectFieldOptionsParam(createMCPRequest(map[string]interface{}{"options": []interface{}{"Todo"}}))
+	assert.Error(t, err)
+}
+
+func TestBulkAddProjectItems(t *testing.T) {
+	t.Run("invalid input", func(t *testing.T) {
+		for name, in := range map[string]*BulkAddProjectItemsInput{
+			"no items":        {ProjectID: "PVT_1"},
+			"no project_id":   {Items: []string{"I_1"}},
+			"item as project": {ProjectID: "PVTI_1", Items: []string{"I_1"}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				out, err := BulkAddProjectItems(context.Background(), in, githubv4.NewClient(nil))
+				assert.Error(t, err)
+				assert.Nil(t, out)
+			})
+		}
+	})
+
+	t.Run("urls and node IDs", func(t *testing.T) {
+		var ops []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			query, vars := decodeGraphQLRequest(t, r)
+			w.WriteHeader(200)
+			if strings.HasPrefix(query, "query") {
+				ops = append(ops, "resolve")
+				assert.Contains(t, query, "$url0:URI!")
+				assert.Contains(t, query, "item0: resource(url: $url0){... on Issue{id},... on PullRequest{id}}")
+				assert.Equal(t, "https://github.com/o/r/issues/1", vars["url0"])
+				assert.Equal(t, "https://github.com/o/r/pull/2", vars["url1"])
+				assert.Equal(t, "https://github.com/o/r/discussions/3", vars["url2"])
+				w.Write([]byte(`{"data":{"item0":{"id":"I_1"},"item1":{"id":"PR_2"},"item2":{}}}`))
+				return
+			}
+			ops = append(ops, "add")
+			assert.Contains(t, query, "$input:AddProjectV2ItemByIdInput!")
+			assert.Contains(t, query, "item0: addProjectV2ItemById(input: $input){item{id}}")
+			assert.Equal(t, map[string]interface{}{"projectId": "PVT_1", "contentId": "I_1"}, vars["input"])
+			assert.Equal(t, "PR_2", vars["input1"].(map[string]interface{})["contentId"])
+			assert.Equal(t, "I_9", vars["input2"].(map[string]interface{})["contentId"])
+			w.Write([]byte(`{"data":{"item0":{"item":{"id":"PVTI_1"}},"item1":{"item":{"id":"PVTI_2"}},"item2":null},` +
+				`"errors":[{"message":"Could not resolve to a node with the global id of 'I_9'"}]}`))
+		}))
+		defer server.Close()
+
+		client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+		out, err := BulkAddProjectItems(context.Background(), &BulkAddProjectItemsInput{
+			ProjectID: "PVT_1",
+			Items: []string{
+				"https://github.com/o/r/issues/1",
+				"https://github.com/o/r/pull/2",
+				"I_9",
+				"PVT_1",
+				"https://github.com/o/r/discussions/3",
+			},
+		}, client)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"resolve", "add"}, ops)
+		require.Len(t, out.Results, 5)
+		assert.Equal(t, BulkAddItemResult{Item: "https://github.com/o/r/issues/1", ContentID: "I_1", ItemID: "PVTI_1"}, out.Results[0])
+		assert.Equal(t, BulkAddItemResult{Item: "https://github.com/o/r/pull/2", ContentID: "PR_2", ItemID: "PVTI_2"}, out.Results[1])
+		assert.Contains(t, out.Results[2].Error, "I_9")
+		assert.Contains(t, out.Results[3].Error, "expected a issue or pull request node ID")
+		assert.Equal(t, "URL does not point to an issue or pull request", out.Results[4].Error)
+		assert.Equal(t, 2, out.Succeeded)
+		assert.Equal(t, 3, out.Failed)
+	})
+
+	t.Run("batches", func(t *testing.T) {
+		var batchSizes []int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, vars := decodeGraphQLRequest(t, r)
+			batchSizes = append(batchSizes, len(vars))
+			var resp strings.Builder
+			resp.WriteString(`{"data":{`)
+			for n := 0; n < len(vars); n++ {
+				if n > 0 {
+					resp.WriteString(",")
+				}
+				fmt.Fprintf(&resp, `"item%d":{"item":{"id":"PVTI_%d"}}`, n, n)
+			}
+			resp.WriteString(`}}`)
+			w.WriteHeader(200)
+			w.Write([]byte(resp.String()))
+		}))
+		defer server.Close()
+
+		items := make([]string, bulkUpdateBatchSize+3)
+		for i := range items {
+			items[i] = "I_" + strconv.Itoa(i)
+		}
+		client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+		out, err := BulkAddProjectItems(context.Background(), &BulkAddProjectItemsInput{ProjectID: "PVT_1", Items: items}, client)
+		require.NoError(t, err)
+		assert.Equal(t, []int{bulkUpdateBatchSize, 3}, batchSizes)
+		assert.Equal(t, len(items), out.Succeeded)
+	})
+}
+
+func TestAddProjectItemByReference(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentID   string
+		resolveWith string
+		wantQuery   string
+		wantVars    map[string]interface{}
+		wantErr     string
+	}{
+		{
+			name:        "url",
+			contentID:   "https://github.com/o/r/issues/7",
+			resolveWith: `{"data":{"resource":{"id":"I_7"}}}`,
+			wantQuery:   "resource(url: $url){... on Issue{id},... on PullRequest{id}}",
+			wantVars:    map[string]interface{}{"url": "https://github.com/o/r/issues/7"},
+		},
+		{
+			name:        "reference",
+			contentID:   "o/r#7",
+			resolveWith: `{"data":{"repository":{"issueOrPullRequest":{"id":"PR_7"}}}}`,
+			wantQuery:   "repository(owner: $owner, name: $name){issueOrPullRequest(number: $number)",
+			wantVars:    map[string]interface{}{"owner": "o", "name": "r", "number": float64(7)},
+		},
+		{
+			name:        "unresolved reference",
+			contentID:   "o/r#8",
+			resolveWith: `{"data":{"repository":{"issueOrPullRequest":null}}}`,
+			wantErr:     `could not resolve "o/r#8"`,
+		},
+		{
+			name:        "url to something else",
+			contentID:   "https://github.com/o/r",
+			resolveWith: `{"data":{"resource":{}}}`,
+			wantErr:     "could not resolve",
+		},
+		{
+			name:      "wrong node ID",
+			contentID: "PVTI_1",
+			wantErr:   "expected a issue or pull request node ID",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var added string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query, vars := decodeGraphQLRequest(t, r)
+				w.WriteHeader(200)
+				if strings.HasPrefix(query, "query") {
+					if tc.wantQuery != "" {
+						assert.Contains(t, query, tc.wantQuery)
+						assert.Equal(t, tc.wantVars, vars)
+					}
+					w.Write([]byte(tc.resolveWith))
+					return
+				}
+				added = vars["input"].(map[string]interface{})["contentId"].(string)
+				w.Write([]byte(`{"data":{"addProjectV2ItemById":{"item":{"id":"PVTI_1","content":null}}}}`))
+			}))
+			defer server.Close()
+
+			client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+			out, err := AddProjectItem(context.Background(), &AddProjectItemInput{ProjectID: "PVT_1", ContentID: tc.contentID}, client)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				assert.Empty(t, added)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "PVTI_1", out.Item.ID)
+			assert.NotEmpty(t, added)
+		})
+	}
+}
+
+func TestParseIssueReference(t *testing.T) {
+	owner, repo, number, ok := parseIssueReference("octo-org/octo-repo#42")
+	assert.True(t, ok)
+	assert.Equal(t, "octo-org", owner)
+	assert.Equal(t, "octo-repo", repo)
+	assert.Equal(t, 42, number)
+
+	for _, ref := range []string{"I_1", "octo-repo#42", "o/r#", "o/r#x", "o/r/x#1", "/r#1", "o/r#0"} {
+		_, _, _, ok := parseIssueReference(ref)
+		assert.False(t, ok, ref)
+	}
+}
+
+func TestListRepositoryProjects(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, vars := decodeGraphQLRequest(t, r)
+		assert.Contains(t, query, "repository(owner: $owner, name: $name){projectsV2(first: $first, after: $after)")
+		assert.Equal(t, "octo-org", vars["owner"])
+		assert.Equal(t, "octo-repo", vars["name"])
+		w.WriteHeader(200)
+		w.Write([]byte(`{"data":{"repository":{"projectsV2":{"nodes":[{"id":"PVT_1","number":1,"title":"Roadmap","url":"https://github.com/orgs/octo-org/projects/1"}],"pageInfo":{"endCursor":"c1","hasNextPage":false}}}}}`))
+	}))
+	defer server.Close()
+
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+	out, err := ListRepositoryProjects(context.Background(), &ListRepositoryProjectsInput{Owner: "octo-org", Repo: "octo-repo"}, client)
+	require.NoError(t, err)
+	assert.Equal(t, []Project{{ID: "PVT_1", Number: 1, Title: "Roadmap", URL: "https://github.com/orgs/octo-org/projects/1"}}, out.Projects)
+	assert.Equal(t, "c1", out.EndCursor)
+
+	_, err = ListRepositoryProjects(context.Background(), &ListRepositoryProjectsInput{Owner: "octo-org"}, client)
+	assert.Error(t, err)
+}
+
+func TestLinkProjectToRepository(t *testing.T) {
+	tests := []struct {
+		name     string
+		link     func(context.Context, *ProjectRepositoryLinkInput, GraphQLClient) (*ProjectRepositoryLinkOutput, error)
+		mutation string
+	}{
+		{name: "link", link: LinkProjectToRepository, mutation: "linkProjectV2ToRepository"},
+		{name: "unlink", link: UnlinkProjectFromRepository, mutation: "unlinkProjectV2FromRepository"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query, vars := decodeGraphQLRequest(t, r)
+				w.WriteHeader(200)
+				if strings.HasPrefix(query, "query") {
+					if vars["name"] == "missing" {
+						w.Write([]byte(`{"data":{"repository":null},"errors":[{"message":"Could not resolve to a Repository with the name 'octo-org/missing'."}]}`))
+						return
+					}
+					w.Write([]byte(`{"data":{"repository":{"id":"R_1"}}}`))
+					return
+				}
+				assert.Contains(t, query, tc.mutation+"(input: $input){repository{id},clientMutationId}")
+				assert.Equal(t, map[string]interface{}{"projectId": "PVT_1", "repositoryId": "R_1", "clientMutationId": "m1"}, vars["input"])
+				fmt.Fprintf(w, `{"data":{%q:{"repository":{"id":"R_1"},"clientMutationId":"m1"}}}`, tc.mutation)
+			}))
+			defer server.Close()
+
+			client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+			out, err := tc.link(context.Background(), &ProjectRepositoryLinkInput{ProjectID: "PVT_1", Owner: "octo-org", Repo: "octo-repo", ClientMutationID: "m1"}, client)
+			require.NoError(t, err)
+			assert.Equal(t, &ProjectRepositoryLinkOutput{ProjectID: "PVT_1", RepositoryID: "R_1", ClientMutationID: "m1"}, out)
+
+			_, err = tc.link(context.Background(), &ProjectRepositoryLinkInput{ProjectID: "PVT_1", Owner: "octo-org", Repo: "missing"}, client)
+			assert.EqualError(t, err, "repository octo-org/missing not found")
+
+			_, err = tc.link(context.Background(), &ProjectRepositoryLinkInput{ProjectID: "PVT_1", Owner: "octo-org"}, client)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestListTeamProjects(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, vars := decodeGraphQLRequest(t, r)
+		assert.Contains(t, query, "organization(login: $org){team(slug: $slug){projectsV2(first: $first, after: $after)")
+		w.WriteHeader(200)
+		if vars["slug"] == "ghosts" {
+			w.Write([]byte(`{"data":{"organization":{"team":null}}}`))
+			return
+		}
+		w.Write([]byte(`{"data":{"organization":{"team":{"projectsV2":{"nodes":[{"id":"PVT_1","number":1,"title":"Sprint board","url":"https://github.com/orgs/acme/projects/1"}],"pageInfo":{"endCursor":"c1","hasNextPage":false}}}}}}`))
+	}))
+	defer server.Close()
+
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+	out, err := ListTeamProjects(context.Background(), &ListTeamProjectsInput{Organization: "acme", Team: "platform"}, client)
+	require.NoError(t, err)
+	assert.Equal(t, []Project{{ID: "PVT_1", Number: 1, Title: "Sprint board", URL: "https://github.com/orgs/acme/projects/1"}}, out.Projects)
+
+	_, err = ListTeamProjects(context.Background(), &ListTeamProjectsInput{Organization: "acme", Team: "ghosts"}, client)
+	assert.EqualError(t, err, "team acme/ghosts not found")
+}
+
+func TestLinkProjectToTeam(t *testing.T) {
+	tests := []struct {
+		name     string
+		link     func(context.Context, *ProjectTeamLinkInput, GraphQLClient) (*ProjectTeamLinkOutput, error)
+		mutation string
+	}{
+		{name: "link", link: LinkProjectToTeam, mutation: "linkProjectV2ToTeam"},
+		{name: "unlink", link: UnlinkProjectFromTeam, mutation: "unlinkProjectV2FromTeam"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query, vars := decodeGraphQLRequest(t, r)
+				w.WriteHeader(200)
+				if strings.HasPrefix(query, "query") {
+					if vars["slug"] == "ghosts" {
+						w.Write([]byte(`{"data":{"organization":{"team":null}}}`))
+						return
+					}
+					w.Write([]byte(`{"data":{"organization":{"team":{"id":"T_1"}}}}`))
+					return
+				}
+				assert.Contains(t, query, tc.mutation+"(input: $input){team{id},clientMutationId}")
+				assert.Equal(t, map[string]interface{}{"projectId": "PVT_1", "teamId": "T_1"}, vars["input"])
+				fmt.Fprintf(w, `{"data":{%q:{"team":{"id":"T_1"},"clientMutationId":null}}}`, tc.mutation)
+			}))
+			defer server.Close()
+
+			client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+			out, err := tc.link(context.Background(), &ProjectTeamLinkInput{ProjectID: "PVT_1", Organization: "acme", Team: "platform"}, client)
+			require.NoError(t, err)
+			assert.Equal(t, &ProjectTeamLinkOutput{ProjectID: "PVT_1", TeamID: "T_1"}, out)
+
+			_, err = tc.link(context.Background(), &ProjectTeamLinkInput{ProjectID: "PVT_1", Organization: "acme", Team: "ghosts"}, client)
+			assert.EqualError(t, err, "team acme/ghosts not found")
+		})
+	}
+}
+
+func TestCopyProject(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     *CopyProjectInput
+		wantInput map[string]interface{}
+		wantErr   string
+	}{
+		{name: "missing title", input: &CopyProjectInput{ProjectID: "PVT_tmpl", Owner: "acme"}, wantErr: "projectID and title are required"},
+		{name: "no owner", input: &CopyProjectInput{ProjectID: "PVT_tmpl", Title: "Sprint 12"}, wantErr: "exactly one of owner or owner_id"},
+		{name: "item as source", input: &CopyProjectInput{ProjectID: "PVTI_1", OwnerID: "O_1", Title: "Sprint 12"}, wantErr: "expected a project node ID"},
+		{
+			name:      "owner login",
+			input:     &CopyProjectInput{ProjectID: "PVT_tmpl", Owner: "acme", Title: "Sprint 12"},
+			wantInput: map[string]interface{}{"projectId": "PVT_tmpl", "ownerId": "O_1", "title": "Sprint 12"},
+		},
+		{
+			name:  "owner ID with drafts",
+			input: &CopyProjectInput{ProjectID: "PVT_tmpl", OwnerID: "O_1", Title: "Sprint 12", IncludeDraftIssues: true, ClientMutationID: "m1"},
+			wantInput: map[string]interface{}{
+				"projectId": "PVT_tmpl", "ownerId": "O_1", "title": "Sprint 12", "includeDraftIssues": true, "clientMutationId": "m1",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query, vars := decodeGraphQLRequest(t, r)
+				w.WriteHeader(200)
+				if strings.HasPrefix(query, "query") {
+					w.Write([]byte(`{"data":{"organization":{"id":"O_1"}}}`))
+					return
+				}
+				assert.Contains(t, query, "mutation($input:CopyProjectV2Input!)")
+				assert.Contains(t, query, "copyProjectV2(input: $input)")
+				assert.Equal(t, tc.wantInput, vars["input"])
+				w.Write([]byte(`{"data":{"copyProjectV2":{"projectV2":{"id":"PVT_new","number":12,"title":"Sprint 12","url":"https://github.com/orgs/acme/projects/12"},"clientMutationId":null}}}`))
+			}))
+			defer server.Close()
+
+			client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+			out, err := CopyProject(context.Background(), tc.input, client)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				assert.Nil(t, out)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, &Project{ID: "PVT_new", Number: 12, Title: "Sprint 12", URL: "https://github.com/orgs/acme/projects/12"}, out)
+		})
+	}
+}
+
+func TestListTemplateProjects(t *testing.T) {
+	pages := []string{
+		`{"data":{"organization":{"projectsV2":{"nodes":[
+			{"id":"PVT_1","number":1,"title":"Roadmap","url":"https://github.com/orgs/acme/projects/1","template":false},
+			{"id":"PVT_2","number":2,"title":"Sprint template","url":"https://github.com/orgs/acme/projects/2","template":true}
+		],"pageInfo":{"endCursor":"c1","hasNextPage":true}}}}}`,
+		`{"data":{"organization":{"projectsV2":{"nodes":[
+			{"id":"PVT_3","number":3,"title":"Bug triage template","url":"https://github.com/orgs/acme/projects/3","template":true}
+		],"pageInfo":{"endCursor":"c2","hasNextPage":false}}}}}`,
+	}
+	var afters []interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, vars := decodeGraphQLRequest(t, r)
+		assert.Contains(t, query, "template")
+		assert.Equal(t, float64(100), vars["first"])
+		afters = append(afters, vars["after"])
+		w.WriteHeader(200)
+		w.Write([]byte(pages[len(afters)-1]))
+	}))
+	defer server.Close()
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+
+	out, err := ListTemplateProjects(context.Background(), &ListTemplateProjectsInput{Organization: "acme"}, client)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"", "c1"}, afters)
+	assert.Equal(t, []Project{
+		{ID: "PVT_2", Number: 2, Title: "Sprint template", URL: "https://github.com/orgs/acme/projects/2"},
+		{ID: "PVT_3", Number: 3, Title: "Bug triage template", URL: "https://github.com/orgs/acme/projects/3"},
+	}, out.Projects)
+	assert.False(t, out.Truncated)
+
+	t.Run("budget", func(t *testing.T) {
+		afters = nil
+		out, err := ListTemplateProjects(context.Background(), &ListTemplateProjectsInput{Organization: "acme", MaxRequests: 1}, client)
+		require.NoError(t, err)
+		assert.Len(t, afters, 1)
+		assert.Len(t, out.Projects, 1)
+		assert.True(t, out.Truncated)
+	})
+
+	_, err = ListTemplateProjects(context.Background(), &ListTemplateProjectsInput{}, client)
+	assert.Error(t, err)
+}
+
+func TestClearProjectItemField(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, vars := decodeGraphQLRequest(t, r)
+		assert.Contains(t, query, "mutation($input:ClearProjectV2ItemFieldValueInput!)")
+		assert.Contains(t, query, "clearProjectV2ItemFieldValue(input: $input){projectV2Item{id},clientMutationId}")
+		assert.Equal(t, map[string]interface{}{
+			"projectId":        "PVT_1",
+			"itemId":           "PVTI_1",
+			"fieldId":          "PVTSSF_1",
+			"clientMutationId": "abc",
+		}, vars["input"])
+		w.WriteHeader(200)
+		w.Write([]byte(`{"data":{"clearProjectV2ItemFieldValue":{"projectV2Item":{"id":"PVTI_1"},"clientMutationId":"abc"}}}`))
+	}))
+	defer server.Close()
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+
+	out, err := ClearProjectItemField(context.Background(), &ClearProjectItemFieldInput{
+		ProjectID:        "PVT_1",
+		ItemID:           "PVTI_1",
+		FieldID:          "PVTSSF_1",
+		ClientMutationID: "abc",
+	}, client)
+	require.NoError(t, err)
+	assert.Equal(t, "PVTI_1", out.Item.ID)
+	assert.Equal(t, "abc", out.ClientMutationID)
+
+	_, err = ClearProjectItemField(context.Background(), &ClearProjectItemFieldInput{ProjectID: "PVT_1", ItemID: "PVTI_1"}, client)
+	assert.Error(t, err)
+}
+
+func TestListOrganizationProjectsAll(t *testing.T) {
+	pages := map[string]string{
+		"": `{"data":{"organization":{"projectsV2":{"nodes":[
+			{"id":"PVT_1","number":1,"title":"One","url":"https://github.com/orgs/acme/projects/1"}
+		],"pageInfo":{"endCursor":"c1","hasNextPage":true}}}}}`,
+		"c1": `{"data":{"organization":{"projectsV2":{"nodes":[
+			{"id":"PVT_2","number":2,"title":"Two","url":"https://github.com/orgs/acme/projects/2"}
+		],"pageInfo":{"endCursor":"c2","hasNextPage":false}}}}}`,
+	}
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, vars := decodeGraphQLRequest(t, r)
+		assert.Equal(t, float64(100), vars["first"])
+		requests++
+		w.WriteHeader(200)
+		w.Write([]byte(pages[vars["after"].(string)]))
+	}))
+	defer server.Close()
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+
+	out, err := ListOrganizationProjects(context.Background(), &ListOrganizationProjectsInput{Organization: "acme", First: 5, All: true}, client)
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests)
+	require.Len(t, out.Projects, 2)
+	assert.Equal(t, "PVT_2", out.Projects[1].ID)
+	assert.Equal(t, "c2", out.EndCursor)
+	assert.False(t, out.HasNextPage)
+	assert.False(t, out.Truncated)
+
+	requests = 0
+	out, err = ListOrganizationProjects(context.Background(), &ListOrganizationProjectsInput{Organization: "acme", All: true, MaxPages: 1}, client)
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+	assert.Len(t, out.Projects, 1)
+	assert.Equal(t, "c1", out.EndCursor)
+	assert.True(t, out.HasNextPage)
+	assert.True(t, out.Truncated)
+
+	_, err = ListOrganizationProjects(context.Background(), &ListOrganizationProjectsInput{Organization: "acme", All: true, MaxPages: -1}, client)
+	assert.Error(t, err)
+}
+
+func TestGetProjectItemsAll(t *testing.T) {
+	pages := map[string]string{
+		"c1": `{"data":{"node":{"items":{"nodes":[
+			{"id":"PVTI_2","fieldValues":{"nodes":[{"__typename":"ProjectV2ItemFieldNumberValue","number":5,"field":{"name":"Estimate"}}]}}
+		],"pageInfo":{"endCursor":"c2","hasNextPage":true}}}}}`,
+		"c2": `{"data":{"node":{"items":{"nodes":[
+			{"id":"PVTI_3","fieldValues":{"nodes":[{"__typename":"ProjectV2ItemFieldNumberValue","number":1,"field":{"name":"Estimate"}}]}}
+		],"pageInfo":{"endCursor":"c3","hasNextPage":false}}}}}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, vars := decodeGraphQLRequest(t, r)
+		w.WriteHeader(200)
+		w.Write([]byte(pages[vars["after"].(string)]))
+	}))
+	defer server.Close()
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+
+	out, err := GetProjectItems(context.Background(), &GetProjectItemsInput{
+		ProjectID: "PVT_1",
+		After:     "c1",
+		All:       true,
+		OrderBy:   &ProjectItemOrder{Field: "Estimate"},
+	}, client)
+	require.NoError(t, err)
+	require.Len(t, out.Items, 2)
+	assert.Equal(t, "PVTI_3", out.Items[0].ID)
+	assert.Equal(t, "PVTI_2", out.Items[1].ID)
+	assert.Equal(t, "c3", out.EndCursor)
+	assert.False(t, out.Truncated)
+}
+
+func TestListProjectCollaborators(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, vars := decodeGraphQLRequest(t, r)
+		assert.Contains(t, query, "collaborators(first: $first, after: $after)")
+		assert.Equal(t, "PVT_1", vars["id"])
+		w.WriteHeader(200)
+		w.Write([]byte(`{"data":{"node":{"collaborators":{"nodes":[
+			{"__typename":"User","id":"U_1","login":"octocat"},
+			{"__typename":"Team","id":"T_1","combinedSlug":"acme/platform"}
+		],"pageInfo":{"endCursor":"c1","hasNextPage":false}}}}}`))
+	}))
+	defer server.Close()
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+
+	out, err := ListProjectCollaborators(context.Background(), &ListProjectCollaboratorsInput{ProjectID: "PVT_1"}, client)
+	require.NoError(t, err)
+	assert.Equal(t, []ProjectCollaborator{
+		{ID: "U_1", Type: "User", Login: "octocat"},
+		{ID: "T_1", Type: "Team", Login: "acme/platform"},
+	}, out.Collaborators)
+	assert.Equal(t, "c1", out.EndCursor)
+}
+
+func TestUpdateProjectCollaborators(t *testing.T) {
+	var mutations int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, vars := decodeGraphQLRequest(t, r)
+		w.WriteHeader(200)
+		switch {
+		case strings.Contains(query, "user(login: $login)"):
+			if vars["login"] == "ghost" {
+				w.Write([]byte(`{"data":{"user":null},"errors":[{"type":"NOT_FOUND","message":"Could not resolve to a User with the login of 'ghost'."}]}`))
+				return
+			}
+			w.Write([]byte(`{"data":{"user":{"id":"U_1"}}}`))
+		case strings.Contains(query, "team(slug: $slug)"):
+			assert.Equal(t, "acme", vars["org"])
+			assert.Equal(t, "platform", vars["slug"])
+			w.Write([]byte(`{"data":{"organization":{"team":{"id":"T_1"}}}}`))
+		default:
+			mutations++
+			assert.Contains(t, query, "updateProjectV2Collaborators(input: $input)")
+			assert.Equal(t, map[string]interface{}{
+				"projectId": "PVT_1",
+				"collaborators": []interface{}{
+					map[string]interface{}{"role": "WRITER", "userId": "U_1"},
+					map[string]interface{}{"role": "WRITER", "teamId": "T_1"},
+				},
+			}, vars["input"])
+			w.Write([]byte(`{"data":{"updateProjectV2Collaborators":{"clientMutationId":null}}}`))
+		}
+	}))
+	defer server.Close()
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+
+	out, err := UpdateProjectCollaborators(context.Background(), &UpdateProjectCollaboratorsInput{
+		ProjectID: "PVT_1",
+		Users:     []string{"octocat"},
+		Teams:     []string{"acme/platform"},
+		Role:      "Writer",
+	}, client)
+	require.NoError(t, err)
+	assert.Equal(t, 1, mutations)
+	assert.Equal(t, []ProjectCollaborator{
+		{ID: "U_1", Type: "User", Login: "octocat", Role: "WRITER"},
+		{ID: "T_1", Type: "Team", Login: "acme/platform", Role: "WRITER"},
+	}, out.Collaborators)
+
+	tests := []struct {
+		name  string
+		input *UpdateProjectCollaboratorsInput
+	}{
+		{"no collaborators", &UpdateProjectCollaboratorsInput{ProjectID: "PVT_1", Role: "reader"}},
+		{"invalid role", &UpdateProjectCollaboratorsInput{ProjectID: "PVT_1", Users: []string{"octocat"}, Role: "owner"}},
+		{"invalid team", &UpdateProjectCollaboratorsInput{ProjectID: "PVT_1", Teams: []string{"platform"}, Role: "reader"}},
+		{"unknown user", &UpdateProjectCollaboratorsInput{ProjectID: "PVT_1", Users: []string{"ghost"}, Role: "none"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := UpdateProjectCollaborators(context.Background(), tc.input, client)
+			assert.Error(t, err)
+		})
+	}
+	assert.Equal(t, 1, mutations)
+}
+
+func TestGetProjectActivity(t *testing.T) {
+	pages := map[string]string{
+		"": `{"data":{"node":{"items":{"nodes":[
+			{"id":"PVTI_1","createdAt":"2025-05-01T00:00:00Z","updatedAt":"2025-06-03T10:00:00Z","isArchived":false,
+			 "content":{"__typename":"Issue","title":"Old issue","url":"https://github.com/o/r/issues/1","closedAt":"2025-06-03T09:00:00Z"},
+			 "fieldValues":{"nodes":[{"__typename":"ProjectV2ItemFieldSingleSelectValue","name":"Done","field":{"name":"Status"}}]}},
+			{"id":"PVTI_2","createdAt":"2025-04-01T00:00:00Z","updatedAt":"2025-04-02T00:00:00Z","isArchived":false,
+			 "content":{"__typename":"DraftIssue","title":"Stale"},"fieldValues":{"nodes":[]}}
+		],"pageInfo":{"endCursor":"c1","hasNextPage":true}}}}}`,
+		"c1": `{"data":{"node":{"items":{"nodes":[
+			{"id":"PVTI_3","createdAt":"2025-06-02T12:00:00Z","updatedAt":"2025-06-02T12:00:00Z","isArchived":false,
+			 "content":{"__typename":"PullRequest","title":"New PR","url":"https://github.com/o/r/pull/2","closedAt":"2025-06-04T00:00:00Z","mergedAt":"2025-06-04T00:00:00Z"},
+			 "fieldValues":{"nodes":[]}},
+			{"id":"PVTI_4","createdAt":"2025-05-01T00:00:00Z","updatedAt":"2025-06-01T08:00:00Z","isArchived":true,
+			 "content":null,"fieldValues":{"nodes":[]}}
+		],"pageInfo":{"endCursor":"c2","hasNextPage":false}}}}}`,
+	}
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, vars := decodeGraphQLRequest(t, r)
+		assert.Contains(t, query, "updatedAt")
+		assert.Equal(t, float64(100), vars["first"])
+		requests++
+		w.WriteHeader(200)
+		w.Write([]byte(pages[vars["after"].(string)]))
+	}))
+	defer server.Close()
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+
+	out, err := GetProjectActivity(context.Background(), &GetProjectActivityInput{ProjectID: "PVT_1", Since: "2025-06-01"}, client)
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, "2025-06-01T00:00:00Z", out.Since)
+	assert.False(t, out.Truncated)
+
+	type event struct{ event, item, at string }
+	var got []event
+	for _, a := range out.Activities {
+		got = append(got, event{a.Event, a.ItemID, a.At})
+	}
+	assert.Equal(t, []event{
+		{"merged", "PVTI_3", "2025-06-04T00:00:00Z"},
+		{"updated", "PVTI_1", "2025-06-03T10:00:00Z"},
+		{"closed", "PVTI_1", "2025-06-03T09:00:00Z"},
+		{"added", "PVTI_3", "2025-06-02T12:00:00Z"},
+		{"archived", "PVTI_4", "2025-06-01T08:00:00Z"},
+	}, got)
+	assert.Equal(t, "Old issue", out.Activities[1].Title)
+	assert.Equal(t, map[string]interface{}{"Status": "Done"}, out.Activities[1].FieldValues)
+
+	requests = 0
+	out, err = GetProjectActivity(context.Background(), &GetProjectActivityInput{ProjectID: "PVT_1", Since: "2025-06-01T00:00:00Z", MaxRequests: 1}, client)
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+	assert.True(t, out.Truncated)
+
+	_, err = GetProjectActivity(context.Background(), &GetProjectActivityInput{ProjectID: "PVT_1", Since: "last week"}, client)
+	assert.Error(t, err)
+}
+
+func TestMoveProjectItem(t *testing.T) {
+	var failUpdate bool
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, vars := decodeGraphQLRequest(t, r)
+		w.WriteHeader(200)
+		switch {
+		case strings.Contains(query, "... on ProjectV2Item"):
+			// The field values span two pages; the source item may only be
+			// removed once both have been read.
+			assert.Equal(t, "PVTI_1", vars["id"])
+			assert.Contains(t, query, "fieldValues(first: 100, after: $after)")
+			content := `"project":{"id":"PVT_src"},
+				"content":{"__typename":"Issue","id":"I_1","title":"Epic","url":"https://github.com/o/r/issues/1",
+				 "assignees":{"nodes":[]},"labels":{"nodes":[]},"repository":{"nameWithOwner":"o/r"},"issueState":"OPEN"}`
+			if vars["after"] == "" {
+				w.Write([]byte(`{"data":{"node":{` + content + `,
+					"fieldValues":{"nodes":[
+						{"__typename":"ProjectV2ItemFieldTextValue","text":"Epic","field":{"name":"Title"}},
+						{"__typename":"ProjectV2ItemFieldTextValue","text":"see doc","field":{"name":"Notes"}},
+						{"__typename":"ProjectV2ItemFieldSingleSelectValue","name":"In Progress","field":{"name":"Status"}},
+						{"__typename":"ProjectV2ItemFieldSingleSelectValue","name":"P0","field":{"name":"Priority"}}
+					],"pageInfo":{"endCursor":"fv1","hasNextPage":true}}}}}`))
+				return
+			}
+			assert.Equal(t, "fv1", vars["after"])
+			w.Write([]byte(`{"data":{"node":{` + content + `,
+				"fieldValues":{"nodes":[
+					{"__typename":"ProjectV2ItemFieldNumberValue","number":3,"field":{"name":"Estimate"}},
+					{"__typename":"ProjectV2ItemFieldDateValue","date":"2025-06-30","field":{"name":"Due"}},
+					{"__typename":"ProjectV2ItemFieldIterationValue","iterationId":"it_s1","title":"Sprint 1","startDate":"2025-06-02","duration":14,"field":{"name":"Sprint"}}
+				],"pageInfo":{"endCursor":"fv2","hasNextPage":false}}}}}`))
+		case strings.Contains(query, "fields(first: $first, after: $after)") && vars["id"] == "PVT_src":
+			w.Write([]byte(`{"data":{"node":{"fields":{"nodes":[
+				{"id":"PVTF_s1","name":"Title","dataType":"TITLE"},
+				{"id":"PVTF_s2","name":"Notes","dataType":"TEXT"},
+				{"id":"PVTSSF_s1","name":"Status","dataType":"SINGLE_SELECT","options":[{"id":"opt_s1","name":"In Progress"}]},
+				{"id":"PVTSSF_s2","name":"Priority","dataType":"SINGLE_SELECT","options":[{"id":"opt_s2","name":"P0"}]},
+				{"id":"PVTF_s3","name":"Estimate","dataType":"NUMBER"},
+				{"id":"PVTF_s4","name":"Due","dataType":"DATE"},
+				{"id":"PVTIF_s1","name":"Sprint","dataType":"ITERATION","configuration":{"iterations":[{"id":"it_s1","title":"Sprint 1","startDate":"2025-06-02","duration":14}]}}
+			],"pageInfo":{"endCursor":"","hasNextPage":false}}}}}`))
+		case strings.Contains(query, "fields(first: $first, after: $after)"):
+			assert.Equal(t, "PVT_dst", vars["id"])
+			w.Write([]byte(`{"data":{"node":{"fields":{"nodes":[
+				{"id":"PVTF_t1","name":"Title","dataType":"TITLE"},
+				{"id":"PVTSSF_t1","name":"status","dataType":"SINGLE_SELECT","options":[{"id":"opt_t1","name":"in progress"}]},
+				{"id":"PVTSSF_t2","name":"Priority","dataType":"SINGLE_SELECT","options":[{"id":"opt_t2","name":"High"}]},
+				{"id":"PVTF_t2","name":"Estimate","dataType":"NUMBER"},
+				{"id":"PVTF_t3","name":"Due","dataType":"TEXT"},
+				{"id":"PVTIF_t1","name":"Sprint","dataType":"ITERATION","configuration":{"iterations":[{"id":"it_t1","title":"Sprint 1","startDate":"2025-06-02","duration":14}]}}
+			],"pageInfo":{"endCursor":"","hasNextPage":false}}}}}`))
+		case strings.Contains(query, "addProjectV2ItemById"):
+			assert.Equal(t, map[string]interface{}{"projectId": "PVT_dst", "contentId": "I_1"}, vars["input"])
+			w.Write([]byte(`{"data":{"addProjectV2ItemById":{"item":{"id":"PVTI_new",
+				"content":{"__typename":"Issue","id":"I_1","title":"Epic","url":"https://github.com/o/r/issues/1",
+				 "assignees":{"nodes":[]},"labels":{"nodes":[]},"repository":{"nameWithOwner":"o/r"},"issueState":"OPEN"}},"clientMutationId":null}}}`))
+		case strings.Contains(query, "updateProjectV2ItemFieldValue"):
+			assert.Equal(t, map[string]interface{}{
+				"projectId": "PVT_dst", "itemId": "PVTI_new", "fieldId": "PVTF_t2",
+				"value": map[string]interface{}{"number": float64(3)},
+			}, vars["input"])
+			assert.Equal(t, map[string]interface{}{"iterationId": "it_t1"}, vars["input1"].(map[string]interface{})["value"])
+			assert.Equal(t, map[string]interface{}{"singleSelectOptionId": "opt_t1"}, vars["input2"].(map[string]interface{})["value"])
+			if failUpdate {
+				w.Write([]byte(`{"data":{"item0":{"projectV2Item":{"id":"PVTI_new"}},"item1":null,"item2":{"projectV2Item":{"id":"PVTI_new"}}},"errors":[{"message":"iteration is closed"}]}`))
+				return
+			}
+			w.Write([]byte(`{"data":{"item0":{"projectV2Item":{"id":"PVTI_new"}},"item1":{"projectV2Item":{"id":"PVTI_new"}},"item2":{"projectV2Item":{"id":"PVTI_new"}}}}`))
+		case strings.Contains(query, "deleteProjectV2Item"):
+			deleted = append(deleted, vars["input"].(map[string]interface{})["itemId"].(string))
+			w.Write([]byte(`{"data":{"deleteProjectV2Item":{"deletedItemId":"PVTI_1","clientMutationId":null}}}`))
+		default:
+			t.Fatalf("unexpected query: %s", query)
+		}
+	}))
+	defer server.Close()
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+
+	input := &MoveProjectItemInput{SourceProjectID: "PVT_src", ItemID: "PVTI_1", TargetProjectID: "PVT_dst", RemoveFromSource: true}
+	out, err := MoveProjectItem(context.Background(), input, client)
+	require.NoError(t, err)
+	assert.Equal(t, "PVTI_new", out.Item.ID)
+	assert.Equal(t, []string{"Estimate", "Sprint", "Status"}, out.CopiedFields)
+	assert.Equal(t, []SkippedFieldValue{
+		{Field: "Due", Reason: "target field is TEXT, not DATE"},
+		{Field: "Notes", Reason: "target project has no field with this name"},
+		{Field: "Priority", Reason: `target field has no option "P0"`},
+	}, out.SkippedFields)
+	assert.True(t, out.RemovedFromSource)
+	assert.Equal(t, []string{"PVTI_1"}, deleted)
+
+	t.Run("failed copy keeps source item", func(t *testing.T) {
+		failUpdate, deleted = true, nil
+		out, err := MoveProjectItem(context.Background(), input, client)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Estimate", "Status"}, out.CopiedFields)
+		assert.Contains(t, out.SkippedFields, SkippedFieldValue{Field: "Sprint", Reason: "github graphql error: iteration is closed"})
+		assert.False(t, out.RemovedFromSource)
+		assert.Empty(t, deleted)
+	})
+
+	t.Run("wrong source project", func(t *testing.T) {
+		_, err := MoveProjectItem(context.Background(), &MoveProjectItemInput{SourceProjectID: "PVT_other", ItemID: "PVTI_1", TargetProjectID: "PVT_dst"}, client)
+		assert.ErrorContains(t, err, "does not belong to project")
+	})
+
+	t.Run("same project", func(t *testing.T) {
+		_, err := MoveProjectItem(context.Background(), &MoveProjectItemInput{SourceProjectID: "PVT_dst", ItemID: "PVTI_1", TargetProjectID: "PVT_dst"}, client)
+		assert.Error(t, err)
+	})
+}
+
+func TestGetProjectSummary(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(200)
+		w.Write([]byte(`{"data":{"node":{"items":{"nodes":[
+			{"id":"PVTI_1","content":{"__typename":"Issue","id":"I_1","title":"A","url":"https://github.com/o/r/issues/1","issueState":"OPEN",
+			  "assignees":{"nodes":[{"login":"alice"},{"login":"bob"}]},"labels":{"nodes":[{"name":"bug"}]},"repository":{"nameWithOwner":"o/r"}},
+			 "fieldValues":{"nodes":[
+				{"__typename":"ProjectV2ItemFieldSingleSelectValue","name":"In Progress","field":{"name":"Status"}},
+				{"__typename":"ProjectV2ItemFieldIterationValue","iterationId":"it_1","title":"Sprint 1","startDate":"2025-06-02","duration":14,"field":{"name":"Sprint"}}]}},
+			{"id":"PVTI_2","content":{"__typename":"PullRequest","id":"PR_1","title":"B","url":"https://github.com/o/r/pull/2","pullRequestState":"MERGED",
+			  "assignees":{"nodes":[{"login":"alice"}]},"labels":{"nodes":[{"name":"bug"},{"name":"ui"}]},"repository":{"nameWithOwner":"o/r"}},
+			 "fieldValues":{"nodes":[{"__typename":"ProjectV2ItemFieldSingleSelectValue","name":"Done","field":{"name":"Status"}}]}},
+			{"id":"PVTI_3","content":{"__typename":"DraftIssue","id":"DI_1","title":"C","body":""},"fieldValues":{"nodes":[]}},
+			{"id":"PVTI_4","content":{"__typename":"DraftIssue","id":"DI_2","title":"D","body":""},
+			 "fieldValues":{"nodes":[{"__typename":"ProjectV2ItemFieldTextValue","text":"D","field":{"name":"Title"}}],"pageInfo":{"hasNextPage":true}}}
+		],"pageInfo":{"endCursor":"c1","hasNextPage":false}}}}}`))
+	}))
+	defer server.Close()
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+
+	out, err := GetProjectSummary(context.Background(), &GetProjectSummaryInput{ProjectID: "PVT_1"}, client)
+	require.NoError(t, err)
+	assert.Equal(t, &ProjectSummary{
+		TotalItems:           4,
+		Open:                 1,
+		Closed:               1,
+		Drafts:               2,
+		ByStatus:             map[string]int{"In Progress": 1, "Done": 1},
+		NoStatus:             1,
+		ByAssignee:           map[string]int{"alice": 2, "bob": 1},
+		Unassigned:           2,
+		ByLabel:              map[string]int{"bug": 2, "ui": 1},
+		NoIteration:          2,
+		FieldValuesTruncated: 1,
+	}, out)
+
+	out, err = GetProjectSummary(context.Background(), &GetProjectSummaryInput{ProjectID: "PVT_1", Filter: &ProjectItemFilter{FieldName: "Sprint", FieldValue: "sprint 1"}}, client)
+	require.NoError(t, err)
+	assert.Equal(t, 1, out.TotalItems)
+	assert.Equal(t, map[string]int{"In Progress": 1}, out.ByStatus)
+}
+
+func TestListProjectsState(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, _ := decodeGraphQLRequest(t, r)
+		assert.Contains(t, query, "closed,public,updatedAt")
+		w.WriteHeader(200)
+		w.Write([]byte(`{"data":{"user":{"projectsV2":{"nodes":[
+			{"id":"PVT_1","number":1,"title":"Live","url":"https://github.com/users/octocat/projects/1","closed":false,"public":true,"updatedAt":"2025-06-02T10:00:00Z"},
+			{"id":"PVT_2","number":2,"title":"Old","url":"https://github.com/users/octocat/projects/2","closed":true,"public":false,"updatedAt":"2024-01-05T00:00:00Z"}
+		],"pageInfo":{"endCursor":"c1","hasNextPage":false}}}}}`))
+	}))
+	defer server.Close()
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+
+	live := Project{ID: "PVT_1", Number: 1, Title: "Live", URL: "https://github.com/users/octocat/projects/1", Public: true, UpdatedAt: "2025-06-02T10:00:00Z"}
+	old := Project{ID: "PVT_2", Number: 2, Title: "Old", URL: "https://github.com/users/octocat/projects/2", Closed: true, UpdatedAt: "2024-01-05T00:00:00Z"}
+	tests := []struct {
+		state string
+		want  []Project
+	}{
+		{"", []Project{live, old}},
+		{"all", []Project{live, old}},
+		{"open", []Project{live}},
+		{"Closed", []Project{old}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.state, func(t *testing.T) {
+			out, err := ListUserProjects(context.Background(), &ListUserProjectsInput{User: "octocat", State: tc.state}, client)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, out.Projects)
+		})
+	}
+
+	_, err := ListUserProjects(context.Background(), &ListUserProjectsInput{User: "octocat", State: "archived"}, client)
+	assert.Error(t, err)
+}
+
+func TestGetProjectReadme(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, _ := decodeGraphQLRequest(t, r)
+		assert.Contains(t, query, "readme")
+		w.WriteHeader(200)
+		w.Write([]byte(`{"data":{"organization":{"projectV2":{"id":"PVT_1","number":4,"title":"Sprint board","url":"https://github.com/orgs/acme/projects/4",` +
+			`"closed":false,"public":false,"updatedAt":"2025-06-02T10:00:00Z","readme":"## Sprint goal\nShip it"}},"user":null}}`))
+	}))
+	defer server.Close()
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+
+	out, err := GetProject(context.Background(), &GetProjectInput{Owner: "acme", Number: 4}, client)
+	require.NoError(t, err)
+	assert.Equal(t, "## Sprint goal\nShip it", out.Readme)
+	assert.Equal(t, "2025-06-02T10:00:00Z", out.UpdatedAt)
+}
+
+func TestUpdateProjectReadmeTool(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, vars := decodeGraphQLRequest(t, r)
+		assert.Equal(t, map[string]interface{}{"projectId": "PVT_1", "readme": ""}, vars["input"])
+		w.WriteHeader(200)
+		w.Write([]byte(`{"data":{"updateProjectV2":{"projectV2":{"id":"PVT_1","number":4,"title":"Board","url":"https://github.com/orgs/acme/projects/4",` +
+			`"shortDescription":"","readme":"","public":false,"closed":false},"clientMutationId":""}}}`))
+	}))
+	defer server.Close()
+	getClient := stubGetGraphQLClientFn(githubv4.NewEnterpriseClient(server.URL, server.Client()))
+
+	_, handler := UpdateProjectReadmeTool(getClient, translations.NullTranslationHelper)
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{"project_id": "PVT_1", "readme": ""}))
+	require.NoError(t, err)
+	assert.Contains(t, getTextResult(t, result).Text, `"readme":""`)
+
+	_, err = handler(context.Background(), createMCPRequest(map[string]interface{}{"project_id": "PVT_1"}))
+	assert.EqualError(t, err, "missing required parameter: readme")
+}
@@ -3,10 +3,12 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+
+	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
-	"github.com/github/github-mcp-server/pkg/translations"
 )
 
 // MCP tool factory for listing organization projects
@@ -15,8 +17,13 @@ func ListOrganizationProjectsTool(getClient GetGraphQLClientFn, t translations.T
 		"list_organization_projects",
 		mcp.WithDescription("List Projects for an organization"),
 		mcp.WithString("organization", mcp.Required(), mcp.Description("The organization login")),
-		mcp.WithNumber("first", mcp.Description("Max number of projects to return")),
+		mcp.WithNumber("first", mcp.Description("Max number of projects to return (default 30, max 100)")),
 		mcp.WithString("after", mcp.Description("Cursor for pagination")),
+		withAllPages("projects"),
+		mcp.WithString("state",
+			mcp.Description("Only return open or closed projects (default all); filtering is applied to the fetched page, so fewer than first may be returned"),
+			mcp.Enum("open", "closed", "all"),
+		),
 	)
 	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		client, err := getClient(ctx)
@@ -28,16 +35,29 @@ func ListOrganizationProjectsTool(getClient GetGraphQLClientFn, t translations.T
 		if err != nil {
 			return nil, err
 		}
-		first, _ := requiredParam[float64](req, "first") // optional
-		after, _ := requiredParam[string](req, "after") // optional
-		ownerID, err := resolveOwnerID(ctx, client, organization)
+		first, err := OptionalIntParamInRange(req, "first", defaultProjectsPageSize, 1, maxProjectsPageSize)
+		if err != nil {
+			return nil, err
+		}
+		after, err := OptionalParam[string](req, "after")
+		if err != nil {
+			return nil, err
+		}
+		all, maxPages, err := allPagesParams(req)
+		if err != nil {
+			return nil, err
+		}
+		state, err := OptionalParam[string](req, "state")
 		if err != nil {
 			return nil, err
 		}
 		input := &ListOrganizationProjectsInput{
-			Organization: fmt.Sprint(ownerID),
-			First:        int(first),
+			Organization: organization,
+			First:        first,
 			After:        after,
+			All:          all,
+			MaxPages:     maxPages,
+			State:        state,
 		}
 		out, err := ListOrganizationProjects(ctx, input, client)
 		if err != nil {
@@ -55,8 +75,13 @@ func ListUserProjectsTool(getClient GetGraphQLClientFn, t translations.Translati
 		"list_user_projects",
 		mcp.WithDescription("List Projects for a user"),
 		mcp.WithString("user", mcp.Required(), mcp.Description("The user login")),
-		mcp.WithNumber("first", mcp.Description("Max number of projects to return")),
+		mcp.WithNumber("first", mcp.Description("Max number of projects to return (default 30, max 100)")),
 		mcp.WithString("after", mcp.Description("Cursor for pagination")),
+		withAllPages("projects"),
+		mcp.WithString("state",
+			mcp.Description("Only return open or closed projects (default all); filtering is applied to the fetched page, so fewer than first may be returned"),
+			mcp.Enum("open", "closed", "all"),
+		),
 	)
 	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		client, err := getClient(ctx)
@@ -68,16 +93,29 @@ func ListUserProjectsTool(getClient GetGraphQLClientFn, t translations.Translati
 		if err != nil {
 			return nil, err
 		}
-		first, _ := requiredParam[float64](req, "first") // optional
-		after, _ := requiredParam[string](req, "after") // optional
-		userID, err := resolveOwnerID(ctx, client, user)
+		first, err := OptionalIntParamInRange(req, "first", defaultProjectsPageSize, 1, maxProjectsPageSize)
+		if err != nil {
+			return nil, err
+		}
+		after, err := OptionalParam[string](req, "after")
+		if err != nil {
+			return nil, err
+		}
+		all, maxPages, err := allPagesParams(req)
+		if err != nil {
+			return nil, err
+		}
+		state, err := OptionalParam[string](req, "state")
 		if err != nil {
 			return nil, err
 		}
 		input := &ListUserProjectsInput{
-			User:  fmt.Sprint(userID),
-			First: int(first),
-			After: after,
+			User:     user,
+			First:    first,
+			After:    after,
+			All:      all,
+			MaxPages: maxPages,
+			State:    state,
 		}
 		out, err := ListUserProjects(ctx, input, client)
 		if err != nil {
@@ -89,13 +127,216 @@ func ListUserProjectsTool(getClient GetGraphQLClientFn, t translations.Translati
 	return tool, handler
 }
 
+// MCP tool factory for listing an organization's template projects
+func ListTemplateProjectsTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"list_template_projects",
+		mcp.WithDescription("List an organization's template projects, which can be copied with copy_project. When truncated is true, raise max_requests to scan more of the organization's projects."),
+		mcp.WithString("organization", mcp.Required(), mcp.Description("The organization login")),
+		mcp.WithNumber("max_requests", mcp.Description("Stop after this many API requests of up to 100 projects each (default 20)")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		org, err := requiredParam[string](req, "organization")
+		if err != nil {
+			return nil, err
+		}
+		maxRequests, err := OptionalIntParam(req, "max_requests")
+		if err != nil {
+			return nil, err
+		}
+		input := &ListTemplateProjectsInput{
+			Organization: org,
+			MaxRequests:  maxRequests,
+		}
+		out, err := ListTemplateProjects(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for listing the projects linked to a repository
+func ListRepositoryProjectsTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"list_repository_projects",
+		mcp.WithDescription("List the projects linked to a repository"),
+		mcp.WithString("owner", mcp.Required(), mcp.Description("Repository owner")),
+		mcp.WithString("repo", mcp.Required(), mcp.Description("Repository name")),
+		mcp.WithNumber("first", mcp.Description("Max number of projects to return (default 30, max 100)")),
+		mcp.WithString("after", mcp.Description("Cursor for pagination")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		owner, err := requiredParam[string](req, "owner")
+		if err != nil {
+			return nil, err
+		}
+		repo, err := requiredParam[string](req, "repo")
+		if err != nil {
+			return nil, err
+		}
+		first, err := OptionalIntParamInRange(req, "first", defaultProjectsPageSize, 1, maxProjectsPageSize)
+		if err != nil {
+			return nil, err
+		}
+		after, err := OptionalParam[string](req, "after")
+		if err != nil {
+			return nil, err
+		}
+		input := &ListRepositoryProjectsInput{
+			Owner: owner,
+			Repo:  repo,
+			First: first,
+			After: after,
+		}
+		out, err := ListRepositoryProjects(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for listing the projects linked to a team
+func ListTeamProjectsTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"list_team_projects",
+		mcp.WithDescription("List the projects linked to a team"),
+		mcp.WithString("organization", mcp.Required(), mcp.Description("The organization login")),
+		mcp.WithString("team", mcp.Required(), mcp.Description("Team slug")),
+		mcp.WithNumber("first", mcp.Description("Max number of projects to return (default 30, max 100)")),
+		mcp.WithString("after", mcp.Description("Cursor for pagination")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		org, err := requiredParam[string](req, "organization")
+		if err != nil {
+			return nil, err
+		}
+		team, err := requiredParam[string](req, "team")
+		if err != nil {
+			return nil, err
+		}
+		first, err := OptionalIntParamInRange(req, "first", defaultProjectsPageSize, 1, maxProjectsPageSize)
+		if err != nil {
+			return nil, err
+		}
+		after, err := OptionalParam[string](req, "after")
+		if err != nil {
+			return nil, err
+		}
+		input := &ListTeamProjectsInput{
+			Organization: org,
+			Team:         team,
+			First:        first,
+			After:        after,
+		}
+		out, err := ListTeamProjects(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
 // MCP tool factory for getting a project
 func GetProjectTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
 	tool := mcp.NewTool(
 		"get_project",
-		mcp.WithDescription("Get a project by owner and number"),
+		mcp.WithDescription("Get a project by owner and number, or by its URL, including its readme"),
+		mcp.WithString("owner", mcp.Description("The organization or user login; required unless url is set")),
+		mcp.WithNumber("number", mcp.Description("Project number; required unless url is set")),
+		mcp.WithString("url", mcp.Description("Project URL, e.g. https://github.com/orgs/ORG/projects/N, instead of owner and number")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		owner, err := OptionalParam[string](req, "owner")
+		if err != nil {
+			return nil, err
+		}
+		number, err := OptionalIntParam(req, "number")
+		if err != nil {
+			return nil, err
+		}
+		projectURL, err := OptionalParam[string](req, "url")
+		if err != nil {
+			return nil, err
+		}
+		// Pass the login string for queries; resolveOwnerID is only needed for mutations.
+		input := &GetProjectInput{
+			Owner:  owner,
+			Number: number,
+			URL:    projectURL,
+		}
+		out, err := GetProject(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for getting a project by URL
+func GetProjectByURLTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"get_project_by_url",
+		mcp.WithDescription("Get a project from its URL, e.g. https://github.com/orgs/ORG/projects/N or https://github.com/users/USER/projects/N"),
+		mcp.WithString("url", mcp.Required(), mcp.Description("Project URL")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		projectURL, err := requiredParam[string](req, "url")
+		if err != nil {
+			return nil, err
+		}
+		out, err := GetProjectByURL(ctx, &GetProjectByURLInput{URL: projectURL}, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for getting a project together with its first page of items
+func GetProjectWithItemsTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"get_project_with_items",
+		mcp.WithDescription("Get a project by owner and number together with its first page of items in one request. Use get_project_items with the returned end_cursor for further pages."),
 		mcp.WithString("owner", mcp.Required(), mcp.Description("The organization or user login")),
 		mcp.WithNumber("number", mcp.Required(), mcp.Description("Project number")),
+		mcp.WithNumber("first", mcp.Description("Max number of items to return (default 30, max 100)")),
 	)
 	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		client, err := getClient(ctx)
@@ -111,12 +352,16 @@ func GetProjectTool(getClient GetGraphQLClientFn, t translations.TranslationHelp
 		if err != nil {
 			return nil, err
 		}
-		// Pass the login string for queries; resolveOwnerID is only needed for mutations.
-		input := &GetProjectInput{
+		first, err := OptionalIntParamInRange(req, "first", defaultProjectsPageSize, 1, maxProjectsPageSize)
+		if err != nil {
+			return nil, err
+		}
+		input := &GetProjectWithItemsInput{
 			Owner:  owner,
 			Number: int(number),
+			First:  first,
 		}
-		out, err := GetProject(ctx, input, client)
+		out, err := GetProjectWithItems(ctx, input, client)
 		if err != nil {
 			return nil, err
 		}
@@ -130,10 +375,18 @@ func GetProjectTool(getClient GetGraphQLClientFn, t translations.TranslationHelp
 func GetProjectItemsTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
 	tool := mcp.NewTool(
 		"get_project_items",
-		mcp.WithDescription("Get items for a project"),
+		mcp.WithDescription("Get items for a project, including each item's project field values (status, iteration, custom fields) keyed by field name"),
 		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
-		mcp.WithNumber("first", mcp.Description("Max number of items to return")),
+		mcp.WithNumber("first", mcp.Description("Max number of items to fetch (default 30, max 100); filters are applied to the fetched page, so fewer may be returned")),
 		mcp.WithString("after", mcp.Description("Cursor for pagination")),
+		withAllPages("items"),
+		withProjectItemFilter(),
+		mcp.WithString("order_by", mcp.Description("Project field to sort the fetched items by, e.g. Priority; items without a value sort last")),
+		mcp.WithString("order_direction",
+			mcp.Description("Sort direction for order_by (default asc)"),
+			mcp.Enum("asc", "desc"),
+		),
+		mcp.WithString("group_by", mcp.Description("Project field to group the fetched items by, e.g. Status; the items are then returned under groups instead of items")),
 	)
 	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		client, err := getClient(ctx)
@@ -145,12 +398,45 @@ func GetProjectItemsTool(getClient GetGraphQLClientFn, t translations.Translatio
 		if err != nil {
 			return nil, err
 		}
-		first, _ := requiredParam[float64](req, "first") // optional
-		after, _ := requiredParam[string](req, "after") // optional
+		first, err := OptionalIntParamInRange(req, "first", defaultProjectsPageSize, 1, maxProjectsPageSize)
+		if err != nil {
+			return nil, err
+		}
+		after, err := OptionalParam[string](req, "after")
+		if err != nil {
+			return nil, err
+		}
+		all, maxPages, err := allPagesParams(req)
+		if err != nil {
+			return nil, err
+		}
+		filter, err := projectItemFilterParam(req)
+		if err != nil {
+			return nil, err
+		}
+		orderBy, err := OptionalParam[string](req, "order_by")
+		if err != nil {
+			return nil, err
+		}
+		direction, err := OptionalParam[string](req, "order_direction")
+		if err != nil {
+			return nil, err
+		}
+		groupBy, err := OptionalParam[string](req, "group_by")
+		if err != nil {
+			return nil, err
+		}
 		input := &GetProjectItemsInput{
 			ProjectID: projectID,
-			First:     int(first),
+			First:     first,
 			After:     after,
+			All:       all,
+			MaxPages:  maxPages,
+			Filter:    filter,
+			GroupBy:   groupBy,
+		}
+		if orderBy != "" || direction != "" {
+			input.OrderBy = &ProjectItemOrder{Field: orderBy, Direction: direction}
 		}
 		out, err := GetProjectItems(ctx, input, client)
 		if err != nil {
@@ -166,10 +452,12 @@ func GetProjectItemsTool(getClient GetGraphQLClientFn, t translations.Translatio
 func CreateProjectTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
 	tool := mcp.NewTool(
 		"create_project",
-		mcp.WithDescription("Create a new project"),
-		mcp.WithString("owner", mcp.Required(), mcp.Description("The organization or user login")),
+		mcp.WithDescription("Create a new project. Exactly one of owner or owner_id is required."),
+		mcp.WithString("owner", mcp.Description("The organization or user login")),
+		mcp.WithString("owner_id", mcp.Description("The organization or user node ID; skips the owner lookup")),
 		mcp.WithString("title", mcp.Required(), mcp.Description("Project title")),
 		mcp.WithString("description", mcp.Description("Project description")),
+		mcp.WithString("client_mutation_id", mcp.Description("Optional client mutation ID echoed back in the response")),
 	)
 	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		client, err := getClient(ctx)
@@ -177,7 +465,11 @@ func CreateProjectTool(getClient GetGraphQLClientFn, t translations.TranslationH
 			return nil, err
 		}
 
-		owner, err := requiredParam[string](req, "owner")
+		owner, err := OptionalParam[string](req, "owner")
+		if err != nil {
+			return nil, err
+		}
+		ownerID, err := OptionalParam[string](req, "owner_id")
 		if err != nil {
 			return nil, err
 		}
@@ -185,11 +477,20 @@ func CreateProjectTool(getClient GetGraphQLClientFn, t translations.TranslationH
 		if err != nil {
 			return nil, err
 		}
-		description, _ := requiredParam[string](req, "description") // optional
+		description, err := OptionalParam[string](req, "description")
+		if err != nil {
+			return nil, err
+		}
+		mutationID, err := OptionalParam[string](req, "client_mutation_id")
+		if err != nil {
+			return nil, err
+		}
 		input := &CreateProjectInput{
-			Owner:       owner,
-			Title:       title,
-			Description: description,
+			Owner:            owner,
+			OwnerID:          ownerID,
+			Title:            title,
+			Description:      description,
+			ClientMutationID: mutationID,
 		}
 		out, err := CreateProject(ctx, input, client)
 		if err != nil {
@@ -201,13 +502,17 @@ func CreateProjectTool(getClient GetGraphQLClientFn, t translations.TranslationH
 	return tool, handler
 }
 
-// MCP tool factory for adding a project item
-func AddProjectItemTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+// MCP tool factory for copying a project
+func CopyProjectTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
 	tool := mcp.NewTool(
-		"add_project_item",
-		mcp.WithDescription("Add an item to a project"),
-		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
-		mcp.WithString("content_id", mcp.Required(), mcp.Description("Content node ID (issue, PR, etc)")),
+		"copy_project",
+		mcp.WithDescription("Create a new project from an existing one, usually a template. Fields, views, workflows and insights are copied; items are not, except draft issues when include_draft_issues is true. Exactly one of owner or owner_id is required."),
+		mcp.WithString("project_id", mcp.Required(), mcp.Description("Node ID of the project to copy")),
+		mcp.WithString("owner", mcp.Description("The organization or user login that will own the new project")),
+		mcp.WithString("owner_id", mcp.Description("The organization or user node ID; skips the owner lookup")),
+		mcp.WithString("title", mcp.Required(), mcp.Description("Title of the new project")),
+		mcp.WithBoolean("include_draft_issues", mcp.Description("Also copy the draft issues of the source project")),
+		mcp.WithString("client_mutation_id", mcp.Description("Optional client mutation ID echoed back in the response")),
 	)
 	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		client, err := getClient(ctx)
@@ -219,15 +524,35 @@ func AddProjectItemTool(getClient GetGraphQLClientFn, t translations.Translation
 		if err != nil {
 			return nil, err
 		}
-		contentID, err := requiredParam[string](req, "content_id")
+		owner, err := OptionalParam[string](req, "owner")
 		if err != nil {
 			return nil, err
 		}
-		input := &AddProjectItemInput{
-			ProjectID: projectID,
-			ContentID: contentID,
+		ownerID, err := OptionalParam[string](req, "owner_id")
+		if err != nil {
+			return nil, err
 		}
-		out, err := AddProjectItem(ctx, input, client)
+		title, err := requiredParam[string](req, "title")
+		if err != nil {
+			return nil, err
+		}
+		includeDrafts, err := OptionalParam[bool](req, "include_draft_issues")
+		if err != nil {
+			return nil, err
+		}
+		mutationID, err := OptionalParam[string](req, "client_mutation_id")
+		if err != nil {
+			return nil, err
+		}
+		input := &CopyProjectInput{
+			ProjectID:          projectID,
+			Owner:              owner,
+			OwnerID:            ownerID,
+			Title:              title,
+			IncludeDraftIssues: includeDrafts,
+			ClientMutationID:   mutationID,
+		}
+		out, err := CopyProject(ctx, input, client)
 		if err != nil {
 			return nil, err
 		}
@@ -237,15 +562,14 @@ func AddProjectItemTool(getClient GetGraphQLClientFn, t translations.Translation
 	return tool, handler
 }
 
-// MCP tool factory for updating a project item field
-func UpdateProjectItemFieldTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+// MCP tool factory for replacing a project's readme
+func UpdateProjectReadmeTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
 	tool := mcp.NewTool(
-		"update_project_item_field",
-		mcp.WithDescription("Update a field on a project item"),
+		"update_project_readme",
+		mcp.WithDescription("Replace a project's readme. The whole readme is overwritten, so read it with get_project first to edit part of it."),
 		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
-		mcp.WithString("item_id", mcp.Required(), mcp.Description("Item node ID")),
-		mcp.WithString("field_id", mcp.Required(), mcp.Description("Field node ID")),
-		mcp.WithString("value", mcp.Required(), mcp.Description("New value for the field")),
+		mcp.WithString("readme", mcp.Required(), mcp.Description("New readme, in Markdown; an empty string clears it")),
+		mcp.WithString("client_mutation_id", mcp.Description("Optional client mutation ID echoed back in the response")),
 	)
 	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		client, err := getClient(ctx)
@@ -257,25 +581,25 @@ func UpdateProjectItemFieldTool(getClient GetGraphQLClientFn, t translations.Tra
 		if err != nil {
 			return nil, err
 		}
-		itemID, err := requiredParam[string](req, "item_id")
+		// An empty readme is valid, so presence is checked rather than
+		// using requiredParam.
+		readme, ok, err := OptionalParamOK[string](req, "readme")
 		if err != nil {
 			return nil, err
 		}
-		fieldID, err := requiredParam[string](req, "field_id")
-		if err != nil {
-			return nil, err
+		if !ok {
+			return nil, errors.New("missing required parameter: readme")
 		}
-		value, err := requiredParam[string](req, "value")
+		mutationID, err := OptionalParam[string](req, "client_mutation_id")
 		if err != nil {
 			return nil, err
 		}
-		input := &UpdateProjectItemFieldInput{
-			ProjectID: projectID,
-			ItemID:    itemID,
-			FieldID:   fieldID,
-			Value:     value,
+		input := &UpdateProjectInput{
+			ProjectID:        projectID,
+			Readme:           &readme,
+			ClientMutationID: mutationID,
 		}
-		out, err := UpdateProjectItemField(ctx, input, client)
+		out, err := UpdateProject(ctx, input, client)
 		if err != nil {
 			return nil, err
 		}
@@ -284,3 +608,1666 @@ func UpdateProjectItemFieldTool(getClient GetGraphQLClientFn, t translations.Tra
 	}
 	return tool, handler
 }
+
+// MCP tool factory for updating a project's settings
+func UpdateProjectTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"update_project",
+		mcp.WithDescription("Update a project's title, short description, readme, visibility or open/closed state. Omitted fields are left unchanged."),
+		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
+		mcp.WithString("title", mcp.Description("New title")),
+		mcp.WithString("short_description", mcp.Description("New short description")),
+		mcp.WithString("readme", mcp.Description("New readme, in Markdown")),
+		mcp.WithBoolean("public", mcp.Description("true to make the project public, false to make it private")),
+		mcp.WithBoolean("closed", mcp.Description("true to close the project, false to reopen it")),
+		mcp.WithString("client_mutation_id", mcp.Description("Optional client mutation ID echoed back in the response")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		projectID, err := requiredParam[string](req, "project_id")
+		if err != nil {
+			return nil, err
+		}
+		mutationID, err := OptionalParam[string](req, "client_mutation_id")
+		if err != nil {
+			return nil, err
+		}
+		input := &UpdateProjectInput{
+			ProjectID:        projectID,
+			ClientMutationID: mutationID,
+		}
+		// Presence, not the zero value, decides whether a field is sent, so
+		// false and empty strings can be set explicitly.
+		title, ok, err := OptionalParamOK[string](req, "title")
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			input.Title = &title
+		}
+		shortDescription, ok, err := OptionalParamOK[string](req, "short_description")
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			input.ShortDescription = &shortDescription
+		}
+		readme, ok, err := OptionalParamOK[string](req, "readme")
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			input.Readme = &readme
+		}
+		public, ok, err := OptionalParamOK[bool](req, "public")
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			input.Public = &public
+		}
+		closed, ok, err := OptionalParamOK[bool](req, "closed")
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			input.Closed = &closed
+		}
+		out, err := UpdateProject(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for deleting a project
+func DeleteProjectTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"delete_project",
+		mcp.WithDescription("Permanently delete a project and all of its items and fields. The issues and pull requests on it are not affected."),
+		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
+		mcp.WithString("client_mutation_id", mcp.Description("Optional client mutation ID echoed back in the response")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		projectID, err := requiredParam[string](req, "project_id")
+		if err != nil {
+			return nil, err
+		}
+		mutationID, err := OptionalParam[string](req, "client_mutation_id")
+		if err != nil {
+			return nil, err
+		}
+		input := &DeleteProjectInput{
+			ProjectID:        projectID,
+			ClientMutationID: mutationID,
+		}
+		out, err := DeleteProject(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for listing project status updates
+func ListProjectStatusUpdatesTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"list_project_status_updates",
+		mcp.WithDescription("List the status updates posted on a project, newest first"),
+		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
+		mcp.WithNumber("first", mcp.Description("Max number of status updates to return (default 30, max 100)")),
+		mcp.WithString("after", mcp.Description("Cursor for pagination")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		projectID, err := requiredParam[string](req, "project_id")
+		if err != nil {
+			return nil, err
+		}
+		first, err := OptionalIntParamInRange(req, "first", defaultProjectsPageSize, 1, maxProjectsPageSize)
+		if err != nil {
+			return nil, err
+		}
+		after, err := OptionalParam[string](req, "after")
+		if err != nil {
+			return nil, err
+		}
+		input := &ListProjectStatusUpdatesInput{
+			ProjectID: projectID,
+			First:     first,
+			After:     after,
+		}
+		out, err := ListProjectStatusUpdates(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for posting a project status update
+func CreateProjectStatusUpdateTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"create_project_status_update",
+		mcp.WithDescription("Post a status update on a project"),
+		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
+		mcp.WithString("status",
+			mcp.Description("Overall project status"),
+			mcp.Enum("inactive", "on_track", "at_risk", "off_track", "complete"),
+		),
+		mcp.WithString("start_date", mcp.Description("Start date in YYYY-MM-DD format")),
+		mcp.WithString("target_date", mcp.Description("Target date in YYYY-MM-DD format")),
+		mcp.WithString("body", mcp.Description("Update text, in Markdown")),
+		mcp.WithString("client_mutation_id", mcp.Description("Optional client mutation ID echoed back in the response")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		projectID, err := requiredParam[string](req, "project_id")
+		if err != nil {
+			return nil, err
+		}
+		status, err := OptionalParam[string](req, "status")
+		if err != nil {
+			return nil, err
+		}
+		startDate, err := OptionalParam[string](req, "start_date")
+		if err != nil {
+			return nil, err
+		}
+		targetDate, err := OptionalParam[string](req, "target_date")
+		if err != nil {
+			return nil, err
+		}
+		body, err := OptionalParam[string](req, "body")
+		if err != nil {
+			return nil, err
+		}
+		mutationID, err := OptionalParam[string](req, "client_mutation_id")
+		if err != nil {
+			return nil, err
+		}
+		input := &CreateProjectStatusUpdateInput{
+			ProjectID:        projectID,
+			Status:           status,
+			StartDate:        startDate,
+			TargetDate:       targetDate,
+			Body:             body,
+			ClientMutationID: mutationID,
+		}
+		out, err := CreateProjectStatusUpdate(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for deleting a project status update
+func DeleteProjectStatusUpdateTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"delete_project_status_update",
+		mcp.WithDescription("Delete a status update from a project"),
+		mcp.WithString("status_update_id", mcp.Required(), mcp.Description("Status update node ID")),
+		mcp.WithString("client_mutation_id", mcp.Description("Optional client mutation ID echoed back in the response")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		statusUpdateID, err := requiredParam[string](req, "status_update_id")
+		if err != nil {
+			return nil, err
+		}
+		mutationID, err := OptionalParam[string](req, "client_mutation_id")
+		if err != nil {
+			return nil, err
+		}
+		input := &DeleteProjectStatusUpdateInput{
+			StatusUpdateID:   statusUpdateID,
+			ClientMutationID: mutationID,
+		}
+		out, err := DeleteProjectStatusUpdate(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// projectRepositoryLinkTool builds the link and unlink tools, which take the
+// same parameters.
+func projectRepositoryLinkTool(
+	name, description string,
+	link func(context.Context, *ProjectRepositoryLinkInput, GraphQLClient) (*ProjectRepositoryLinkOutput, error),
+	getClient GetGraphQLClientFn,
+) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		name,
+		mcp.WithDescription(description),
+		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
+		mcp.WithString("owner", mcp.Required(), mcp.Description("Repository owner")),
+		mcp.WithString("repo", mcp.Required(), mcp.Description("Repository name")),
+		mcp.WithString("client_mutation_id", mcp.Description("Optional client mutation ID echoed back in the response")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		projectID, err := requiredParam[string](req, "project_id")
+		if err != nil {
+			return nil, err
+		}
+		owner, err := requiredParam[string](req, "owner")
+		if err != nil {
+			return nil, err
+		}
+		repo, err := requiredParam[string](req, "repo")
+		if err != nil {
+			return nil, err
+		}
+		mutationID, err := OptionalParam[string](req, "client_mutation_id")
+		if err != nil {
+			return nil, err
+		}
+		input := &ProjectRepositoryLinkInput{
+			ProjectID:        projectID,
+			Owner:            owner,
+			Repo:             repo,
+			ClientMutationID: mutationID,
+		}
+		out, err := link(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for linking a project to a repository
+func LinkProjectToRepositoryTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return projectRepositoryLinkTool(
+		"link_project_to_repository",
+		"Link a project to a repository so it appears in the repository's Projects tab",
+		LinkProjectToRepository,
+		getClient,
+	)
+}
+
+// MCP tool factory for unlinking a project from a repository
+func UnlinkProjectFromRepositoryTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return projectRepositoryLinkTool(
+		"unlink_project_from_repository",
+		"Remove the link between a project and a repository. The project and its items are not affected.",
+		UnlinkProjectFromRepository,
+		getClient,
+	)
+}
+
+// projectTeamLinkTool builds the team link and unlink tools, which take the
+// same parameters.
+func projectTeamLinkTool(
+	name, description string,
+	link func(context.Context, *ProjectTeamLinkInput, GraphQLClient) (*ProjectTeamLinkOutput, error),
+	getClient GetGraphQLClientFn,
+) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		name,
+		mcp.WithDescription(description),
+		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
+		mcp.WithString("organization", mcp.Required(), mcp.Description("The organization login")),
+		mcp.WithString("team", mcp.Required(), mcp.Description("Team slug")),
+		mcp.WithString("client_mutation_id", mcp.Description("Optional client mutation ID echoed back in the response")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		projectID, err := requiredParam[string](req, "project_id")
+		if err != nil {
+			return nil, err
+		}
+		org, err := requiredParam[string](req, "organization")
+		if err != nil {
+			return nil, err
+		}
+		team, err := requiredParam[string](req, "team")
+		if err != nil {
+			return nil, err
+		}
+		mutationID, err := OptionalParam[string](req, "client_mutation_id")
+		if err != nil {
+			return nil, err
+		}
+		input := &ProjectTeamLinkInput{
+			ProjectID:        projectID,
+			Organization:     org,
+			Team:             team,
+			ClientMutationID: mutationID,
+		}
+		out, err := link(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for listing project collaborators
+func ListProjectCollaboratorsTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"list_project_collaborators",
+		mcp.WithDescription("List the users and teams with direct access to a project. Teams are reported as org/slug; roles are not included."),
+		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
+		mcp.WithNumber("first", mcp.Description("Max number of collaborators to return (default 30, max 100)")),
+		mcp.WithString("after", mcp.Description("Cursor for pagination")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		projectID, err := requiredParam[string](req, "project_id")
+		if err != nil {
+			return nil, err
+		}
+		first, err := OptionalIntParamInRange(req, "first", defaultProjectsPageSize, 1, maxProjectsPageSize)
+		if err != nil {
+			return nil, err
+		}
+		after, err := OptionalParam[string](req, "after")
+		if err != nil {
+			return nil, err
+		}
+		input := &ListProjectCollaboratorsInput{
+			ProjectID: projectID,
+			First:     first,
+			After:     after,
+		}
+		out, err := ListProjectCollaborators(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// projectCollaboratorsTool builds the add and remove collaborator tools,
+// which differ in whether the role is taken from the request or fixed to none.
+func projectCollaboratorsTool(name, description string, withRole bool, getClient GetGraphQLClientFn) (mcp.Tool, server.ToolHandlerFunc) {
+	opts := []mcp.ToolOption{
+		mcp.WithDescription(description),
+		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
+		mcp.WithArray("users",
+			mcp.Description("User logins"),
+			mcp.Items(
+				map[string]interface{}{
+					"type": "string",
+				},
+			),
+		),
+		mcp.WithArray("teams",
+			mcp.Description("Teams as org/slug, e.g. octo-org/platform"),
+			mcp.Items(
+				map[string]interface{}{
+					"type": "string",
+				},
+			),
+		),
+	}
+	if withRole {
+		opts = append(opts, mcp.WithString("role",
+			mcp.Required(),
+			mcp.Description("Role to grant"),
+			mcp.Enum("reader", "writer", "admin"),
+		))
+	}
+	opts = append(opts, mcp.WithString("client_mutation_id", mcp.Description("Optional client mutation ID echoed back in the response")))
+	tool := mcp.NewTool(name, opts...)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		projectID, err := requiredParam[string](req, "project_id")
+		if err != nil {
+			return nil, err
+		}
+		users, err := OptionalStringArrayParam(req, "users")
+		if err != nil {
+			return nil, err
+		}
+		teams, err := OptionalStringArrayParam(req, "teams")
+		if err != nil {
+			return nil, err
+		}
+		role := "none"
+		if withRole {
+			if role, err = requiredParam[string](req, "role"); err != nil {
+				return nil, err
+			}
+		}
+		mutationID, err := OptionalParam[string](req, "client_mutation_id")
+		if err != nil {
+			return nil, err
+		}
+		input := &UpdateProjectCollaboratorsInput{
+			ProjectID:        projectID,
+			Users:            users,
+			Teams:            teams,
+			Role:             role,
+			ClientMutationID: mutationID,
+		}
+		out, err := UpdateProjectCollaborators(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for adding project collaborators
+func AddProjectCollaboratorsTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return projectCollaboratorsTool(
+		"add_project_collaborators",
+		"Give users and teams direct access to a project with the given role, or change the role of existing collaborators. At least one user or team is required.",
+		true,
+		getClient,
+	)
+}
+
+// MCP tool factory for removing project collaborators
+func RemoveProjectCollaboratorsTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return projectCollaboratorsTool(
+		"remove_project_collaborators",
+		"Remove the direct access of users and teams to a project. At least one user or team is required.",
+		false,
+		getClient,
+	)
+}
+
+// MCP tool factory for linking a project to a team
+func LinkProjectToTeamTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return projectTeamLinkTool(
+		"link_project_to_team",
+		"Link a project to a team, giving the team's members access to it",
+		LinkProjectToTeam,
+		getClient,
+	)
+}
+
+// MCP tool factory for unlinking a project from a team
+func UnlinkProjectFromTeamTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return projectTeamLinkTool(
+		"unlink_project_from_team",
+		"Remove the link between a project and a team",
+		UnlinkProjectFromTeam,
+		getClient,
+	)
+}
+
+// MCP tool factory for adding a project item
+func AddProjectItemTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"add_project_item",
+		mcp.WithDescription("Add an issue or pull request to a project"),
+		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
+		mcp.WithString("content_id", mcp.Required(), mcp.Description("Issue or pull request to add: a node ID, a URL such as https://github.com/owner/repo/issues/42, or owner/repo#42")),
+		mcp.WithString("client_mutation_id", mcp.Description("Optional client mutation ID echoed back in the response")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		projectID, err := requiredParam[string](req, "project_id")
+		if err != nil {
+			return nil, err
+		}
+		contentID, err := requiredParam[string](req, "content_id")
+		if err != nil {
+			return nil, err
+		}
+		mutationID, err := OptionalParam[string](req, "client_mutation_id")
+		if err != nil {
+			return nil, err
+		}
+		input := &AddProjectItemInput{
+			ProjectID:        projectID,
+			ContentID:        contentID,
+			ClientMutationID: mutationID,
+		}
+		out, err := AddProjectItem(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for creating a draft issue on a project
+func AddProjectDraftIssueTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"add_project_draft_issue",
+		mcp.WithDescription("Create a draft issue directly on a project, without creating an issue in a repository"),
+		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
+		mcp.WithString("title", mcp.Required(), mcp.Description("Draft issue title")),
+		mcp.WithString("body", mcp.Description("Draft issue body")),
+		mcp.WithString("client_mutation_id", mcp.Description("Optional client mutation ID echoed back in the response")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		projectID, err := requiredParam[string](req, "project_id")
+		if err != nil {
+			return nil, err
+		}
+		title, err := requiredParam[string](req, "title")
+		if err != nil {
+			return nil, err
+		}
+		body, err := OptionalParam[string](req, "body")
+		if err != nil {
+			return nil, err
+		}
+		mutationID, err := OptionalParam[string](req, "client_mutation_id")
+		if err != nil {
+			return nil, err
+		}
+		input := &AddProjectDraftIssueInput{
+			ProjectID:        projectID,
+			Title:            title,
+			Body:             body,
+			ClientMutationID: mutationID,
+		}
+		out, err := AddProjectDraftIssue(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for updating a project item field
+func UpdateProjectItemFieldTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"update_project_item_field",
+		mcp.WithDescription("Update a field on a project item"),
+		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
+		mcp.WithString("item_id", mcp.Required(), mcp.Description("Item node ID")),
+		mcp.WithString("field_id", mcp.Required(), mcp.Description("Field node ID")),
+		mcp.WithString("field_type",
+			mcp.Description("Data type of the field, which decides how value is interpreted (default text)"),
+			mcp.Enum("text", "number", "date", "single_select", "iteration"),
+		),
+		mcp.WithString("value", mcp.Required(), mcp.Description("New value: text, a number, a YYYY-MM-DD date, a single select option name or ID, or an iteration ID")),
+		mcp.WithString("client_mutation_id", mcp.Description("Optional client mutation ID echoed back in the response")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		projectID, err := requiredParam[string](req, "project_id")
+		if err != nil {
+			return nil, err
+		}
+		itemID, err := requiredParam[string](req, "item_id")
+		if err != nil {
+			return nil, err
+		}
+		fieldID, err := requiredParam[string](req, "field_id")
+		if err != nil {
+			return nil, err
+		}
+		fieldType, err := OptionalParam[string](req, "field_type")
+		if err != nil {
+			return nil, err
+		}
+		value, err := requiredParam[string](req, "value")
+		if err != nil {
+			return nil, err
+		}
+		mutationID, err := OptionalParam[string](req, "client_mutation_id")
+		if err != nil {
+			return nil, err
+		}
+		input := &UpdateProjectItemFieldInput{
+			ProjectID:        projectID,
+			ItemID:           itemID,
+			FieldID:          fieldID,
+			FieldType:        ProjectFieldType(fieldType),
+			Value:            value,
+			ClientMutationID: mutationID,
+		}
+		out, err := UpdateProjectItemField(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for clearing a project item field
+func ClearProjectItemFieldTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"clear_project_item_field",
+		mcp.WithDescription("Remove the value of a field on a project item, such as its Status or Iteration"),
+		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
+		mcp.WithString("item_id", mcp.Required(), mcp.Description("Item node ID")),
+		mcp.WithString("field_id", mcp.Required(), mcp.Description("Field node ID")),
+		mcp.WithString("client_mutation_id", mcp.Description("Optional client mutation ID echoed back in the response")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		projectID, err := requiredParam[string](req, "project_id")
+		if err != nil {
+			return nil, err
+		}
+		itemID, err := requiredParam[string](req, "item_id")
+		if err != nil {
+			return nil, err
+		}
+		fieldID, err := requiredParam[string](req, "field_id")
+		if err != nil {
+			return nil, err
+		}
+		mutationID, err := OptionalParam[string](req, "client_mutation_id")
+		if err != nil {
+			return nil, err
+		}
+		input := &ClearProjectItemFieldInput{
+			ProjectID:        projectID,
+			ItemID:           itemID,
+			FieldID:          fieldID,
+			ClientMutationID: mutationID,
+		}
+		out, err := ClearProjectItemField(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for removing an item from a project
+func DeleteProjectItemTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"delete_project_item",
+		mcp.WithDescription("Remove an item from a project. The underlying issue or pull request is not deleted; a draft issue item is deleted permanently."),
+		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID the item belongs to")),
+		mcp.WithString("item_id", mcp.Required(), mcp.Description("Item node ID to remove")),
+		mcp.WithString("client_mutation_id", mcp.Description("Optional client mutation ID echoed back in the response")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		projectID, err := requiredParam[string](req, "project_id")
+		if err != nil {
+			return nil, err
+		}
+		itemID, err := requiredParam[string](req, "item_id")
+		if err != nil {
+			return nil, err
+		}
+		mutationID, err := OptionalParam[string](req, "client_mutation_id")
+		if err != nil {
+			return nil, err
+		}
+		input := &DeleteProjectItemInput{
+			ProjectID:        projectID,
+			ItemID:           itemID,
+			ClientMutationID: mutationID,
+		}
+		out, err := DeleteProjectItem(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for moving an item to another project
+func MoveItemBetweenProjectsTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"move_item_between_projects",
+		mcp.WithDescription("Add an item's issue or pull request to another project and copy its field values where the target has a field with the same name and type, then optionally remove it from the source project. Values that cannot be copied are listed in skipped_fields, and the source item is kept if any copy fails. Draft issues cannot be moved."),
+		mcp.WithString("source_project_id", mcp.Required(), mcp.Description("Node ID of the project the item is in")),
+		mcp.WithString("item_id", mcp.Required(), mcp.Description("Item node ID in the source project")),
+		mcp.WithString("target_project_id", mcp.Required(), mcp.Description("Node ID of the project to move the item to")),
+		mcp.WithBoolean("remove_from_source", mcp.Description("Delete the item from the source project once it is in the target (default false)")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		sourceID, err := requiredParam[string](req, "source_project_id")
+		if err != nil {
+			return nil, err
+		}
+		itemID, err := requiredParam[string](req, "item_id")
+		if err != nil {
+			return nil, err
+		}
+		targetID, err := requiredParam[string](req, "target_project_id")
+		if err != nil {
+			return nil, err
+		}
+		remove, err := OptionalParam[bool](req, "remove_from_source")
+		if err != nil {
+			return nil, err
+		}
+		input := &MoveProjectItemInput{
+			SourceProjectID:  sourceID,
+			ItemID:           itemID,
+			TargetProjectID:  targetID,
+			RemoveFromSource: remove,
+		}
+		out, err := MoveProjectItem(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for reordering a project item
+func ReorderProjectItemTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"reorder_project_item",
+		mcp.WithDescription("Move an item within a project. Omit after_item_id to move the item to the top."),
+		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
+		mcp.WithString("item_id", mcp.Required(), mcp.Description("Item node ID to move")),
+		mcp.WithString("after_item_id", mcp.Description("Item node ID to place the item after")),
+		mcp.WithString("client_mutation_id", mcp.Description("Optional client mutation ID echoed back in the response")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		projectID, err := requiredParam[string](req, "project_id")
+		if err != nil {
+			return nil, err
+		}
+		itemID, err := requiredParam[string](req, "item_id")
+		if err != nil {
+			return nil, err
+		}
+		afterItemID, err := OptionalParam[string](req, "after_item_id")
+		if err != nil {
+			return nil, err
+		}
+		mutationID, err := OptionalParam[string](req, "client_mutation_id")
+		if err != nil {
+			return nil, err
+		}
+		input := &ReorderProjectItemInput{
+			ProjectID:        projectID,
+			ItemID:           itemID,
+			AfterItemID:      afterItemID,
+			ClientMutationID: mutationID,
+		}
+		out, err := ReorderProjectItem(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for marking or unmarking a project as a template
+func SetProjectTemplateTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"set_project_template",
+		mcp.WithDescription("Mark or unmark a project as a template"),
+		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
+		mcp.WithBoolean("is_template", mcp.Required(), mcp.Description("true to mark the project as a template, false to unmark it")),
+		mcp.WithString("client_mutation_id", mcp.Description("Optional client mutation ID echoed back in the response")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		projectID, err := requiredParam[string](req, "project_id")
+		if err != nil {
+			return nil, err
+		}
+		// requiredParam rejects false as a zero value, so check presence instead.
+		isTemplate, ok, err := OptionalParamOK[bool](req, "is_template")
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, fmt.Errorf("missing required parameter: is_template")
+		}
+		mutationID, err := OptionalParam[string](req, "client_mutation_id")
+		if err != nil {
+			return nil, err
+		}
+		input := &SetProjectTemplateInput{
+			ProjectID:        projectID,
+			IsTemplate:       isTemplate,
+			ClientMutationID: mutationID,
+		}
+		out, err := SetProjectTemplate(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for editing a draft issue
+func UpdateDraftIssueTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"update_draft_issue",
+		mcp.WithDescription("Edit the title and/or body of a draft issue in a project. Identify the draft by exactly one of item_id or draft_issue_id; omitted fields are left unchanged."),
+		mcp.WithString("item_id", mcp.Description("Project item node ID of the draft issue")),
+		mcp.WithString("draft_issue_id", mcp.Description("Draft issue node ID")),
+		mcp.WithString("title", mcp.Description("New title")),
+		mcp.WithString("body", mcp.Description("New body")),
+		mcp.WithString("client_mutation_id", mcp.Description("Optional client mutation ID echoed back in the response")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		itemID, err := OptionalParam[string](req, "item_id")
+		if err != nil {
+			return nil, err
+		}
+		draftIssueID, err := OptionalParam[string](req, "draft_issue_id")
+		if err != nil {
+			return nil, err
+		}
+		mutationID, err := OptionalParam[string](req, "client_mutation_id")
+		if err != nil {
+			return nil, err
+		}
+		input := &UpdateDraftIssueInput{
+			ItemID:           itemID,
+			DraftIssueID:     draftIssueID,
+			ClientMutationID: mutationID,
+		}
+		// Presence, not emptiness, decides whether a field is sent, so an
+		// explicit empty body clears it.
+		title, ok, err := OptionalParamOK[string](req, "title")
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			input.Title = &title
+		}
+		body, ok, err := OptionalParamOK[string](req, "body")
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			input.Body = &body
+		}
+		out, err := UpdateDraftIssue(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// withProjectFieldValue adds the mutually exclusive parameters that make up a
+// ProjectFieldValue.
+func withProjectFieldValue() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("text", mcp.Description("Text value, for text fields"))(tool)
+		mcp.WithNumber("number", mcp.Description("Numeric value, for number fields"))(tool)
+		mcp.WithString("date", mcp.Description("Date value in YYYY-MM-DD format, for date fields"))(tool)
+		mcp.WithString("single_select_option_id", mcp.Description("Option ID, for single select fields"))(tool)
+		mcp.WithString("iteration_id", mcp.Description("Iteration ID, for iteration fields"))(tool)
+	}
+}
+
+// projectFieldValueParam reads the parameters added by withProjectFieldValue.
+// Exactly one of them is validated to be set when the value is converted.
+func projectFieldValueParam(req mcp.CallToolRequest) (ProjectFieldValue, error) {
+	var v ProjectFieldValue
+	text, ok, err := OptionalParamOK[string](req, "text")
+	if err != nil {
+		return v, err
+	}
+	if ok {
+		v.Text = &text
+	}
+	number, ok, err := OptionalParamOK[float64](req, "number")
+	if err != nil {
+		return v, err
+	}
+	if ok {
+		v.Number = &number
+	}
+	if v.Date, err = OptionalParam[string](req, "date"); err != nil {
+		return v, err
+	}
+	if v.SingleSelectOptionID, err = OptionalParam[string](req, "single_select_option_id"); err != nil {
+		return v, err
+	}
+	if v.IterationID, err = OptionalParam[string](req, "iteration_id"); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+// MCP tool factory for adding many issues and pull requests to a project
+func BulkAddProjectItemsTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"bulk_add_project_items",
+		mcp.WithDescription("Add many issues and pull requests to a project in one call. Items are added independently and a per-item result with the new project item ID is returned, so one failure does not stop the rest."),
+		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
+		mcp.WithArray("items",
+			mcp.Required(),
+			mcp.Description("Issue or pull request node IDs or URLs, e.g. https://github.com/owner/repo/issues/42"),
+			mcp.Items(
+				map[string]interface{}{
+					"type": "string",
+				},
+			),
+		),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		projectID, err := requiredParam[string](req, "project_id")
+		if err != nil {
+			return nil, err
+		}
+		items, err := OptionalStringArrayParam(req, "items")
+		if err != nil {
+			return nil, err
+		}
+		if len(items) == 0 {
+			return nil, fmt.Errorf("missing required parameter: items")
+		}
+		input := &BulkAddProjectItemsInput{
+			ProjectID: projectID,
+			Items:     items,
+		}
+		out, err := BulkAddProjectItems(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for setting one field value on many project items
+func UpdateProjectItemFieldBulkTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"update_project_item_field_bulk",
+		mcp.WithDescription("Set the same field value on many project items. Provide exactly one value parameter. Items are updated independently and a per-item result is returned, so one failure does not stop the rest."),
+		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
+		mcp.WithString("field_id", mcp.Required(), mcp.Description("Field node ID")),
+		mcp.WithArray("item_ids",
+			mcp.Required(),
+			mcp.Description("Item node IDs to update"),
+			mcp.Items(
+				map[string]interface{}{
+					"type": "string",
+				},
+			),
+		),
+		withProjectFieldValue(),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		projectID, err := requiredParam[string](req, "project_id")
+		if err != nil {
+			return nil, err
+		}
+		fieldID, err := requiredParam[string](req, "field_id")
+		if err != nil {
+			return nil, err
+		}
+		itemIDs, err := OptionalStringArrayParam(req, "item_ids")
+		if err != nil {
+			return nil, err
+		}
+		if len(itemIDs) == 0 {
+			return nil, fmt.Errorf("missing required parameter: item_ids")
+		}
+		value, err := projectFieldValueParam(req)
+		if err != nil {
+			return nil, err
+		}
+		input := &UpdateProjectItemFieldBulkInput{
+			ProjectID: projectID,
+			FieldID:   fieldID,
+			Value:     value,
+			ItemIDs:   itemIDs,
+		}
+		out, err := UpdateProjectItemFieldBulk(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for listing project fields
+func ListProjectFieldsTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"list_project_fields",
+		mcp.WithDescription("List the fields of a project with their IDs and data types, including the options of single select fields and the iterations of iteration fields. Use these IDs with update_project_item_field."),
+		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
+		mcp.WithNumber("first", mcp.Description("Max number of fields to return (default 30, max 100)")),
+		mcp.WithString("after", mcp.Description("Cursor for pagination")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		projectID, err := requiredParam[string](req, "project_id")
+		if err != nil {
+			return nil, err
+		}
+		first, err := OptionalIntParamInRange(req, "first", defaultProjectsPageSize, 1, maxProjectsPageSize)
+		if err != nil {
+			return nil, err
+		}
+		after, err := OptionalParam[string](req, "after")
+		if err != nil {
+			return nil, err
+		}
+		input := &ListProjectFieldsInput{
+			ProjectID: projectID,
+			First:     first,
+			After:     after,
+		}
+		out, err := ListProjectFields(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// withProjectFieldOptions adds the options parameter used to define single
+// select options.
+func withProjectFieldOptions(description string) mcp.ToolOption {
+	return mcp.WithArray("options",
+		mcp.Description(description),
+		mcp.Items(
+			map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"name": map[string]interface{}{
+						"type":        "string",
+						"description": "Option name",
+					},
+					"color": map[string]interface{}{
+						"type":        "string",
+						"description": "Option color (default GRAY)",
+						"enum":        []string{"GRAY", "BLUE", "GREEN", "YELLOW", "ORANGE", "RED", "PINK", "PURPLE"},
+					},
+					"description": map[string]interface{}{
+						"type":        "string",
+						"description": "Option description",
+					},
+				},
+				"required": []string{"name"},
+			},
+		),
+	)
+}
+
+// projectFieldOptionsParam reads the parameter added by withProjectFieldOptions.
+func projectFieldOptionsParam(req mcp.CallToolRequest) ([]ProjectFieldOptionInput, error) {
+	raw, ok := req.GetArguments()["options"]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	list, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("parameter options could not be coerced to an array, is %T", raw)
+	}
+	var options []ProjectFieldOptionInput
+	for i, v := range list {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("options[%d] is not an object, is %T", i, v)
+		}
+		var o ProjectFieldOptionInput
+		for key, dst := range map[string]*string{"name": &o.Name, "color": &o.Color, "description": &o.Description} {
+			if m[key] == nil {
+				continue
+			}
+			s, ok := m[key].(string)
+			if !ok {
+				return nil, fmt.Errorf("options[%d].%s is not of type string, is %T", i, key, m[key])
+			}
+			*dst = s
+		}
+		options = append(options, o)
+	}
+	return options, nil
+}
+
+// MCP tool factory for creating a custom project field
+func CreateProjectFieldTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"create_project_field",
+		mcp.WithDescription("Add a custom text, number, date or single select field to a project"),
+		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
+		mcp.WithString("name", mcp.Required(), mcp.Description("Field name")),
+		mcp.WithString("data_type",
+			mcp.Required(),
+			mcp.Description("Field data type"),
+			mcp.Enum("text", "number", "date", "single_select"),
+		),
+		withProjectFieldOptions("Options for a single select field; required when data_type is single_select"),
+		mcp.WithString("client_mutation_id", mcp.Description("Optional client mutation ID echoed back in the response")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		projectID, err := requiredParam[string](req, "project_id")
+		if err != nil {
+			return nil, err
+		}
+		name, err := requiredParam[string](req, "name")
+		if err != nil {
+			return nil, err
+		}
+		dataType, err := requiredParam[string](req, "data_type")
+		if err != nil {
+			return nil, err
+		}
+		options, err := projectFieldOptionsParam(req)
+		if err != nil {
+			return nil, err
+		}
+		mutationID, err := OptionalParam[string](req, "client_mutation_id")
+		if err != nil {
+			return nil, err
+		}
+		input := &CreateProjectFieldInput{
+			ProjectID:        projectID,
+			Name:             name,
+			DataType:         ProjectFieldType(dataType),
+			Options:          options,
+			ClientMutationID: mutationID,
+		}
+		out, err := CreateProjectField(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for renaming a project field or replacing its options
+func UpdateProjectFieldTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"update_project_field",
+		mcp.WithDescription("Rename a project field and/or replace the options of a single select field. Replacing options gives every option a new ID and clears item values for options that are dropped."),
+		mcp.WithString("field_id", mcp.Required(), mcp.Description("Field node ID")),
+		mcp.WithString("name", mcp.Description("New field name")),
+		withProjectFieldOptions("Complete new list of options for a single select field"),
+		mcp.WithString("client_mutation_id", mcp.Description("Optional client mutation ID echoed back in the response")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		fieldID, err := requiredParam[string](req, "field_id")
+		if err != nil {
+			return nil, err
+		}
+		name, err := OptionalParam[string](req, "name")
+		if err != nil {
+			return nil, err
+		}
+		options, err := projectFieldOptionsParam(req)
+		if err != nil {
+			return nil, err
+		}
+		mutationID, err := OptionalParam[string](req, "client_mutation_id")
+		if err != nil {
+			return nil, err
+		}
+		input := &UpdateProjectFieldInput{
+			FieldID:          fieldID,
+			Name:             name,
+			Options:          options,
+			ClientMutationID: mutationID,
+		}
+		out, err := UpdateProjectField(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for deleting a custom project field
+func DeleteProjectFieldTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"delete_project_field",
+		mcp.WithDescription("Delete a custom field from a project, along with every item's value for it"),
+		mcp.WithString("field_id", mcp.Required(), mcp.Description("Field node ID")),
+		mcp.WithString("client_mutation_id", mcp.Description("Optional client mutation ID echoed back in the response")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		fieldID, err := requiredParam[string](req, "field_id")
+		if err != nil {
+			return nil, err
+		}
+		mutationID, err := OptionalParam[string](req, "client_mutation_id")
+		if err != nil {
+			return nil, err
+		}
+		input := &DeleteProjectFieldInput{
+			FieldID:          fieldID,
+			ClientMutationID: mutationID,
+		}
+		out, err := DeleteProjectField(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for listing the saved views of a project
+func ListProjectViewsTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"list_project_views",
+		mcp.WithDescription("List the saved views of a project with their layout, filter string and the fields they group and sort by. Use get_project_view to fetch a single view by number."),
+		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
+		mcp.WithNumber("first", mcp.Description("Max number of views to return (default 30, max 100)")),
+		mcp.WithString("after", mcp.Description("Cursor for pagination")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		projectID, err := requiredParam[string](req, "project_id")
+		if err != nil {
+			return nil, err
+		}
+		first, err := OptionalIntParamInRange(req, "first", defaultProjectsPageSize, 1, maxProjectsPageSize)
+		if err != nil {
+			return nil, err
+		}
+		after, err := OptionalParam[string](req, "after")
+		if err != nil {
+			return nil, err
+		}
+		input := &ListProjectViewsInput{
+			ProjectID: projectID,
+			First:     first,
+			After:     after,
+		}
+		out, err := ListProjectViews(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for getting a single project view
+func GetProjectViewTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"get_project_view",
+		mcp.WithDescription("Get a saved project view by number, including its layout, filter string and the fields it groups and sorts by."),
+		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
+		mcp.WithNumber("number", mcp.Required(), mcp.Description("View number, as shown in the view's URL")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		projectID, err := requiredParam[string](req, "project_id")
+		if err != nil {
+			return nil, err
+		}
+		number, err := requiredParam[float64](req, "number")
+		if err != nil {
+			return nil, err
+		}
+		input := &GetProjectViewInput{
+			ProjectID: projectID,
+			Number:    int(number),
+		}
+		out, err := GetProjectView(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for getting every item in a project within a budget
+func GetAllProjectItemsTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"get_all_project_items",
+		mcp.WithDescription("Get all items in a project, following pagination until the last page or a budget is reached. When truncated is true, resume with get_project_items from end_cursor."),
+		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
+		mcp.WithNumber("max_items", mcp.Description("Stop after this many matching items (default no limit)")),
+		mcp.WithNumber("max_requests", mcp.Description("Stop after this many API requests of up to 100 items each (default 20)")),
+		withProjectItemFilter(),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		projectID, err := requiredParam[string](req, "project_id")
+		if err != nil {
+			return nil, err
+		}
+		maxItems, err := OptionalIntParam(req, "max_items")
+		if err != nil {
+			return nil, err
+		}
+		maxRequests, err := OptionalIntParam(req, "max_requests")
+		if err != nil {
+			return nil, err
+		}
+		filter, err := projectItemFilterParam(req)
+		if err != nil {
+			return nil, err
+		}
+		input := &GetAllProjectItemsInput{
+			ProjectID:   projectID,
+			MaxItems:    maxItems,
+			MaxRequests: maxRequests,
+			Filter:      filter,
+		}
+		out, err := GetAllProjectItems(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for summarizing a project's items
+func GetProjectSummaryTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"get_project_summary",
+		mcp.WithDescription("Count a project's items by status, assignee and label, open vs closed, and without an iteration, instead of fetching every item. Use the filter parameters to summarize a subset, e.g. field_name Sprint and field_value the current sprint."),
+		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
+		mcp.WithString("status_field", mcp.Description("Field to count in by_status (default Status)")),
+		mcp.WithNumber("max_requests", mcp.Description("Stop after this many API requests of up to 100 items each (default 20); truncated is set if items were left uncounted")),
+		withProjectItemFilter(),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		projectID, err := requiredParam[string](req, "project_id")
+		if err != nil {
+			return nil, err
+		}
+		statusField, err := OptionalParam[string](req, "status_field")
+		if err != nil {
+			return nil, err
+		}
+		maxRequests, err := OptionalIntParam(req, "max_requests")
+		if err != nil {
+			return nil, err
+		}
+		filter, err := projectItemFilterParam(req)
+		if err != nil {
+			return nil, err
+		}
+		input := &GetProjectSummaryInput{
+			ProjectID:   projectID,
+			StatusField: statusField,
+			Filter:      filter,
+			MaxRequests: maxRequests,
+		}
+		out, err := GetProjectSummary(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// MCP tool factory for summarizing recent project activity
+func GetProjectActivityTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool(
+		"get_project_activity",
+		mcp.WithDescription("Get recent activity on a project's items, newest first: items added, updated or archived, and issues and pull requests closed or merged. Updates say when an item or its content changed, not which field; each entry carries the item's current field values."),
+		mcp.WithString("project_id", mcp.Required(), mcp.Description("Project node ID")),
+		mcp.WithString("since", mcp.Description("Only report activity at or after this RFC 3339 timestamp or YYYY-MM-DD date (default seven days ago)")),
+		mcp.WithNumber("max_requests", mcp.Description("Stop after this many API requests of up to 100 items each (default 20)")),
+	)
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		projectID, err := requiredParam[string](req, "project_id")
+		if err != nil {
+			return nil, err
+		}
+		since, err := OptionalParam[string](req, "since")
+		if err != nil {
+			return nil, err
+		}
+		maxRequests, err := OptionalIntParam(req, "max_requests")
+		if err != nil {
+			return nil, err
+		}
+		input := &GetProjectActivityInput{
+			ProjectID:   projectID,
+			Since:       since,
+			MaxRequests: maxRequests,
+		}
+		out, err := GetProjectActivity(ctx, input, client)
+		if err != nil {
+			return nil, err
+		}
+		b, _ := json.Marshal(out)
+		return mcp.NewToolResultText(string(b)), nil
+	}
+	return tool, handler
+}
+
+// withProjectItemFilter adds the parameters that make up a ProjectItemFilter.
+func withProjectItemFilter() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("content_type",
+			mcp.Description("Only return items of this content type"),
+			mcp.Enum("issue", "pull_request", "draft_issue"),
+		)(tool)
+		mcp.WithString("state",
+			mcp.Description("Only return issues and pull requests in this state; merged pull requests count as closed"),
+			mcp.Enum("open", "closed"),
+		)(tool)
+		mcp.WithString("assignee", mcp.Description("Only return items assigned to this login"))(tool)
+		mcp.WithString("label", mcp.Description("Only return items with this label"))(tool)
+		mcp.WithString("field_name", mcp.Description("Project field to match, e.g. Status; requires field_value"))(tool)
+		mcp.WithString("field_value", mcp.Description("Value field_name must have, e.g. In Progress"))(tool)
+	}
+}
+
+// withAllPages adds the all and max_pages parameters, which make a listing
+// tool follow the pagination cursor itself.
+func withAllPages(noun string) mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithBoolean("all", mcp.Description("Follow the cursor and return all "+noun+" instead of a single page; first is ignored and truncated is set if max_pages runs out"))(tool)
+		mcp.WithNumber("max_pages", mcp.Description("With all, stop after this many pages of up to 100 "+noun+" each (default 20)"))(tool)
+	}
+}
+
+// allPagesParams reads the parameters added by withAllPages.
+func allPagesParams(req mcp.CallToolRequest) (bool, int, error) {
+	all, err := OptionalParam[bool](req, "all")
+	if err != nil {
+		return false, 0, err
+	}
+	maxPages, err := OptionalIntParam(req, "max_pages")
+	if err != nil {
+		return false, 0, err
+	}
+	return all, maxPages, nil
+}
+
+// projectItemFilterParam reads the parameters added by withProjectItemFilter,
+// returning nil when none are set.
+func projectItemFilterParam(req mcp.CallToolRequest) (*ProjectItemFilter, error) {
+	var f ProjectItemFilter
+	var err error
+	if f.ContentType, err = OptionalParam[string](req, "content_type"); err != nil {
+		return nil, err
+	}
+	if f.State, err = OptionalParam[string](req, "state"); err != nil {
+		return nil, err
+	}
+	if f.Assignee, err = OptionalParam[string](req, "assignee"); err != nil {
+		return nil, err
+	}
+	if f.Label, err = OptionalParam[string](req, "label"); err != nil {
+		return nil, err
+	}
+	if f.FieldName, err = OptionalParam[string](req, "field_name"); err != nil {
+		return nil, err
+	}
+	if f.FieldValue, err = OptionalParam[string](req, "field_value"); err != nil {
+		return nil, err
+	}
+	if f == (ProjectItemFilter{}) {
+		return nil, nil
+	}
+	return &f, nil
+}
@@ -0,0 +1,288 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// RegisterPrompts adds the workflow prompts to the server. Each prompt is
+// built from live data, fetched with the handlers of the tools that the
+// workflow goes on to use.
+func RegisterPrompts(s *server.MCPServer, getClient GetClientFn, getGraphQLClient GetGraphQLClientFn, t translations.TranslationHelperFunc) {
+	s.AddPrompt(TriageIssuesPrompt(getClient, getGraphQLClient, t))
+	s.AddPrompt(SummarizeProjectBoardPrompt(getGraphQLClient, t))
+	s.AddPrompt(ReviewPullRequestPrompt(getClient, t))
+}
+
+// TriageIssuesPrompt creates a prompt to triage a repository's open issues into a project.
+func TriageIssuesPrompt(getClient GetClientFn, getGraphQLClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Prompt, server.PromptHandlerFunc) {
+	return mcp.NewPrompt("triage_issues",
+			mcp.WithPromptDescription(t("PROMPT_TRIAGE_ISSUES_DESCRIPTION", "Triage the newest open issues of a repository into a project, setting their project fields")),
+			mcp.WithArgument("owner", mcp.ArgumentDescription("Repository owner"), mcp.RequiredArgument()),
+			mcp.WithArgument("repo", mcp.ArgumentDescription("Repository name"), mcp.RequiredArgument()),
+			mcp.WithArgument("project_owner", mcp.ArgumentDescription("Organization or user login that owns the project"), mcp.RequiredArgument()),
+			mcp.WithArgument("project_number", mcp.ArgumentDescription("Project number"), mcp.RequiredArgument()),
+			mcp.WithArgument("since", mcp.ArgumentDescription("Only issues updated after this ISO 8601 timestamp")),
+		),
+		func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+			owner, err := requiredPromptArg(request, "owner")
+			if err != nil {
+				return nil, err
+			}
+			repo, err := requiredPromptArg(request, "repo")
+			if err != nil {
+				return nil, err
+			}
+			projectOwner, err := requiredPromptArg(request, "project_owner")
+			if err != nil {
+				return nil, err
+			}
+			projectNumber, err := requiredPromptNumber(request, "project_number")
+			if err != nil {
+				return nil, err
+			}
+
+			project, projectJSON, err := promptProject(ctx, getGraphQLClient, t, projectOwner, projectNumber)
+			if err != nil {
+				return nil, err
+			}
+			_, listFields := ListProjectFieldsTool(getGraphQLClient, t)
+			fields, err := promptData(ctx, listFields, map[string]interface{}{
+				"project_id": project.ID,
+				"first":      float64(maxProjectsPageSize),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list project fields: %w", err)
+			}
+			issueArgs := map[string]interface{}{
+				"owner":     owner,
+				"repo":      repo,
+				"state":     "open",
+				"sort":      "created",
+				"direction": "desc",
+				"perPage":   float64(30),
+			}
+			if since := request.Params.Arguments["since"]; since != "" {
+				issueArgs["since"] = since
+			}
+			_, listIssues := ListIssues(getClient, t)
+			issues, err := promptData(ctx, listIssues, issueArgs)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list issues: %w", err)
+			}
+
+			var b strings.Builder
+			fmt.Fprintf(&b, "Triage the open issues of %s/%s below into the project %q (ID %s).\n\n", owner, repo, project.Title, project.ID)
+			b.WriteString("For each issue that belongs on the project and is not on it yet, add it with add_project_item, " +
+				"passing the issue URL as content_id. Then set its fields, such as status and priority, with update_project_item_field, " +
+				"using the field and option IDs listed below. Skip issues that are duplicates or out of scope, and say why. " +
+				"Finish with a table of the issues and what you did with each.\n")
+			writePromptSection(&b, "Project", "json", projectJSON)
+			writePromptSection(&b, "Project fields", "json", fields)
+			writePromptSection(&b, "Open issues", "json", issues)
+
+			return mcp.NewGetPromptResult(
+				fmt.Sprintf("Triage issues of %s/%s into project %d", owner, repo, projectNumber),
+				[]mcp.PromptMessage{mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(b.String()))},
+			), nil
+		}
+}
+
+// SummarizeProjectBoardPrompt creates a prompt to summarize the state of a project board, such as a sprint board.
+func SummarizeProjectBoardPrompt(getGraphQLClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (mcp.Prompt, server.PromptHandlerFunc) {
+	return mcp.NewPrompt("summarize_project_board",
+			mcp.WithPromptDescription(t("PROMPT_SUMMARIZE_PROJECT_BOARD_DESCRIPTION", "Summarize the progress of a project board, such as a sprint board, from its items grouped by a field")),
+			mcp.WithArgument("owner", mcp.ArgumentDescription("Organization or user login that owns the project"), mcp.RequiredArgument()),
+			mcp.WithArgument("project_number", mcp.ArgumentDescription("Project number"), mcp.RequiredArgument()),
+			mcp.WithArgument("group_by", mcp.ArgumentDescription("Project field to group the items by (default Status)")),
+			mcp.WithArgument("field_name", mcp.ArgumentDescription("Project field to narrow the items by, e.g. Iteration; requires field_value")),
+			mcp.WithArgument("field_value", mcp.ArgumentDescription("Value field_name must have, e.g. Sprint 12, to summarize one sprint")),
+		),
+		func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+			owner, err := requiredPromptArg(request, "owner")
+			if err != nil {
+				return nil, err
+			}
+			projectNumber, err := requiredPromptNumber(request, "project_number")
+			if err != nil {
+				return nil, err
+			}
+			groupBy := request.Params.Arguments["group_by"]
+			if groupBy == "" {
+				groupBy = "Status"
+			}
+
+			project, projectJSON, err := promptProject(ctx, getGraphQLClient, t, owner, projectNumber)
+			if err != nil {
+				return nil, err
+			}
+			itemArgs := map[string]interface{}{
+				"project_id": project.ID,
+				"all":        true,
+				"group_by":   groupBy,
+			}
+			for _, name := range []string{"field_name", "field_value"} {
+				if value := request.Params.Arguments[name]; value != "" {
+					itemArgs[name] = value
+				}
+			}
+			_, getItems := GetProjectItemsTool(getGraphQLClient, t)
+			items, err := promptData(ctx, getItems, itemArgs)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get project items: %w", err)
+			}
+
+			var b strings.Builder
+			fmt.Fprintf(&b, "Summarize the state of the project %q from its items below, grouped by %s.\n\n", project.Title, groupBy)
+			b.WriteString("Report how much is done, in progress and not started, call out items that look blocked, stale or unassigned, " +
+				"and list the most important work left. Keep it short enough to paste into a status update; " +
+				"create_project_status_update can post it to the project.\n")
+			writePromptSection(&b, "Project", "json", projectJSON)
+			writePromptSection(&b, "Items by "+groupBy, "json", items)
+
+			return mcp.NewGetPromptResult(
+				fmt.Sprintf("Summary of project %d of %s", projectNumber, owner),
+				[]mcp.PromptMessage{mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(b.String()))},
+			), nil
+		}
+}
+
+// ReviewPullRequestPrompt creates a prompt to review a pull request.
+func ReviewPullRequestPrompt(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Prompt, server.PromptHandlerFunc) {
+	return mcp.NewPrompt("review_pull_request",
+			mcp.WithPromptDescription(t("PROMPT_REVIEW_PULL_REQUEST_DESCRIPTION", "Review a pull request from its description, changed files and diff")),
+			mcp.WithArgument("owner", mcp.ArgumentDescription("Repository owner"), mcp.RequiredArgument()),
+			mcp.WithArgument("repo", mcp.ArgumentDescription("Repository name"), mcp.RequiredArgument()),
+			mcp.WithArgument("pullNumber", mcp.ArgumentDescription("Pull request number"), mcp.RequiredArgument()),
+		),
+		func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+			owner, err := requiredPromptArg(request, "owner")
+			if err != nil {
+				return nil, err
+			}
+			repo, err := requiredPromptArg(request, "repo")
+			if err != nil {
+				return nil, err
+			}
+			pullNumber, err := requiredPromptNumber(request, "pullNumber")
+			if err != nil {
+				return nil, err
+			}
+			args := map[string]interface{}{
+				"owner":      owner,
+				"repo":       repo,
+				"pullNumber": float64(pullNumber),
+			}
+
+			_, getPullRequest := GetPullRequest(getClient, t)
+			pr, err := promptData(ctx, getPullRequest, args)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get pull request: %w", err)
+			}
+			_, getFiles := GetPullRequestFiles(getClient, t)
+			files, err := promptData(ctx, getFiles, args)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get pull request files: %w", err)
+			}
+			_, getDiff := GetPullRequestDiff(getClient, t)
+			diffJSON, err := promptData(ctx, getDiff, args)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get pull request diff: %w", err)
+			}
+			var diff GetPullRequestDiffOutput
+			if err := json.Unmarshal([]byte(diffJSON), &diff); err != nil {
+				return nil, fmt.Errorf("failed to parse pull request diff: %w", err)
+			}
+
+			var b strings.Builder
+			fmt.Fprintf(&b, "Review pull request #%d of %s/%s.\n\n", pullNumber, owner, repo)
+			b.WriteString("Check that the change does what its description says, and look for bugs, missing tests, " +
+				"security problems and unclear code. Point each finding at its file and line, say how serious it is, " +
+				"and finish with an overall verdict: approve, comment or request changes. " +
+				"The review can be submitted with create_pull_request_review.\n")
+			writePromptSection(&b, "Pull request", "json", pr)
+			writePromptSection(&b, "Changed files", "json", files)
+			if diff.Truncated {
+				fmt.Fprintf(&b, "\nThe diff is %d bytes and was truncated; use get_pull_request_diff or get_file_contents to read the rest.\n", diff.Size)
+			}
+			writePromptSection(&b, "Diff", "diff", diff.Diff)
+
+			return mcp.NewGetPromptResult(
+				fmt.Sprintf("Review of %s/%s#%d", owner, repo, pullNumber),
+				[]mcp.PromptMessage{mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(b.String()))},
+			), nil
+		}
+}
+
+// promptProject looks a project up with the get_project handler, returning
+// it decoded and as the tool's JSON.
+func promptProject(ctx context.Context, getGraphQLClient GetGraphQLClientFn, t translations.TranslationHelperFunc, owner string, number int) (Project, string, error) {
+	_, getProject := GetProjectTool(getGraphQLClient, t)
+	data, err := promptData(ctx, getProject, map[string]interface{}{
+		"owner":  owner,
+		"number": float64(number),
+	})
+	if err != nil {
+		return Project{}, "", fmt.Errorf("failed to get project: %w", err)
+	}
+	var project Project
+	if err := json.Unmarshal([]byte(data), &project); err != nil {
+		return Project{}, "", fmt.Errorf("failed to parse project: %w", err)
+	}
+	return project, data, nil
+}
+
+// promptData calls a tool handler with the arguments and returns its text
+// output, kept within the default response budget. An error result is
+// returned as an error.
+func promptData(ctx context.Context, handler server.ToolHandlerFunc, args map[string]interface{}) (string, error) {
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = args
+	result, err := withResponseBudget(handler)(ctx, request)
+	if err != nil {
+		return "", err
+	}
+	var text string
+	if len(result.Content) > 0 {
+		if content, ok := result.Content[0].(mcp.TextContent); ok {
+			text = content.Text
+		}
+	}
+	if result.IsError {
+		return "", errors.New(text)
+	}
+	return text, nil
+}
+
+// writePromptSection appends a titled, fenced block of data to a prompt.
+func writePromptSection(b *strings.Builder, title, lang, body string) {
+	fmt.Fprintf(b, "\n## %s\n\n```%s\n%s\n```\n", title, lang, strings.TrimRight(body, "\n"))
+}
+
+func requiredPromptArg(request mcp.GetPromptRequest, name string) (string, error) {
+	value := request.Params.Arguments[name]
+	if value == "" {
+		return "", fmt.Errorf("missing required argument: %s", name)
+	}
+	return value, nil
+}
+
+func requiredPromptNumber(request mcp.GetPromptRequest, name string) (int, error) {
+	value, err := requiredPromptArg(request, name)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("argument %s must be a positive number, is %q", name, value)
+	}
+	return n, nil
+}
@@ -0,0 +1,114 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPromptRequest(args map[string]string) mcp.GetPromptRequest {
+	request := mcp.GetPromptRequest{}
+	request.Params.Arguments = args
+	return request
+}
+
+func promptText(t *testing.T, result *mcp.GetPromptResult) string {
+	t.Helper()
+	require.Len(t, result.Messages, 1)
+	assert.Equal(t, mcp.RoleUser, result.Messages[0].Role)
+	content, ok := result.Messages[0].Content.(mcp.TextContent)
+	require.True(t, ok)
+	return content.Text
+}
+
+func Test_ReviewPullRequestPrompt(t *testing.T) {
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposPullsByOwnerByRepoByPullNumber,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if strings.Contains(r.Header.Get("Accept"), "diff") {
+					_, _ = w.Write([]byte("diff --git a/main.go b/main.go\n+fmt.Println(\"hi\")\n"))
+					return
+				}
+				_, _ = w.Write(mock.MustMarshal(&github.PullRequest{
+					Number: github.Ptr(7),
+					Title:  github.Ptr("Say hi"),
+				}))
+			}),
+		),
+		mock.WithRequestMatch(
+			mock.GetReposPullsFilesByOwnerByRepoByPullNumber,
+			[]*github.CommitFile{{Filename: github.Ptr("main.go"), Additions: github.Ptr(1)}},
+		),
+	)
+	prompt, handler := ReviewPullRequestPrompt(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+	assert.Equal(t, "review_pull_request", prompt.Name)
+	require.Len(t, prompt.Arguments, 3)
+
+	result, err := handler(context.Background(), createPromptRequest(map[string]string{
+		"owner":      "owner",
+		"repo":       "repo",
+		"pullNumber": "7",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "Review of owner/repo#7", result.Description)
+	text := promptText(t, result)
+	assert.True(t, strings.HasPrefix(text, "Review pull request #7 of owner/repo.\n"))
+	assert.Contains(t, text, `"title":"Say hi"`)
+	assert.Contains(t, text, `"path":"main.go"`)
+	assert.Contains(t, text, "## Diff\n\n```diff\ndiff --git a/main.go b/main.go\n+fmt.Println(\"hi\")\n```\n")
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		_, err := handler(context.Background(), createPromptRequest(map[string]string{"owner": "owner"}))
+		assert.EqualError(t, err, "missing required argument: repo")
+		_, err = handler(context.Background(), createPromptRequest(map[string]string{"owner": "owner", "repo": "repo", "pullNumber": "seven"}))
+		assert.EqualError(t, err, `argument pullNumber must be a positive number, is "seven"`)
+	})
+}
+
+func Test_SummarizeProjectBoardPrompt(t *testing.T) {
+	var itemVars map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, vars := decodeGraphQLRequest(t, r)
+		switch {
+		case strings.Contains(query, "projectV2(number: $number)"):
+			_, _ = w.Write([]byte(`{"data":{"organization":{"projectV2":{"id":"PVT_1","number":3,"title":"Sprint board","url":"https://github.com/orgs/octo/projects/3"}},"user":null}}`))
+		case strings.Contains(query, "items(first: $first, after: $after)"):
+			itemVars = vars
+			_, _ = w.Write([]byte(`{"data":{"node":{"items":{"nodes":[],"pageInfo":{"hasNextPage":false,"endCursor":""}}}}}`))
+		default:
+			t.Errorf("unexpected query: %s", query)
+		}
+	}))
+	defer server.Close()
+	gqlClient := stubGetGraphQLClientFn(githubv4.NewEnterpriseClient(server.URL, server.Client()))
+
+	_, handler := SummarizeProjectBoardPrompt(gqlClient, translations.NullTranslationHelper)
+	result, err := handler(context.Background(), createPromptRequest(map[string]string{
+		"owner":          "octo",
+		"project_number": "3",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "PVT_1", itemVars["id"])
+	text := promptText(t, result)
+	assert.True(t, strings.HasPrefix(text, `Summarize the state of the project "Sprint board" from its items below, grouped by Status.`))
+	assert.Contains(t, text, "## Items by Status\n")
+
+	t.Run("invalid project number", func(t *testing.T) {
+		_, err := handler(context.Background(), createPromptRequest(map[string]string{
+			"owner":          "octo",
+			"project_number": "0",
+		}))
+		assert.EqualError(t, err, `argument project_number must be a positive number, is "0"`)
+	})
+}
@@ -0,0 +1,378 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	ghv4 "github.com/shurcooL/githubv4"
+)
+
+// The pending review tools split create_pull_request_review into steps: a
+// review is created without an event, comments are added to it one at a
+// time, and it is then submitted or discarded. The REST API cannot add
+// comments to an existing pending review, so that step uses GraphQL.
+
+// withPendingReviewTarget adds the owner, repo, pullNumber and reviewId
+// parameters shared by the tools that act on an existing pending review.
+func withPendingReviewTarget() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner"),
+		)(tool)
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		)(tool)
+		mcp.WithNumber("pullNumber",
+			mcp.Required(),
+			mcp.Description("Pull request number"),
+		)(tool)
+		mcp.WithNumber("reviewId",
+			mcp.Required(),
+			mcp.Description("ID of the pending review, as returned by create_pending_pull_request_review"),
+		)(tool)
+	}
+}
+
+// pendingReviewTarget reads the parameters added by withPendingReviewTarget.
+func pendingReviewTarget(request mcp.CallToolRequest) (owner, repo string, pullNumber int, reviewID int64, err error) {
+	if owner, err = requiredParam[string](request, "owner"); err != nil {
+		return "", "", 0, 0, err
+	}
+	if repo, err = requiredParam[string](request, "repo"); err != nil {
+		return "", "", 0, 0, err
+	}
+	if pullNumber, err = RequiredInt(request, "pullNumber"); err != nil {
+		return "", "", 0, 0, err
+	}
+	id, err := requiredParam[float64](request, "reviewId")
+	if err != nil {
+		return "", "", 0, 0, err
+	}
+	return owner, repo, pullNumber, int64(id), nil
+}
+
+// CreatePendingPullRequestReview creates a tool to start a pending review on a pull request.
+func CreatePendingPullRequestReview(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_pending_pull_request_review",
+			mcp.WithDescription(t("TOOL_CREATE_PENDING_PULL_REQUEST_REVIEW_DESCRIPTION", "Start a pending review on a pull request. Add comments with add_pending_pull_request_review_comment, then submit it with submit_pending_pull_request_review")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithString("commitId",
+				mcp.Description("SHA of commit to review, defaults to the latest commit"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			commitID, err := OptionalParam[string](request, "commitId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// Leaving Event unset is what makes the review pending.
+			reviewRequest := &github.PullRequestReviewRequest{}
+			if commitID != "" {
+				reviewRequest.CommitID = github.Ptr(commitID)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			review, resp, err := client.PullRequests.CreateReview(ctx, owner, repo, pullNumber, reviewRequest)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create pending review: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create pending review: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(review)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// PendingReviewThread is the review thread created by add_pending_pull_request_review_comment.
+type PendingReviewThread struct {
+	ID        string `json:"id"`
+	Path      string `json:"path"`
+	Line      int    `json:"line,omitempty"`
+	StartLine int    `json:"start_line,omitempty"`
+}
+
+// AddPendingPullRequestReviewComment creates a tool to add a file or line
+// comment to a pending review.
+func AddPendingPullRequestReviewComment(getClient GetClientFn, getGraphQLClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_pending_pull_request_review_comment",
+			mcp.WithDescription(t("TOOL_ADD_PENDING_PULL_REQUEST_REVIEW_COMMENT_DESCRIPTION", "Add a comment on a file or line range to a pending pull request review")),
+			withPendingReviewTarget(),
+			mcp.WithString("path",
+				mcp.Required(),
+				mcp.Description("Path of the file to comment on"),
+			),
+			mcp.WithString("body",
+				mcp.Required(),
+				mcp.Description("Comment text"),
+			),
+			mcp.WithString("subjectType",
+				mcp.Description("Comment on a line range or on the whole file, defaults to LINE"),
+				mcp.Enum("LINE", "FILE"),
+			),
+			mcp.WithNumber("line",
+				mcp.Description("Line to comment on, or the last line of a multi-line range. Required for LINE comments"),
+			),
+			mcp.WithString("side",
+				mcp.Description("Side of the diff the line is on, defaults to RIGHT"),
+				mcp.Enum("LEFT", "RIGHT"),
+			),
+			mcp.WithNumber("startLine",
+				mcp.Description("First line of a multi-line range"),
+			),
+			mcp.WithString("startSide",
+				mcp.Description("Side of the diff the start line is on"),
+				mcp.Enum("LEFT", "RIGHT"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, pullNumber, reviewID, err := pendingReviewTarget(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := requiredParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := requiredParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			subjectType, err := OptionalParam[string](request, "subjectType")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			line, err := OptionalIntParam(request, "line")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			side, err := OptionalParam[string](request, "side")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			startLine, err := OptionalIntParam(request, "startLine")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			startSide, err := OptionalParam[string](request, "startSide")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			input := ghv4.AddPullRequestReviewThreadInput{
+				Path: ghv4.String(path),
+				Body: ghv4.String(body),
+			}
+			if subjectType == "FILE" {
+				input.SubjectType = github.Ptr(ghv4.PullRequestReviewThreadSubjectTypeFile)
+			} else {
+				if line == 0 {
+					return mcp.NewToolResultError("line is required for LINE comments"), nil
+				}
+				input.Line = github.Ptr(ghv4.Int(line))
+				if side != "" {
+					input.Side = github.Ptr(ghv4.DiffSide(side))
+				}
+				if startLine != 0 {
+					input.StartLine = github.Ptr(ghv4.Int(startLine))
+				}
+				if startSide != "" {
+					input.StartSide = github.Ptr(ghv4.DiffSide(startSide))
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			review, resp, err := client.PullRequests.GetReview(ctx, owner, repo, pullNumber, reviewID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get review: %w", err)
+			}
+			_ = resp.Body.Close()
+			if review.GetState() != "PENDING" {
+				return mcp.NewToolResultError(fmt.Sprintf("review %d is %s, comments can only be added to a pending review", reviewID, review.GetState())), nil
+			}
+			input.PullRequestReviewID = github.Ptr(ghv4.ID(review.GetNodeID()))
+
+			gqlClient, err := getGraphQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+			var m struct {
+				AddPullRequestReviewThread struct {
+					Thread struct {
+						ID        ghv4.ID
+						Path      ghv4.String
+						Line      *ghv4.Int
+						StartLine *ghv4.Int
+					}
+				} `graphql:"addPullRequestReviewThread(input: $input)"`
+			}
+			if err := gqlClient.Mutate(ctx, &m, input, nil); err != nil {
+				return nil, fmt.Errorf("github graphql error: %w", err)
+			}
+
+			thread := m.AddPullRequestReviewThread.Thread
+			out := PendingReviewThread{
+				ID:   fmt.Sprint(thread.ID),
+				Path: string(thread.Path),
+			}
+			if thread.Line != nil {
+				out.Line = int(*thread.Line)
+			}
+			if thread.StartLine != nil {
+				out.StartLine = int(*thread.StartLine)
+			}
+			b, _ := json.Marshal(out)
+			return mcp.NewToolResultText(string(b)), nil
+		}
+}
+
+// SubmitPendingPullRequestReview creates a tool to submit a pending review.
+func SubmitPendingPullRequestReview(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("submit_pending_pull_request_review",
+			mcp.WithDescription(t("TOOL_SUBMIT_PENDING_PULL_REQUEST_REVIEW_DESCRIPTION", "Submit a pending pull request review with its comments")),
+			withPendingReviewTarget(),
+			mcp.WithString("event",
+				mcp.Required(),
+				mcp.Description("Review action to perform"),
+				mcp.Enum("APPROVE", "REQUEST_CHANGES", "COMMENT"),
+			),
+			mcp.WithString("body",
+				mcp.Description("Review summary text. Required for REQUEST_CHANGES and COMMENT"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, pullNumber, reviewID, err := pendingReviewTarget(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			event, err := requiredParam[string](request, "event")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := OptionalParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			reviewRequest := &github.PullRequestReviewRequest{
+				Event: github.Ptr(event),
+			}
+			if body != "" {
+				reviewRequest.Body = github.Ptr(body)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			review, resp, err := client.PullRequests.SubmitReview(ctx, owner, repo, pullNumber, reviewID, reviewRequest)
+			if err != nil {
+				return nil, fmt.Errorf("failed to submit review: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to submit review: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(review)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// DeletePendingPullRequestReview creates a tool to discard a pending review.
+func DeletePendingPullRequestReview(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_pending_pull_request_review",
+			mcp.WithDescription(t("TOOL_DELETE_PENDING_PULL_REQUEST_REVIEW_DESCRIPTION", "Discard a pending pull request review and its comments")),
+			withPendingReviewTarget(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, pullNumber, reviewID, err := pendingReviewTarget(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			review, resp, err := client.PullRequests.DeletePendingReview(ctx, owner, repo, pullNumber, reviewID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to delete pending review: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to delete pending review: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(review)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,174 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CreatePendingPullRequestReview(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := CreatePendingPullRequestReview(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_pending_pull_request_review", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.NotContains(t, tool.InputSchema.Properties, "event")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
+			expectRequestBody(t, map[string]any{
+				"commit_id": "abc123",
+			}).andThen(
+				mockResponse(t, http.StatusOK, &github.PullRequestReview{
+					ID:     github.Ptr(int64(77)),
+					NodeID: github.Ptr("PRR_77"),
+					State:  github.Ptr("PENDING"),
+				}),
+			),
+		),
+	))
+	_, handler := CreatePendingPullRequestReview(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":      "owner",
+		"repo":       "repo",
+		"pullNumber": float64(42),
+		"commitId":   "abc123",
+	}))
+	require.NoError(t, err)
+
+	var returned github.PullRequestReview
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, int64(77), returned.GetID())
+	assert.Equal(t, "PENDING", returned.GetState())
+}
+
+func Test_AddPendingPullRequestReviewComment(t *testing.T) {
+	reviewState := "PENDING"
+	restClient := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposPullsReviewsByOwnerByRepoByPullNumberByReviewId,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mockResponse(t, http.StatusOK, &github.PullRequestReview{
+					ID:     github.Ptr(int64(77)),
+					NodeID: github.Ptr("PRR_77"),
+					State:  github.Ptr(reviewState),
+				})(w, r)
+			}),
+		),
+	))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, vars := decodeGraphQLRequest(t, r)
+		assert.Contains(t, query, "addPullRequestReviewThread(input: $input)")
+		assert.Equal(t, map[string]interface{}{
+			"pullRequestReviewId": "PRR_77",
+			"path":                "main.go",
+			"body":                "Handle the error here",
+			"line":                float64(12),
+			"startLine":           float64(10),
+			"side":                "RIGHT",
+		}, vars["input"])
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"data":{"addPullRequestReviewThread":{"thread":{"id":"PRRT_1","path":"main.go","line":12,"startLine":10}}}}`))
+	}))
+	defer server.Close()
+	gqlClient := stubGetGraphQLClientFn(githubv4.NewEnterpriseClient(server.URL, server.Client()))
+
+	tool, handler := AddPendingPullRequestReviewComment(stubGetClientFn(restClient), gqlClient, translations.NullTranslationHelper)
+	assert.Equal(t, "add_pending_pull_request_review_comment", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber", "reviewId", "path", "body"})
+
+	args := map[string]interface{}{
+		"owner":      "owner",
+		"repo":       "repo",
+		"pullNumber": float64(42),
+		"reviewId":   float64(77),
+		"path":       "main.go",
+		"body":       "Handle the error here",
+		"line":       float64(12),
+		"startLine":  float64(10),
+		"side":       "RIGHT",
+	}
+	result, err := handler(context.Background(), createMCPRequest(args))
+	require.NoError(t, err)
+
+	var thread PendingReviewThread
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &thread))
+	assert.Equal(t, PendingReviewThread{ID: "PRRT_1", Path: "main.go", Line: 12, StartLine: 10}, thread)
+
+	delete(args, "line")
+	result, err = handler(context.Background(), createMCPRequest(args))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, getTextResult(t, result).Text, "line is required")
+
+	args["line"] = float64(12)
+	reviewState = "APPROVED"
+	result, err = handler(context.Background(), createMCPRequest(args))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, getTextResult(t, result).Text, "only be added to a pending review")
+}
+
+func Test_SubmitAndDeletePendingPullRequestReview(t *testing.T) {
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostReposPullsReviewsEventsByOwnerByRepoByPullNumberByReviewId,
+			expectRequestBody(t, map[string]any{
+				"event": "REQUEST_CHANGES",
+				"body":  "See comments",
+			}).andThen(
+				mockResponse(t, http.StatusOK, &github.PullRequestReview{
+					ID:    github.Ptr(int64(77)),
+					State: github.Ptr("CHANGES_REQUESTED"),
+				}),
+			),
+		),
+		mock.WithRequestMatch(
+			mock.DeleteReposPullsReviewsByOwnerByRepoByPullNumberByReviewId,
+			&github.PullRequestReview{
+				ID:    github.Ptr(int64(78)),
+				State: github.Ptr("PENDING"),
+			},
+		),
+	))
+
+	tool, submit := SubmitPendingPullRequestReview(stubGetClientFn(client), translations.NullTranslationHelper)
+	assert.Equal(t, "submit_pending_pull_request_review", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber", "reviewId", "event"})
+
+	result, err := submit(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":      "owner",
+		"repo":       "repo",
+		"pullNumber": float64(42),
+		"reviewId":   float64(77),
+		"event":      "REQUEST_CHANGES",
+		"body":       "See comments",
+	}))
+	require.NoError(t, err)
+	assert.Contains(t, getTextResult(t, result).Text, `"state":"CHANGES_REQUESTED"`)
+
+	tool, del := DeletePendingPullRequestReview(stubGetClientFn(client), translations.NullTranslationHelper)
+	assert.Equal(t, "delete_pending_pull_request_review", tool.Name)
+
+	result, err = del(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":      "owner",
+		"repo":       "repo",
+		"pullNumber": float64(42),
+		"reviewId":   float64(78),
+	}))
+	require.NoError(t, err)
+	assert.Contains(t, getTextResult(t, result).Text, `"id":78`)
+}
@@ -3,9 +3,11 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v69/github"
@@ -188,6 +190,15 @@ func UpdatePullRequest(getClient GetClientFn, t translations.TranslationHelperFu
 		}
 }
 
+// ListPullRequestsOutput is the result of list_pull_requests, with the same
+// paging fields as ListIssuesOutput.
+type ListPullRequestsOutput struct {
+	PullRequests []*github.PullRequest `json:"pull_requests"`
+	NextPage     int                   `json:"next_page,omitempty"`
+	HasNextPage  bool                  `json:"has_next_page"`
+	Truncated    bool                  `json:"truncated,omitempty"`
+}
+
 // ListPullRequests creates a tool to list and filter repository pull requests.
 func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("list_pull_requests",
@@ -219,6 +230,7 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 				mcp.Enum("asc", "desc"),
 			),
 			WithPagination(),
+			withAllRESTPages("pull requests"),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -253,6 +265,10 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			all, maxPages, err := allRESTPagesParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			opts := &github.PullRequestListOptions{
 				State:     state,
@@ -270,6 +286,23 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
+
+			if all {
+				prs, truncated, err := fetchAllPages(ctx, maxPages, func(ctx context.Context, page int) ([]*github.PullRequest, *github.Response, error) {
+					pageOpts := *opts
+					pageOpts.ListOptions = github.ListOptions{Page: page, PerPage: 100}
+					return client.PullRequests.List(ctx, owner, repo, &pageOpts)
+				})
+				if err != nil {
+					return nil, fmt.Errorf("failed to list pull requests: %w", err)
+				}
+				r, err := json.Marshal(ListPullRequestsOutput{PullRequests: prs, Truncated: truncated})
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal response: %w", err)
+				}
+				return mcp.NewToolResultText(string(r)), nil
+			}
+
 			prs, resp, err := client.PullRequests.List(ctx, owner, repo, opts)
 			if err != nil {
 				return nil, fmt.Errorf("failed to list pull requests: %w", err)
@@ -284,7 +317,12 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list pull requests: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(prs)
+			out := ListPullRequestsOutput{
+				PullRequests: prs,
+				NextPage:     resp.NextPage,
+				HasNextPage:  resp.NextPage != 0,
+			}
+			r, err := json.Marshal(out)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -378,6 +416,43 @@ func MergePullRequest(getClient GetClientFn, t translations.TranslationHelperFun
 		}
 }
 
+// PullRequestFile is a changed file as returned by get_pull_request_files
+// and compare_commits.
+type PullRequestFile struct {
+	Path           string `json:"path"`
+	PreviousPath   string `json:"previous_path,omitempty"`
+	Status         string `json:"status"`
+	Additions      int    `json:"additions"`
+	Deletions      int    `json:"deletions"`
+	Patch          string `json:"patch,omitempty"`
+	PatchTruncated bool   `json:"patch_truncated,omitempty"`
+}
+
+// pullRequestFile converts a changed file, truncating its patch to
+// maxPatchLines when that is positive.
+func pullRequestFile(f *github.CommitFile, maxPatchLines int) PullRequestFile {
+	file := PullRequestFile{
+		Path:         f.GetFilename(),
+		PreviousPath: f.GetPreviousFilename(),
+		Status:       f.GetStatus(),
+		Additions:    f.GetAdditions(),
+		Deletions:    f.GetDeletions(),
+		Patch:        f.GetPatch(),
+	}
+	if maxPatchLines > 0 {
+		file.Patch, file.PatchTruncated = truncateLines(file.Patch, maxPatchLines)
+	}
+	return file
+}
+
+// GetPullRequestFilesOutput is the result of get_pull_request_files, with the
+// same paging fields as ListIssuesOutput.
+type GetPullRequestFilesOutput struct {
+	Files       []PullRequestFile `json:"files"`
+	NextPage    int               `json:"next_page,omitempty"`
+	HasNextPage bool              `json:"has_next_page"`
+}
+
 // GetPullRequestFiles creates a tool to get the list of files changed in a pull request.
 func GetPullRequestFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_pull_request_files",
@@ -394,6 +469,10 @@ func GetPullRequestFiles(getClient GetClientFn, t translations.TranslationHelper
 				mcp.Required(),
 				mcp.Description("Pull request number"),
 			),
+			mcp.WithNumber("maxPatchLines",
+				mcp.Description("Truncate each file's patch to this many lines, 0 keeps the full patch"),
+			),
+			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -408,12 +487,23 @@ func GetPullRequestFiles(getClient GetClientFn, t translations.TranslationHelper
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			maxPatchLines, err := OptionalIntParam(request, "maxPatchLines")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			opts := &github.ListOptions{}
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
 			files, resp, err := client.PullRequests.ListFiles(ctx, owner, repo, pullNumber, opts)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get pull request files: %w", err)
@@ -428,7 +518,100 @@ func GetPullRequestFiles(getClient GetClientFn, t translations.TranslationHelper
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get pull request files: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(files)
+			out := GetPullRequestFilesOutput{
+				Files:       make([]PullRequestFile, 0, len(files)),
+				NextPage:    resp.NextPage,
+				HasNextPage: resp.NextPage != 0,
+			}
+			for _, f := range files {
+				out.Files = append(out.Files, pullRequestFile(f, maxPatchLines))
+			}
+
+			r, err := json.Marshal(out)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// defaultMaxDiffBytes bounds the diff returned by get_pull_request_diff when
+// maxBytes is not given.
+const defaultMaxDiffBytes = 100000
+
+// GetPullRequestDiffOutput is the result of get_pull_request_diff. Size is
+// the length of the full diff in bytes, even when Diff was truncated.
+type GetPullRequestDiffOutput struct {
+	Diff      string `json:"diff"`
+	Size      int    `json:"size"`
+	Truncated bool   `json:"truncated,omitempty"`
+}
+
+// GetPullRequestDiff creates a tool to get the unified diff of a pull request.
+func GetPullRequestDiff(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pull_request_diff",
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_DIFF_DESCRIPTION", "Get the unified diff of a pull request")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithNumber("maxBytes",
+				mcp.Description(fmt.Sprintf("Truncate the diff at a line boundary before this many bytes, defaults to %d", defaultMaxDiffBytes)),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxBytes, err := OptionalIntParamWithDefault(request, "maxBytes", defaultMaxDiffBytes)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			diff, resp, err := client.PullRequests.GetRaw(ctx, owner, repo, pullNumber, github.RawOptions{Type: github.Diff})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get pull request diff: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get pull request diff: %s", string(body))), nil
+			}
+
+			out := GetPullRequestDiffOutput{Diff: diff, Size: len(diff)}
+			if maxBytes > 0 && len(diff) > maxBytes {
+				cut := strings.LastIndexByte(diff[:maxBytes], '\n')
+				out.Diff = diff[:cut+1]
+				out.Truncated = true
+			}
+
+			r, err := json.Marshal(out)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -437,10 +620,70 @@ func GetPullRequestFiles(getClient GetClientFn, t translations.TranslationHelper
 		}
 }
 
+// truncateLines returns the first n lines of s and whether any were dropped.
+func truncateLines(s string, n int) (string, bool) {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) <= n {
+		return s, false
+	}
+	return strings.Join(lines[:n], ""), true
+}
+
+// PullRequestCheckRun is a check run reported for a pull request's head commit.
+type PullRequestCheckRun struct {
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Conclusion string `json:"conclusion,omitempty"`
+	DetailsURL string `json:"details_url,omitempty"`
+	Summary    string `json:"summary,omitempty"`
+}
+
+// PullRequestStatusOutput is the result of get_pull_request_status: the
+// combined commit status of the head SHA, its check runs, and an overall
+// Conclusion of "success", "failure" or "pending" across both.
+type PullRequestStatusOutput struct {
+	*github.CombinedStatus
+	CheckRuns  []PullRequestCheckRun `json:"check_runs"`
+	Conclusion string                `json:"conclusion"`
+}
+
+// conclusion folds commit statuses and check runs into one result. A
+// combined state with no statuses is ignored, since GitHub reports it as
+// pending even when only checks are configured.
+func (o PullRequestStatusOutput) conclusion() string {
+	pending := false
+	if o.GetTotalCount() > 0 {
+		switch o.GetState() {
+		case "failure", "error":
+			return "failure"
+		case "pending":
+			pending = true
+		}
+	}
+	for _, run := range o.CheckRuns {
+		if run.Status != "completed" {
+			pending = true
+			continue
+		}
+		switch run.Conclusion {
+		case "success", "neutral", "skipped":
+		default:
+			return "failure"
+		}
+	}
+	if pending {
+		return "pending"
+	}
+	return "success"
+}
+
 // GetPullRequestStatus creates a tool to get the combined status of all status checks for a pull request.
 func GetPullRequestStatus(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_pull_request_status",
-			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_STATUS_DESCRIPTION", "Get the combined status of all status checks for a pull request")),
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_STATUS_DESCRIPTION", "Get the combined commit status and check runs for a pull request's head commit, with an overall success, failure or pending conclusion")),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -501,7 +744,40 @@ func GetPullRequestStatus(getClient GetClientFn, t translations.TranslationHelpe
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get combined status: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(status)
+			// Get check runs for the same SHA; GitHub Actions and most CI
+			// apps report through checks rather than commit statuses.
+			checks, resp, err := client.Checks.ListCheckRunsForRef(ctx, owner, repo, *pr.Head.SHA, &github.ListCheckRunsOptions{
+				ListOptions: github.ListOptions{PerPage: 100},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list check runs: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list check runs: %s", string(body))), nil
+			}
+
+			out := PullRequestStatusOutput{
+				CombinedStatus: status,
+				CheckRuns:      make([]PullRequestCheckRun, 0, len(checks.CheckRuns)),
+			}
+			for _, run := range checks.CheckRuns {
+				out.CheckRuns = append(out.CheckRuns, PullRequestCheckRun{
+					Name:       run.GetName(),
+					Status:     run.GetStatus(),
+					Conclusion: run.GetConclusion(),
+					DetailsURL: run.GetDetailsURL(),
+					Summary:    run.GetOutput().GetSummary(),
+				})
+			}
+			out.Conclusion = out.conclusion()
+
+			r, err := json.Marshal(out)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -510,6 +786,15 @@ func GetPullRequestStatus(getClient GetClientFn, t translations.TranslationHelpe
 		}
 }
 
+// UpdatePullRequestBranchOutput is the result of update_pull_request_branch.
+// Status is "in_progress" once GitHub has accepted the update, or "conflict"
+// when the head branch cannot be updated without resolving merge conflicts.
+type UpdatePullRequestBranchOutput struct {
+	Status   string `json:"status"`
+	Message  string `json:"message,omitempty"`
+	Conflict bool   `json:"conflict"`
+}
+
 // UpdatePullRequestBranch creates a tool to update a pull request branch with the latest changes from the base branch.
 func UpdatePullRequestBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("update_pull_request_branch",
@@ -557,25 +842,36 @@ func UpdatePullRequestBranch(getClient GetClientFn, t translations.TranslationHe
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
 			result, resp, err := client.PullRequests.UpdateBranch(ctx, owner, repo, pullNumber, opts)
+			out := UpdatePullRequestBranchOutput{Status: "in_progress", Message: "Pull request branch update is in progress"}
 			if err != nil {
+				var errResp *github.ErrorResponse
+				switch {
 				// Check if it's an acceptedError. An acceptedError indicates that the update is in progress,
 				// and it's not a real error.
-				if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
-					return mcp.NewToolResultText("Pull request branch update is in progress"), nil
+				case resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err):
+				// GitHub refuses to update a branch that conflicts with its base.
+				case errors.As(err, &errResp) && errResp.Response.StatusCode == http.StatusUnprocessableEntity &&
+					strings.Contains(strings.ToLower(errResp.Message), "conflict"):
+					out = UpdatePullRequestBranchOutput{Status: "conflict", Message: errResp.Message, Conflict: true}
+				default:
+					return nil, fmt.Errorf("failed to update pull request branch: %w", err)
 				}
-				return nil, fmt.Errorf("failed to update pull request branch: %w", err)
-			}
-			defer func() { _ = resp.Body.Close() }()
+			} else {
+				defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != http.StatusAccepted {
-				body, err := io.ReadAll(resp.Body)
-				if err != nil {
-					return nil, fmt.Errorf("failed to read response body: %w", err)
+				if resp.StatusCode != http.StatusAccepted {
+					body, err := io.ReadAll(resp.Body)
+					if err != nil {
+						return nil, fmt.Errorf("failed to read response body: %w", err)
+					}
+					return mcp.NewToolResultError(fmt.Sprintf("failed to update pull request branch: %s", string(body))), nil
+				}
+				if result.GetMessage() != "" {
+					out.Message = result.GetMessage()
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to update pull request branch: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(result)
+			r, err := json.Marshal(out)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -720,9 +1016,13 @@ func AddPullRequestReviewComment(getClient GetClientFn, t translations.Translati
 			}
 
 			// Check if this is a reply to an existing comment
-			if replyToFloat, ok := request.Params.Arguments["in_reply_to"].(float64); ok {
+			replyTo, err := OptionalIntParam(request, "in_reply_to")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if replyTo != 0 {
 				// Use the specialized method for reply comments due to inconsistency in underlying go-github library: https://github.com/google/go-github/pull/950
-				commentID := int64(replyToFloat)
+				commentID := int64(replyTo)
 				createdReply, resp, err := client.PullRequests.CreateCommentInReplyTo(ctx, owner, repo, pullNumber, body, commentID)
 				if err != nil {
 					return nil, fmt.Errorf("failed to reply to pull request comment: %w", err)
@@ -767,10 +1067,22 @@ func AddPullRequestReviewComment(getClient GetClientFn, t translations.Translati
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 			if subjectType != "file" {
-				line, lineExists := request.Params.Arguments["line"].(float64)
-				startLine, startLineExists := request.Params.Arguments["start_line"].(float64)
-				side, sideExists := request.Params.Arguments["side"].(string)
-				startSide, startSideExists := request.Params.Arguments["start_side"].(string)
+				line, lineExists, err := OptionalParamOK[float64](request, "line")
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				startLine, startLineExists, err := OptionalParamOK[float64](request, "start_line")
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				side, sideExists, err := OptionalParamOK[string](request, "side")
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				startSide, startSideExists, err := OptionalParamOK[string](request, "start_side")
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
 
 				if !lineExists {
 					return mcp.NewToolResultError("line parameter is required unless using subject_type:file"), nil
@@ -986,7 +1298,7 @@ func CreatePullRequestReview(getClient GetClientFn, t translations.TranslationHe
 			}
 
 			// Add comments if provided
-			if commentsObj, ok := request.Params.Arguments["comments"].([]interface{}); ok && len(commentsObj) > 0 {
+			if commentsObj, ok := request.GetArguments()["comments"].([]interface{}); ok && len(commentsObj) > 0 {
 				comments := []*github.DraftReviewComment{}
 
 				for _, c := range commentsObj {
@@ -1183,3 +1495,124 @@ func CreatePullRequest(getClient GetClientFn, t translations.TranslationHelperFu
 			return mcp.NewToolResultText(string(r)), nil
 		}
 }
+
+// RequestReviewers creates a tool to request reviews on a pull request from users or teams.
+func RequestReviewers(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return pullRequestReviewersTool("request_reviewers",
+		t("TOOL_REQUEST_REVIEWERS_DESCRIPTION", "Request reviews on a pull request from users or teams"),
+		getClient,
+		func(ctx context.Context, client *github.Client, owner, repo string, number int, reviewers github.ReviewersRequest) (*github.Reviewers, *github.Response, error) {
+			pr, resp, err := client.PullRequests.RequestReviewers(ctx, owner, repo, number, reviewers)
+			if err != nil {
+				return nil, resp, err
+			}
+			return &github.Reviewers{Users: pr.RequestedReviewers, Teams: pr.RequestedTeams}, resp, nil
+		})
+}
+
+// RemoveRequestedReviewers creates a tool to withdraw review requests on a pull request.
+func RemoveRequestedReviewers(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return pullRequestReviewersTool("remove_requested_reviewers",
+		t("TOOL_REMOVE_REQUESTED_REVIEWERS_DESCRIPTION", "Withdraw review requests on a pull request from users or teams"),
+		getClient,
+		func(ctx context.Context, client *github.Client, owner, repo string, number int, reviewers github.ReviewersRequest) (*github.Reviewers, *github.Response, error) {
+			resp, err := client.PullRequests.RemoveReviewers(ctx, owner, repo, number, reviewers)
+			if err != nil {
+				return nil, resp, err
+			}
+			_ = resp.Body.Close()
+			// The removal response carries no reviewers, so list what is left.
+			return client.PullRequests.ListReviewers(ctx, owner, repo, number, &github.ListOptions{PerPage: 100})
+		})
+}
+
+// pullRequestReviewersTool builds the request/remove reviewer tools, which
+// share their parameters and return the reviewers still requested.
+func pullRequestReviewersTool(name, description string, getClient GetClientFn,
+	apply func(ctx context.Context, client *github.Client, owner, repo string, number int, reviewers github.ReviewersRequest) (*github.Reviewers, *github.Response, error),
+) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool(name,
+			mcp.WithDescription(description),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithArray("reviewers",
+				mcp.Description("User logins"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithArray("teamReviewers",
+				mcp.Description("Team slugs in the repository's organization"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			users, err := OptionalStringArrayParam(request, "reviewers")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teams, err := OptionalStringArrayParam(request, "teamReviewers")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(users) == 0 && len(teams) == 0 {
+				return mcp.NewToolResultError("at least one of reviewers or teamReviewers is required"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			reviewers, resp, err := apply(ctx, client, owner, repo, pullNumber, github.ReviewersRequest{
+				Reviewers:     users,
+				TeamReviewers: teams,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to update requested reviewers: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to update requested reviewers: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(reviewers)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
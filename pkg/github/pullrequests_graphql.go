@@ -0,0 +1,686 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	ghv4 "github.com/shurcooL/githubv4"
+)
+
+// Pull request operations that only exist in the GraphQL API. They address
+// pull requests by owner, repo and number like the REST tools, and resolve
+// the node ID with pullRequestNode.
+
+// PullRequestRef identifies a pull request by repository and number.
+type PullRequestRef struct {
+	Owner  string
+	Repo   string
+	Number int
+}
+
+func (r PullRequestRef) validate() error {
+	if r.Owner == "" || r.Repo == "" || r.Number <= 0 {
+		return errors.New("owner, repo and pull request number are required")
+	}
+	return nil
+}
+
+// mergeQueueEntryFields is the merge queue entry selection shared by the
+// queries and mutations below.
+type mergeQueueEntryFields struct {
+	ID                   ghv4.ID
+	State                ghv4.MergeQueueEntryState
+	Position             ghv4.Int
+	EstimatedTimeToMerge *ghv4.Int
+	EnqueuedAt           ghv4.DateTime
+}
+
+// MergeQueueEntry is a pull request's place in its base branch's merge queue.
+// EstimatedTimeToMerge is in seconds and omitted when GitHub has no estimate.
+type MergeQueueEntry struct {
+	ID                   string    `json:"id"`
+	State                string    `json:"state"`
+	Position             int       `json:"position"`
+	EstimatedTimeToMerge int       `json:"estimated_time_to_merge,omitempty"`
+	EnqueuedAt           time.Time `json:"enqueued_at"`
+}
+
+func (e *mergeQueueEntryFields) output() *MergeQueueEntry {
+	if e == nil {
+		return nil
+	}
+	out := &MergeQueueEntry{
+		ID:         fmt.Sprint(e.ID),
+		State:      string(e.State),
+		Position:   int(e.Position),
+		EnqueuedAt: e.EnqueuedAt.Time,
+	}
+	if e.EstimatedTimeToMerge != nil {
+		out.EstimatedTimeToMerge = int(*e.EstimatedTimeToMerge)
+	}
+	return out
+}
+
+// pullRequestNodeFields is the pull request state fetched by pullRequestNode.
+type pullRequestNodeFields struct {
+	ID                  ghv4.ID
+	IsDraft             ghv4.Boolean
+	IsMergeQueueEnabled ghv4.Boolean
+	MergeQueueEntry     *mergeQueueEntryFields
+}
+
+// pullRequestNode looks up a pull request by repository and number.
+func pullRequestNode(ctx context.Context, client GraphQLClient, ref PullRequestRef) (*pullRequestNodeFields, error) {
+	var q struct {
+		Repository *struct {
+			PullRequest *pullRequestNodeFields `graphql:"pullRequest(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	vars := map[string]interface{}{
+		"owner":  ghv4.String(ref.Owner),
+		"name":   ghv4.String(ref.Repo),
+		"number": ghv4.Int(ref.Number),
+	}
+	err := graphQLQuery(ctx, client, "pullRequestNode", &q, vars)
+	switch {
+	case q.Repository != nil && q.Repository.PullRequest != nil:
+		return q.Repository.PullRequest, nil
+	case err != nil && !isUnresolvedError(err):
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+	return nil, fmt.Errorf("pull request %s/%s#%d not found", ref.Owner, ref.Repo, ref.Number)
+}
+
+// MergeQueueStatus reports whether a pull request is in a merge queue.
+type MergeQueueStatus struct {
+	PullRequestID      string           `json:"pull_request_id"`
+	MergeQueueRequired bool             `json:"merge_queue_required"`
+	Queued             bool             `json:"queued"`
+	Entry              *MergeQueueEntry `json:"entry,omitempty"`
+}
+
+// GetMergeQueueEntry returns the merge queue position and estimated merge
+// time of a pull request using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func GetMergeQueueEntry(ctx context.Context, in *PullRequestRef, client GraphQLClient) (*MergeQueueStatus, error) {
+	if err := in.validate(); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	pr, err := pullRequestNode(ctx, client, *in)
+	if err != nil {
+		return nil, err
+	}
+	return &MergeQueueStatus{
+		PullRequestID:      fmt.Sprint(pr.ID),
+		MergeQueueRequired: bool(pr.IsMergeQueueEnabled),
+		Queued:             pr.MergeQueueEntry != nil,
+		Entry:              pr.MergeQueueEntry.output(),
+	}, nil
+}
+
+// EnqueuePullRequestInput holds the parameters for EnqueuePullRequest.
+// ExpectedHeadSHA makes the request fail if the head branch has moved, and
+// Jump places the pull request at the front of the queue.
+type EnqueuePullRequestInput struct {
+	PullRequestRef
+	ExpectedHeadSHA string
+	Jump            bool
+}
+
+// EnqueuePullRequest adds a pull request to its base branch's merge queue
+// using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func EnqueuePullRequest(ctx context.Context, in *EnqueuePullRequestInput, client GraphQLClient) (*MergeQueueEntry, error) {
+	if err := in.validate(); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	pr, err := pullRequestNode(ctx, client, in.PullRequestRef)
+	if err != nil {
+		return nil, err
+	}
+
+	input := ghv4.EnqueuePullRequestInput{PullRequestID: pr.ID}
+	if in.ExpectedHeadSHA != "" {
+		oid := ghv4.GitObjectID(in.ExpectedHeadSHA)
+		input.ExpectedHeadOid = &oid
+	}
+	if in.Jump {
+		jump := ghv4.Boolean(true)
+		input.Jump = &jump
+	}
+	var m struct {
+		EnqueuePullRequest struct {
+			MergeQueueEntry *mergeQueueEntryFields
+		} `graphql:"enqueuePullRequest(input: $input)"`
+	}
+	if err := graphQLMutate(ctx, client, "EnqueuePullRequest", &m, input, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+	return m.EnqueuePullRequest.MergeQueueEntry.output(), nil
+}
+
+// DequeuePullRequest removes a pull request from its merge queue using the
+// provided GraphQLClient and returns the entry that was removed.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func DequeuePullRequest(ctx context.Context, in *PullRequestRef, client GraphQLClient) (*MergeQueueEntry, error) {
+	if err := in.validate(); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	pr, err := pullRequestNode(ctx, client, *in)
+	if err != nil {
+		return nil, err
+	}
+	if pr.MergeQueueEntry == nil {
+		return nil, fmt.Errorf("pull request %s/%s#%d is not in a merge queue", in.Owner, in.Repo, in.Number)
+	}
+
+	input := ghv4.DequeuePullRequestInput{ID: pr.ID}
+	var m struct {
+		DequeuePullRequest struct {
+			MergeQueueEntry *mergeQueueEntryFields
+		} `graphql:"dequeuePullRequest(input: $input)"`
+	}
+	if err := graphQLMutate(ctx, client, "DequeuePullRequest", &m, input, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+	return m.DequeuePullRequest.MergeQueueEntry.output(), nil
+}
+
+// pullRequestDraftFields is the pull request selection returned by the
+// draft conversion mutations.
+type pullRequestDraftFields struct {
+	ID      ghv4.ID
+	Number  ghv4.Int
+	IsDraft ghv4.Boolean
+	URL     ghv4.URI
+}
+
+// PullRequestDraftState is the draft state of a pull request after
+// ConvertPullRequestToDraft or MarkPullRequestReadyForReview.
+type PullRequestDraftState struct {
+	ID      string `json:"id"`
+	Number  int    `json:"number"`
+	IsDraft bool   `json:"is_draft"`
+	URL     string `json:"url,omitempty"`
+}
+
+func (f pullRequestDraftFields) output() *PullRequestDraftState {
+	out := &PullRequestDraftState{
+		ID:      fmt.Sprint(f.ID),
+		Number:  int(f.Number),
+		IsDraft: bool(f.IsDraft),
+	}
+	if f.URL.URL != nil {
+		out.URL = f.URL.String()
+	}
+	return out
+}
+
+// ConvertPullRequestToDraft converts an open pull request back to a draft
+// using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func ConvertPullRequestToDraft(ctx context.Context, in *PullRequestRef, client GraphQLClient) (*PullRequestDraftState, error) {
+	return setPullRequestDraft(ctx, in, true, client)
+}
+
+// MarkPullRequestReadyForReview marks a draft pull request as ready for
+// review using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func MarkPullRequestReadyForReview(ctx context.Context, in *PullRequestRef, client GraphQLClient) (*PullRequestDraftState, error) {
+	return setPullRequestDraft(ctx, in, false, client)
+}
+
+// setPullRequestDraft runs the mutation that moves the pull request into the
+// requested draft state, or returns the current state if it is already there.
+func setPullRequestDraft(ctx context.Context, in *PullRequestRef, draft bool, client GraphQLClient) (*PullRequestDraftState, error) {
+	if err := in.validate(); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	pr, err := pullRequestNode(ctx, client, *in)
+	if err != nil {
+		return nil, err
+	}
+	if bool(pr.IsDraft) == draft {
+		return &PullRequestDraftState{ID: fmt.Sprint(pr.ID), Number: in.Number, IsDraft: draft}, nil
+	}
+
+	if draft {
+		var m struct {
+			ConvertPullRequestToDraft struct {
+				PullRequest pullRequestDraftFields
+			} `graphql:"convertPullRequestToDraft(input: $input)"`
+		}
+		input := ghv4.ConvertPullRequestToDraftInput{PullRequestID: pr.ID}
+		if err := graphQLMutate(ctx, client, "ConvertPullRequestToDraft", &m, input, nil); err != nil {
+			return nil, fmt.Errorf("github graphql error: %w", err)
+		}
+		return m.ConvertPullRequestToDraft.PullRequest.output(), nil
+	}
+
+	var m struct {
+		MarkPullRequestReadyForReview struct {
+			PullRequest pullRequestDraftFields
+		} `graphql:"markPullRequestReadyForReview(input: $input)"`
+	}
+	input := ghv4.MarkPullRequestReadyForReviewInput{PullRequestID: pr.ID}
+	if err := graphQLMutate(ctx, client, "MarkPullRequestReadyForReview", &m, input, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+	return m.MarkPullRequestReadyForReview.PullRequest.output(), nil
+}
+
+// pullRequestAutoMergeFields is the pull request selection returned by the
+// auto-merge mutations.
+type pullRequestAutoMergeFields struct {
+	ID               ghv4.ID
+	Number           ghv4.Int
+	AutoMergeRequest *struct {
+		MergeMethod ghv4.PullRequestMergeMethod
+		EnabledAt   ghv4.DateTime
+		EnabledBy   *struct {
+			Login ghv4.String
+		}
+	}
+}
+
+// PullRequestAutoMerge is the auto-merge setting of a pull request.
+type PullRequestAutoMerge struct {
+	ID          string     `json:"id"`
+	Number      int        `json:"number"`
+	Enabled     bool       `json:"enabled"`
+	MergeMethod string     `json:"merge_method,omitempty"`
+	EnabledAt   *time.Time `json:"enabled_at,omitempty"`
+	EnabledBy   string     `json:"enabled_by,omitempty"`
+}
+
+func (f pullRequestAutoMergeFields) output() *PullRequestAutoMerge {
+	out := &PullRequestAutoMerge{
+		ID:     fmt.Sprint(f.ID),
+		Number: int(f.Number),
+	}
+	if r := f.AutoMergeRequest; r != nil {
+		out.Enabled = true
+		out.MergeMethod = strings.ToLower(string(r.MergeMethod))
+		out.EnabledAt = &r.EnabledAt.Time
+		if r.EnabledBy != nil {
+			out.EnabledBy = string(r.EnabledBy.Login)
+		}
+	}
+	return out
+}
+
+// EnablePullRequestAutoMergeInput holds the parameters for
+// EnablePullRequestAutoMerge. MergeMethod is one of merge, squash or rebase
+// and defaults to merge; it and the commit fields are ignored by GitHub when
+// the base branch uses a merge queue.
+type EnablePullRequestAutoMergeInput struct {
+	PullRequestRef
+	MergeMethod     string
+	CommitTitle     string
+	CommitMessage   string
+	ExpectedHeadSHA string
+}
+
+// EnablePullRequestAutoMerge sets a pull request to merge automatically once
+// its requirements are met using the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func EnablePullRequestAutoMerge(ctx context.Context, in *EnablePullRequestAutoMergeInput, client GraphQLClient) (*PullRequestAutoMerge, error) {
+	if err := in.validate(); err != nil {
+		return nil, err
+	}
+
+	input := ghv4.EnablePullRequestAutoMergeInput{}
+	if in.MergeMethod != "" {
+		method := ghv4.PullRequestMergeMethod(strings.ToUpper(in.MergeMethod))
+		switch method {
+		case ghv4.PullRequestMergeMethodMerge, ghv4.PullRequestMergeMethodSquash, ghv4.PullRequestMergeMethodRebase:
+			input.MergeMethod = &method
+		default:
+			return nil, fmt.Errorf("invalid merge method %q: must be merge, squash or rebase", in.MergeMethod)
+		}
+	}
+	if in.CommitTitle != "" {
+		input.CommitHeadline = ghv4.NewString(ghv4.String(in.CommitTitle))
+	}
+	if in.CommitMessage != "" {
+		input.CommitBody = ghv4.NewString(ghv4.String(in.CommitMessage))
+	}
+	if in.ExpectedHeadSHA != "" {
+		oid := ghv4.GitObjectID(in.ExpectedHeadSHA)
+		input.ExpectedHeadOid = &oid
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	pr, err := pullRequestNode(ctx, client, in.PullRequestRef)
+	if err != nil {
+		return nil, err
+	}
+	input.PullRequestID = pr.ID
+
+	var m struct {
+		EnablePullRequestAutoMerge struct {
+			PullRequest pullRequestAutoMergeFields
+		} `graphql:"enablePullRequestAutoMerge(input: $input)"`
+	}
+	if err := graphQLMutate(ctx, client, "EnablePullRequestAutoMerge", &m, input, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+	return m.EnablePullRequestAutoMerge.PullRequest.output(), nil
+}
+
+// DisablePullRequestAutoMerge turns off auto-merge for a pull request using
+// the provided GraphQLClient.
+// If client is nil, a default client is created using GITHUB_TOKEN from environment.
+func DisablePullRequestAutoMerge(ctx context.Context, in *PullRequestRef, client GraphQLClient) (*PullRequestAutoMerge, error) {
+	if err := in.validate(); err != nil {
+		return nil, err
+	}
+
+	if isNilGraphQLClient(client) {
+		var err error
+		if client, err = defaultGraphQLClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	pr, err := pullRequestNode(ctx, client, *in)
+	if err != nil {
+		return nil, err
+	}
+
+	input := ghv4.DisablePullRequestAutoMergeInput{PullRequestID: pr.ID}
+	var m struct {
+		DisablePullRequestAutoMerge struct {
+			PullRequest pullRequestAutoMergeFields
+		} `graphql:"disablePullRequestAutoMerge(input: $input)"`
+	}
+	if err := graphQLMutate(ctx, client, "DisablePullRequestAutoMerge", &m, input, nil); err != nil {
+		return nil, fmt.Errorf("github graphql error: %w", err)
+	}
+	return m.DisablePullRequestAutoMerge.PullRequest.output(), nil
+}
+
+// withPullRequestRef adds the owner, repo and pullNumber parameters.
+func withPullRequestRef() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner"),
+		)(tool)
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		)(tool)
+		mcp.WithNumber("pullNumber",
+			mcp.Required(),
+			mcp.Description("Pull request number"),
+		)(tool)
+	}
+}
+
+// pullRequestRefParams reads the parameters added by withPullRequestRef.
+func pullRequestRefParams(request mcp.CallToolRequest) (PullRequestRef, error) {
+	owner, err := requiredParam[string](request, "owner")
+	if err != nil {
+		return PullRequestRef{}, err
+	}
+	repo, err := requiredParam[string](request, "repo")
+	if err != nil {
+		return PullRequestRef{}, err
+	}
+	number, err := RequiredInt(request, "pullNumber")
+	if err != nil {
+		return PullRequestRef{}, err
+	}
+	return PullRequestRef{Owner: owner, Repo: repo, Number: number}, nil
+}
+
+// GetMergeQueueEntryTool creates a tool to inspect a pull request's merge queue position.
+func GetMergeQueueEntryTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_merge_queue_entry",
+			mcp.WithDescription(t("TOOL_GET_MERGE_QUEUE_ENTRY_DESCRIPTION", "Get whether a pull request is in a merge queue, with its position and estimated time to merge")),
+			withPullRequestRef(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ref, err := pullRequestRefParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+			out, err := GetMergeQueueEntry(ctx, &ref, client)
+			if err != nil {
+				return nil, err
+			}
+			b, _ := json.Marshal(out)
+			return mcp.NewToolResultText(string(b)), nil
+		}
+}
+
+// EnqueuePullRequestTool creates a tool to add a pull request to a merge queue.
+func EnqueuePullRequestTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("enqueue_pull_request",
+			mcp.WithDescription(t("TOOL_ENQUEUE_PULL_REQUEST_DESCRIPTION", "Add a pull request to its base branch's merge queue. Use this instead of merge_pull_request when the branch requires a merge queue")),
+			withPullRequestRef(),
+			mcp.WithString("expectedHeadSha",
+				mcp.Description("Fail if the pull request's head commit is not this SHA"),
+			),
+			mcp.WithBoolean("jump",
+				mcp.Description("Add the pull request to the front of the queue"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ref, err := pullRequestRefParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			expectedHeadSHA, err := OptionalParam[string](request, "expectedHeadSha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			jump, err := OptionalParam[bool](request, "jump")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+			out, err := EnqueuePullRequest(ctx, &EnqueuePullRequestInput{
+				PullRequestRef:  ref,
+				ExpectedHeadSHA: expectedHeadSHA,
+				Jump:            jump,
+			}, client)
+			if err != nil {
+				return nil, err
+			}
+			b, _ := json.Marshal(out)
+			return mcp.NewToolResultText(string(b)), nil
+		}
+}
+
+// DequeuePullRequestTool creates a tool to remove a pull request from a merge queue.
+func DequeuePullRequestTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("dequeue_pull_request",
+			mcp.WithDescription(t("TOOL_DEQUEUE_PULL_REQUEST_DESCRIPTION", "Remove a pull request from its merge queue")),
+			withPullRequestRef(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ref, err := pullRequestRefParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+			out, err := DequeuePullRequest(ctx, &ref, client)
+			if err != nil {
+				return nil, err
+			}
+			b, _ := json.Marshal(out)
+			return mcp.NewToolResultText(string(b)), nil
+		}
+}
+
+// ConvertPullRequestToDraftTool creates a tool to convert a pull request to a draft.
+func ConvertPullRequestToDraftTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return pullRequestDraftTool("convert_pull_request_to_draft",
+		t("TOOL_CONVERT_PULL_REQUEST_TO_DRAFT_DESCRIPTION", "Convert an open pull request to a draft so it cannot be merged until marked ready for review"),
+		getClient, ConvertPullRequestToDraft)
+}
+
+// MarkPullRequestReadyForReviewTool creates a tool to mark a draft pull request as ready for review.
+func MarkPullRequestReadyForReviewTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return pullRequestDraftTool("mark_pull_request_ready_for_review",
+		t("TOOL_MARK_PULL_REQUEST_READY_FOR_REVIEW_DESCRIPTION", "Mark a draft pull request as ready for review"),
+		getClient, MarkPullRequestReadyForReview)
+}
+
+// pullRequestDraftTool builds the two draft conversion tools, which differ
+// only in the function they call.
+func pullRequestDraftTool(name, description string, getClient GetGraphQLClientFn,
+	apply func(ctx context.Context, in *PullRequestRef, client GraphQLClient) (*PullRequestDraftState, error),
+) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool(name,
+			mcp.WithDescription(description),
+			withPullRequestRef(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ref, err := pullRequestRefParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+			out, err := apply(ctx, &ref, client)
+			if err != nil {
+				return nil, err
+			}
+			b, _ := json.Marshal(out)
+			return mcp.NewToolResultText(string(b)), nil
+		}
+}
+
+// EnablePullRequestAutoMergeTool creates a tool to enable auto-merge on a pull request.
+func EnablePullRequestAutoMergeTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("enable_pull_request_auto_merge",
+			mcp.WithDescription(t("TOOL_ENABLE_PULL_REQUEST_AUTO_MERGE_DESCRIPTION", "Merge a pull request automatically once its required reviews and checks pass")),
+			withPullRequestRef(),
+			mcp.WithString("merge_method",
+				mcp.Description("Merge method, ignored when the base branch uses a merge queue"),
+				mcp.Enum("merge", "squash", "rebase"),
+			),
+			mcp.WithString("commit_title",
+				mcp.Description("Title for merge commit"),
+			),
+			mcp.WithString("commit_message",
+				mcp.Description("Extra detail for merge commit"),
+			),
+			mcp.WithString("expectedHeadSha",
+				mcp.Description("Fail if the pull request's head commit is not this SHA"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ref, err := pullRequestRefParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			in := &EnablePullRequestAutoMergeInput{PullRequestRef: ref}
+			if in.MergeMethod, err = OptionalParam[string](request, "merge_method"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if in.CommitTitle, err = OptionalParam[string](request, "commit_title"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if in.CommitMessage, err = OptionalParam[string](request, "commit_message"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if in.ExpectedHeadSHA, err = OptionalParam[string](request, "expectedHeadSha"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+			out, err := EnablePullRequestAutoMerge(ctx, in, client)
+			if err != nil {
+				return nil, err
+			}
+			b, _ := json.Marshal(out)
+			return mcp.NewToolResultText(string(b)), nil
+		}
+}
+
+// DisablePullRequestAutoMergeTool creates a tool to disable auto-merge on a pull request.
+func DisablePullRequestAutoMergeTool(getClient GetGraphQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("disable_pull_request_auto_merge",
+			mcp.WithDescription(t("TOOL_DISABLE_PULL_REQUEST_AUTO_MERGE_DESCRIPTION", "Turn off auto-merge for a pull request")),
+			withPullRequestRef(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ref, err := pullRequestRefParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+			out, err := DisablePullRequestAutoMerge(ctx, &ref, client)
+			if err != nil {
+				return nil, err
+			}
+			b, _ := json.Marshal(out)
+			return mcp.NewToolResultText(string(b)), nil
+		}
+}
@@ -0,0 +1,274 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pullRequestGraphQLServer answers the pullRequestNode lookup with prNode and
+// hands any other operation to mutate.
+func pullRequestGraphQLServer(t *testing.T, prNode string, mutate func(query string, input map[string]interface{}) string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, vars := decodeGraphQLRequest(t, r)
+		w.WriteHeader(http.StatusOK)
+		if strings.HasPrefix(query, "query") {
+			assert.Equal(t, "owner", vars["owner"])
+			assert.Equal(t, "repo", vars["name"])
+			assert.Equal(t, float64(42), vars["number"])
+			_, _ = w.Write([]byte(`{"data":{"repository":{"pullRequest":` + prNode + `}}}`))
+			return
+		}
+		input, _ := vars["input"].(map[string]interface{})
+		_, _ = w.Write([]byte(mutate(query, input)))
+	}))
+}
+
+const queuedPullRequestNode = `{"id":"PR_1","isMergeQueueEnabled":true,"mergeQueueEntry":{"id":"MQE_1","state":"QUEUED","position":2,"estimatedTimeToMerge":600,"enqueuedAt":"2025-01-02T03:04:05Z"}}`
+
+func Test_GetMergeQueueEntryTool(t *testing.T) {
+	tool, _ := GetMergeQueueEntryTool(stubGetGraphQLClientFn(nil), translations.NullTranslationHelper)
+	assert.Equal(t, "get_merge_queue_entry", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	server := pullRequestGraphQLServer(t, queuedPullRequestNode, nil)
+	defer server.Close()
+	_, handler := GetMergeQueueEntryTool(stubGetGraphQLClientFn(githubv4.NewEnterpriseClient(server.URL, server.Client())), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":      "owner",
+		"repo":       "repo",
+		"pullNumber": float64(42),
+	}))
+	require.NoError(t, err)
+
+	var out MergeQueueStatus
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &out))
+	assert.True(t, out.MergeQueueRequired)
+	assert.True(t, out.Queued)
+	require.NotNil(t, out.Entry)
+	assert.Equal(t, "QUEUED", out.Entry.State)
+	assert.Equal(t, 2, out.Entry.Position)
+	assert.Equal(t, 600, out.Entry.EstimatedTimeToMerge)
+
+	result, err = handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+	}))
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+}
+
+func TestGetMergeQueueEntry_NotQueued(t *testing.T) {
+	server := pullRequestGraphQLServer(t, `{"id":"PR_1","isMergeQueueEnabled":false,"mergeQueueEntry":null}`, nil)
+	defer server.Close()
+
+	out, err := GetMergeQueueEntry(context.Background(), &PullRequestRef{Owner: "owner", Repo: "repo", Number: 42}, githubv4.NewEnterpriseClient(server.URL, server.Client()))
+	require.NoError(t, err)
+	assert.Equal(t, "PR_1", out.PullRequestID)
+	assert.False(t, out.Queued)
+	assert.Nil(t, out.Entry)
+}
+
+func TestEnqueuePullRequest(t *testing.T) {
+	server := pullRequestGraphQLServer(t, `{"id":"PR_1","isMergeQueueEnabled":true,"mergeQueueEntry":null}`,
+		func(query string, input map[string]interface{}) string {
+			assert.Contains(t, query, "enqueuePullRequest")
+			assert.Equal(t, "PR_1", input["pullRequestId"])
+			assert.Equal(t, "abc123", input["expectedHeadOid"])
+			assert.Equal(t, true, input["jump"])
+			return `{"data":{"enqueuePullRequest":{"mergeQueueEntry":{"id":"MQE_1","state":"QUEUED","position":1,"estimatedTimeToMerge":null,"enqueuedAt":"2025-01-02T03:04:05Z"}}}}`
+		})
+	defer server.Close()
+
+	out, err := EnqueuePullRequest(context.Background(), &EnqueuePullRequestInput{
+		PullRequestRef:  PullRequestRef{Owner: "owner", Repo: "repo", Number: 42},
+		ExpectedHeadSHA: "abc123",
+		Jump:            true,
+	}, githubv4.NewEnterpriseClient(server.URL, server.Client()))
+	require.NoError(t, err)
+	assert.Equal(t, "MQE_1", out.ID)
+	assert.Equal(t, 1, out.Position)
+	assert.Zero(t, out.EstimatedTimeToMerge)
+
+	_, err = EnqueuePullRequest(context.Background(), &EnqueuePullRequestInput{}, githubv4.NewEnterpriseClient(server.URL, server.Client()))
+	assert.Error(t, err)
+}
+
+func TestDequeuePullRequest(t *testing.T) {
+	server := pullRequestGraphQLServer(t, queuedPullRequestNode,
+		func(query string, input map[string]interface{}) string {
+			assert.Contains(t, query, "dequeuePullRequest")
+			assert.Equal(t, "PR_1", input["id"])
+			return `{"data":{"dequeuePullRequest":{"mergeQueueEntry":{"id":"MQE_1","state":"QUEUED","position":2,"estimatedTimeToMerge":600,"enqueuedAt":"2025-01-02T03:04:05Z"}}}}`
+		})
+	defer server.Close()
+
+	out, err := DequeuePullRequest(context.Background(), &PullRequestRef{Owner: "owner", Repo: "repo", Number: 42}, githubv4.NewEnterpriseClient(server.URL, server.Client()))
+	require.NoError(t, err)
+	assert.Equal(t, "MQE_1", out.ID)
+
+	notQueued := pullRequestGraphQLServer(t, `{"id":"PR_1","isMergeQueueEnabled":true,"mergeQueueEntry":null}`, nil)
+	defer notQueued.Close()
+	_, err = DequeuePullRequest(context.Background(), &PullRequestRef{Owner: "owner", Repo: "repo", Number: 42}, githubv4.NewEnterpriseClient(notQueued.URL, notQueued.Client()))
+	assert.ErrorContains(t, err, "not in a merge queue")
+}
+
+func TestPullRequestNode_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"data":{"repository":{"pullRequest":null}},"errors":[{"type":"NOT_FOUND","message":"Could not resolve to a PullRequest with the number of 42."}]}`))
+	}))
+	defer server.Close()
+
+	_, err := GetMergeQueueEntry(context.Background(), &PullRequestRef{Owner: "owner", Repo: "repo", Number: 42}, githubv4.NewEnterpriseClient(server.URL, server.Client()))
+	assert.ErrorContains(t, err, "owner/repo#42 not found")
+}
+
+func Test_PullRequestDraftTools(t *testing.T) {
+	tests := []struct {
+		name      string
+		tool      func(GetGraphQLClientFn, translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc)
+		prNode    string
+		mutation  string
+		wantDraft bool
+	}{
+		{
+			name:      "convert_pull_request_to_draft",
+			tool:      ConvertPullRequestToDraftTool,
+			prNode:    `{"id":"PR_1","isDraft":false}`,
+			mutation:  "convertPullRequestToDraft",
+			wantDraft: true,
+		},
+		{
+			name:      "mark_pull_request_ready_for_review",
+			tool:      MarkPullRequestReadyForReviewTool,
+			prNode:    `{"id":"PR_1","isDraft":true}`,
+			mutation:  "markPullRequestReadyForReview",
+			wantDraft: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tool, _ := tc.tool(stubGetGraphQLClientFn(nil), translations.NullTranslationHelper)
+			assert.Equal(t, tc.name, tool.Name)
+			assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+			mutations := 0
+			srv := pullRequestGraphQLServer(t, tc.prNode, func(query string, input map[string]interface{}) string {
+				mutations++
+				assert.Contains(t, query, tc.mutation)
+				assert.Equal(t, "PR_1", input["pullRequestId"])
+				return fmt.Sprintf(`{"data":{"%s":{"pullRequest":{"id":"PR_1","number":42,"isDraft":%t,"url":"https://github.com/owner/repo/pull/42"}}}}`, tc.mutation, tc.wantDraft)
+			})
+			defer srv.Close()
+			_, handler := tc.tool(stubGetGraphQLClientFn(githubv4.NewEnterpriseClient(srv.URL, srv.Client())), translations.NullTranslationHelper)
+
+			args := map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+
+			var out PullRequestDraftState
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &out))
+			assert.Equal(t, tc.wantDraft, out.IsDraft)
+			assert.Equal(t, 42, out.Number)
+			assert.Equal(t, "https://github.com/owner/repo/pull/42", out.URL)
+			assert.Equal(t, 1, mutations)
+		})
+	}
+}
+
+func TestSetPullRequestDraft_AlreadyInState(t *testing.T) {
+	srv := pullRequestGraphQLServer(t, `{"id":"PR_1","isDraft":true}`, func(string, map[string]interface{}) string {
+		t.Error("unexpected mutation")
+		return `{}`
+	})
+	defer srv.Close()
+
+	out, err := ConvertPullRequestToDraft(context.Background(), &PullRequestRef{Owner: "owner", Repo: "repo", Number: 42}, githubv4.NewEnterpriseClient(srv.URL, srv.Client()))
+	require.NoError(t, err)
+	assert.True(t, out.IsDraft)
+	assert.Equal(t, "PR_1", out.ID)
+}
+
+func Test_EnablePullRequestAutoMergeTool(t *testing.T) {
+	tool, _ := EnablePullRequestAutoMergeTool(stubGetGraphQLClientFn(nil), translations.NullTranslationHelper)
+	assert.Equal(t, "enable_pull_request_auto_merge", tool.Name)
+	assert.Contains(t, tool.InputSchema.Properties, "merge_method")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	srv := pullRequestGraphQLServer(t, `{"id":"PR_1"}`, func(query string, input map[string]interface{}) string {
+		assert.Contains(t, query, "enablePullRequestAutoMerge")
+		assert.Equal(t, "PR_1", input["pullRequestId"])
+		assert.Equal(t, "SQUASH", input["mergeMethod"])
+		assert.Equal(t, "Ship it", input["commitHeadline"])
+		assert.NotContains(t, input, "commitBody")
+		return `{"data":{"enablePullRequestAutoMerge":{"pullRequest":{"id":"PR_1","number":42,"autoMergeRequest":{"mergeMethod":"SQUASH","enabledAt":"2025-01-02T03:04:05Z","enabledBy":{"login":"octocat"}}}}}}`
+	})
+	defer srv.Close()
+	_, handler := EnablePullRequestAutoMergeTool(stubGetGraphQLClientFn(githubv4.NewEnterpriseClient(srv.URL, srv.Client())), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":        "owner",
+		"repo":         "repo",
+		"pullNumber":   float64(42),
+		"merge_method": "squash",
+		"commit_title": "Ship it",
+	}))
+	require.NoError(t, err)
+
+	var out PullRequestAutoMerge
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &out))
+	assert.True(t, out.Enabled)
+	assert.Equal(t, "squash", out.MergeMethod)
+	assert.Equal(t, "octocat", out.EnabledBy)
+	require.NotNil(t, out.EnabledAt)
+}
+
+func TestEnablePullRequestAutoMerge_InvalidMethod(t *testing.T) {
+	_, err := EnablePullRequestAutoMerge(context.Background(), &EnablePullRequestAutoMergeInput{
+		PullRequestRef: PullRequestRef{Owner: "owner", Repo: "repo", Number: 42},
+		MergeMethod:    "fast-forward",
+	}, githubv4.NewClient(nil))
+	assert.ErrorContains(t, err, "invalid merge method")
+}
+
+func Test_DisablePullRequestAutoMergeTool(t *testing.T) {
+	srv := pullRequestGraphQLServer(t, `{"id":"PR_1"}`, func(query string, input map[string]interface{}) string {
+		assert.Contains(t, query, "disablePullRequestAutoMerge")
+		assert.Equal(t, "PR_1", input["pullRequestId"])
+		return `{"data":{"disablePullRequestAutoMerge":{"pullRequest":{"id":"PR_1","number":42,"autoMergeRequest":null}}}}`
+	})
+	defer srv.Close()
+	tool, handler := DisablePullRequestAutoMergeTool(stubGetGraphQLClientFn(githubv4.NewEnterpriseClient(srv.URL, srv.Client())), translations.NullTranslationHelper)
+	assert.Equal(t, "disable_pull_request_auto_merge", tool.Name)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":      "owner",
+		"repo":       "repo",
+		"pullNumber": float64(42),
+	}))
+	require.NoError(t, err)
+
+	var out PullRequestAutoMerge
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &out))
+	assert.False(t, out.Enabled)
+	assert.Empty(t, out.MergeMethod)
+	assert.Nil(t, out.EnabledAt)
+}
@@ -43,6 +43,8 @@ func Test_GetPullRequest(t *testing.T) {
 		User: &github.User{
 			Login: github.Ptr("testuser"),
 		},
+		RequestedReviewers: []*github.User{{Login: github.Ptr("reviewer1")}},
+		RequestedTeams:     []*github.Team{{Slug: github.Ptr("core")}},
 	}
 
 	tests := []struct {
@@ -122,6 +124,10 @@ func Test_GetPullRequest(t *testing.T) {
 			assert.Equal(t, *tc.expectedPR.Title, *returnedPR.Title)
 			assert.Equal(t, *tc.expectedPR.State, *returnedPR.State)
 			assert.Equal(t, *tc.expectedPR.HTMLURL, *returnedPR.HTMLURL)
+			require.Len(t, returnedPR.RequestedReviewers, 1)
+			assert.Equal(t, "reviewer1", returnedPR.RequestedReviewers[0].GetLogin())
+			require.Len(t, returnedPR.RequestedTeams, 1)
+			assert.Equal(t, "core", returnedPR.RequestedTeams[0].GetSlug())
 		})
 	}
 }
@@ -424,9 +430,11 @@ func Test_ListPullRequests(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedPRs []*github.PullRequest
-			err = json.Unmarshal([]byte(textContent.Text), &returnedPRs)
+			var returned ListPullRequestsOutput
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
 			require.NoError(t, err)
+			assert.False(t, returned.HasNextPage)
+			returnedPRs := returned.PullRequests
 			assert.Len(t, returnedPRs, 2)
 			assert.Equal(t, *tc.expectedPRs[0].Number, *returnedPRs[0].Number)
 			assert.Equal(t, *tc.expectedPRs[0].Title, *returnedPRs[0].Title)
@@ -569,7 +577,7 @@ func Test_GetPullRequestFiles(t *testing.T) {
 			Additions: github.Ptr(10),
 			Deletions: github.Ptr(5),
 			Changes:   github.Ptr(15),
-			Patch:     github.Ptr("@@ -1,5 +1,10 @@"),
+			Patch:     github.Ptr("@@ -1,5 +1,10 @@\n-old\n+new\n"),
 		},
 		{
 			Filename:  github.Ptr("file2.go"),
@@ -582,12 +590,13 @@ func Test_GetPullRequestFiles(t *testing.T) {
 	}
 
 	tests := []struct {
-		name           string
-		mockedClient   *http.Client
-		requestArgs    map[string]interface{}
-		expectError    bool
-		expectedFiles  []*github.CommitFile
-		expectedErrMsg string
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedFiles   []*github.CommitFile
+		expectedPatches string
+		expectedErrMsg  string
 	}{
 		{
 			name: "successful files fetch",
@@ -602,8 +611,34 @@ func Test_GetPullRequestFiles(t *testing.T) {
 				"repo":       "repo",
 				"pullNumber": float64(42),
 			},
-			expectError:   false,
-			expectedFiles: mockFiles,
+			expectError:     false,
+			expectedFiles:   mockFiles,
+			expectedPatches: "@@ -1,5 +1,10 @@\n-old\n+new\n",
+		},
+		{
+			name: "files fetch with truncated patches",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsFilesByOwnerByRepoByPullNumber,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "50",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockFiles),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"pullNumber":    float64(42),
+				"maxPatchLines": float64(2),
+				"page":          float64(2),
+				"perPage":       float64(50),
+			},
+			expectError:     false,
+			expectedFiles:   mockFiles,
+			expectedPatches: "@@ -1,5 +1,10 @@\n-old\n",
 		},
 		{
 			name: "files fetch fails",
@@ -651,16 +686,73 @@ func Test_GetPullRequestFiles(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedFiles []*github.CommitFile
-			err = json.Unmarshal([]byte(textContent.Text), &returnedFiles)
+			var returned GetPullRequestFilesOutput
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
 			require.NoError(t, err)
-			assert.Len(t, returnedFiles, len(tc.expectedFiles))
-			for i, file := range returnedFiles {
-				assert.Equal(t, *tc.expectedFiles[i].Filename, *file.Filename)
-				assert.Equal(t, *tc.expectedFiles[i].Status, *file.Status)
-				assert.Equal(t, *tc.expectedFiles[i].Additions, *file.Additions)
-				assert.Equal(t, *tc.expectedFiles[i].Deletions, *file.Deletions)
+			assert.Len(t, returned.Files, len(tc.expectedFiles))
+			for i, file := range returned.Files {
+				assert.Equal(t, *tc.expectedFiles[i].Filename, file.Path)
+				assert.Equal(t, *tc.expectedFiles[i].Status, file.Status)
+				assert.Equal(t, *tc.expectedFiles[i].Additions, file.Additions)
+				assert.Equal(t, *tc.expectedFiles[i].Deletions, file.Deletions)
 			}
+			assert.Equal(t, tc.expectedPatches, returned.Files[0].Patch)
+		})
+	}
+}
+
+func Test_GetPullRequestDiff(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := GetPullRequestDiff(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_pull_request_diff", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "maxBytes")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	diff := "diff --git a/main.go b/main.go\n--- a/main.go\n+++ b/main.go\n@@ -1 +1 @@\n-old\n+new\n"
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposPullsByOwnerByRepoByPullNumber,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "application/vnd.github.v3.diff", r.Header.Get("Accept"))
+				_, _ = w.Write([]byte(diff))
+			}),
+		),
+	))
+	_, handler := GetPullRequestDiff(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	tests := []struct {
+		name     string
+		maxBytes interface{}
+		expected GetPullRequestDiffOutput
+	}{
+		{
+			name:     "full diff",
+			expected: GetPullRequestDiffOutput{Diff: diff, Size: len(diff)},
+		},
+		{
+			name:     "truncated at line boundary",
+			maxBytes: float64(40),
+			expected: GetPullRequestDiffOutput{Diff: "diff --git a/main.go b/main.go\n", Size: len(diff), Truncated: true},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			args := map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			}
+			if tc.maxBytes != nil {
+				args["maxBytes"] = tc.maxBytes
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+
+			var returned GetPullRequestDiffOutput
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expected, returned)
 		})
 	}
 }
@@ -714,13 +806,32 @@ func Test_GetPullRequestStatus(t *testing.T) {
 		},
 	}
 
+	mockCheckRuns := &github.ListCheckRunsResults{
+		Total: github.Ptr(2),
+		CheckRuns: []*github.CheckRun{
+			{
+				Name:       github.Ptr("build"),
+				Status:     github.Ptr("completed"),
+				Conclusion: github.Ptr("success"),
+				DetailsURL: github.Ptr("https://github.com/owner/repo/actions/runs/1"),
+			},
+			{
+				Name:       github.Ptr("test"),
+				Status:     github.Ptr("completed"),
+				Conclusion: github.Ptr("failure"),
+				Output:     &github.CheckRunOutput{Summary: github.Ptr("2 tests failed")},
+			},
+		},
+	}
+
 	tests := []struct {
-		name           string
-		mockedClient   *http.Client
-		requestArgs    map[string]interface{}
-		expectError    bool
-		expectedStatus *github.CombinedStatus
-		expectedErrMsg string
+		name               string
+		mockedClient       *http.Client
+		requestArgs        map[string]interface{}
+		expectError        bool
+		expectedStatus     *github.CombinedStatus
+		expectedConclusion string
+		expectedErrMsg     string
 	}{
 		{
 			name: "successful status fetch",
@@ -733,14 +844,19 @@ func Test_GetPullRequestStatus(t *testing.T) {
 					mock.GetReposCommitsStatusByOwnerByRepoByRef,
 					mockStatus,
 				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+					mockCheckRuns,
+				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":      "owner",
 				"repo":       "repo",
 				"pullNumber": float64(42),
 			},
-			expectError:    false,
-			expectedStatus: mockStatus,
+			expectError:        false,
+			expectedStatus:     mockStatus,
+			expectedConclusion: "failure",
 		},
 		{
 			name: "PR fetch fails",
@@ -811,9 +927,12 @@ func Test_GetPullRequestStatus(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedStatus github.CombinedStatus
+			var returnedStatus PullRequestStatusOutput
 			err = json.Unmarshal([]byte(textContent.Text), &returnedStatus)
 			require.NoError(t, err)
+			assert.Equal(t, tc.expectedConclusion, returnedStatus.Conclusion)
+			require.Len(t, returnedStatus.CheckRuns, 2)
+			assert.Equal(t, PullRequestCheckRun{Name: "test", Status: "completed", Conclusion: "failure", Summary: "2 tests failed"}, returnedStatus.CheckRuns[1])
 			assert.Equal(t, *tc.expectedStatus.State, *returnedStatus.State)
 			assert.Equal(t, *tc.expectedStatus.TotalCount, *returnedStatus.TotalCount)
 			assert.Len(t, returnedStatus.Statuses, len(tc.expectedStatus.Statuses))
@@ -826,6 +945,54 @@ func Test_GetPullRequestStatus(t *testing.T) {
 	}
 }
 
+func Test_PullRequestStatusConclusion(t *testing.T) {
+	completed := func(conclusion string) PullRequestCheckRun {
+		return PullRequestCheckRun{Status: "completed", Conclusion: conclusion}
+	}
+	tests := []struct {
+		name     string
+		status   *github.CombinedStatus
+		runs     []PullRequestCheckRun
+		expected string
+	}{
+		{
+			name:     "checks only, combined status reports pending with no statuses",
+			status:   &github.CombinedStatus{State: github.Ptr("pending"), TotalCount: github.Ptr(0)},
+			runs:     []PullRequestCheckRun{completed("success"), completed("skipped")},
+			expected: "success",
+		},
+		{
+			name:     "running check",
+			status:   &github.CombinedStatus{State: github.Ptr("success"), TotalCount: github.Ptr(1)},
+			runs:     []PullRequestCheckRun{completed("success"), {Status: "in_progress"}},
+			expected: "pending",
+		},
+		{
+			name:     "failed status wins over running check",
+			status:   &github.CombinedStatus{State: github.Ptr("failure"), TotalCount: github.Ptr(1)},
+			runs:     []PullRequestCheckRun{{Status: "queued"}},
+			expected: "failure",
+		},
+		{
+			name:     "cancelled check",
+			status:   &github.CombinedStatus{State: github.Ptr("success"), TotalCount: github.Ptr(1)},
+			runs:     []PullRequestCheckRun{completed("cancelled")},
+			expected: "failure",
+		},
+		{
+			name:     "pending status",
+			status:   &github.CombinedStatus{State: github.Ptr("pending"), TotalCount: github.Ptr(2)},
+			expected: "pending",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			out := PullRequestStatusOutput{CombinedStatus: tc.status, CheckRuns: tc.runs}
+			assert.Equal(t, tc.expected, out.conclusion())
+		})
+	}
+}
+
 func Test_UpdatePullRequestBranch(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
@@ -851,6 +1018,7 @@ func Test_UpdatePullRequestBranch(t *testing.T) {
 		requestArgs          map[string]interface{}
 		expectError          bool
 		expectedUpdateResult *github.PullRequestBranchUpdateResponse
+		expectedOutput       UpdatePullRequestBranchOutput
 		expectedErrMsg       string
 	}{
 		{
@@ -873,6 +1041,7 @@ func Test_UpdatePullRequestBranch(t *testing.T) {
 			},
 			expectError:          false,
 			expectedUpdateResult: mockUpdateResult,
+			expectedOutput:       UpdatePullRequestBranchOutput{Status: "in_progress", Message: "Pull request branch update is in progress"},
 		},
 		{
 			name: "branch update without expected SHA",
@@ -891,6 +1060,26 @@ func Test_UpdatePullRequestBranch(t *testing.T) {
 			},
 			expectError:          false,
 			expectedUpdateResult: mockUpdateResult,
+			expectedOutput:       UpdatePullRequestBranchOutput{Status: "in_progress", Message: "Pull request branch update is in progress"},
+		},
+		{
+			name: "branch update reports merge conflict",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposPullsUpdateBranchByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "merge conflict between base and head"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:    false,
+			expectedOutput: UpdatePullRequestBranchOutput{Status: "conflict", Message: "merge conflict between base and head", Conflict: true},
 		},
 		{
 			name: "branch update fails",
@@ -937,7 +1126,9 @@ func Test_UpdatePullRequestBranch(t *testing.T) {
 			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
-			assert.Contains(t, textContent.Text, "is in progress")
+			var returned UpdatePullRequestBranchOutput
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedOutput, returned)
 		})
 	}
 }
@@ -1916,3 +2107,81 @@ func Test_AddPullRequestReviewComment(t *testing.T) {
 		})
 	}
 }
+
+func Test_RequestAndRemoveReviewers(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := RequestReviewers(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "request_reviewers", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "reviewers")
+	assert.Contains(t, tool.InputSchema.Properties, "teamReviewers")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
+			expectRequestBody(t, map[string]any{
+				"reviewers":      []any{"octocat"},
+				"team_reviewers": []any{"core"},
+			}).andThen(
+				mockResponse(t, http.StatusCreated, &github.PullRequest{
+					Number:             github.Ptr(42),
+					RequestedReviewers: []*github.User{{Login: github.Ptr("octocat")}},
+					RequestedTeams:     []*github.Team{{Slug: github.Ptr("core")}},
+				}),
+			),
+		),
+		mock.WithRequestMatchHandler(
+			mock.DeleteReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
+			expectRequestBody(t, map[string]any{
+				"reviewers": []any{"octocat"},
+			}).andThen(
+				mockResponse(t, http.StatusOK, &github.PullRequest{Number: github.Ptr(42)}),
+			),
+		),
+		mock.WithRequestMatch(
+			mock.GetReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
+			&github.Reviewers{Teams: []*github.Team{{Slug: github.Ptr("core")}}},
+		),
+	))
+
+	_, request := RequestReviewers(stubGetClientFn(client), translations.NullTranslationHelper)
+	result, err := request(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":         "owner",
+		"repo":          "repo",
+		"pullNumber":    float64(42),
+		"reviewers":     []any{"octocat"},
+		"teamReviewers": []any{"core"},
+	}))
+	require.NoError(t, err)
+	var reviewers github.Reviewers
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &reviewers))
+	require.Len(t, reviewers.Users, 1)
+	assert.Equal(t, "octocat", reviewers.Users[0].GetLogin())
+	require.Len(t, reviewers.Teams, 1)
+
+	tool, remove := RemoveRequestedReviewers(stubGetClientFn(client), translations.NullTranslationHelper)
+	assert.Equal(t, "remove_requested_reviewers", tool.Name)
+	result, err = remove(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":      "owner",
+		"repo":       "repo",
+		"pullNumber": float64(42),
+		"reviewers":  []any{"octocat"},
+	}))
+	require.NoError(t, err)
+	reviewers = github.Reviewers{}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &reviewers))
+	assert.Empty(t, reviewers.Users)
+	require.Len(t, reviewers.Teams, 1)
+	assert.Equal(t, "core", reviewers.Teams[0].GetSlug())
+
+	result, err = remove(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":      "owner",
+		"repo":       "repo",
+		"pullNumber": float64(42),
+	}))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, getTextResult(t, result).Text, "at least one of reviewers or teamReviewers")
+}
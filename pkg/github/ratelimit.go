@@ -0,0 +1,278 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// defaultRateLimitRetries is how often RateLimitTransport retries a
+	// request that hit a secondary rate limit.
+	defaultRateLimitRetries = 3
+	// defaultRateLimitMaxWait is the longest RateLimitTransport waits before
+	// a retry; responses asking for a longer wait are returned as they are.
+	defaultRateLimitMaxWait = time.Minute
+)
+
+// ObservedRateLimit is the state of a rate limit resource, such as core or
+// graphql, as reported by the X-RateLimit headers of the latest response.
+type ObservedRateLimit struct {
+	Resource  string    `json:"resource"`
+	Limit     int       `json:"limit"`
+	Remaining int       `json:"remaining"`
+	Used      int       `json:"used"`
+	Reset     time.Time `json:"reset"`
+	// LastCost is the number of points the latest request used. For the
+	// graphql resource it is the cost of the query. It is approximate when
+	// requests run concurrently, and 0 when unknown: until two responses in
+	// the same reset window can be compared.
+	LastCost int `json:"last_cost"`
+}
+
+// RateLimitTracker records the rate limits GitHub reports on responses and
+// the retries RateLimitTransport made. It is safe for concurrent use.
+type RateLimitTracker struct {
+	mu      sync.Mutex
+	limits  map[string]ObservedRateLimit
+	retries int
+}
+
+// NewRateLimitTracker creates an empty RateLimitTracker.
+func NewRateLimitTracker() *RateLimitTracker {
+	return &RateLimitTracker{limits: make(map[string]ObservedRateLimit)}
+}
+
+// Observe records the rate limit headers of a response. Responses without
+// them, such as those of GitHub Enterprise Server instances with rate
+// limiting disabled, are ignored.
+func (t *RateLimitTracker) Observe(h http.Header) {
+	limit, err := strconv.Atoi(h.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return
+	}
+	remaining, _ := strconv.Atoi(h.Get("X-RateLimit-Remaining"))
+	used, _ := strconv.Atoi(h.Get("X-RateLimit-Used"))
+	reset, _ := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64)
+	resource := h.Get("X-RateLimit-Resource")
+	if resource == "" {
+		resource = "core"
+	}
+
+	l := ObservedRateLimit{
+		Resource:  resource,
+		Limit:     limit,
+		Remaining: remaining,
+		Used:      used,
+		Reset:     time.Unix(reset, 0).UTC(),
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	// Within a window the cost is the growth of used. The first response of
+	// a window has nothing to compare with, since used also counts requests
+	// made before the server started or by other clients of the token.
+	if prev, ok := t.limits[resource]; ok && prev.Reset.Equal(l.Reset) && used >= prev.Used {
+		l.LastCost = used - prev.Used
+	}
+	t.limits[resource] = l
+}
+
+// Limits returns the latest state of every resource seen, ordered by name.
+func (t *RateLimitTracker) Limits() []ObservedRateLimit {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	limits := make([]ObservedRateLimit, 0, len(t.limits))
+	for _, l := range t.limits {
+		limits = append(limits, l)
+	}
+	sort.Slice(limits, func(i, j int) bool { return limits[i].Resource < limits[j].Resource })
+	return limits
+}
+
+// Retries returns the number of requests RateLimitTransport retried after a
+// secondary rate limit.
+func (t *RateLimitTracker) Retries() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.retries
+}
+
+func (t *RateLimitTracker) addRetry() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.retries++
+}
+
+// RateLimitTransport records rate limit headers in Tracker, which may be nil,
+// and retries requests rejected by a secondary rate limit after the wait
+// given by their Retry-After header, or with exponential backoff when there
+// is none. Exhausted primary rate limits are not retried, since they may take
+// up to an hour to reset. It serves both the REST and the GraphQL clients.
+type RateLimitTransport struct {
+	Base    http.RoundTripper
+	Tracker *RateLimitTracker
+	// MaxRetries defaults to 3.
+	MaxRetries int
+	// MaxWait defaults to a minute.
+	MaxWait time.Duration
+
+	// sleep waits for d or until ctx is done; tests replace it.
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	maxRetries := t.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = defaultRateLimitRetries
+	}
+	maxWait := t.MaxWait
+	if maxWait == 0 {
+		maxWait = defaultRateLimitMaxWait
+	}
+	sleep := t.sleep
+	if sleep == nil {
+		sleep = sleepContext
+	}
+	// Requests with a body can only be retried if it can be replayed.
+	replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.Base.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		if t.Tracker != nil {
+			t.Tracker.Observe(resp.Header)
+		}
+		if attempt == maxRetries || !replayable {
+			return resp, nil
+		}
+		wait, ok := secondaryRateLimitWait(resp, attempt)
+		if !ok || wait > maxWait {
+			return resp, nil
+		}
+
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		if err := sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+		if t.Tracker != nil {
+			t.Tracker.addRetry()
+		}
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to rewind request body: %w", err)
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// secondaryRateLimitWait reports whether resp was rejected by a secondary
+// rate limit and, if so, how long to wait before the next attempt. resp's
+// body stays readable.
+func secondaryRateLimitWait(resp *http.Response, attempt int) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		// The primary rate limit is exhausted.
+		return 0, false
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return 0, false
+	}
+	msg := strings.ToLower(string(body))
+	if !strings.Contains(msg, "secondary rate limit") && !strings.Contains(msg, "abuse") {
+		return 0, false
+	}
+	return time.Duration(1<<attempt) * time.Second, true
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// RateLimitOutput is the remaining rate limit budget of the token.
+type RateLimitOutput struct {
+	// Resources is the budget of each rate limit resource as GitHub reports
+	// it; asking does not count against any of them.
+	Resources *github.RateLimits `json:"resources"`
+	// Observed is what this server saw on its own recent responses,
+	// including the cost of its last GraphQL query.
+	Observed []ObservedRateLimit `json:"observed,omitempty"`
+	// Retries counts requests retried after a secondary rate limit.
+	Retries int `json:"secondary_rate_limit_retries,omitempty"`
+}
+
+// GetRateLimitTool creates a tool that reports the remaining rate limit budget.
+// tracker, which may be nil, is the one used by the clients of the server's
+// own token; it is not reported for requests made with another token.
+func GetRateLimitTool(getClient GetClientFn, tracker *RateLimitTracker, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_rate_limit",
+			mcp.WithDescription(t("TOOL_GET_RATE_LIMIT_DESCRIPTION", "Get the remaining GitHub API rate limit budget of the token for REST, search and GraphQL requests, and when each resets. Use this before large batches of calls or after rate limit errors.")),
+		),
+		func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			limits, resp, err := client.RateLimit.Get(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get rate limit: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get rate limit: %s", string(body))), nil
+			}
+
+			out := RateLimitOutput{Resources: limits}
+			if _, ok := TokenFromContext(ctx); !ok && tracker != nil {
+				out.Observed = tracker.Limits()
+				out.Retries = tracker.Retries()
+			}
+
+			r, err := json.Marshal(out)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
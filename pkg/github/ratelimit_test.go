@@ -0,0 +1,206 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func rateLimitHeader(resource string, remaining, used int) http.Header {
+	h := http.Header{}
+	h.Set("X-RateLimit-Limit", "5000")
+	h.Set("X-RateLimit-Remaining", fmt.Sprint(remaining))
+	h.Set("X-RateLimit-Used", fmt.Sprint(used))
+	h.Set("X-RateLimit-Reset", "1760000000")
+	h.Set("X-RateLimit-Resource", resource)
+	return h
+}
+
+func Test_RateLimitTracker(t *testing.T) {
+	tracker := NewRateLimitTracker()
+	tracker.Observe(rateLimitHeader("graphql", 4990, 10))
+	tracker.Observe(rateLimitHeader("graphql", 4985, 15))
+	tracker.Observe(rateLimitHeader("core", 4999, 1))
+	tracker.Observe(http.Header{})
+
+	reset := time.Unix(1760000000, 0).UTC()
+	assert.Equal(t, []ObservedRateLimit{
+		{Resource: "core", Limit: 5000, Remaining: 4999, Used: 1, Reset: reset},
+		{Resource: "graphql", Limit: 5000, Remaining: 4985, Used: 15, Reset: reset, LastCost: 5},
+	}, tracker.Limits())
+
+	// A new window has no previous response to compare with.
+	h := rateLimitHeader("graphql", 4998, 2)
+	h.Set("X-RateLimit-Reset", "1760003600")
+	tracker.Observe(h)
+	assert.Equal(t, 0, tracker.Limits()[1].LastCost)
+}
+
+func Test_RateLimitTransport(t *testing.T) {
+	secondary := func(w http.ResponseWriter, retryAfter string) {
+		if retryAfter != "" {
+			w.Header().Set("Retry-After", retryAfter)
+		}
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message":"You have exceeded a secondary rate limit."}`))
+	}
+
+	tests := []struct {
+		name          string
+		failures      int
+		fail          func(w http.ResponseWriter)
+		expectedCalls int
+		expectedWaits []time.Duration
+		expectedCode  int
+	}{
+		{
+			name:          "retry after header",
+			failures:      1,
+			fail:          func(w http.ResponseWriter) { secondary(w, "2") },
+			expectedCalls: 2,
+			expectedWaits: []time.Duration{2 * time.Second},
+			expectedCode:  http.StatusOK,
+		},
+		{
+			name:          "backoff without retry after",
+			failures:      2,
+			fail:          func(w http.ResponseWriter) { secondary(w, "") },
+			expectedCalls: 3,
+			expectedWaits: []time.Duration{time.Second, 2 * time.Second},
+			expectedCode:  http.StatusOK,
+		},
+		{
+			name:          "gives up after max retries",
+			failures:      10,
+			fail:          func(w http.ResponseWriter) { secondary(w, "1") },
+			expectedCalls: 4,
+			expectedWaits: []time.Duration{time.Second, time.Second, time.Second},
+			expectedCode:  http.StatusForbidden,
+		},
+		{
+			name:          "wait too long",
+			failures:      1,
+			fail:          func(w http.ResponseWriter) { secondary(w, "3600") },
+			expectedCalls: 1,
+			expectedCode:  http.StatusForbidden,
+		},
+		{
+			name:     "primary rate limit exhausted",
+			failures: 1,
+			fail: func(w http.ResponseWriter) {
+				w.Header().Set("X-RateLimit-Remaining", "0")
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"message":"API rate limit exceeded"}`))
+			},
+			expectedCalls: 1,
+			expectedCode:  http.StatusForbidden,
+		},
+		{
+			name:     "other forbidden",
+			failures: 1,
+			fail: func(w http.ResponseWriter) {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"message":"Resource not accessible by integration"}`))
+			},
+			expectedCalls: 1,
+			expectedCode:  http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls int
+			var bodies []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				body, _ := io.ReadAll(r.Body)
+				bodies = append(bodies, string(body))
+				if calls <= tc.failures {
+					tc.fail(w)
+					return
+				}
+				for k, v := range rateLimitHeader("core", 4999, 1) {
+					w.Header()[k] = v
+				}
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			defer server.Close()
+
+			var waits []time.Duration
+			tracker := NewRateLimitTracker()
+			client := &http.Client{Transport: &RateLimitTransport{
+				Base:    http.DefaultTransport,
+				Tracker: tracker,
+				sleep: func(_ context.Context, d time.Duration) error {
+					waits = append(waits, d)
+					return nil
+				},
+			}}
+
+			resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"title":"x"}`))
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedCode, resp.StatusCode)
+			assert.NotEmpty(t, body)
+			assert.Equal(t, tc.expectedCalls, calls)
+			assert.Equal(t, tc.expectedWaits, waits)
+			assert.Equal(t, len(tc.expectedWaits), tracker.Retries())
+			for _, b := range bodies {
+				assert.Equal(t, `{"title":"x"}`, b)
+			}
+		})
+	}
+}
+
+func Test_GetRateLimitTool(t *testing.T) {
+	limits := map[string]interface{}{
+		"resources": map[string]interface{}{
+			"core":    map[string]interface{}{"limit": 5000, "remaining": 4990, "used": 10, "reset": 1760000000},
+			"graphql": map[string]interface{}{"limit": 5000, "remaining": 4900, "used": 100, "reset": 1760000000},
+		},
+	}
+	mockClient := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(mock.GetRateLimit, limits, limits),
+	))
+	tracker := NewRateLimitTracker()
+	tracker.Observe(rateLimitHeader("graphql", 4900, 100))
+	tool, handler := GetRateLimitTool(stubGetClientFn(mockClient), tracker, translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_rate_limit", tool.Name)
+	assert.Empty(t, tool.InputSchema.Required)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned RateLimitOutput
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	require.NotNil(t, returned.Resources.Core)
+	assert.Equal(t, 4990, returned.Resources.Core.Remaining)
+	require.NotNil(t, returned.Resources.GraphQL)
+	assert.Equal(t, 4900, returned.Resources.GraphQL.Remaining)
+	require.Len(t, returned.Observed, 1)
+	assert.Equal(t, "graphql", returned.Observed[0].Resource)
+
+	// The tracker belongs to the server's token, not to the caller's.
+	result, err = handler(ContextWithToken(context.Background(), "other"), createMCPRequest(map[string]interface{}{}))
+	require.NoError(t, err)
+	var other RateLimitOutput
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &other))
+	assert.Empty(t, other.Observed)
+}
@@ -0,0 +1,593 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ListReleases creates a tool to list the releases of a repository.
+func ListReleases(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_releases",
+			mcp.WithDescription(t("TOOL_LIST_RELEASES_DESCRIPTION", "List the releases of a GitHub repository, newest first. Drafts are only included if you can push to the repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			releases, resp, err := client.Repositories.ListReleases(ctx, owner, repo, &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list releases: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list releases: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(releases)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetLatestRelease creates a tool to get the latest release of a repository.
+func GetLatestRelease(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_latest_release",
+			mcp.WithDescription(t("TOOL_GET_LATEST_RELEASE_DESCRIPTION", "Get the latest published full release of a GitHub repository, skipping drafts and prereleases")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			release, resp, err := client.Repositories.GetLatestRelease(ctx, owner, repo)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get latest release: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get latest release: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(release)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateRelease creates a tool to create a release, optionally with notes
+// generated from the changes since the previous release.
+func CreateRelease(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_release",
+			mcp.WithDescription(t("TOOL_CREATE_RELEASE_DESCRIPTION", "Create a release in a GitHub repository. The tag is created from targetCommitish if it does not exist yet")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("tagName",
+				mcp.Required(),
+				mcp.Description("Tag of the release, such as v1.2.0"),
+			),
+			withReleaseFields(),
+			mcp.WithBoolean("generateReleaseNotes",
+				mcp.Description("Generate the name and notes from the changes since the previous release; a given name or body takes precedence"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			tagName, err := requiredParam[string](request, "tagName")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			release, _, err := releaseFromRequest(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			release.TagName = github.Ptr(tagName)
+			generateNotes, err := OptionalParam[bool](request, "generateReleaseNotes")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if generateNotes {
+				release.GenerateReleaseNotes = github.Ptr(true)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			created, resp, err := client.Repositories.CreateRelease(ctx, owner, repo, release)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create release: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create release: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(created)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// UpdateRelease creates a tool to update a release, for example to publish a
+// draft.
+func UpdateRelease(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_release",
+			mcp.WithDescription(t("TOOL_UPDATE_RELEASE_DESCRIPTION", "Update a release, for example to edit its notes or publish a draft; only the fields given are changed")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("releaseId",
+				mcp.Required(),
+				mcp.Description("Release ID"),
+			),
+			mcp.WithString("tagName",
+				mcp.Description("New tag of the release"),
+			),
+			withReleaseFields(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			releaseID, err := RequiredInt(request, "releaseId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			release, updateNeeded, err := releaseFromRequest(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if tagName, ok, err := OptionalParamOK[string](request, "tagName"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				release.TagName = github.Ptr(tagName)
+				updateNeeded = true
+			}
+			if !updateNeeded {
+				return mcp.NewToolResultError("No update parameters provided."), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			updated, resp, err := client.Repositories.EditRelease(ctx, owner, repo, int64(releaseID), release)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update release: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to update release: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(updated)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// UploadReleaseAsset creates a tool to attach a file to a release.
+func UploadReleaseAsset(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("upload_release_asset",
+			mcp.WithDescription(t("TOOL_UPLOAD_RELEASE_ASSET_DESCRIPTION", "Upload a file as an asset of a release")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("releaseId",
+				mcp.Required(),
+				mcp.Description("Release ID"),
+			),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("File name of the asset, unique within the release"),
+			),
+			mcp.WithString("content",
+				mcp.Required(),
+				mcp.Description("Content of the asset"),
+			),
+			mcp.WithString("encoding",
+				mcp.Description("Encoding of content, defaults to utf-8; use base64 for binary files"),
+				mcp.Enum("utf-8", "base64"),
+			),
+			mcp.WithString("contentType",
+				mcp.Description("Media type of the asset, guessed from the name if omitted"),
+			),
+			mcp.WithString("label",
+				mcp.Description("Label shown instead of the file name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			releaseID, err := RequiredInt(request, "releaseId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := requiredParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			content, err := requiredParam[string](request, "content")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			encoding, err := OptionalParam[string](request, "encoding")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			contentType, err := OptionalParam[string](request, "contentType")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			label, err := OptionalParam[string](request, "label")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			data := []byte(content)
+			if encoding == "base64" {
+				if data, err = base64.StdEncoding.DecodeString(content); err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("content is not valid base64: %s", err)), nil
+				}
+			}
+			if contentType == "" {
+				contentType = mime.TypeByExtension(path.Ext(name))
+			}
+			if contentType == "" {
+				contentType = "application/octet-stream"
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			// Repositories.UploadReleaseAsset only reads from an *os.File, so
+			// send the upload request directly.
+			query := url.Values{"name": {name}}
+			if label != "" {
+				query.Set("label", label)
+			}
+			u := fmt.Sprintf("repos/%s/%s/releases/%d/assets?%s", owner, repo, releaseID, query.Encode())
+			req, err := client.NewUploadRequest(u, bytes.NewReader(data), int64(len(data)), contentType)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+			asset := new(github.ReleaseAsset)
+			resp, err := client.Do(ctx, req, asset)
+			if err != nil {
+				return nil, fmt.Errorf("failed to upload release asset: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to upload release asset: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(asset)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListTags creates a tool to list the tags of a repository.
+func ListTags(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_tags",
+			mcp.WithDescription(t("TOOL_LIST_TAGS_DESCRIPTION", "List the tags of a GitHub repository with the commit each points to")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			tags, resp, err := client.Repositories.ListTags(ctx, owner, repo, &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list tags: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list tags: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(tags)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetTag creates a tool to get a tag by name. Annotated tags are returned
+// with their message and tagger; lightweight tags only have the object they
+// point to.
+func GetTag(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_tag",
+			mcp.WithDescription(t("TOOL_GET_TAG_DESCRIPTION", "Get a tag by name with the object it points to, and the message and tagger of annotated tags")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("tag",
+				mcp.Required(),
+				mcp.Description("Tag name, such as v1.2.0"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			tagName, err := requiredParam[string](request, "tag")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			ref, resp, err := client.Git.GetRef(ctx, owner, repo, "tags/"+tagName)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get tag reference: %w", err)
+			}
+			_ = resp.Body.Close()
+
+			// A lightweight tag points straight at a commit, so there is no tag
+			// object to fetch.
+			tag := &github.Tag{
+				Tag:    github.Ptr(tagName),
+				SHA:    ref.GetObject().SHA,
+				Object: ref.GetObject(),
+			}
+			if ref.GetObject().GetType() == "tag" {
+				tag, resp, err = client.Git.GetTag(ctx, owner, repo, ref.GetObject().GetSHA())
+				if err != nil {
+					return nil, fmt.Errorf("failed to get tag: %w", err)
+				}
+				defer func() { _ = resp.Body.Close() }()
+
+				if resp.StatusCode != http.StatusOK {
+					body, err := io.ReadAll(resp.Body)
+					if err != nil {
+						return nil, fmt.Errorf("failed to read response body: %w", err)
+					}
+					return mcp.NewToolResultError(fmt.Sprintf("failed to get tag: %s", string(body))), nil
+				}
+			}
+
+			r, err := json.Marshal(tag)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// withReleaseFields adds the optional parameters shared by create_release and
+// update_release.
+func withReleaseFields() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("targetCommitish",
+			mcp.Description("Branch or commit SHA the tag is created from when it does not exist, defaults to the default branch"),
+		)(tool)
+		mcp.WithString("name",
+			mcp.Description("Release title"),
+		)(tool)
+		mcp.WithString("body",
+			mcp.Description("Release notes in Markdown"),
+		)(tool)
+		mcp.WithBoolean("draft",
+			mcp.Description("Whether the release is an unpublished draft"),
+		)(tool)
+		mcp.WithBoolean("prerelease",
+			mcp.Description("Whether the release is a prerelease"),
+		)(tool)
+		mcp.WithString("makeLatest",
+			mcp.Description("Whether to mark the release as latest; legacy picks the latest by creation date and semantic version"),
+			mcp.Enum("true", "false", "legacy"),
+		)(tool)
+	}
+}
+
+// releaseFromRequest builds a release from the parameters added by
+// withReleaseFields, reporting whether any were set.
+func releaseFromRequest(request mcp.CallToolRequest) (*github.RepositoryRelease, bool, error) {
+	release := &github.RepositoryRelease{}
+	set := false
+
+	for param, field := range map[string]**string{
+		"targetCommitish": &release.TargetCommitish,
+		"name":            &release.Name,
+		"body":            &release.Body,
+		"makeLatest":      &release.MakeLatest,
+	} {
+		if value, ok, err := OptionalParamOK[string](request, param); err != nil {
+			return nil, false, err
+		} else if ok {
+			*field = github.Ptr(value)
+			set = true
+		}
+	}
+
+	for param, field := range map[string]**bool{
+		"draft":      &release.Draft,
+		"prerelease": &release.Prerelease,
+	} {
+		if value, ok, err := OptionalParamOK[bool](request, param); err != nil {
+			return nil, false, err
+		} else if ok {
+			*field = github.Ptr(value)
+			set = true
+		}
+	}
+
+	return release, set, nil
+}
@@ -0,0 +1,410 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var mockRelease = &github.RepositoryRelease{
+	ID:      github.Ptr(int64(42)),
+	TagName: github.Ptr("v1.2.0"),
+	Name:    github.Ptr("v1.2.0"),
+	Body:    github.Ptr("## What's Changed\n* Fix the parser"),
+	HTMLURL: github.Ptr("https://github.com/owner/repo/releases/tag/v1.2.0"),
+}
+
+func Test_ListReleases(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListReleases(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_releases", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposReleasesByOwnerByRepo,
+			expectQueryParams(t, map[string]string{
+				"page":     "2",
+				"per_page": "10",
+			}).andThen(
+				mockResponse(t, http.StatusOK, []*github.RepositoryRelease{mockRelease}),
+			),
+		),
+	))
+	_, handler := ListReleases(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":   "owner",
+		"repo":    "repo",
+		"page":    float64(2),
+		"perPage": float64(10),
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned []*github.RepositoryRelease
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	require.Len(t, returned, 1)
+	assert.Equal(t, "v1.2.0", returned[0].GetTagName())
+}
+
+func Test_GetLatestRelease(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := GetLatestRelease(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_latest_release", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name         string
+		mockedClient *http.Client
+		expectError  bool
+	}{
+		{
+			name: "latest release",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposReleasesLatestByOwnerByRepo, mockRelease),
+			),
+		},
+		{
+			name: "no releases",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposReleasesLatestByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetLatestRelease(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			}))
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "failed to get latest release")
+				return
+			}
+			require.NoError(t, err)
+
+			var returned github.RepositoryRelease
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, mockRelease.GetID(), returned.GetID())
+		})
+	}
+}
+
+func Test_CreateRelease(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateRelease(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_release", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "generateReleaseNotes")
+	assert.Contains(t, tool.InputSchema.Properties, "makeLatest")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "tagName"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostReposReleasesByOwnerByRepo,
+			expectRequestBody(t, map[string]interface{}{
+				"tag_name":               "v1.2.0",
+				"target_commitish":       "main",
+				"draft":                  true,
+				"make_latest":            "true",
+				"generate_release_notes": true,
+			}).andThen(
+				mockResponse(t, http.StatusCreated, mockRelease),
+			),
+		),
+	))
+	_, handler := CreateRelease(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":                "owner",
+		"repo":                 "repo",
+		"tagName":              "v1.2.0",
+		"targetCommitish":      "main",
+		"draft":                true,
+		"makeLatest":           "true",
+		"generateReleaseNotes": true,
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned github.RepositoryRelease
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, mockRelease.GetBody(), returned.GetBody())
+}
+
+func Test_UpdateRelease(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateRelease(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "update_release", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "releaseId"})
+
+	published := *mockRelease
+	published.Draft = github.Ptr(false)
+
+	tests := []struct {
+		name          string
+		mockedClient  *http.Client
+		requestArgs   map[string]interface{}
+		expectToolErr bool
+		expectedText  string
+	}{
+		{
+			name: "publish draft",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposReleasesByOwnerByRepoByReleaseId,
+					expectRequestBody(t, map[string]interface{}{
+						"draft": false,
+					}).andThen(
+						mockResponse(t, http.StatusOK, &published),
+					),
+				),
+			),
+			requestArgs:  map[string]interface{}{"draft": false},
+			expectedText: `"draft":false`,
+		},
+		{
+			name:          "nothing to update",
+			mockedClient:  mock.NewMockedHTTPClient(),
+			requestArgs:   map[string]interface{}{},
+			expectToolErr: true,
+			expectedText:  "No update parameters provided.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateRelease(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			tc.requestArgs["owner"] = "owner"
+			tc.requestArgs["repo"] = "repo"
+			tc.requestArgs["releaseId"] = float64(42)
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectToolErr, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
+
+func Test_UploadReleaseAsset(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := UploadReleaseAsset(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "upload_release_asset", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "encoding")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "releaseId", "name", "content"})
+
+	tests := []struct {
+		name          string
+		requestArgs   map[string]interface{}
+		expectedBody  string
+		expectedType  string
+		expectToolErr bool
+		expectedText  string
+	}{
+		{
+			name: "text asset",
+			requestArgs: map[string]interface{}{
+				"name":    "checksums.txt",
+				"content": "abc123  app.tar.gz\n",
+				"label":   "Checksums",
+			},
+			expectedBody: "abc123  app.tar.gz\n",
+			expectedType: "text/plain; charset=utf-8",
+			expectedText: `"name":"checksums.txt"`,
+		},
+		{
+			name: "binary asset",
+			requestArgs: map[string]interface{}{
+				"name":     "app.bin",
+				"content":  "AAEC",
+				"encoding": "base64",
+			},
+			expectedBody: "\x00\x01\x02",
+			expectedType: "application/octet-stream",
+			expectedText: `"name":"app.bin"`,
+		},
+		{
+			name: "invalid base64",
+			requestArgs: map[string]interface{}{
+				"name":     "app.bin",
+				"content":  "not base64!",
+				"encoding": "base64",
+			},
+			expectToolErr: true,
+			expectedText:  "content is not valid base64",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposReleasesAssetsByOwnerByRepoByReleaseId,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						body, err := io.ReadAll(r.Body)
+						require.NoError(t, err)
+						assert.Equal(t, tc.expectedBody, string(body))
+						assert.Equal(t, tc.expectedType, r.Header.Get("Content-Type"))
+						assert.Equal(t, tc.requestArgs["name"], r.URL.Query().Get("name"))
+						if label, ok := tc.requestArgs["label"]; ok {
+							assert.Equal(t, label, r.URL.Query().Get("label"))
+						}
+						mockResponse(t, http.StatusCreated, &github.ReleaseAsset{
+							ID:   github.Ptr(int64(7)),
+							Name: github.Ptr(r.URL.Query().Get("name")),
+						})(w, r)
+					}),
+				),
+			))
+			_, handler := UploadReleaseAsset(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			tc.requestArgs["owner"] = "owner"
+			tc.requestArgs["repo"] = "repo"
+			tc.requestArgs["releaseId"] = float64(42)
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectToolErr, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
+
+func Test_ListTags(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListTags(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_tags", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposTagsByOwnerByRepo,
+			[]*github.RepositoryTag{
+				{Name: github.Ptr("v1.2.0"), Commit: &github.Commit{SHA: github.Ptr("c0ffee")}},
+			},
+		),
+	))
+	_, handler := ListTags(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned []*github.RepositoryTag
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	require.Len(t, returned, 1)
+	assert.Equal(t, "c0ffee", returned[0].GetCommit().GetSHA())
+}
+
+func Test_GetTag(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := GetTag(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_tag", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "tag"})
+
+	tests := []struct {
+		name         string
+		mockedClient *http.Client
+		expectedTag  github.Tag
+	}{
+		{
+			name: "annotated tag",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					&github.Reference{
+						Ref:    github.Ptr("refs/tags/v1.2.0"),
+						Object: &github.GitObject{Type: github.Ptr("tag"), SHA: github.Ptr("7a9"), URL: github.Ptr("https://api.github.com/repos/owner/repo/git/tags/7a9")},
+					},
+				),
+				mock.WithRequestMatch(
+					mock.GetReposGitTagsByOwnerByRepoByTagSha,
+					&github.Tag{
+						Tag:     github.Ptr("v1.2.0"),
+						SHA:     github.Ptr("7a9"),
+						Message: github.Ptr("Release v1.2.0"),
+						Object:  &github.GitObject{Type: github.Ptr("commit"), SHA: github.Ptr("c0ffee")},
+					},
+				),
+			),
+			expectedTag: github.Tag{
+				Tag:     github.Ptr("v1.2.0"),
+				SHA:     github.Ptr("7a9"),
+				Message: github.Ptr("Release v1.2.0"),
+				Object:  &github.GitObject{Type: github.Ptr("commit"), SHA: github.Ptr("c0ffee")},
+			},
+		},
+		{
+			name: "lightweight tag",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					&github.Reference{
+						Ref:    github.Ptr("refs/tags/v1.2.0"),
+						Object: &github.GitObject{Type: github.Ptr("commit"), SHA: github.Ptr("c0ffee")},
+					},
+				),
+			),
+			expectedTag: github.Tag{
+				Tag:    github.Ptr("v1.2.0"),
+				SHA:    github.Ptr("c0ffee"),
+				Object: &github.GitObject{Type: github.Ptr("commit"), SHA: github.Ptr("c0ffee")},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetTag(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"tag":   "v1.2.0",
+			}))
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			var returned github.Tag
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expectedTag, returned)
+		})
+	}
+}
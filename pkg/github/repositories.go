@@ -1,11 +1,16 @@
 package github
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v69/github"
@@ -13,9 +18,10 @@ import (
 	"github.com/mark3labs/mcp-go/server"
 )
 
-func GetCommit(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("get_commit",
-			mcp.WithDescription(t("TOOL_GET_COMMITS_DESCRIPTION", "Get details for a commit from a GitHub repository")),
+// ListBranches creates a tool to list branches in a GitHub repository.
+func ListBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_branches",
+			mcp.WithDescription(t("TOOL_LIST_BRANCHES_DESCRIPTION", "List branches in a GitHub repository")),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -24,10 +30,6 @@ func GetCommit(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 				mcp.Required(),
 				mcp.Description("Repository name"),
 			),
-			mcp.WithString("sha",
-				mcp.Required(),
-				mcp.Description("Commit SHA, branch name, or tag name"),
-			),
 			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -39,39 +41,38 @@ func GetCommit(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			sha, err := requiredParam[string](request, "sha")
-			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			}
 			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			opts := &github.ListOptions{
-				Page:    pagination.page,
-				PerPage: pagination.perPage,
+			opts := &github.BranchListOptions{
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
 			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			commit, resp, err := client.Repositories.GetCommit(ctx, owner, repo, sha, opts)
+
+			branches, resp, err := client.Repositories.ListBranches(ctx, owner, repo, opts)
 			if err != nil {
-				return nil, fmt.Errorf("failed to get commit: %w", err)
+				return nil, fmt.Errorf("failed to list branches: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != 200 {
+			if resp.StatusCode != http.StatusOK {
 				body, err := io.ReadAll(resp.Body)
 				if err != nil {
 					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to get commit: %s", string(body))), nil
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list branches: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(commit)
+			r, err := json.Marshal(branches)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -80,22 +81,37 @@ func GetCommit(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 		}
 }
 
-// ListCommits creates a tool to get commits of a branch in a repository.
-func ListCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("list_commits",
-			mcp.WithDescription(t("TOOL_LIST_COMMITS_DESCRIPTION", "Get list of commits of a branch in a GitHub repository")),
+// CreateOrUpdateFile creates a tool to create or update a file in a GitHub repository.
+func CreateOrUpdateFile(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_or_update_file",
+			mcp.WithDescription(t("TOOL_CREATE_OR_UPDATE_FILE_DESCRIPTION", "Create or update a single file in a GitHub repository")),
 			mcp.WithString("owner",
 				mcp.Required(),
-				mcp.Description("Repository owner"),
+				mcp.Description("Repository owner (username or organization)"),
 			),
 			mcp.WithString("repo",
 				mcp.Required(),
 				mcp.Description("Repository name"),
 			),
+			mcp.WithString("path",
+				mcp.Required(),
+				mcp.Description("Path where to create/update the file"),
+			),
+			mcp.WithString("content",
+				mcp.Required(),
+				mcp.Description("Content of the file"),
+			),
+			mcp.WithString("message",
+				mcp.Required(),
+				mcp.Description("Commit message"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Branch to create/update the file in"),
+			),
 			mcp.WithString("sha",
-				mcp.Description("SHA or Branch name"),
+				mcp.Description("SHA of file being replaced (for updates)"),
 			),
-			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -106,42 +122,69 @@ func ListCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			sha, err := OptionalParam[string](request, "sha")
+			path, err := requiredParam[string](request, "path")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			pagination, err := OptionalPaginationParams(request)
+			content, err := requiredParam[string](request, "content")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			message, err := requiredParam[string](request, "message")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := requiredParam[string](request, "branch")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			opts := &github.CommitsListOptions{
-				SHA: sha,
-				ListOptions: github.ListOptions{
-					Page:    pagination.page,
-					PerPage: pagination.perPage,
-				},
+			// Convert content to base64
+			contentBytes := []byte(content)
+
+			// Create the file options
+			opts := &github.RepositoryContentFileOptions{
+				Message: github.Ptr(message),
+				Content: contentBytes,
+				Branch:  github.Ptr(branch),
 			}
 
+			// If SHA is provided, set it (for updates)
+			sha, err := OptionalParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if sha != "" {
+				opts.SHA = github.Ptr(sha)
+			}
+
+			// Create or update the file
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			commits, resp, err := client.Repositories.ListCommits(ctx, owner, repo, opts)
+			fileContent, resp, err := client.Repositories.CreateFile(ctx, owner, repo, path, opts)
 			if err != nil {
-				return nil, fmt.Errorf("failed to list commits: %w", err)
+				// Updating an existing file without its SHA is rejected with a
+				// 422; tell the caller which SHA to send rather than overwrite.
+				if sha == "" && resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+					if current, lookupErr := fileSHA(ctx, client, owner, repo, path, branch); lookupErr == nil && current != "" {
+						return mcp.NewToolResultError(fmt.Sprintf("%s already exists on %s; pass sha %q to update it", path, branch, current)), nil
+					}
+				}
+				return nil, fmt.Errorf("failed to create/update file: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != 200 {
+			if resp.StatusCode != 200 && resp.StatusCode != 201 {
 				body, err := io.ReadAll(resp.Body)
 				if err != nil {
 					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to list commits: %s", string(body))), nil
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create/update file: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(commits)
+			r, err := json.Marshal(fileContent)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -150,19 +193,33 @@ func ListCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 		}
 }
 
-// ListBranches creates a tool to list branches in a GitHub repository.
-func ListBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("list_branches",
-			mcp.WithDescription(t("TOOL_LIST_BRANCHES_DESCRIPTION", "List branches in a GitHub repository")),
+// DeleteFile creates a tool to delete a file from a GitHub repository.
+func DeleteFile(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_file",
+			mcp.WithDescription(t("TOOL_DELETE_FILE_DESCRIPTION", "Delete a file from a GitHub repository")),
 			mcp.WithString("owner",
 				mcp.Required(),
-				mcp.Description("Repository owner"),
+				mcp.Description("Repository owner (username or organization)"),
 			),
 			mcp.WithString("repo",
 				mcp.Required(),
 				mcp.Description("Repository name"),
 			),
-			WithPagination(),
+			mcp.WithString("path",
+				mcp.Required(),
+				mcp.Description("Path of the file to delete"),
+			),
+			mcp.WithString("message",
+				mcp.Required(),
+				mcp.Description("Commit message"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Branch to delete the file from"),
+			),
+			mcp.WithString("sha",
+				mcp.Description("SHA of the file being deleted, looked up on the branch if omitted"),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -173,26 +230,43 @@ func ListBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			pagination, err := OptionalPaginationParams(request)
+			path, err := requiredParam[string](request, "path")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-
-			opts := &github.BranchListOptions{
-				ListOptions: github.ListOptions{
-					Page:    pagination.page,
-					PerPage: pagination.perPage,
-				},
+			message, err := requiredParam[string](request, "message")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := requiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sha, err := OptionalParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
 			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
+			if sha == "" {
+				if sha, err = fileSHA(ctx, client, owner, repo, path, branch); err != nil {
+					return nil, fmt.Errorf("failed to look up file: %w", err)
+				}
+				if sha == "" {
+					return mcp.NewToolResultError(fmt.Sprintf("%s does not exist on %s", path, branch)), nil
+				}
+			}
 
-			branches, resp, err := client.Repositories.ListBranches(ctx, owner, repo, opts)
+			result, resp, err := client.Repositories.DeleteFile(ctx, owner, repo, path, &github.RepositoryContentFileOptions{
+				Message: github.Ptr(message),
+				Branch:  github.Ptr(branch),
+				SHA:     github.Ptr(sha),
+			})
 			if err != nil {
-				return nil, fmt.Errorf("failed to list branches: %w", err)
+				return nil, fmt.Errorf("failed to delete file: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
@@ -201,10 +275,10 @@ func ListBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (
 				if err != nil {
 					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to list branches: %s", string(body))), nil
+				return mcp.NewToolResultError(fmt.Sprintf("failed to delete file: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(branches)
+			r, err := json.Marshal(result)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -213,103 +287,146 @@ func ListBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (
 		}
 }
 
-// CreateOrUpdateFile creates a tool to create or update a file in a GitHub repository.
-func CreateOrUpdateFile(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("create_or_update_file",
-			mcp.WithDescription(t("TOOL_CREATE_OR_UPDATE_FILE_DESCRIPTION", "Create or update a single file in a GitHub repository")),
-			mcp.WithString("owner",
-				mcp.Required(),
-				mcp.Description("Repository owner (username or organization)"),
-			),
-			mcp.WithString("repo",
+// fileSHA returns the blob SHA of the file at path on branch, or "" if there
+// is no such file.
+func fileSHA(ctx context.Context, client *github.Client, owner, repo, path, branch string) (string, error) {
+	file, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, path, &github.RepositoryContentGetOptions{Ref: branch})
+	if resp != nil {
+		defer func() { _ = resp.Body.Close() }()
+	}
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return "", nil
+		}
+		return "", err
+	}
+	if file == nil {
+		return "", fmt.Errorf("%s is a directory", path)
+	}
+	return file.GetSHA(), nil
+}
+
+// CreateRepository creates a tool to create a new GitHub repository.
+func CreateRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_repository",
+			mcp.WithDescription(t("TOOL_CREATE_REPOSITORY_DESCRIPTION", "Create a new GitHub repository in your account or an organization, optionally from a template repository")),
+			mcp.WithString("name",
 				mcp.Required(),
 				mcp.Description("Repository name"),
 			),
-			mcp.WithString("path",
-				mcp.Required(),
-				mcp.Description("Path where to create/update the file"),
+			mcp.WithString("organization",
+				mcp.Description("Organization to create the repository in, defaults to your account"),
 			),
-			mcp.WithString("content",
-				mcp.Required(),
-				mcp.Description("Content of the file"),
+			mcp.WithString("description",
+				mcp.Description("Repository description"),
 			),
-			mcp.WithString("message",
-				mcp.Required(),
-				mcp.Description("Commit message"),
+			mcp.WithBoolean("private",
+				mcp.Description("Whether repo should be private"),
 			),
-			mcp.WithString("branch",
-				mcp.Required(),
-				mcp.Description("Branch to create/update the file in"),
+			mcp.WithString("visibility",
+				mcp.Description("Repository visibility, overrides private; internal is only available to organizations on GitHub Enterprise"),
+				mcp.Enum("public", "private", "internal"),
 			),
-			mcp.WithString("sha",
-				mcp.Description("SHA of file being replaced (for updates)"),
+			mcp.WithBoolean("autoInit",
+				mcp.Description("Initialize with README"),
+			),
+			mcp.WithString("template",
+				mcp.Description("Template repository to generate from, as owner/repo"),
+			),
+			mcp.WithBoolean("includeAllBranches",
+				mcp.Description("Copy all branches of the template, not just the default branch"),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			owner, err := requiredParam[string](request, "owner")
+			name, err := requiredParam[string](request, "name")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			repo, err := requiredParam[string](request, "repo")
+			org, err := OptionalParam[string](request, "organization")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			path, err := requiredParam[string](request, "path")
+			description, err := OptionalParam[string](request, "description")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			content, err := requiredParam[string](request, "content")
+			private, err := OptionalParam[bool](request, "private")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			message, err := requiredParam[string](request, "message")
+			visibility, err := OptionalParam[string](request, "visibility")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			branch, err := requiredParam[string](request, "branch")
+			autoInit, err := OptionalParam[bool](request, "autoInit")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-
-			// Convert content to base64
-			contentBytes := []byte(content)
-
-			// Create the file options
-			opts := &github.RepositoryContentFileOptions{
-				Message: github.Ptr(message),
-				Content: contentBytes,
-				Branch:  github.Ptr(branch),
-			}
-
-			// If SHA is provided, set it (for updates)
-			sha, err := OptionalParam[string](request, "sha")
+			template, err := OptionalParam[string](request, "template")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			if sha != "" {
-				opts.SHA = github.Ptr(sha)
+			includeAllBranches, err := OptionalParam[bool](request, "includeAllBranches")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			// Create or update the file
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			fileContent, resp, err := client.Repositories.CreateFile(ctx, owner, repo, path, opts)
+
+			var createdRepo *github.Repository
+			var resp *github.Response
+			if template != "" {
+				templateOwner, templateRepo, ok := strings.Cut(template, "/")
+				if !ok || templateOwner == "" || templateRepo == "" {
+					return mcp.NewToolResultError("template must be in owner/repo form"), nil
+				}
+				// Template generation only distinguishes public and private.
+				if visibility == "internal" {
+					return mcp.NewToolResultError("internal visibility is not supported when creating from a template"), nil
+				}
+				if visibility != "" {
+					private = visibility == "private"
+				}
+				req := &github.TemplateRepoRequest{
+					Name:               github.Ptr(name),
+					Description:        github.Ptr(description),
+					IncludeAllBranches: github.Ptr(includeAllBranches),
+					Private:            github.Ptr(private),
+				}
+				if org != "" {
+					req.Owner = github.Ptr(org)
+				}
+				createdRepo, resp, err = client.Repositories.CreateFromTemplate(ctx, templateOwner, templateRepo, req)
+			} else {
+				repo := &github.Repository{
+					Name:        github.Ptr(name),
+					Description: github.Ptr(description),
+					AutoInit:    github.Ptr(autoInit),
+				}
+				if visibility != "" {
+					repo.Visibility = github.Ptr(visibility)
+				} else {
+					repo.Private = github.Ptr(private)
+				}
+				createdRepo, resp, err = client.Repositories.Create(ctx, org, repo)
+			}
 			if err != nil {
-				return nil, fmt.Errorf("failed to create/update file: %w", err)
+				return nil, fmt.Errorf("failed to create repository: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != 200 && resp.StatusCode != 201 {
+			if resp.StatusCode != http.StatusCreated {
 				body, err := io.ReadAll(resp.Body)
 				if err != nil {
 					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to create/update file: %s", string(body))), nil
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create repository: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(fileContent)
+			r, err := json.Marshal(createdRepo)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -318,68 +435,165 @@ func CreateOrUpdateFile(getClient GetClientFn, t translations.TranslationHelperF
 		}
 }
 
-// CreateRepository creates a tool to create a new GitHub repository.
-func CreateRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("create_repository",
-			mcp.WithDescription(t("TOOL_CREATE_REPOSITORY_DESCRIPTION", "Create a new GitHub repository in your account")),
-			mcp.WithString("name",
+// UpdateRepositorySettings creates a tool to change a repository's
+// description, topics, default branch and merge options.
+func UpdateRepositorySettings(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_repository_settings",
+			mcp.WithDescription(t("TOOL_UPDATE_REPOSITORY_SETTINGS_DESCRIPTION", "Update a GitHub repository's settings. Only the settings given are changed")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
 				mcp.Required(),
 				mcp.Description("Repository name"),
 			),
 			mcp.WithString("description",
 				mcp.Description("Repository description"),
 			),
-			mcp.WithBoolean("private",
-				mcp.Description("Whether repo should be private"),
+			mcp.WithString("homepage",
+				mcp.Description("Repository homepage URL"),
 			),
-			mcp.WithBoolean("autoInit",
-				mcp.Description("Initialize with README"),
+			mcp.WithArray("topics",
+				mcp.Description("Topics to replace the current topics with; an empty list removes them all"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithString("defaultBranch",
+				mcp.Description("Name of an existing branch to make the default"),
+			),
+			mcp.WithBoolean("allowMergeCommit",
+				mcp.Description("Allow merging pull requests with a merge commit"),
+			),
+			mcp.WithBoolean("allowSquashMerge",
+				mcp.Description("Allow squash-merging pull requests"),
+			),
+			mcp.WithBoolean("allowRebaseMerge",
+				mcp.Description("Allow rebase-merging pull requests"),
+			),
+			mcp.WithBoolean("allowAutoMerge",
+				mcp.Description("Allow auto-merge to be enabled on pull requests"),
+			),
+			mcp.WithBoolean("deleteBranchOnMerge",
+				mcp.Description("Delete head branches automatically after pull requests are merged"),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			name, err := requiredParam[string](request, "name")
+			owner, err := requiredParam[string](request, "owner")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			description, err := OptionalParam[string](request, "description")
+			repo, err := requiredParam[string](request, "repo")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			private, err := OptionalParam[bool](request, "private")
-			if err != nil {
+
+			edit := &github.Repository{}
+			updateNeeded := false
+
+			if description, ok, err := OptionalParamOK[string](request, "description"); err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				edit.Description = github.Ptr(description)
+				updateNeeded = true
 			}
-			autoInit, err := OptionalParam[bool](request, "autoInit")
-			if err != nil {
+
+			if homepage, ok, err := OptionalParamOK[string](request, "homepage"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				edit.Homepage = github.Ptr(homepage)
+				updateNeeded = true
+			}
+
+			if defaultBranch, ok, err := OptionalParamOK[string](request, "defaultBranch"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				edit.DefaultBranch = github.Ptr(defaultBranch)
+				updateNeeded = true
+			}
+
+			if allowMergeCommit, ok, err := OptionalParamOK[bool](request, "allowMergeCommit"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				edit.AllowMergeCommit = github.Ptr(allowMergeCommit)
+				updateNeeded = true
+			}
+
+			if allowSquashMerge, ok, err := OptionalParamOK[bool](request, "allowSquashMerge"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				edit.AllowSquashMerge = github.Ptr(allowSquashMerge)
+				updateNeeded = true
+			}
+
+			if allowRebaseMerge, ok, err := OptionalParamOK[bool](request, "allowRebaseMerge"); err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				edit.AllowRebaseMerge = github.Ptr(allowRebaseMerge)
+				updateNeeded = true
 			}
 
-			repo := &github.Repository{
-				Name:        github.Ptr(name),
-				Description: github.Ptr(description),
-				Private:     github.Ptr(private),
-				AutoInit:    github.Ptr(autoInit),
+			if allowAutoMerge, ok, err := OptionalParamOK[bool](request, "allowAutoMerge"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				edit.AllowAutoMerge = github.Ptr(allowAutoMerge)
+				updateNeeded = true
+			}
+
+			if deleteBranchOnMerge, ok, err := OptionalParamOK[bool](request, "deleteBranchOnMerge"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				edit.DeleteBranchOnMerge = github.Ptr(deleteBranchOnMerge)
+				updateNeeded = true
+			}
+
+			_, setTopics := request.GetArguments()["topics"]
+			topics, err := OptionalStringArrayParam(request, "topics")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !updateNeeded && !setTopics {
+				return mcp.NewToolResultError("no settings to update"), nil
 			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			createdRepo, resp, err := client.Repositories.Create(ctx, "", repo)
+
+			var updated *github.Repository
+			var resp *github.Response
+			if updateNeeded {
+				updated, resp, err = client.Repositories.Edit(ctx, owner, repo, edit)
+			} else {
+				updated, resp, err = client.Repositories.Get(ctx, owner, repo)
+			}
 			if err != nil {
-				return nil, fmt.Errorf("failed to create repository: %w", err)
+				return nil, fmt.Errorf("failed to update repository settings: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != http.StatusCreated {
+			if resp.StatusCode != http.StatusOK {
 				body, err := io.ReadAll(resp.Body)
 				if err != nil {
 					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to create repository: %s", string(body))), nil
+				return mcp.NewToolResultError(fmt.Sprintf("failed to update repository settings: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(createdRepo)
+			if setTopics {
+				replaced, resp, err := client.Repositories.ReplaceAllTopics(ctx, owner, repo, topics)
+				if err != nil {
+					return nil, fmt.Errorf("failed to update repository topics: %w", err)
+				}
+				_ = resp.Body.Close()
+				updated.Topics = replaced
+			}
+
+			r, err := json.Marshal(updated)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -388,10 +602,76 @@ func CreateRepository(getClient GetClientFn, t translations.TranslationHelperFun
 		}
 }
 
+// defaultMaxFileBytes bounds the file content returned by get_file_contents
+// when maxBytes is not given.
+const defaultMaxFileBytes = 100000
+
+// FileContentsOutput is the result of get_file_contents for a file. Content
+// is the decoded text, or base64 when Encoding is "base64" because the file is
+// binary. Size is the full file size; Truncated is set when Content holds
+// less than that, including files too large for the contents API.
+type FileContentsOutput struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Path        string `json:"path"`
+	SHA         string `json:"sha"`
+	Size        int    `json:"size"`
+	Encoding    string `json:"encoding"`
+	Content     string `json:"content"`
+	Truncated   bool   `json:"truncated,omitempty"`
+	HTMLURL     string `json:"html_url,omitempty"`
+	DownloadURL string `json:"download_url,omitempty"`
+}
+
+// fileContentsOutput decodes a file returned by the contents API, keeping at
+// most maxBytes of it.
+func fileContentsOutput(file *github.RepositoryContent, maxBytes int) (*FileContentsOutput, error) {
+	out := &FileContentsOutput{
+		Type:        file.GetType(),
+		Name:        file.GetName(),
+		Path:        file.GetPath(),
+		SHA:         file.GetSHA(),
+		Size:        file.GetSize(),
+		Encoding:    "utf-8",
+		HTMLURL:     file.GetHTMLURL(),
+		DownloadURL: file.GetDownloadURL(),
+	}
+	// Files over 1MB come back with encoding "none" and no content.
+	if file.GetEncoding() == "none" {
+		out.Truncated = out.Size > 0
+		return out, nil
+	}
+	content, err := file.GetContent()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode file contents: %w", err)
+	}
+
+	out.Content, out.Encoding, out.Truncated = encodeContent([]byte(content), maxBytes)
+	return out, nil
+}
+
+// encodeContent keeps at most maxBytes of data, returning it as text when it
+// is valid UTF-8 without NUL bytes and as base64 otherwise.
+func encodeContent(data []byte, maxBytes int) (content, encoding string, truncated bool) {
+	binary := !utf8.Valid(data)
+	if len(data) > maxBytes {
+		data = data[:maxBytes]
+		truncated = true
+	}
+	if binary || bytes.IndexByte(data, 0) >= 0 {
+		return base64.StdEncoding.EncodeToString(data), "base64", truncated
+	}
+	// Don't split a multi-byte character at the cut.
+	for len(data) > 0 && !utf8.Valid(data) {
+		data = data[:len(data)-1]
+	}
+	return string(data), "utf-8", truncated
+}
+
 // GetFileContents creates a tool to get the contents of a file or directory from a GitHub repository.
 func GetFileContents(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_file_contents",
-			mcp.WithDescription(t("TOOL_GET_FILE_CONTENTS_DESCRIPTION", "Get the contents of a file or directory from a GitHub repository")),
+			mcp.WithDescription(t("TOOL_GET_FILE_CONTENTS_DESCRIPTION", "Get the contents of a file or directory from a GitHub repository. Text files are returned decoded, binary files as base64")),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner (username or organization)"),
@@ -404,8 +684,15 @@ func GetFileContents(getClient GetClientFn, t translations.TranslationHelperFunc
 				mcp.Required(),
 				mcp.Description("Path to file/directory"),
 			),
+			mcp.WithString("ref",
+				mcp.Description("Branch, tag or commit SHA to read from, defaults to the default branch"),
+			),
 			mcp.WithString("branch",
-				mcp.Description("Branch to get contents from"),
+				mcp.Description("Branch to get contents from (deprecated, use ref)"),
+			),
+			mcp.WithNumber("maxBytes",
+				mcp.Description(fmt.Sprintf("Truncate file content to this many bytes, defaults to %d", defaultMaxFileBytes)),
+				mcp.Min(1),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -421,7 +708,11 @@ func GetFileContents(getClient GetClientFn, t translations.TranslationHelperFunc
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			branch, err := OptionalParam[string](request, "branch")
+			ref, err := contentsRefParam(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxBytes, err := OptionalIntParamInRange(request, "maxBytes", defaultMaxFileBytes, 1, math.MaxInt32)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -430,7 +721,7 @@ func GetFileContents(getClient GetClientFn, t translations.TranslationHelperFunc
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			opts := &github.RepositoryContentGetOptions{Ref: branch}
+			opts := &github.RepositoryContentGetOptions{Ref: ref}
 			fileContent, dirContent, resp, err := client.Repositories.GetContents(ctx, owner, repo, path, opts)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get file contents: %w", err)
@@ -447,7 +738,9 @@ func GetFileContents(getClient GetClientFn, t translations.TranslationHelperFunc
 
 			var result interface{}
 			if fileContent != nil {
-				result = fileContent
+				if result, err = fileContentsOutput(fileContent, maxBytes); err != nil {
+					return nil, err
+				}
 			} else {
 				result = dirContent
 			}
@@ -461,6 +754,109 @@ func GetFileContents(getClient GetClientFn, t translations.TranslationHelperFunc
 		}
 }
 
+// contentsRefParam reads the ref parameter, falling back to the older
+// branch parameter.
+func contentsRefParam(request mcp.CallToolRequest) (string, error) {
+	ref, err := OptionalParam[string](request, "ref")
+	if err != nil || ref != "" {
+		return ref, err
+	}
+	return OptionalParam[string](request, "branch")
+}
+
+// DirectoryEntry is one item of a list_directory result.
+type DirectoryEntry struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+	Type string `json:"type"`
+	Size int    `json:"size,omitempty"`
+	SHA  string `json:"sha"`
+}
+
+// ListDirectoryOutput is the result of list_directory.
+type ListDirectoryOutput struct {
+	Path    string           `json:"path"`
+	Entries []DirectoryEntry `json:"entries"`
+}
+
+// ListDirectory creates a tool to list the entries of a directory in a GitHub repository.
+func ListDirectory(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_directory",
+			mcp.WithDescription(t("TOOL_LIST_DIRECTORY_DESCRIPTION", "List the files and subdirectories of a directory in a GitHub repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner (username or organization)"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("path",
+				mcp.Description("Directory path, defaults to the repository root"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Branch, tag or commit SHA to read from, defaults to the default branch"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := OptionalParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			fileContent, dirContent, resp, err := client.Repositories.GetContents(ctx, owner, repo, path, &github.RepositoryContentGetOptions{Ref: ref})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list directory: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list directory: %s", string(body))), nil
+			}
+			if fileContent != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("%s is a file, use get_file_contents to read it", path)), nil
+			}
+
+			out := ListDirectoryOutput{Path: path, Entries: make([]DirectoryEntry, 0, len(dirContent))}
+			for _, entry := range dirContent {
+				out.Entries = append(out.Entries, DirectoryEntry{
+					Name: entry.GetName(),
+					Path: entry.GetPath(),
+					Type: entry.GetType(),
+					Size: entry.GetSize(),
+					SHA:  entry.GetSHA(),
+				})
+			}
+
+			r, err := json.Marshal(out)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
 // ForkRepository creates a tool to fork a repository.
 func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("fork_repository",
@@ -476,6 +872,12 @@ func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc)
 			mcp.WithString("organization",
 				mcp.Description("Organization to fork to"),
 			),
+			mcp.WithString("name",
+				mcp.Description("Name for the fork, defaults to the upstream repository name"),
+			),
+			mcp.WithBoolean("defaultBranchOnly",
+				mcp.Description("Copy only the default branch"),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -491,9 +893,19 @@ func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc)
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			opts := &github.RepositoryCreateForkOptions{}
-			if org != "" {
-				opts.Organization = org
+			name, err := OptionalParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			defaultBranchOnly, err := OptionalParam[bool](request, "defaultBranchOnly")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.RepositoryCreateForkOptions{
+				Organization:      org,
+				Name:              name,
+				DefaultBranchOnly: defaultBranchOnly,
 			}
 
 			client, err := getClient(ctx)
@@ -673,7 +1085,7 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 			}
 
 			// Parse files parameter - this should be an array of objects with path and content
-			filesObj, ok := request.Params.Arguments["files"].([]interface{})
+			filesObj, ok := request.GetArguments()["files"].([]interface{})
 			if !ok {
 				return mcp.NewToolResultError("files parameter must be an array of objects with path and content"), nil
 			}
@@ -2,6 +2,7 @@ package github
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"net/http"
 	"testing"
@@ -26,6 +27,8 @@ func Test_GetFileContents(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "path")
 	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.Contains(t, tool.InputSchema.Properties, "maxBytes")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "path"})
 
 	// Setup mock file content for success case
@@ -34,6 +37,7 @@ func Test_GetFileContents(t *testing.T) {
 		Name:        github.Ptr("README.md"),
 		Path:        github.Ptr("README.md"),
 		Content:     github.Ptr("IyBUZXN0IFJlcG9zaXRvcnkKClRoaXMgaXMgYSB0ZXN0IHJlcG9zaXRvcnku"), // Base64 encoded "# Test Repository\n\nThis is a test repository."
+		Encoding:    github.Ptr("base64"),
 		SHA:         github.Ptr("abc123"),
 		Size:        github.Ptr(42),
 		HTMLURL:     github.Ptr("https://github.com/owner/repo/blob/main/README.md"),
@@ -66,6 +70,7 @@ func Test_GetFileContents(t *testing.T) {
 		expectError    bool
 		expectedResult interface{}
 		expectedErrMsg string
+		expectToolErr  string
 	}{
 		{
 			name: "successful file content fetch",
@@ -88,6 +93,28 @@ func Test_GetFileContents(t *testing.T) {
 			expectError:    false,
 			expectedResult: mockFileContent,
 		},
+		{
+			name: "ref takes precedence over branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					expectQueryParams(t, map[string]string{
+						"ref": "v1.0.0",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockFileContent),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"path":   "README.md",
+				"ref":    "v1.0.0",
+				"branch": "main",
+			},
+			expectError:    false,
+			expectedResult: mockFileContent,
+		},
 		{
 			name: "successful directory content fetch",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -126,6 +153,17 @@ func Test_GetFileContents(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "failed to get file contents",
 		},
+		{
+			name:         "negative maxBytes",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"path":     "README.md",
+				"maxBytes": float64(-1),
+			},
+			expectToolErr: "parameter maxBytes must be between 1 and",
+		},
 	}
 
 	for _, tc := range tests {
@@ -136,13 +174,7 @@ func Test_GetFileContents(t *testing.T) {
 
 			// Create call request
 			request := mcp.CallToolRequest{
-				Params: struct {
-					Name      string                 `json:"name"`
-					Arguments map[string]interface{} `json:"arguments,omitempty"`
-					Meta      *struct {
-						ProgressToken mcp.ProgressToken `json:"progressToken,omitempty"`
-					} `json:"_meta,omitempty"`
-				}{
+				Params: mcp.CallToolParams{
 					Arguments: tc.requestArgs,
 				},
 			}
@@ -161,6 +193,11 @@ func Test_GetFileContents(t *testing.T) {
 
 			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
+			if tc.expectToolErr != "" {
+				require.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectToolErr)
+				return
+			}
 
 			// Verify based on expected type
 			switch expected := tc.expectedResult.(type) {
@@ -171,6 +208,8 @@ func Test_GetFileContents(t *testing.T) {
 				assert.Equal(t, *expected.Name, *returnedContent.Name)
 				assert.Equal(t, *expected.Path, *returnedContent.Path)
 				assert.Equal(t, *expected.Type, *returnedContent.Type)
+				assert.Equal(t, "utf-8", returnedContent.GetEncoding())
+				assert.Equal(t, "# Test Repository\n\nThis is a test repository.", *returnedContent.Content)
 			case []*github.RepositoryContent:
 				var returnedContents []*github.RepositoryContent
 				err = json.Unmarshal([]byte(textContent.Text), &returnedContents)
@@ -186,6 +225,118 @@ func Test_GetFileContents(t *testing.T) {
 	}
 }
 
+func Test_FileContentsOutput(t *testing.T) {
+	encode := func(s string) *string { return github.Ptr(base64.StdEncoding.EncodeToString([]byte(s))) }
+
+	tests := []struct {
+		name          string
+		file          *github.RepositoryContent
+		maxBytes      int
+		wantEncoding  string
+		wantContent   string
+		wantTruncated bool
+	}{
+		{
+			name:         "text",
+			file:         &github.RepositoryContent{Encoding: github.Ptr("base64"), Content: encode("hello\n"), Size: github.Ptr(6)},
+			maxBytes:     100,
+			wantEncoding: "utf-8",
+			wantContent:  "hello\n",
+		},
+		{
+			name:          "text truncated on a character boundary",
+			file:          &github.RepositoryContent{Encoding: github.Ptr("base64"), Content: encode("héllo"), Size: github.Ptr(6)},
+			maxBytes:      2,
+			wantEncoding:  "utf-8",
+			wantContent:   "h",
+			wantTruncated: true,
+		},
+		{
+			name:         "binary",
+			file:         &github.RepositoryContent{Encoding: github.Ptr("base64"), Content: encode("\x89PNG\x00\x01"), Size: github.Ptr(6)},
+			maxBytes:     100,
+			wantEncoding: "base64",
+			wantContent:  base64.StdEncoding.EncodeToString([]byte("\x89PNG\x00\x01")),
+		},
+		{
+			name:          "too large for the contents API",
+			file:          &github.RepositoryContent{Encoding: github.Ptr("none"), Content: github.Ptr(""), Size: github.Ptr(5 << 20)},
+			maxBytes:      100,
+			wantEncoding:  "utf-8",
+			wantTruncated: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := fileContentsOutput(tc.file, tc.maxBytes)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantEncoding, out.Encoding)
+			assert.Equal(t, tc.wantContent, out.Content)
+			assert.Equal(t, tc.wantTruncated, out.Truncated)
+			assert.Equal(t, tc.file.GetSize(), out.Size)
+		})
+	}
+}
+
+func Test_ListDirectory(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListDirectory(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_directory", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockDirContent := []*github.RepositoryContent{
+		{Type: github.Ptr("file"), Name: github.Ptr("main.go"), Path: github.Ptr("cmd/main.go"), SHA: github.Ptr("abc123"), Size: github.Ptr(42)},
+		{Type: github.Ptr("dir"), Name: github.Ptr("internal"), Path: github.Ptr("cmd/internal"), SHA: github.Ptr("def456")},
+	}
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposContentsByOwnerByRepoByPath,
+			expectQueryParams(t, map[string]string{
+				"ref": "main",
+			}).andThen(
+				mockResponse(t, http.StatusOK, mockDirContent),
+			),
+		),
+	))
+	_, handler := ListDirectory(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+		"path":  "cmd",
+		"ref":   "main",
+	}))
+	require.NoError(t, err)
+
+	var out ListDirectoryOutput
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &out))
+	assert.Equal(t, "cmd", out.Path)
+	require.Len(t, out.Entries, 2)
+	assert.Equal(t, DirectoryEntry{Name: "main.go", Path: "cmd/main.go", Type: "file", Size: 42, SHA: "abc123"}, out.Entries[0])
+	assert.Equal(t, "dir", out.Entries[1].Type)
+
+	fileClient := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposContentsByOwnerByRepoByPath,
+			&github.RepositoryContent{Type: github.Ptr("file"), Name: github.Ptr("README.md"), Path: github.Ptr("README.md")},
+		),
+	))
+	_, handler = ListDirectory(stubGetClientFn(fileClient), translations.NullTranslationHelper)
+	result, err = handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+		"path":  "README.md",
+	}))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, getTextResult(t, result).Text, "use get_file_contents")
+}
+
 func Test_ForkRepository(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
@@ -196,6 +347,7 @@ func Test_ForkRepository(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "organization")
+	assert.Contains(t, tool.InputSchema.Properties, "defaultBranchOnly")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
 
 	// Setup mock forked repo for success case
@@ -235,6 +387,30 @@ func Test_ForkRepository(t *testing.T) {
 			expectError:  false,
 			expectedRepo: mockForkedRepo,
 		},
+		{
+			name: "fork into an organization under a new name",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposForksByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"organization":        "acme",
+						"name":                "repo-fork",
+						"default_branch_only": true,
+					}).andThen(
+						mockResponse(t, http.StatusAccepted, mockForkedRepo),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"organization":      "acme",
+				"name":              "repo-fork",
+				"defaultBranchOnly": true,
+			},
+			expectError:  false,
+			expectedRepo: mockForkedRepo,
+		},
 		{
 			name: "repository fork fails",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -475,305 +651,6 @@ func Test_CreateBranch(t *testing.T) {
 	}
 }
 
-func Test_GetCommit(t *testing.T) {
-	// Verify tool definition once
-	mockClient := github.NewClient(nil)
-	tool, _ := GetCommit(stubGetClientFn(mockClient), translations.NullTranslationHelper)
-
-	assert.Equal(t, "get_commit", tool.Name)
-	assert.NotEmpty(t, tool.Description)
-	assert.Contains(t, tool.InputSchema.Properties, "owner")
-	assert.Contains(t, tool.InputSchema.Properties, "repo")
-	assert.Contains(t, tool.InputSchema.Properties, "sha")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "sha"})
-
-	mockCommit := &github.RepositoryCommit{
-		SHA: github.Ptr("abc123def456"),
-		Commit: &github.Commit{
-			Message: github.Ptr("First commit"),
-			Author: &github.CommitAuthor{
-				Name:  github.Ptr("Test User"),
-				Email: github.Ptr("test@example.com"),
-				Date:  &github.Timestamp{Time: time.Now().Add(-48 * time.Hour)},
-			},
-		},
-		Author: &github.User{
-			Login: github.Ptr("testuser"),
-		},
-		HTMLURL: github.Ptr("https://github.com/owner/repo/commit/abc123def456"),
-		Stats: &github.CommitStats{
-			Additions: github.Ptr(10),
-			Deletions: github.Ptr(2),
-			Total:     github.Ptr(12),
-		},
-		Files: []*github.CommitFile{
-			{
-				Filename:  github.Ptr("file1.go"),
-				Status:    github.Ptr("modified"),
-				Additions: github.Ptr(10),
-				Deletions: github.Ptr(2),
-				Changes:   github.Ptr(12),
-				Patch:     github.Ptr("@@ -1,2 +1,10 @@"),
-			},
-		},
-	}
-
-	tests := []struct {
-		name           string
-		mockedClient   *http.Client
-		requestArgs    map[string]interface{}
-		expectError    bool
-		expectedCommit *github.RepositoryCommit
-		expectedErrMsg string
-	}{
-		{
-			name: "successful commit fetch",
-			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatchHandler(
-					mock.GetReposCommitsByOwnerByRepoByRef,
-					mockResponse(t, http.StatusOK, mockCommit),
-				),
-			),
-			requestArgs: map[string]interface{}{
-				"owner": "owner",
-				"repo":  "repo",
-				"sha":   "abc123def456",
-			},
-			expectError:    false,
-			expectedCommit: mockCommit,
-		},
-		{
-			name: "commit fetch fails",
-			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatchHandler(
-					mock.GetReposCommitsByOwnerByRepoByRef,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusNotFound)
-						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
-					}),
-				),
-			),
-			requestArgs: map[string]interface{}{
-				"owner": "owner",
-				"repo":  "repo",
-				"sha":   "nonexistent-sha",
-			},
-			expectError:    true,
-			expectedErrMsg: "failed to get commit",
-		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			// Setup client with mock
-			client := github.NewClient(tc.mockedClient)
-			_, handler := GetCommit(stubGetClientFn(client), translations.NullTranslationHelper)
-
-			// Create call request
-			request := createMCPRequest(tc.requestArgs)
-
-			// Call handler
-			result, err := handler(context.Background(), request)
-
-			// Verify results
-			if tc.expectError {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tc.expectedErrMsg)
-				return
-			}
-
-			require.NoError(t, err)
-
-			// Parse the result and get the text content if no error
-			textContent := getTextResult(t, result)
-
-			// Unmarshal and verify the result
-			var returnedCommit github.RepositoryCommit
-			err = json.Unmarshal([]byte(textContent.Text), &returnedCommit)
-			require.NoError(t, err)
-
-			assert.Equal(t, *tc.expectedCommit.SHA, *returnedCommit.SHA)
-			assert.Equal(t, *tc.expectedCommit.Commit.Message, *returnedCommit.Commit.Message)
-			assert.Equal(t, *tc.expectedCommit.Author.Login, *returnedCommit.Author.Login)
-			assert.Equal(t, *tc.expectedCommit.HTMLURL, *returnedCommit.HTMLURL)
-		})
-	}
-}
-
-func Test_ListCommits(t *testing.T) {
-	// Verify tool definition once
-	mockClient := github.NewClient(nil)
-	tool, _ := ListCommits(stubGetClientFn(mockClient), translations.NullTranslationHelper)
-
-	assert.Equal(t, "list_commits", tool.Name)
-	assert.NotEmpty(t, tool.Description)
-	assert.Contains(t, tool.InputSchema.Properties, "owner")
-	assert.Contains(t, tool.InputSchema.Properties, "repo")
-	assert.Contains(t, tool.InputSchema.Properties, "sha")
-	assert.Contains(t, tool.InputSchema.Properties, "page")
-	assert.Contains(t, tool.InputSchema.Properties, "perPage")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
-
-	// Setup mock commits for success case
-	mockCommits := []*github.RepositoryCommit{
-		{
-			SHA: github.Ptr("abc123def456"),
-			Commit: &github.Commit{
-				Message: github.Ptr("First commit"),
-				Author: &github.CommitAuthor{
-					Name:  github.Ptr("Test User"),
-					Email: github.Ptr("test@example.com"),
-					Date:  &github.Timestamp{Time: time.Now().Add(-48 * time.Hour)},
-				},
-			},
-			Author: &github.User{
-				Login: github.Ptr("testuser"),
-			},
-			HTMLURL: github.Ptr("https://github.com/owner/repo/commit/abc123def456"),
-		},
-		{
-			SHA: github.Ptr("def456abc789"),
-			Commit: &github.Commit{
-				Message: github.Ptr("Second commit"),
-				Author: &github.CommitAuthor{
-					Name:  github.Ptr("Another User"),
-					Email: github.Ptr("another@example.com"),
-					Date:  &github.Timestamp{Time: time.Now().Add(-24 * time.Hour)},
-				},
-			},
-			Author: &github.User{
-				Login: github.Ptr("anotheruser"),
-			},
-			HTMLURL: github.Ptr("https://github.com/owner/repo/commit/def456abc789"),
-		},
-	}
-
-	tests := []struct {
-		name            string
-		mockedClient    *http.Client
-		requestArgs     map[string]interface{}
-		expectError     bool
-		expectedCommits []*github.RepositoryCommit
-		expectedErrMsg  string
-	}{
-		{
-			name: "successful commits fetch with default params",
-			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatch(
-					mock.GetReposCommitsByOwnerByRepo,
-					mockCommits,
-				),
-			),
-			requestArgs: map[string]interface{}{
-				"owner": "owner",
-				"repo":  "repo",
-			},
-			expectError:     false,
-			expectedCommits: mockCommits,
-		},
-		{
-			name: "successful commits fetch with branch",
-			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatchHandler(
-					mock.GetReposCommitsByOwnerByRepo,
-					expectQueryParams(t, map[string]string{
-						"sha":      "main",
-						"page":     "1",
-						"per_page": "30",
-					}).andThen(
-						mockResponse(t, http.StatusOK, mockCommits),
-					),
-				),
-			),
-			requestArgs: map[string]interface{}{
-				"owner": "owner",
-				"repo":  "repo",
-				"sha":   "main",
-			},
-			expectError:     false,
-			expectedCommits: mockCommits,
-		},
-		{
-			name: "successful commits fetch with pagination",
-			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatchHandler(
-					mock.GetReposCommitsByOwnerByRepo,
-					expectQueryParams(t, map[string]string{
-						"page":     "2",
-						"per_page": "10",
-					}).andThen(
-						mockResponse(t, http.StatusOK, mockCommits),
-					),
-				),
-			),
-			requestArgs: map[string]interface{}{
-				"owner":   "owner",
-				"repo":    "repo",
-				"page":    float64(2),
-				"perPage": float64(10),
-			},
-			expectError:     false,
-			expectedCommits: mockCommits,
-		},
-		{
-			name: "commits fetch fails",
-			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatchHandler(
-					mock.GetReposCommitsByOwnerByRepo,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusNotFound)
-						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
-					}),
-				),
-			),
-			requestArgs: map[string]interface{}{
-				"owner": "owner",
-				"repo":  "nonexistent-repo",
-			},
-			expectError:    true,
-			expectedErrMsg: "failed to list commits",
-		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			// Setup client with mock
-			client := github.NewClient(tc.mockedClient)
-			_, handler := ListCommits(stubGetClientFn(client), translations.NullTranslationHelper)
-
-			// Create call request
-			request := createMCPRequest(tc.requestArgs)
-
-			// Call handler
-			result, err := handler(context.Background(), request)
-
-			// Verify results
-			if tc.expectError {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tc.expectedErrMsg)
-				return
-			}
-
-			require.NoError(t, err)
-
-			// Parse the result and get the text content if no error
-			textContent := getTextResult(t, result)
-
-			// Unmarshal and verify the result
-			var returnedCommits []*github.RepositoryCommit
-			err = json.Unmarshal([]byte(textContent.Text), &returnedCommits)
-			require.NoError(t, err)
-			assert.Len(t, returnedCommits, len(tc.expectedCommits))
-			for i, commit := range returnedCommits {
-				assert.Equal(t, *tc.expectedCommits[i].SHA, *commit.SHA)
-				assert.Equal(t, *tc.expectedCommits[i].Commit.Message, *commit.Commit.Message)
-				assert.Equal(t, *tc.expectedCommits[i].Author.Login, *commit.Author.Login)
-				assert.Equal(t, *tc.expectedCommits[i].HTMLURL, *commit.HTMLURL)
-			}
-		})
-	}
-}
-
 func Test_CreateOrUpdateFile(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
@@ -937,6 +814,151 @@ func Test_CreateOrUpdateFile(t *testing.T) {
 	}
 }
 
+func Test_CreateOrUpdateFile_ExistingFileWithoutSHA(t *testing.T) {
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PutReposContentsByOwnerByRepoByPath,
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				_, _ = w.Write([]byte(`{"message": "Invalid request.\n\n\"sha\" wasn't supplied."}`))
+			}),
+		),
+		mock.WithRequestMatchHandler(
+			mock.GetReposContentsByOwnerByRepoByPath,
+			expectQueryParams(t, map[string]string{
+				"ref": "main",
+			}).andThen(
+				mockResponse(t, http.StatusOK, &github.RepositoryContent{Type: github.Ptr("file"), SHA: github.Ptr("abc123")}),
+			),
+		),
+	))
+	_, handler := CreateOrUpdateFile(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":   "owner",
+		"repo":    "repo",
+		"path":    "docs/example.md",
+		"content": "# Example",
+		"message": "Update example file",
+		"branch":  "main",
+	}))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, getTextResult(t, result).Text, `pass sha "abc123"`)
+}
+
+func Test_DeleteFile(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := DeleteFile(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "delete_file", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "sha")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "path", "message", "branch"})
+
+	mockResponseBody := &github.RepositoryContentResponse{
+		Commit: github.Commit{
+			SHA:     github.Ptr("def456"),
+			Message: github.Ptr("Remove example file"),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectToolErr  string
+		expectedCommit string
+	}{
+		{
+			name: "delete with sha",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposContentsByOwnerByRepoByPath,
+					expectRequestBody(t, map[string]interface{}{
+						"message": "Remove example file",
+						"content": nil,
+						"branch":  "main",
+						"sha":     "abc123",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockResponseBody),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"sha": "abc123",
+			},
+			expectedCommit: "def456",
+		},
+		{
+			name: "sha looked up on the branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					expectQueryParams(t, map[string]string{
+						"ref": "main",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.RepositoryContent{Type: github.Ptr("file"), SHA: github.Ptr("abc123")}),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposContentsByOwnerByRepoByPath,
+					expectRequestBody(t, map[string]interface{}{
+						"message": "Remove example file",
+						"content": nil,
+						"branch":  "main",
+						"sha":     "abc123",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockResponseBody),
+					),
+				),
+			),
+			requestArgs:    map[string]interface{}{},
+			expectedCommit: "def456",
+		},
+		{
+			name: "file does not exist",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs:   map[string]interface{}{},
+			expectToolErr: "docs/example.md does not exist on main",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DeleteFile(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			tc.requestArgs["owner"] = "owner"
+			tc.requestArgs["repo"] = "repo"
+			tc.requestArgs["path"] = "docs/example.md"
+			tc.requestArgs["message"] = "Remove example file"
+			tc.requestArgs["branch"] = "main"
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectToolErr != "" {
+				require.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectToolErr)
+				return
+			}
+
+			var returned github.RepositoryContentResponse
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedCommit, returned.Commit.GetSHA())
+		})
+	}
+}
+
 func Test_CreateRepository(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
@@ -1083,6 +1105,217 @@ func Test_CreateRepository(t *testing.T) {
 	}
 }
 
+func Test_CreateRepository_OrganizationAndTemplate(t *testing.T) {
+	mockRepo := &github.Repository{
+		Name:     github.Ptr("test-repo"),
+		FullName: github.Ptr("acme/test-repo"),
+	}
+
+	tests := []struct {
+		name          string
+		mockedClient  *http.Client
+		requestArgs   map[string]interface{}
+		expectToolErr string
+	}{
+		{
+			name: "organization repository with visibility",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostOrgsReposByOrg,
+					expectRequestBody(t, map[string]interface{}{
+						"name":        "test-repo",
+						"description": "",
+						"auto_init":   false,
+						"visibility":  "internal",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockRepo),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"name":         "test-repo",
+				"organization": "acme",
+				"visibility":   "internal",
+			},
+		},
+		{
+			name: "from a template",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposGenerateByTemplateOwnerByTemplateRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"name":                 "test-repo",
+						"owner":                "acme",
+						"description":          "From template",
+						"include_all_branches": true,
+						"private":              true,
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockRepo),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"name":               "test-repo",
+				"organization":       "acme",
+				"description":        "From template",
+				"template":           "acme/service-template",
+				"includeAllBranches": true,
+				"visibility":         "private",
+			},
+		},
+		{
+			name:         "malformed template",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"name":     "test-repo",
+				"template": "service-template",
+			},
+			expectToolErr: "template must be in owner/repo form",
+		},
+		{
+			name:         "internal template repository",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"name":       "test-repo",
+				"template":   "acme/service-template",
+				"visibility": "internal",
+			},
+			expectToolErr: "internal visibility is not supported",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectToolErr != "" {
+				require.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectToolErr)
+				return
+			}
+
+			var returnedRepo github.Repository
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returnedRepo))
+			assert.Equal(t, "acme/test-repo", returnedRepo.GetFullName())
+		})
+	}
+}
+
+func Test_UpdateRepositorySettings(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateRepositorySettings(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "update_repository_settings", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "topics")
+	assert.Contains(t, tool.InputSchema.Properties, "defaultBranch")
+	assert.Contains(t, tool.InputSchema.Properties, "allowSquashMerge")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockRepo := &github.Repository{
+		Name:          github.Ptr("repo"),
+		DefaultBranch: github.Ptr("main"),
+		Topics:        []string{"old"},
+	}
+
+	tests := []struct {
+		name          string
+		mockedClient  *http.Client
+		requestArgs   map[string]interface{}
+		expectToolErr string
+		expectTopics  []string
+	}{
+		{
+			name: "settings and topics",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"description":        "",
+						"default_branch":     "main",
+						"allow_squash_merge": true,
+						"allow_merge_commit": false,
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockRepo),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PutReposTopicsByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"names": []interface{}{"go", "mcp"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, map[string]interface{}{"names": []string{"go", "mcp"}}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"description":      "",
+				"defaultBranch":    "main",
+				"allowSquashMerge": true,
+				"allowMergeCommit": false,
+				"topics":           []interface{}{"go", "mcp"},
+			},
+			expectTopics: []string{"go", "mcp"},
+		},
+		{
+			name: "topics only",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PutReposTopicsByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"names": []interface{}{},
+					}).andThen(
+						mockResponse(t, http.StatusOK, map[string]interface{}{"names": []string{}}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"topics": []interface{}{},
+			},
+			expectTopics: []string{},
+		},
+		{
+			name:          "nothing to update",
+			mockedClient:  mock.NewMockedHTTPClient(),
+			requestArgs:   map[string]interface{}{},
+			expectToolErr: "no settings to update",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateRepositorySettings(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			tc.requestArgs["owner"] = "owner"
+			tc.requestArgs["repo"] = "repo"
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectToolErr != "" {
+				require.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectToolErr)
+				return
+			}
+
+			var returnedRepo github.Repository
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returnedRepo))
+			assert.Equal(t, "main", returnedRepo.GetDefaultBranch())
+			assert.ElementsMatch(t, tc.expectTopics, returnedRepo.Topics)
+		})
+	}
+}
+
 func Test_PushFiles(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
@@ -17,10 +17,11 @@ import (
 	"github.com/mark3labs/mcp-go/server"
 )
 
-// GetRepositoryResourceContent defines the resource template and handler for getting repository content.
+// GetRepositoryResourceContent defines the resource template and handler for getting repository content,
+// at the default branch or at the branch, tag or commit given as ref.
 func GetRepositoryResourceContent(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.ResourceTemplate, server.ResourceTemplateHandlerFunc) {
 	return mcp.NewResourceTemplate(
-			"repo://{owner}/{repo}/contents{/path*}", // Resource template
+			"repo://{owner}/{repo}/contents{/path*}{?ref}", // Resource template
 			t("RESOURCE_REPOSITORY_CONTENT_DESCRIPTION", "Repository Content"),
 		),
 		RepositoryResourceContentsHandler(getClient)
@@ -88,6 +89,11 @@ func RepositoryResourceContentsHandler(getClient GetClientFn) func(ctx context.C
 
 		opts := &github.RepositoryContentGetOptions{}
 
+		ref, ok := request.Params.Arguments["ref"].([]string)
+		if ok && len(ref) > 0 {
+			opts.Ref = ref[0]
+		}
+
 		sha, ok := request.Params.Arguments["sha"].([]string)
 		if ok && len(sha) > 0 {
 			opts.Ref = sha[0]
@@ -165,6 +165,29 @@ func Test_repositoryResourceContentsHandler(t *testing.T) {
 			},
 			expectedResult: expectedTextContent,
 		},
+		{
+			name: "content fetch at ref",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						require.Equal(t, "v1.0", r.URL.Query().Get("ref"))
+						_, _ = w.Write(mock.MustMarshal(mockTextContent))
+					}),
+				),
+				mock.WithRequestMatch(
+					GetRawReposContentsByOwnerByRepoByPath,
+					[]byte("# Test Repository\n\nThis is a test repository."),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner": []string{"owner"},
+				"repo":  []string{"repo"},
+				"path":  []string{"README.md"},
+				"ref":   []string{"v1.0"},
+			},
+			expectedResult: expectedTextContent,
+		},
 		{
 			name: "successful directory content fetch",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -271,7 +294,7 @@ func Test_repositoryResourceContentsHandler(t *testing.T) {
 
 func Test_GetRepositoryResourceContent(t *testing.T) {
 	tmpl, _ := GetRepositoryResourceContent(nil, translations.NullTranslationHelper)
-	require.Equal(t, "repo://{owner}/{repo}/contents{/path*}", tmpl.URITemplate.Raw())
+	require.Equal(t, "repo://{owner}/{repo}/contents{/path*}{?ref}", tmpl.URITemplate.Raw())
 }
 
 func Test_GetRepositoryResourceBranchContent(t *testing.T) {
@@ -0,0 +1,265 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// defaultMaxResponseBytes bounds a tool's output when the call sets no
+	// budget of its own, about 64k tokens.
+	defaultMaxResponseBytes = 256 << 10
+	// bytesPerToken converts max_response_tokens to bytes. It is a rough
+	// average for JSON and code.
+	bytesPerToken = 4
+	// minResponseBytes keeps a budget large enough to make progress.
+	minResponseBytes = 256
+)
+
+// TruncatedResponse replaces the output of a tool call that exceeded its
+// budget, and is returned for every call that passes response_cursor. An
+// output that is a JSON array is cut between elements, which are returned in
+// Items. A JSON object is cut the same way inside its largest array field,
+// named by ItemsField, and returned in Object with that field shortened. Any
+// other output is cut at a line or character boundary and returned in
+// Content.
+type TruncatedResponse struct {
+	Truncated  bool              `json:"truncated"`
+	Items      []json.RawMessage `json:"items,omitempty"`
+	Object     json.RawMessage   `json:"object,omitempty"`
+	ItemsField string            `json:"items_field,omitempty"`
+	Content    string            `json:"content,omitempty"`
+	// NextCursor continues the output: call the tool again with the same
+	// arguments and response_cursor set to it.
+	NextCursor string `json:"next_cursor,omitempty"`
+	// TotalItems or TotalBytes is the size of the whole output.
+	TotalItems int `json:"total_items,omitempty"`
+	TotalBytes int `json:"total_bytes,omitempty"`
+}
+
+// errNotTruncatable is returned for JSON objects over the budget that have no
+// array field to shorten; cutting them anywhere would not leave valid JSON.
+var errNotTruncatable = errors.New("output cannot be truncated")
+
+// responseCursor is the position in a tool's output that response_cursor
+// refers to: an element of a JSON array, or a byte offset otherwise.
+type responseCursor struct {
+	items  bool
+	offset int
+}
+
+func (c responseCursor) String() string {
+	kind := "b"
+	if c.items {
+		kind = "i"
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(kind + ":" + strconv.Itoa(c.offset)))
+}
+
+func parseResponseCursor(s string) (responseCursor, error) {
+	invalid := fmt.Errorf("parameter response_cursor is not a cursor returned by this server: %q", s)
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return responseCursor{}, invalid
+	}
+	kind, offset, ok := strings.Cut(string(raw), ":")
+	n, err := strconv.Atoi(offset)
+	if !ok || err != nil || n < 0 || (kind != "i" && kind != "b") {
+		return responseCursor{}, invalid
+	}
+	return responseCursor{items: kind == "i", offset: n}, nil
+}
+
+// withResponseBudgetParams adds the parameters read by withResponseBudget.
+func withResponseBudgetParams(tool mcp.Tool) mcp.Tool {
+	mcp.WithNumber("max_response_bytes",
+		mcp.Description(fmt.Sprintf("Truncate the output to about this many bytes, with a cursor to continue from (default %d)", defaultMaxResponseBytes)),
+		mcp.Min(minResponseBytes),
+	)(&tool)
+	mcp.WithNumber("max_response_tokens",
+		mcp.Description("Like max_response_bytes, counted in tokens of about 4 bytes"),
+		mcp.Min(minResponseBytes/bytesPerToken),
+	)(&tool)
+	mcp.WithString("response_cursor",
+		mcp.Description("next_cursor of a truncated output, to get the part that follows it; pass the same other arguments"),
+	)(&tool)
+	return tool
+}
+
+// responseBudget reads the parameters added by withResponseBudgetParams.
+func responseBudget(r mcp.CallToolRequest) (int, string, error) {
+	maxBytes, err := OptionalIntParamInRange(r, "max_response_bytes", 0, minResponseBytes, math.MaxInt32)
+	if err != nil {
+		return 0, "", err
+	}
+	maxTokens, err := OptionalIntParamInRange(r, "max_response_tokens", 0, minResponseBytes/bytesPerToken, math.MaxInt32/bytesPerToken)
+	if err != nil {
+		return 0, "", err
+	}
+	cursor, err := OptionalParam[string](r, "response_cursor")
+	if err != nil {
+		return 0, "", err
+	}
+	budget := defaultMaxResponseBytes
+	switch {
+	case maxBytes > 0 && maxTokens > 0:
+		budget = min(maxBytes, maxTokens*bytesPerToken)
+	case maxBytes > 0:
+		budget = maxBytes
+	case maxTokens > 0:
+		budget = maxTokens * bytesPerToken
+	}
+	return budget, cursor, nil
+}
+
+// withResponseBudget wraps a tool handler so that a text output over the
+// call's budget, or one continued with response_cursor, is returned as a
+// TruncatedResponse. It is only for read tools: continuing an output calls
+// the handler again.
+func withResponseBudget(handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		budget, cursor, err := responseBudget(request)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		var from *responseCursor
+		if cursor != "" {
+			c, err := parseResponseCursor(cursor)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			from = &c
+		}
+
+		result, err := handler(ctx, request)
+		if err != nil || result == nil || result.IsError || len(result.Content) != 1 {
+			return result, err
+		}
+		text, ok := result.Content[0].(mcp.TextContent)
+		if !ok || (from == nil && len(text.Text) <= budget) {
+			return result, nil
+		}
+		out, err := truncateOutput(text.Text, budget, from)
+		if errors.Is(err, errNotTruncatable) {
+			return ToolError{
+				Code:      ErrorCodeValidation,
+				Message:   err.Error(),
+				Parameter: "max_response_bytes",
+				Hint:      "Raise max_response_bytes, or narrow the call so that its output is smaller",
+			}.Result(), nil
+		}
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		r, err := json.Marshal(out)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal response: %w", err)
+		}
+		return mcp.NewToolResultText(string(r)), nil
+	}
+}
+
+// truncateOutput returns the part of output that starts at from, or at the
+// beginning when from is nil, and fits in budget bytes.
+func truncateOutput(output string, budget int, from *responseCursor) (*TruncatedResponse, error) {
+	var items []json.RawMessage
+	isArray := strings.HasPrefix(output, "[") && json.Unmarshal([]byte(output), &items) == nil
+	var object map[string]json.RawMessage
+	isObject := strings.HasPrefix(output, "{") && json.Unmarshal([]byte(output), &object) == nil
+	field := ""
+	if isObject {
+		field = largestArrayField(object)
+		if field == "" {
+			return nil, fmt.Errorf("%w: it is a JSON object of %d bytes, over the budget of %d bytes, without an array to shorten", errNotTruncatable, len(output), budget)
+		}
+		_ = json.Unmarshal(object[field], &items)
+		// The rest of the object is returned with every part.
+		budget -= len(output) - len(object[field])
+	}
+	if from == nil {
+		from = &responseCursor{items: isArray || isObject}
+	}
+	if from.items != (isArray || isObject) {
+		return nil, fmt.Errorf("parameter response_cursor does not match this output; call the tool again without it")
+	}
+
+	if isArray || isObject {
+		if from.offset > len(items) {
+			return nil, fmt.Errorf("parameter response_cursor is past the end of the output, which has %d items", len(items))
+		}
+		out := &TruncatedResponse{TotalItems: len(items), Items: []json.RawMessage{}}
+		size := 0
+		end := from.offset
+		// Always return at least one item, so that the cursor moves on.
+		for ; end < len(items) && (end == from.offset || size+len(items[end])+1 <= budget); end++ {
+			size += len(items[end]) + 1
+		}
+		out.Items = items[from.offset:end]
+		if end < len(items) {
+			out.Truncated = true
+			out.NextCursor = responseCursor{items: true, offset: end}.String()
+		}
+		if isObject {
+			shortened, err := json.Marshal(out.Items)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal items: %w", err)
+			}
+			object[field] = shortened
+			if out.Object, err = json.Marshal(object); err != nil {
+				return nil, fmt.Errorf("failed to marshal output: %w", err)
+			}
+			out.ItemsField = field
+			out.Items = nil
+		}
+		return out, nil
+	}
+
+	if from.offset > len(output) {
+		return nil, fmt.Errorf("parameter response_cursor is past the end of the output, which has %d bytes", len(output))
+	}
+	out := &TruncatedResponse{TotalBytes: len(output)}
+	rest := output[from.offset:]
+	if len(rest) <= budget {
+		out.Content = rest
+		return out, nil
+	}
+	cut := budget
+	// Prefer a line boundary in the second half of the budget; otherwise do
+	// not split a character.
+	if nl := strings.LastIndexByte(rest[:cut], '\n'); nl >= cut/2 {
+		cut = nl + 1
+	} else {
+		for cut > 0 && !utf8.RuneStart(rest[cut]) {
+			cut--
+		}
+	}
+	out.Content = rest[:cut]
+	out.Truncated = true
+	out.NextCursor = responseCursor{offset: from.offset + cut}.String()
+	return out, nil
+}
+
+// largestArrayField returns the name of the array field of object with the
+// largest encoding, or "" when it has none.
+func largestArrayField(object map[string]json.RawMessage) string {
+	field := ""
+	for name, value := range object {
+		if !strings.HasPrefix(string(value), "[") {
+			continue
+		}
+		if field == "" || len(value) > len(object[field]) || (len(value) == len(object[field]) && name < field) {
+			field = name
+		}
+	}
+	return field
+}
@@ -0,0 +1,221 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// itemsJSON returns a JSON array of n objects of about 100 bytes each.
+func itemsJSON(n int) string {
+	items := make([]string, n)
+	for i := range items {
+		items[i] = fmt.Sprintf(`{"number":%d,"body":%q}`, i, strings.Repeat("x", 80))
+	}
+	return "[" + strings.Join(items, ",") + "]"
+}
+
+func Test_truncateOutput(t *testing.T) {
+	lines := strings.Repeat(strings.Repeat("a", 99)+"\n", 10)
+
+	t.Run("items", func(t *testing.T) {
+		var numbers []int
+		var from *responseCursor
+		for pages := 0; ; pages++ {
+			require.Less(t, pages, 10)
+			out, err := truncateOutput(itemsJSON(10), 350, from)
+			require.NoError(t, err)
+			assert.Equal(t, 10, out.TotalItems)
+			for _, item := range out.Items {
+				var v struct{ Number int }
+				require.NoError(t, json.Unmarshal(item, &v))
+				numbers = append(numbers, v.Number)
+			}
+			if !out.Truncated {
+				assert.Empty(t, out.NextCursor)
+				break
+			}
+			c, err := parseResponseCursor(out.NextCursor)
+			require.NoError(t, err)
+			from = &c
+		}
+		assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, numbers)
+	})
+
+	t.Run("item over budget", func(t *testing.T) {
+		out, err := truncateOutput(itemsJSON(2), 10, nil)
+		require.NoError(t, err)
+		assert.Len(t, out.Items, 1)
+		assert.True(t, out.Truncated)
+	})
+
+	t.Run("text at line boundary", func(t *testing.T) {
+		out, err := truncateOutput(lines, 450, nil)
+		require.NoError(t, err)
+		assert.True(t, out.Truncated)
+		assert.Equal(t, lines[:400], out.Content)
+		assert.Equal(t, 1000, out.TotalBytes)
+
+		c, err := parseResponseCursor(out.NextCursor)
+		require.NoError(t, err)
+		out, err = truncateOutput(lines, 1000, &c)
+		require.NoError(t, err)
+		assert.False(t, out.Truncated)
+		assert.Equal(t, lines[400:], out.Content)
+	})
+
+	t.Run("text without lines", func(t *testing.T) {
+		out, err := truncateOutput(strings.Repeat("é", 100), 51, nil)
+		require.NoError(t, err)
+		assert.Equal(t, strings.Repeat("é", 25), out.Content)
+	})
+
+	t.Run("object", func(t *testing.T) {
+		output := `{"total_count":10,"items":` + itemsJSON(10) + `,"labels":["a"]}`
+		var numbers []int
+		var from *responseCursor
+		for pages := 0; ; pages++ {
+			require.Less(t, pages, 10)
+			out, err := truncateOutput(output, 400, from)
+			require.NoError(t, err)
+			assert.Equal(t, "items", out.ItemsField)
+			assert.Equal(t, 10, out.TotalItems)
+			assert.Empty(t, out.Items)
+			var object struct {
+				TotalCount int      `json:"total_count"`
+				Labels     []string `json:"labels"`
+				Items      []struct{ Number int }
+			}
+			require.NoError(t, json.Unmarshal(out.Object, &object))
+			assert.Equal(t, 10, object.TotalCount)
+			assert.Equal(t, []string{"a"}, object.Labels)
+			for _, item := range object.Items {
+				numbers = append(numbers, item.Number)
+			}
+			if !out.Truncated {
+				break
+			}
+			c, err := parseResponseCursor(out.NextCursor)
+			require.NoError(t, err)
+			from = &c
+		}
+		assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, numbers)
+	})
+
+	t.Run("object without array", func(t *testing.T) {
+		_, err := truncateOutput(`{"body":"`+strings.Repeat("x", 1000)+`"}`, 500, nil)
+		assert.ErrorIs(t, err, errNotTruncatable)
+	})
+
+	t.Run("cursor mismatch", func(t *testing.T) {
+		_, err := truncateOutput(lines, 450, &responseCursor{items: true})
+		assert.Error(t, err)
+		_, err = truncateOutput(lines, 450, &responseCursor{offset: 2000})
+		assert.Error(t, err)
+	})
+}
+
+func Test_parseResponseCursor(t *testing.T) {
+	c, err := parseResponseCursor(responseCursor{items: true, offset: 42}.String())
+	require.NoError(t, err)
+	assert.Equal(t, responseCursor{items: true, offset: 42}, c)
+
+	for _, s := range []string{"42", "eDo0Mg", "aTotMQ"} {
+		_, err := parseResponseCursor(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func Test_withResponseBudget(t *testing.T) {
+	output := itemsJSON(5)
+	handler := withResponseBudget(func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText(output), nil
+	})
+
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		expectedItems int
+		expectedError string
+	}{
+		{
+			name:          "within default budget",
+			args:          map[string]interface{}{},
+			expectedItems: -1,
+		},
+		{
+			name:          "max_response_bytes",
+			args:          map[string]interface{}{"max_response_bytes": float64(300)},
+			expectedItems: 2,
+		},
+		{
+			name:          "smaller of bytes and tokens",
+			args:          map[string]interface{}{"max_response_bytes": float64(1000), "max_response_tokens": float64(100)},
+			expectedItems: 3,
+		},
+		{
+			name:          "budget too small",
+			args:          map[string]interface{}{"max_response_bytes": float64(10)},
+			expectedError: "parameter max_response_bytes must be between",
+		},
+		{
+			name:          "bad cursor",
+			args:          map[string]interface{}{"response_cursor": "nope"},
+			expectedError: "parameter response_cursor is not a cursor",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := handler(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+			text := getTextResult(t, result).Text
+			if tc.expectedError != "" {
+				require.True(t, result.IsError)
+				assert.Contains(t, text, tc.expectedError)
+				return
+			}
+			require.False(t, result.IsError)
+			if tc.expectedItems < 0 {
+				assert.Equal(t, output, text)
+				return
+			}
+			var out TruncatedResponse
+			require.NoError(t, json.Unmarshal([]byte(text), &out))
+			assert.True(t, out.Truncated)
+			assert.Len(t, out.Items, tc.expectedItems)
+			assert.Equal(t, 5, out.TotalItems)
+		})
+	}
+}
+
+func Test_newReadServerTool_ResponseBudgetParams(t *testing.T) {
+	tool := newReadServerTool(mcp.NewTool("example"), nil).Tool
+	assert.Contains(t, tool.InputSchema.Properties, "max_response_bytes")
+	assert.Contains(t, tool.InputSchema.Properties, "max_response_tokens")
+	assert.Contains(t, tool.InputSchema.Properties, "response_cursor")
+	assert.Empty(t, tool.InputSchema.Required)
+
+	// Write tools have no budget, since continuing would write again.
+	tool = newServerTool(mcp.NewTool("example"), nil).Tool
+	assert.Empty(t, tool.InputSchema.Properties)
+}
+
+func Test_withResponseBudget_NotTruncatable(t *testing.T) {
+	handler := withResponseBudget(func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText(`{"body":"` + strings.Repeat("x", 1000) + `"}`), nil
+	})
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{"max_response_bytes": float64(500)}))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	var te ToolError
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &te))
+	assert.Equal(t, ErrorCodeValidation, te.Code)
+	assert.Equal(t, "max_response_bytes", te.Parameter)
+}
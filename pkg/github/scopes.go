@@ -0,0 +1,148 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/toolsets"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// impliedScopes lists, for each classic scope, the scopes it grants as well.
+var impliedScopes = map[string][]string{
+	"repo":             {"public_repo", "repo:status", "repo_deployment", "repo:invite", "security_events"},
+	"admin:org":        {"write:org"},
+	"write:org":        {"read:org"},
+	"project":          {"read:project"},
+	"user":             {"read:user", "user:email", "user:follow"},
+	"write:packages":   {"read:packages"},
+	"admin:repo_hook":  {"write:repo_hook"},
+	"write:repo_hook":  {"read:repo_hook"},
+	"admin:public_key": {"write:public_key"},
+	"write:public_key": {"read:public_key"},
+}
+
+// hasScope reports whether granted includes scope, directly or through a
+// broader scope that implies it.
+func hasScope(granted []string, scope string) bool {
+	for _, g := range granted {
+		if g == scope || hasScope(impliedScopes[g], scope) {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenScopes returns the scopes GitHub reported for the token behind resp.
+// ok is false when the response carries no scope header at all, which is the
+// case for fine-grained tokens and GitHub Apps.
+func tokenScopes(resp *github.Response) (scopes []string, ok bool) {
+	values, ok := resp.Header[http.CanonicalHeaderKey("X-OAuth-Scopes")]
+	if !ok {
+		return nil, false
+	}
+	for _, value := range values {
+		for _, scope := range strings.Split(value, ",") {
+			if scope = strings.TrimSpace(scope); scope != "" {
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+	return scopes, true
+}
+
+// UnavailableTool is a registered tool the token lacks the scope for.
+type UnavailableTool struct {
+	Name       string   `json:"name"`
+	Toolset    string   `json:"toolset"`
+	NeedsOneOf []string `json:"needs_one_of"`
+}
+
+// TokenScopeReport is the result of checking a token against the tools the
+// server registered.
+type TokenScopeReport struct {
+	Login string `json:"login"`
+	// Classic is false for fine-grained tokens and GitHub Apps. GitHub does
+	// not report their permissions, so their tools are not checked.
+	Classic     bool              `json:"classic"`
+	Scopes      []string          `json:"scopes"`
+	Unavailable []UnavailableTool `json:"unavailable_tools,omitempty"`
+	Note        string            `json:"note,omitempty"`
+}
+
+// CheckTokenScopes looks up the scopes of the token behind client and reports
+// which active tools in tsg will fail for lack of one.
+func CheckTokenScopes(ctx context.Context, client *github.Client, tsg *toolsets.ToolsetGroup) (*TokenScopeReport, error) {
+	user, resp, err := client.Users.Get(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	report := &TokenScopeReport{Login: user.GetLogin(), Scopes: []string{}}
+	scopes, ok := tokenScopes(resp)
+	if !ok {
+		report.Note = "Fine-grained tokens and GitHub Apps do not report their permissions, so tools were not checked; calls fail with 403 when a permission is missing"
+		return report, nil
+	}
+	report.Classic = true
+	if scopes != nil {
+		report.Scopes = scopes
+	}
+
+	for _, toolset := range tsg.Toolsets {
+		for name, needs := range toolset.ActiveToolScopes() {
+			satisfied := false
+			for _, scope := range needs {
+				if hasScope(scopes, scope) {
+					satisfied = true
+					break
+				}
+			}
+			if !satisfied {
+				report.Unavailable = append(report.Unavailable, UnavailableTool{Name: name, Toolset: toolset.Name, NeedsOneOf: needs})
+			}
+		}
+	}
+	sort.Slice(report.Unavailable, func(i, j int) bool {
+		a, b := report.Unavailable[i], report.Unavailable[j]
+		if a.Toolset != b.Toolset {
+			return a.Toolset < b.Toolset
+		}
+		return a.Name < b.Name
+	})
+
+	return report, nil
+}
+
+// CheckTokenPermissions creates a tool that reports which of the registered
+// tools the current token lacks the scopes for.
+func CheckTokenPermissions(getClient GetClientFn, tsg *toolsets.ToolsetGroup, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("check_token_permissions",
+			mcp.WithDescription(t("TOOL_CHECK_TOKEN_PERMISSIONS_DESCRIPTION", "Check the scopes of the GitHub token against the enabled tools and list the tools that will fail for lack of a scope. Use this when calls fail with 403 or before relying on a toolset")),
+		),
+		func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			report, err := CheckTokenScopes(ctx, client, tsg)
+			if err != nil {
+				return nil, err
+			}
+
+			r, err := json.Marshal(report)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,111 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/toolsets"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_hasScope(t *testing.T) {
+	assert.True(t, hasScope([]string{"repo"}, "repo"))
+	assert.True(t, hasScope([]string{"repo"}, "security_events"))
+	assert.True(t, hasScope([]string{"gist", "admin:org"}, "read:org"))
+	assert.False(t, hasScope([]string{"read:org"}, "admin:org"))
+	assert.False(t, hasScope(nil, "repo"))
+}
+
+func Test_CheckTokenPermissions(t *testing.T) {
+	tsg := toolsets.NewToolsetGroup(false)
+	tsg.AddToolset(toolsets.NewToolset("orgs", "Organizations").
+		AddReadTools(toolsets.NewServerTool(mcp.NewTool("list_org_members"), nil)).
+		AddWriteTools(toolsets.NewServerTool(mcp.NewTool("add_team_member"), nil)).
+		RequireScopes([]string{"read:org"}, []string{"admin:org"}))
+	tsg.AddToolset(toolsets.NewToolset("projects", "Projects").
+		AddReadTools(toolsets.NewServerTool(mcp.NewTool("list_projects"), nil)).
+		RequireScopes([]string{"read:project"}, nil))
+	require.NoError(t, tsg.EnableToolsets([]string{"all"}))
+
+	mockClient := github.NewClient(nil)
+	tool, _ := CheckTokenPermissions(stubGetClientFn(mockClient), tsg, translations.NullTranslationHelper)
+	assert.Equal(t, "check_token_permissions", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+
+	userWithScopes := func(scopes []string) http.HandlerFunc {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			for _, scope := range scopes {
+				w.Header().Add("X-OAuth-Scopes", scope)
+			}
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"login":"octocat"}`))
+		}
+	}
+
+	tests := []struct {
+		name     string
+		handler  http.HandlerFunc
+		expected TokenScopeReport
+	}{
+		{
+			name:    "classic token missing scopes",
+			handler: userWithScopes([]string{"repo, write:org"}),
+			expected: TokenScopeReport{
+				Login:   "octocat",
+				Classic: true,
+				Scopes:  []string{"repo", "write:org"},
+				Unavailable: []UnavailableTool{
+					{Name: "add_team_member", Toolset: "orgs", NeedsOneOf: []string{"admin:org"}},
+					{Name: "list_projects", Toolset: "projects", NeedsOneOf: []string{"read:project"}},
+				},
+			},
+		},
+		{
+			name:    "classic token without scopes",
+			handler: userWithScopes([]string{""}),
+			expected: TokenScopeReport{
+				Login:   "octocat",
+				Classic: true,
+				Scopes:  []string{},
+				Unavailable: []UnavailableTool{
+					{Name: "add_team_member", Toolset: "orgs", NeedsOneOf: []string{"admin:org"}},
+					{Name: "list_org_members", Toolset: "orgs", NeedsOneOf: []string{"read:org"}},
+					{Name: "list_projects", Toolset: "projects", NeedsOneOf: []string{"read:project"}},
+				},
+			},
+		},
+		{
+			name:    "fine-grained token",
+			handler: userWithScopes(nil),
+			expected: TokenScopeReport{
+				Login:  "octocat",
+				Scopes: []string{},
+				Note:   "Fine-grained tokens and GitHub Apps do not report their permissions, so tools were not checked; calls fail with 403 when a permission is missing",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetUser, tc.handler),
+			))
+			_, handler := CheckTokenPermissions(stubGetClientFn(client), tsg, translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{}))
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			var returned TokenScopeReport
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
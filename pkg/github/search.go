@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
+	"strings"
 
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v69/github"
@@ -66,14 +68,50 @@ func SearchRepositories(getClient GetClientFn, t translations.TranslationHelperF
 		}
 }
 
+// defaultMaxCodeFragments is how many highlighted fragments search_code
+// returns per file unless told otherwise.
+const defaultMaxCodeFragments = 3
+
+// CodeSearchItem is one matching file in a search_code result.
+type CodeSearchItem struct {
+	Name       string   `json:"name"`
+	Path       string   `json:"path"`
+	Repository string   `json:"repository"`
+	SHA        string   `json:"sha"`
+	HTMLURL    string   `json:"html_url"`
+	Fragments  []string `json:"fragments,omitempty"`
+}
+
+// SearchCodeOutput is the result of search_code, with the same paging
+// fields as SearchIssuesOutput.
+type SearchCodeOutput struct {
+	TotalCount        int              `json:"total_count"`
+	IncompleteResults bool             `json:"incomplete_results"`
+	Items             []CodeSearchItem `json:"items"`
+	NextPage          int              `json:"next_page,omitempty"`
+	HasNextPage       bool             `json:"has_next_page"`
+}
+
 // SearchCode creates a tool to search for code across GitHub repositories.
 func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("search_code",
-			mcp.WithDescription(t("TOOL_SEARCH_CODE_DESCRIPTION", "Search for code across GitHub repositories")),
+			mcp.WithDescription(t("TOOL_SEARCH_CODE_DESCRIPTION", "Search for code across GitHub repositories, returning matching files with highlighted fragments")),
 			mcp.WithString("q",
 				mcp.Required(),
 				mcp.Description("Search query using GitHub code search syntax"),
 			),
+			mcp.WithString("repo",
+				mcp.Description("Only search this repository, as owner/repo"),
+			),
+			mcp.WithString("org",
+				mcp.Description("Only search repositories of this organization or user"),
+			),
+			mcp.WithString("path",
+				mcp.Description("Only search files under this path"),
+			),
+			mcp.WithString("language",
+				mcp.Description("Only search files in this language"),
+			),
 			mcp.WithString("sort",
 				mcp.Description("Sort field ('indexed' only)"),
 			),
@@ -81,6 +119,10 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				mcp.Description("Sort order"),
 				mcp.Enum("asc", "desc"),
 			),
+			mcp.WithNumber("maxFragments",
+				mcp.Description("Highlighted fragments to return per file, defaults to 3; 0 returns none"),
+				mcp.Min(0),
+			),
 			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -88,6 +130,15 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			for _, qualifier := range []string{"repo", "org", "path", "language"} {
+				value, err := OptionalParam[string](request, qualifier)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if value != "" {
+					query += fmt.Sprintf(" %s:%s", qualifier, value)
+				}
+			}
 			sort, err := OptionalParam[string](request, "sort")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -96,14 +147,21 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			// An explicit 0 turns text matches off, so only an absent value
+			// takes the default.
+			maxFragments, err := OptionalIntParamInRange(request, "maxFragments", defaultMaxCodeFragments, 0, math.MaxInt32)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
 			opts := &github.SearchOptions{
-				Sort:  sort,
-				Order: order,
+				Sort:      sort,
+				Order:     order,
+				TextMatch: maxFragments > 0,
 				ListOptions: github.ListOptions{
 					PerPage: pagination.perPage,
 					Page:    pagination.page,
@@ -129,7 +187,31 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				return mcp.NewToolResultError(fmt.Sprintf("failed to search code: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(result)
+			out := SearchCodeOutput{
+				TotalCount:        result.GetTotal(),
+				IncompleteResults: result.GetIncompleteResults(),
+				Items:             make([]CodeSearchItem, 0, len(result.CodeResults)),
+				NextPage:          resp.NextPage,
+				HasNextPage:       resp.NextPage != 0,
+			}
+			for _, code := range result.CodeResults {
+				item := CodeSearchItem{
+					Name:       code.GetName(),
+					Path:       code.GetPath(),
+					Repository: code.GetRepository().GetFullName(),
+					SHA:        code.GetSHA(),
+					HTMLURL:    code.GetHTMLURL(),
+				}
+				for _, match := range code.TextMatches {
+					if len(item.Fragments) == maxFragments {
+						break
+					}
+					item.Fragments = append(item.Fragments, match.GetFragment())
+				}
+				out.Items = append(out.Items, item)
+			}
+
+			r, err := json.Marshal(out)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -138,13 +220,50 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 		}
 }
 
+// AccountSearchItem is one user or organization in a search_users or
+// search_orgs result. The profile fields are only filled in when profiles
+// are requested.
+type AccountSearchItem struct {
+	Login    string `json:"login"`
+	ID       int64  `json:"id"`
+	Type     string `json:"type"`
+	HTMLURL  string `json:"html_url"`
+	Name     string `json:"name,omitempty"`
+	Company  string `json:"company,omitempty"`
+	Location string `json:"location,omitempty"`
+	Bio      string `json:"bio,omitempty"`
+}
+
+// SearchAccountsOutput is the result of search_users and search_orgs, with
+// the same paging fields as SearchIssuesOutput.
+type SearchAccountsOutput struct {
+	TotalCount        int                 `json:"total_count"`
+	IncompleteResults bool                `json:"incomplete_results"`
+	Items             []AccountSearchItem `json:"items"`
+	NextPage          int                 `json:"next_page,omitempty"`
+	HasNextPage       bool                `json:"has_next_page"`
+}
+
 // SearchUsers creates a tool to search for GitHub users.
 func SearchUsers(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("search_users",
-			mcp.WithDescription(t("TOOL_SEARCH_USERS_DESCRIPTION", "Search for GitHub users")),
+	return searchAccountsTool(getClient, "search_users", "user",
+		t("TOOL_SEARCH_USERS_DESCRIPTION", "Search for GitHub users by login, name, email, location or other profile fields"))
+}
+
+// SearchOrgs creates a tool to search for GitHub organizations.
+func SearchOrgs(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return searchAccountsTool(getClient, "search_orgs", "org",
+		t("TOOL_SEARCH_ORGS_DESCRIPTION", "Search for GitHub organizations by login, name, location or other profile fields"))
+}
+
+// searchAccountsTool builds search_users and search_orgs, which differ only
+// in the type qualifier added to the query.
+func searchAccountsTool(getClient GetClientFn, name, accountType, description string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(name,
+			mcp.WithDescription(description),
 			mcp.WithString("q",
 				mcp.Required(),
-				mcp.Description("Search query using GitHub users search syntax"),
+				mcp.Description("Search query using GitHub users search syntax, e.g. 'jane in:name location:berlin'"),
 			),
 			mcp.WithString("sort",
 				mcp.Description("Sort field by category"),
@@ -154,6 +273,9 @@ func SearchUsers(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 				mcp.Description("Sort order"),
 				mcp.Enum("asc", "desc"),
 			),
+			mcp.WithBoolean("includeProfiles",
+				mcp.Description("Fetch each result's name, company, location and bio to tell similar accounts apart; costs one request per result"),
+			),
 			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -161,6 +283,9 @@ func SearchUsers(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			if !strings.Contains(query, "type:") {
+				query += " type:" + accountType
+			}
 			sort, err := OptionalParam[string](request, "sort")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -169,6 +294,10 @@ func SearchUsers(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			includeProfiles, err := OptionalParam[bool](request, "includeProfiles")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -190,7 +319,7 @@ func SearchUsers(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 
 			result, resp, err := client.Search.Users(ctx, query, opts)
 			if err != nil {
-				return nil, fmt.Errorf("failed to search users: %w", err)
+				return nil, fmt.Errorf("failed to search %ss: %w", accountType, err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
@@ -199,10 +328,38 @@ func SearchUsers(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 				if err != nil {
 					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to search users: %s", string(body))), nil
+				return mcp.NewToolResultError(fmt.Sprintf("failed to search %ss: %s", accountType, string(body))), nil
 			}
 
-			r, err := json.Marshal(result)
+			out := SearchAccountsOutput{
+				TotalCount:        result.GetTotal(),
+				IncompleteResults: result.GetIncompleteResults(),
+				Items:             make([]AccountSearchItem, 0, len(result.Users)),
+				NextPage:          resp.NextPage,
+				HasNextPage:       resp.NextPage != 0,
+			}
+			for _, user := range result.Users {
+				if includeProfiles {
+					profile, resp, err := client.Users.Get(ctx, user.GetLogin())
+					if err != nil {
+						return nil, fmt.Errorf("failed to get profile of %s: %w", user.GetLogin(), err)
+					}
+					_ = resp.Body.Close()
+					user = profile
+				}
+				out.Items = append(out.Items, AccountSearchItem{
+					Login:    user.GetLogin(),
+					ID:       user.GetID(),
+					Type:     user.GetType(),
+					HTMLURL:  user.GetHTMLURL(),
+					Name:     user.GetName(),
+					Company:  user.GetCompany(),
+					Location: user.GetLocation(),
+					Bio:      user.GetBio(),
+				})
+			}
+
+			r, err := json.Marshal(out)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -168,8 +168,13 @@ func Test_SearchCode(t *testing.T) {
 	assert.Equal(t, "search_code", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "q")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.Contains(t, tool.InputSchema.Properties, "language")
 	assert.Contains(t, tool.InputSchema.Properties, "sort")
 	assert.Contains(t, tool.InputSchema.Properties, "order")
+	assert.Contains(t, tool.InputSchema.Properties, "maxFragments")
 	assert.Contains(t, tool.InputSchema.Properties, "perPage")
 	assert.Contains(t, tool.InputSchema.Properties, "page")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"q"})
@@ -185,6 +190,10 @@ func Test_SearchCode(t *testing.T) {
 				SHA:        github.Ptr("abc123def456"),
 				HTMLURL:    github.Ptr("https://github.com/owner/repo/blob/main/path/to/file1.go"),
 				Repository: &github.Repository{Name: github.Ptr("repo"), FullName: github.Ptr("owner/repo")},
+				TextMatches: []*github.TextMatch{
+					{Fragment: github.Ptr("fmt.Println(\"one\")")},
+					{Fragment: github.Ptr("fmt.Println(\"two\")")},
+				},
 			},
 			{
 				Name:       github.Ptr("file2.go"),
@@ -201,7 +210,7 @@ func Test_SearchCode(t *testing.T) {
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedResult *github.CodeSearchResult
+		expectedResult SearchCodeOutput
 		expectedErrMsg string
 	}{
 		{
@@ -210,7 +219,7 @@ func Test_SearchCode(t *testing.T) {
 				mock.WithRequestMatchHandler(
 					mock.GetSearchCode,
 					expectQueryParams(t, map[string]string{
-						"q":        "fmt.Println language:go",
+						"q":        "fmt.Println repo:owner/repo path:path/to language:go",
 						"sort":     "indexed",
 						"order":    "desc",
 						"page":     "1",
@@ -221,14 +230,37 @@ func Test_SearchCode(t *testing.T) {
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"q":       "fmt.Println language:go",
-				"sort":    "indexed",
-				"order":   "desc",
-				"page":    float64(1),
-				"perPage": float64(30),
+				"q":            "fmt.Println",
+				"repo":         "owner/repo",
+				"path":         "path/to",
+				"language":     "go",
+				"sort":         "indexed",
+				"order":        "desc",
+				"maxFragments": float64(1),
+				"page":         float64(1),
+				"perPage":      float64(30),
+			},
+			expectError: false,
+			expectedResult: SearchCodeOutput{
+				TotalCount: 2,
+				Items: []CodeSearchItem{
+					{
+						Name:       "file1.go",
+						Path:       "path/to/file1.go",
+						Repository: "owner/repo",
+						SHA:        "abc123def456",
+						HTMLURL:    "https://github.com/owner/repo/blob/main/path/to/file1.go",
+						Fragments:  []string{"fmt.Println(\"one\")"},
+					},
+					{
+						Name:       "file2.go",
+						Path:       "path/to/file2.go",
+						Repository: "owner/repo",
+						SHA:        "def456abc123",
+						HTMLURL:    "https://github.com/owner/repo/blob/main/path/to/file2.go",
+					},
+				},
 			},
-			expectError:    false,
-			expectedResult: mockSearchResult,
 		},
 		{
 			name: "code search with minimal parameters",
@@ -236,7 +268,7 @@ func Test_SearchCode(t *testing.T) {
 				mock.WithRequestMatchHandler(
 					mock.GetSearchCode,
 					expectQueryParams(t, map[string]string{
-						"q":        "fmt.Println language:go",
+						"q":        "fmt.Println org:github",
 						"page":     "1",
 						"per_page": "30",
 					}).andThen(
@@ -245,10 +277,66 @@ func Test_SearchCode(t *testing.T) {
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"q": "fmt.Println language:go",
+				"q":   "fmt.Println",
+				"org": "github",
+			},
+			expectError: false,
+			expectedResult: SearchCodeOutput{
+				TotalCount: 2,
+				Items: []CodeSearchItem{
+					{
+						Name:       "file1.go",
+						Path:       "path/to/file1.go",
+						Repository: "owner/repo",
+						SHA:        "abc123def456",
+						HTMLURL:    "https://github.com/owner/repo/blob/main/path/to/file1.go",
+						Fragments:  []string{"fmt.Println(\"one\")", "fmt.Println(\"two\")"},
+					},
+					{
+						Name:       "file2.go",
+						Path:       "path/to/file2.go",
+						Repository: "owner/repo",
+						SHA:        "def456abc123",
+						HTMLURL:    "https://github.com/owner/repo/blob/main/path/to/file2.go",
+					},
+				},
+			},
+		},
+		{
+			name: "code search without fragments",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchCode,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.NotContains(t, r.Header.Get("Accept"), "text-match")
+						mockResponse(t, http.StatusOK, mockSearchResult)(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"q":            "fmt.Println",
+				"maxFragments": float64(0),
+			},
+			expectError: false,
+			expectedResult: SearchCodeOutput{
+				TotalCount: 2,
+				Items: []CodeSearchItem{
+					{
+						Name:       "file1.go",
+						Path:       "path/to/file1.go",
+						Repository: "owner/repo",
+						SHA:        "abc123def456",
+						HTMLURL:    "https://github.com/owner/repo/blob/main/path/to/file1.go",
+					},
+					{
+						Name:       "file2.go",
+						Path:       "path/to/file2.go",
+						Repository: "owner/repo",
+						SHA:        "def456abc123",
+						HTMLURL:    "https://github.com/owner/repo/blob/main/path/to/file2.go",
+					},
+				},
 			},
-			expectError:    false,
-			expectedResult: mockSearchResult,
 		},
 		{
 			name: "search code fails",
@@ -294,19 +382,10 @@ func Test_SearchCode(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedResult github.CodeSearchResult
+			var returnedResult SearchCodeOutput
 			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
 			require.NoError(t, err)
-			assert.Equal(t, *tc.expectedResult.Total, *returnedResult.Total)
-			assert.Equal(t, *tc.expectedResult.IncompleteResults, *returnedResult.IncompleteResults)
-			assert.Len(t, returnedResult.CodeResults, len(tc.expectedResult.CodeResults))
-			for i, code := range returnedResult.CodeResults {
-				assert.Equal(t, *tc.expectedResult.CodeResults[i].Name, *code.Name)
-				assert.Equal(t, *tc.expectedResult.CodeResults[i].Path, *code.Path)
-				assert.Equal(t, *tc.expectedResult.CodeResults[i].SHA, *code.SHA)
-				assert.Equal(t, *tc.expectedResult.CodeResults[i].HTMLURL, *code.HTMLURL)
-				assert.Equal(t, *tc.expectedResult.CodeResults[i].Repository.FullName, *code.Repository.FullName)
-			}
+			assert.Equal(t, tc.expectedResult, returnedResult)
 		})
 	}
 }
@@ -321,6 +400,7 @@ func Test_SearchUsers(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "q")
 	assert.Contains(t, tool.InputSchema.Properties, "sort")
 	assert.Contains(t, tool.InputSchema.Properties, "order")
+	assert.Contains(t, tool.InputSchema.Properties, "includeProfiles")
 	assert.Contains(t, tool.InputSchema.Properties, "perPage")
 	assert.Contains(t, tool.InputSchema.Properties, "page")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"q"})
@@ -351,12 +431,20 @@ func Test_SearchUsers(t *testing.T) {
 		},
 	}
 
+	expectedResult := SearchAccountsOutput{
+		TotalCount: 2,
+		Items: []AccountSearchItem{
+			{Login: "user1", ID: 1001, Type: "User", HTMLURL: "https://github.com/user1"},
+			{Login: "user2", ID: 1002, Type: "User", HTMLURL: "https://github.com/user2"},
+		},
+	}
+
 	tests := []struct {
 		name           string
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedResult *github.UsersSearchResult
+		expectedResult SearchAccountsOutput
 		expectedErrMsg string
 	}{
 		{
@@ -365,7 +453,7 @@ func Test_SearchUsers(t *testing.T) {
 				mock.WithRequestMatchHandler(
 					mock.GetSearchUsers,
 					expectQueryParams(t, map[string]string{
-						"q":        "location:finland language:go",
+						"q":        "location:finland language:go type:user",
 						"sort":     "followers",
 						"order":    "desc",
 						"page":     "1",
@@ -383,7 +471,7 @@ func Test_SearchUsers(t *testing.T) {
 				"perPage": float64(30),
 			},
 			expectError:    false,
-			expectedResult: mockSearchResult,
+			expectedResult: expectedResult,
 		},
 		{
 			name: "users search with minimal parameters",
@@ -391,7 +479,7 @@ func Test_SearchUsers(t *testing.T) {
 				mock.WithRequestMatchHandler(
 					mock.GetSearchUsers,
 					expectQueryParams(t, map[string]string{
-						"q":        "location:finland language:go",
+						"q":        "type:user location:finland",
 						"page":     "1",
 						"per_page": "30",
 					}).andThen(
@@ -400,10 +488,10 @@ func Test_SearchUsers(t *testing.T) {
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"q": "location:finland language:go",
+				"q": "type:user location:finland",
 			},
 			expectError:    false,
-			expectedResult: mockSearchResult,
+			expectedResult: expectedResult,
 		},
 		{
 			name: "search users fails",
@@ -451,20 +539,68 @@ func Test_SearchUsers(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedResult github.UsersSearchResult
+			var returnedResult SearchAccountsOutput
 			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
 			require.NoError(t, err)
-			assert.Equal(t, *tc.expectedResult.Total, *returnedResult.Total)
-			assert.Equal(t, *tc.expectedResult.IncompleteResults, *returnedResult.IncompleteResults)
-			assert.Len(t, returnedResult.Users, len(tc.expectedResult.Users))
-			for i, user := range returnedResult.Users {
-				assert.Equal(t, *tc.expectedResult.Users[i].Login, *user.Login)
-				assert.Equal(t, *tc.expectedResult.Users[i].ID, *user.ID)
-				assert.Equal(t, *tc.expectedResult.Users[i].HTMLURL, *user.HTMLURL)
-				assert.Equal(t, *tc.expectedResult.Users[i].AvatarURL, *user.AvatarURL)
-				assert.Equal(t, *tc.expectedResult.Users[i].Type, *user.Type)
-				assert.Equal(t, *tc.expectedResult.Users[i].Followers, *user.Followers)
-			}
+			assert.Equal(t, tc.expectedResult, returnedResult)
 		})
 	}
 }
+
+func Test_SearchOrgs(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SearchOrgs(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "search_orgs", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "q")
+	assert.Contains(t, tool.InputSchema.Properties, "includeProfiles")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"q"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetSearchUsers,
+			expectQueryParams(t, map[string]string{
+				"q":        "acme in:name type:org",
+				"page":     "1",
+				"per_page": "30",
+			}).andThen(
+				mockResponse(t, http.StatusOK, &github.UsersSearchResult{
+					Total: github.Ptr(1),
+					Users: []*github.User{
+						{Login: github.Ptr("acme"), ID: github.Ptr(int64(42)), Type: github.Ptr("Organization")},
+					},
+				}),
+			),
+		),
+		mock.WithRequestMatch(
+			mock.GetUsersByUsername,
+			&github.User{
+				Login:    github.Ptr("acme"),
+				ID:       github.Ptr(int64(42)),
+				Type:     github.Ptr("Organization"),
+				HTMLURL:  github.Ptr("https://github.com/acme"),
+				Name:     github.Ptr("Acme Corp"),
+				Location: github.Ptr("Springfield"),
+			},
+		),
+	))
+	_, handler := SearchOrgs(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"q":               "acme in:name",
+		"includeProfiles": true,
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returnedResult SearchAccountsOutput
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returnedResult))
+	assert.Equal(t, SearchAccountsOutput{
+		TotalCount: 1,
+		Items: []AccountSearchItem{
+			{Login: "acme", ID: 42, Type: "Organization", HTMLURL: "https://github.com/acme", Name: "Acme Corp", Location: "Springfield"},
+		},
+	}, returnedResult)
+}
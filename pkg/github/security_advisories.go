@@ -0,0 +1,172 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// AdvisoryPackage is a package an advisory affects, with the versions
+// involved.
+type AdvisoryPackage struct {
+	Ecosystem          string `json:"ecosystem"`
+	Name               string `json:"name"`
+	VulnerableVersions string `json:"vulnerable_versions,omitempty"`
+	PatchedVersions    string `json:"patched_versions,omitempty"`
+}
+
+// RepositoryAdvisorySummary is one advisory in a
+// list_repository_security_advisories result.
+type RepositoryAdvisorySummary struct {
+	GHSAID      string            `json:"ghsa_id"`
+	CVEID       string            `json:"cve_id,omitempty"`
+	Summary     string            `json:"summary"`
+	Severity    string            `json:"severity"`
+	State       string            `json:"state"`
+	Packages    []AdvisoryPackage `json:"packages,omitempty"`
+	HTMLURL     string            `json:"html_url"`
+	CreatedAt   *time.Time        `json:"created_at,omitempty"`
+	PublishedAt *time.Time        `json:"published_at,omitempty"`
+}
+
+// ListRepositoryAdvisoriesOutput is the result of
+// list_repository_security_advisories, paged by cursor like
+// ListDependabotAlertsOutput.
+type ListRepositoryAdvisoriesOutput struct {
+	Advisories  []RepositoryAdvisorySummary `json:"advisories"`
+	EndCursor   string                      `json:"end_cursor,omitempty"`
+	HasNextPage bool                        `json:"has_next_page"`
+}
+
+// ListRepositorySecurityAdvisories creates a tool to list the security
+// advisories a repository has drafted or published.
+func ListRepositorySecurityAdvisories(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repository_security_advisories",
+			mcp.WithDescription(t("TOOL_LIST_REPOSITORY_SECURITY_ADVISORIES_DESCRIPTION", "List the security advisories of a repository, including drafts and ones in triage you can see")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("state",
+				mcp.Description("Only advisories in this state"),
+				mcp.Enum("triage", "draft", "published", "closed"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort field, defaults to created"),
+				mcp.Enum("created", "updated", "published"),
+			),
+			mcp.WithString("direction",
+				mcp.Description("Sort direction, defaults to desc"),
+				mcp.Enum("asc", "desc"),
+			),
+			mcp.WithNumber("perPage",
+				mcp.Description("Results per page (max 100)"),
+				mcp.Min(1),
+				mcp.Max(100),
+			),
+			mcp.WithString("after",
+				mcp.Description("Cursor from a previous end_cursor to get the next page"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			perPage, err := OptionalIntParamInRange(request, "perPage", 30, 1, 100)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			after, err := OptionalParam[string](request, "after")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts := &github.ListRepositorySecurityAdvisoriesOptions{
+				ListCursorOptions: github.ListCursorOptions{
+					PerPage: perPage,
+					After:   after,
+				},
+			}
+			for param, field := range map[string]*string{
+				"state":     &opts.State,
+				"sort":      &opts.Sort,
+				"direction": &opts.Direction,
+			} {
+				if *field, err = OptionalParam[string](request, param); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			advisories, resp, err := client.SecurityAdvisories.ListRepositorySecurityAdvisories(ctx, owner, repo, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list security advisories: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list security advisories: %s", string(body))), nil
+			}
+
+			out := ListRepositoryAdvisoriesOutput{
+				Advisories:  make([]RepositoryAdvisorySummary, 0, len(advisories)),
+				EndCursor:   resp.After,
+				HasNextPage: resp.After != "",
+			}
+			for _, a := range advisories {
+				summary := RepositoryAdvisorySummary{
+					GHSAID:   a.GetGHSAID(),
+					CVEID:    a.GetCVEID(),
+					Summary:  a.GetSummary(),
+					Severity: a.GetSeverity(),
+					State:    a.GetState(),
+					HTMLURL:  a.GetHTMLURL(),
+				}
+				for _, v := range a.Vulnerabilities {
+					summary.Packages = append(summary.Packages, AdvisoryPackage{
+						Ecosystem:          v.GetPackage().GetEcosystem(),
+						Name:               v.GetPackage().GetName(),
+						VulnerableVersions: v.GetVulnerableVersionRange(),
+						PatchedVersions:    v.GetPatchedVersions(),
+					})
+				}
+				if a.CreatedAt != nil {
+					summary.CreatedAt = &a.CreatedAt.Time
+				}
+				if a.PublishedAt != nil {
+					summary.PublishedAt = &a.PublishedAt.Time
+				}
+				out.Advisories = append(out.Advisories, summary)
+			}
+
+			r, err := json.Marshal(out)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,80 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListRepositorySecurityAdvisories(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRepositorySecurityAdvisories(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_repository_security_advisories", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "state")
+	assert.Contains(t, tool.InputSchema.Properties, "after")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposSecurityAdvisoriesByOwnerByRepo,
+			expectQueryParams(t, map[string]string{
+				"state":    "published",
+				"per_page": "30",
+			}).andThen(
+				mockResponse(t, http.StatusOK, []*github.SecurityAdvisory{
+					{
+						GHSAID:   github.Ptr("GHSA-abcd-1234-efgh"),
+						CVEID:    github.Ptr("CVE-2050-00000"),
+						Summary:  github.Ptr("Path traversal in archive extraction"),
+						Severity: github.Ptr("high"),
+						State:    github.Ptr("published"),
+						HTMLURL:  github.Ptr("https://github.com/owner/repo/security/advisories/GHSA-abcd-1234-efgh"),
+						Vulnerabilities: []*github.AdvisoryVulnerability{
+							{
+								Package:                &github.VulnerabilityPackage{Ecosystem: github.Ptr("go"), Name: github.Ptr("github.com/owner/repo")},
+								VulnerableVersionRange: github.Ptr("< 1.4.2"),
+								PatchedVersions:        github.Ptr("1.4.2"),
+							},
+						},
+					},
+				}),
+			),
+		),
+	))
+	_, handler := ListRepositorySecurityAdvisories(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+		"state": "published",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned ListRepositoryAdvisoriesOutput
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, ListRepositoryAdvisoriesOutput{
+		Advisories: []RepositoryAdvisorySummary{
+			{
+				GHSAID:   "GHSA-abcd-1234-efgh",
+				CVEID:    "CVE-2050-00000",
+				Summary:  "Path traversal in archive extraction",
+				Severity: "high",
+				State:    "published",
+				Packages: []AdvisoryPackage{
+					{Ecosystem: "go", Name: "github.com/owner/repo", VulnerableVersions: "< 1.4.2", PatchedVersions: "1.4.2"},
+				},
+				HTMLURL: "https://github.com/owner/repo/security/advisories/GHSA-abcd-1234-efgh",
+			},
+		},
+	}, returned)
+}
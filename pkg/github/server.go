@@ -3,6 +3,7 @@ package github
 import (
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/google/go-github/v69/github"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -33,7 +34,7 @@ func NewServer(version string, opts ...server.ServerOption) *server.MCPServer {
 // It returns the value, a boolean indicating if the parameter was present, and an error if the type is wrong.
 func OptionalParamOK[T any](r mcp.CallToolRequest, p string) (value T, ok bool, err error) {
 	// Check if the parameter is present in the request
-	val, exists := r.Params.Arguments[p]
+	val, exists := r.GetArguments()[p]
 	if !exists {
 		// Not present, return zero value, false, no error
 		return
@@ -68,21 +69,21 @@ func requiredParam[T comparable](r mcp.CallToolRequest, p string) (T, error) {
 	var zero T
 
 	// Check if the parameter is present in the request
-	if _, ok := r.Params.Arguments[p]; !ok {
+	if _, ok := r.GetArguments()[p]; !ok {
 		return zero, fmt.Errorf("missing required parameter: %s", p)
 	}
 
 	// Check if the parameter is of the expected type
-	if _, ok := r.Params.Arguments[p].(T); !ok {
+	if _, ok := r.GetArguments()[p].(T); !ok {
 		return zero, fmt.Errorf("parameter %s is not of type %T", p, zero)
 	}
 
-	if r.Params.Arguments[p].(T) == zero {
+	if r.GetArguments()[p].(T) == zero {
 		return zero, fmt.Errorf("missing required parameter: %s", p)
 
 	}
 
-	return r.Params.Arguments[p].(T), nil
+	return r.GetArguments()[p].(T), nil
 }
 
 // RequiredInt is a helper function that can be used to fetch a requested parameter from the request.
@@ -95,6 +96,15 @@ func RequiredInt(r mcp.CallToolRequest, p string) (int, error) {
 	if err != nil {
 		return 0, err
 	}
+	return toInt(p, v)
+}
+
+// toInt converts the number passed for parameter p to an int, rejecting
+// fractions rather than truncating them.
+func toInt(p string, v float64) (int, error) {
+	if v != math.Trunc(v) {
+		return 0, fmt.Errorf("parameter %s must be an integer, is %v", p, v)
+	}
 	return int(v), nil
 }
 
@@ -106,16 +116,16 @@ func OptionalParam[T any](r mcp.CallToolRequest, p string) (T, error) {
 	var zero T
 
 	// Check if the parameter is present in the request
-	if _, ok := r.Params.Arguments[p]; !ok {
+	if _, ok := r.GetArguments()[p]; !ok {
 		return zero, nil
 	}
 
 	// Check if the parameter is of the expected type
-	if _, ok := r.Params.Arguments[p].(T); !ok {
-		return zero, fmt.Errorf("parameter %s is not of type %T, is %T", p, zero, r.Params.Arguments[p])
+	if _, ok := r.GetArguments()[p].(T); !ok {
+		return zero, fmt.Errorf("parameter %s is not of type %T, is %T", p, zero, r.GetArguments()[p])
 	}
 
-	return r.Params.Arguments[p].(T), nil
+	return r.GetArguments()[p].(T), nil
 }
 
 // OptionalIntParam is a helper function that can be used to fetch a requested parameter from the request.
@@ -127,7 +137,7 @@ func OptionalIntParam(r mcp.CallToolRequest, p string) (int, error) {
 	if err != nil {
 		return 0, err
 	}
-	return int(v), nil
+	return toInt(p, v)
 }
 
 // OptionalIntParamWithDefault is a helper function that can be used to fetch a requested parameter from the request
@@ -143,17 +153,40 @@ func OptionalIntParamWithDefault(r mcp.CallToolRequest, p string, d int) (int, e
 	return v, nil
 }
 
+// OptionalIntParamInRange is a helper function that can be used to fetch an integer parameter
+// bounded by the tool's schema, such as a page size.
+// It does the following checks:
+// 1. Checks if the parameter is present in the request, if not, it returns the default value
+// 2. If it is present, it checks that it is an integer between lo and hi inclusive and returns it
+func OptionalIntParamInRange(r mcp.CallToolRequest, p string, d, lo, hi int) (int, error) {
+	v, ok, err := OptionalParamOK[float64](r, p)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return d, nil
+	}
+	n, err := toInt(p, v)
+	if err != nil {
+		return 0, err
+	}
+	if n < lo || n > hi {
+		return 0, fmt.Errorf("parameter %s must be between %d and %d, is %d", p, lo, hi, n)
+	}
+	return n, nil
+}
+
 // OptionalStringArrayParam is a helper function that can be used to fetch a requested parameter from the request.
 // It does the following checks:
 // 1. Checks if the parameter is present in the request, if not, it returns its zero-value
 // 2. If it is present, iterates the elements and checks each is a string
 func OptionalStringArrayParam(r mcp.CallToolRequest, p string) ([]string, error) {
 	// Check if the parameter is present in the request
-	if _, ok := r.Params.Arguments[p]; !ok {
+	if _, ok := r.GetArguments()[p]; !ok {
 		return []string{}, nil
 	}
 
-	switch v := r.Params.Arguments[p].(type) {
+	switch v := r.GetArguments()[p].(type) {
 	case nil:
 		return []string{}, nil
 	case []string:
@@ -169,7 +202,7 @@ func OptionalStringArrayParam(r mcp.CallToolRequest, p string) ([]string, error)
 		}
 		return strSlice, nil
 	default:
-		return []string{}, fmt.Errorf("parameter %s could not be coerced to []string, is %T", p, r.Params.Arguments[p])
+		return []string{}, fmt.Errorf("parameter %s could not be coerced to []string, is %T", p, r.GetArguments()[p])
 	}
 }
 
@@ -197,15 +230,16 @@ type PaginationParams struct {
 
 // OptionalPaginationParams returns the "page" and "perPage" parameters from the request,
 // or their default values if not present, "page" default is 1, "perPage" default is 30.
+// Values outside the bounds declared by WithPagination are rejected.
 // In future, we may want to make the default values configurable, or even have this
 // function returned from `withPagination`, where the defaults are provided alongside
 // the min/max values.
 func OptionalPaginationParams(r mcp.CallToolRequest) (PaginationParams, error) {
-	page, err := OptionalIntParamWithDefault(r, "page", 1)
+	page, err := OptionalIntParamInRange(r, "page", 1, 1, math.MaxInt32)
 	if err != nil {
 		return PaginationParams{}, err
 	}
-	perPage, err := OptionalIntParamWithDefault(r, "perPage", 30)
+	perPage, err := OptionalIntParamInRange(r, "perPage", 30, 1, 100)
 	if err != nil {
 		return PaginationParams{}, err
 	}
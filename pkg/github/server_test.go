@@ -239,6 +239,13 @@ func Test_OptionalNumberParam(t *testing.T) {
 			expected:    0,
 			expectError: true,
 		},
+		{
+			name:        "fractional value",
+			params:      map[string]interface{}{"count": float64(1.5)},
+			paramName:   "count",
+			expected:    0,
+			expectError: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -435,6 +442,68 @@ func TestOptionalStringArrayParam(t *testing.T) {
 	}
 }
 
+func Test_OptionalNumberParamInRange(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      map[string]interface{}
+		expected    int
+		expectError bool
+	}{
+		{
+			name:        "valid number parameter",
+			params:      map[string]interface{}{"first": float64(42)},
+			expected:    42,
+			expectError: false,
+		},
+		{
+			name:        "missing parameter",
+			params:      map[string]interface{}{},
+			expected:    30,
+			expectError: false,
+		},
+		{
+			name:        "upper bound",
+			params:      map[string]interface{}{"first": float64(100)},
+			expected:    100,
+			expectError: false,
+		},
+		{
+			name:        "zero value",
+			params:      map[string]interface{}{"first": float64(0)},
+			expectError: true,
+		},
+		{
+			name:        "above maximum",
+			params:      map[string]interface{}{"first": float64(101)},
+			expectError: true,
+		},
+		{
+			name:        "fractional value",
+			params:      map[string]interface{}{"first": float64(2.5)},
+			expectError: true,
+		},
+		{
+			name:        "wrong type parameter",
+			params:      map[string]interface{}{"first": "ten"},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			request := createMCPRequest(tc.params)
+			result, err := OptionalIntParamInRange(request, "first", 30, 1, 100)
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
+
 func TestOptionalPaginationParams(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -501,6 +570,22 @@ func TestOptionalPaginationParams(t *testing.T) {
 			expected:    PaginationParams{},
 			expectError: true,
 		},
+		{
+			name: "page below minimum",
+			params: map[string]any{
+				"page": float64(0),
+			},
+			expected:    PaginationParams{},
+			expectError: true,
+		},
+		{
+			name: "perPage above maximum",
+			params: map[string]any{
+				"perPage": float64(500),
+			},
+			expected:    PaginationParams{},
+			expectError: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,256 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// go-github v69 predates the sub-issues API, so these tools build the REST
+// requests themselves with client.NewRequest and client.Do.
+
+// subIssueRequest is the body accepted by the sub-issue write endpoints.
+type subIssueRequest struct {
+	SubIssueID    int64  `json:"sub_issue_id"`
+	ReplaceParent *bool  `json:"replace_parent,omitempty"`
+	AfterID       *int64 `json:"after_id,omitempty"`
+	BeforeID      *int64 `json:"before_id,omitempty"`
+}
+
+// withSubIssueTarget adds the owner, repo and parent issue_number parameters
+// shared by all sub-issue tools.
+func withSubIssueTarget() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner"),
+		)(tool)
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		)(tool)
+		mcp.WithNumber("issue_number",
+			mcp.Required(),
+			mcp.Description("Number of the parent issue"),
+		)(tool)
+	}
+}
+
+// subIssueTarget reads the parameters added by withSubIssueTarget.
+func subIssueTarget(request mcp.CallToolRequest) (owner, repo string, issueNumber int, err error) {
+	if owner, err = requiredParam[string](request, "owner"); err != nil {
+		return "", "", 0, err
+	}
+	if repo, err = requiredParam[string](request, "repo"); err != nil {
+		return "", "", 0, err
+	}
+	if issueNumber, err = RequiredInt(request, "issue_number"); err != nil {
+		return "", "", 0, err
+	}
+	return owner, repo, issueNumber, nil
+}
+
+// optionalInt64Param returns a pointer to the named number parameter, or nil
+// if it was not provided.
+func optionalInt64Param(request mcp.CallToolRequest, p string) (*int64, error) {
+	v, ok, err := OptionalParamOK[float64](request, p)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return github.Ptr(int64(v)), nil
+}
+
+// ListSubIssues creates a tool to list the sub-issues of an issue.
+func ListSubIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_sub_issues",
+			mcp.WithDescription(t("TOOL_LIST_SUB_ISSUES_DESCRIPTION", "List the sub-issues of an issue in a GitHub repository")),
+			withSubIssueTarget(),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, issueNumber, err := subIssueTarget(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			u := fmt.Sprintf("repos/%s/%s/issues/%d/sub_issues?page=%d&per_page=%d",
+				owner, repo, issueNumber, pagination.page, pagination.perPage)
+			req, err := client.NewRequest(http.MethodGet, u, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+			var issues []*github.Issue
+			resp, err := client.Do(ctx, req, &issues)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list sub-issues: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list sub-issues: %s", string(body))), nil
+			}
+
+			out := ListIssuesOutput{
+				Issues:      issues,
+				NextPage:    resp.NextPage,
+				HasNextPage: resp.NextPage != 0,
+			}
+			r, err := json.Marshal(out)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// AddSubIssue creates a tool to add a sub-issue to an issue.
+func AddSubIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_sub_issue",
+			mcp.WithDescription(t("TOOL_ADD_SUB_ISSUE_DESCRIPTION", "Add an existing issue as a sub-issue of another issue")),
+			withSubIssueTarget(),
+			mcp.WithNumber("sub_issue_id",
+				mcp.Required(),
+				mcp.Description("ID of the issue to add as a sub-issue (the issue's id, not its number)"),
+			),
+			mcp.WithBoolean("replace_parent",
+				mcp.Description("Move the sub-issue from its current parent instead of failing if it already has one"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			body, err := subIssueBody(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			replaceParent, ok, err := OptionalParamOK[bool](request, "replace_parent")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if ok {
+				body.ReplaceParent = github.Ptr(replaceParent)
+			}
+			return doSubIssueWrite(ctx, getClient, request, http.MethodPost, "sub_issues", body, http.StatusCreated, "add sub-issue")
+		}
+}
+
+// RemoveSubIssue creates a tool to remove a sub-issue from an issue.
+func RemoveSubIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("remove_sub_issue",
+			mcp.WithDescription(t("TOOL_REMOVE_SUB_ISSUE_DESCRIPTION", "Remove a sub-issue from its parent issue. The sub-issue itself is not deleted")),
+			withSubIssueTarget(),
+			mcp.WithNumber("sub_issue_id",
+				mcp.Required(),
+				mcp.Description("ID of the sub-issue to remove (the issue's id, not its number)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			body, err := subIssueBody(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			return doSubIssueWrite(ctx, getClient, request, http.MethodDelete, "sub_issue", body, http.StatusOK, "remove sub-issue")
+		}
+}
+
+// ReprioritizeSubIssue creates a tool to change the position of a sub-issue
+// within its parent's list.
+func ReprioritizeSubIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("reprioritize_sub_issue",
+			mcp.WithDescription(t("TOOL_REPRIORITIZE_SUB_ISSUE_DESCRIPTION", "Move a sub-issue before or after another sub-issue of the same parent")),
+			withSubIssueTarget(),
+			mcp.WithNumber("sub_issue_id",
+				mcp.Required(),
+				mcp.Description("ID of the sub-issue to move (the issue's id, not its number)"),
+			),
+			mcp.WithNumber("after_id",
+				mcp.Description("ID of the sub-issue to place it after. Exactly one of after_id and before_id is required"),
+			),
+			mcp.WithNumber("before_id",
+				mcp.Description("ID of the sub-issue to place it before. Exactly one of after_id and before_id is required"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			body, err := subIssueBody(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if body.AfterID, err = optionalInt64Param(request, "after_id"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if body.BeforeID, err = optionalInt64Param(request, "before_id"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if (body.AfterID == nil) == (body.BeforeID == nil) {
+				return mcp.NewToolResultError("exactly one of after_id and before_id must be provided"), nil
+			}
+			return doSubIssueWrite(ctx, getClient, request, http.MethodPatch, "sub_issues/priority", body, http.StatusOK, "reprioritize sub-issue")
+		}
+}
+
+// subIssueBody reads the sub_issue_id parameter into a request body.
+func subIssueBody(request mcp.CallToolRequest) (*subIssueRequest, error) {
+	id, err := requiredParam[float64](request, "sub_issue_id")
+	if err != nil {
+		return nil, err
+	}
+	return &subIssueRequest{SubIssueID: int64(id)}, nil
+}
+
+// doSubIssueWrite sends body to the given sub-issue endpoint of the parent
+// issue and returns the updated parent issue.
+func doSubIssueWrite(ctx context.Context, getClient GetClientFn, request mcp.CallToolRequest, method, path string, body *subIssueRequest, wantStatus int, action string) (*mcp.CallToolResult, error) {
+	owner, repo, issueNumber, err := subIssueTarget(request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	client, err := getClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+	}
+	u := fmt.Sprintf("repos/%s/%s/issues/%d/%s", owner, repo, issueNumber, path)
+	req, err := client.NewRequest(method, u, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	issue := new(github.Issue)
+	resp, err := client.Do(ctx, req, issue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s: %w", action, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != wantStatus {
+		respBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+		return mcp.NewToolResultError(fmt.Sprintf("failed to %s: %s", action, string(respBody))), nil
+	}
+
+	r, err := json.Marshal(issue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(r)), nil
+}
@@ -0,0 +1,187 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	getSubIssues = mock.EndpointPattern{
+		Pattern: "/repos/{owner}/{repo}/issues/{issue_number}/sub_issues",
+		Method:  "GET",
+	}
+	postSubIssue = mock.EndpointPattern{
+		Pattern: "/repos/{owner}/{repo}/issues/{issue_number}/sub_issues",
+		Method:  "POST",
+	}
+	deleteSubIssue = mock.EndpointPattern{
+		Pattern: "/repos/{owner}/{repo}/issues/{issue_number}/sub_issue",
+		Method:  "DELETE",
+	}
+	patchSubIssuePriority = mock.EndpointPattern{
+		Pattern: "/repos/{owner}/{repo}/issues/{issue_number}/sub_issues/priority",
+		Method:  "PATCH",
+	}
+)
+
+func Test_ListSubIssues(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListSubIssues(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_sub_issues", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
+
+	mockIssues := []*github.Issue{
+		{ID: github.Ptr(int64(1001)), Number: github.Ptr(2), Title: github.Ptr("Child one")},
+		{ID: github.Ptr(int64(1002)), Number: github.Ptr(3), Title: github.Ptr("Child two")},
+	}
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			getSubIssues,
+			expectQueryParams(t, map[string]string{
+				"page":     "2",
+				"per_page": "10",
+			}).andThen(
+				mockResponse(t, http.StatusOK, mockIssues),
+			),
+		),
+	))
+	_, handler := ListSubIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":        "owner",
+		"repo":         "repo",
+		"issue_number": float64(1),
+		"page":         float64(2),
+		"perPage":      float64(10),
+	}))
+	require.NoError(t, err)
+
+	var out ListIssuesOutput
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &out))
+	require.Len(t, out.Issues, 2)
+	assert.Equal(t, int64(1002), out.Issues[1].GetID())
+	assert.False(t, out.HasNextPage)
+}
+
+func Test_AddSubIssue(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := AddSubIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "add_sub_issue", tool.Name)
+	assert.Contains(t, tool.InputSchema.Properties, "replace_parent")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number", "sub_issue_id"})
+
+	parent := &github.Issue{Number: github.Ptr(1), Title: github.Ptr("Parent")}
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			postSubIssue,
+			expectRequestBody(t, map[string]any{
+				"sub_issue_id":   float64(1001),
+				"replace_parent": true,
+			}).andThen(
+				mockResponse(t, http.StatusCreated, parent),
+			),
+		),
+	))
+	_, handler := AddSubIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":          "owner",
+		"repo":           "repo",
+		"issue_number":   float64(1),
+		"sub_issue_id":   float64(1001),
+		"replace_parent": true,
+	}))
+	require.NoError(t, err)
+
+	var returned github.Issue
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, "Parent", returned.GetTitle())
+}
+
+func Test_RemoveSubIssue(t *testing.T) {
+	parent := &github.Issue{Number: github.Ptr(1), Title: github.Ptr("Parent")}
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			deleteSubIssue,
+			expectRequestBody(t, map[string]any{
+				"sub_issue_id": float64(1001),
+			}).andThen(
+				mockResponse(t, http.StatusOK, parent),
+			),
+		),
+	))
+	tool, handler := RemoveSubIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+	assert.Equal(t, "remove_sub_issue", tool.Name)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":        "owner",
+		"repo":         "repo",
+		"issue_number": float64(1),
+		"sub_issue_id": float64(1001),
+	}))
+	require.NoError(t, err)
+	assert.Contains(t, getTextResult(t, result).Text, `"title":"Parent"`)
+
+	result, err = handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":        "owner",
+		"repo":         "repo",
+		"issue_number": float64(1),
+	}))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, getTextResult(t, result).Text, "sub_issue_id")
+}
+
+func Test_ReprioritizeSubIssue(t *testing.T) {
+	parent := &github.Issue{Number: github.Ptr(1), Title: github.Ptr("Parent")}
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			patchSubIssuePriority,
+			expectRequestBody(t, map[string]any{
+				"sub_issue_id": float64(1001),
+				"after_id":     float64(1002),
+			}).andThen(
+				mockResponse(t, http.StatusOK, parent),
+			),
+		),
+	))
+	tool, handler := ReprioritizeSubIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+	assert.Equal(t, "reprioritize_sub_issue", tool.Name)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":        "owner",
+		"repo":         "repo",
+		"issue_number": float64(1),
+		"sub_issue_id": float64(1001),
+		"after_id":     float64(1002),
+	}))
+	require.NoError(t, err)
+	assert.False(t, result.IsError)
+
+	for _, args := range []map[string]interface{}{
+		{"after_id": float64(1002), "before_id": float64(1003)},
+		{},
+	} {
+		args["owner"] = "owner"
+		args["repo"] = "repo"
+		args["issue_number"] = float64(1)
+		args["sub_issue_id"] = float64(1001)
+		result, err := handler(context.Background(), createMCPRequest(args))
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "exactly one of after_id and before_id")
+	}
+}
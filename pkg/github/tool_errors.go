@@ -0,0 +1,162 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/toolsets"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Error codes reported in ToolError.Code.
+const (
+	ErrorCodeNotFound    = "not_found"
+	ErrorCodeForbidden   = "forbidden"
+	ErrorCodeRateLimited = "rate_limited"
+	ErrorCodeValidation  = "validation"
+	ErrorCodeInternal    = "internal"
+	// ErrorCodeNotConfirmed is reported for destructive tools the user did
+	// not confirm; see RequireConfirmation.
+	ErrorCodeNotConfirmed = "not_confirmed"
+)
+
+// ToolError is the payload of a failed tool call, so clients can tell missing
+// resources, permission problems, rate limits and bad input apart without
+// parsing messages.
+type ToolError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	// Parameter names the tool or API parameter at fault, when known.
+	Parameter string `json:"parameter,omitempty"`
+	// Hint suggests how to recover.
+	Hint string `json:"hint,omitempty"`
+}
+
+// Result returns e as a tool error result.
+func (e ToolError) Result() *mcp.CallToolResult {
+	r, err := json.Marshal(e)
+	if err != nil {
+		return mcp.NewToolResultError(e.Message)
+	}
+	return mcp.NewToolResultError(string(r))
+}
+
+// newServerTool is toolsets.NewServerTool for this package's tools: failures
+// reach the client as ToolError results, and every call is traced and
+// logged.
+func newServerTool(tool mcp.Tool, handler server.ToolHandlerFunc) server.ServerTool {
+	return toolsets.NewServerTool(tool, withCallTracing(tool.Name, withCallLogging(tool.Name, withToolErrors(handler))))
+}
+
+// newReadServerTool is newServerTool for tools that only read, whose outputs
+// are also kept within the call's response budget. Write tools must not use
+// it, since continuing a truncated output runs the handler again.
+func newReadServerTool(tool mcp.Tool, handler server.ToolHandlerFunc) server.ServerTool {
+	return newServerTool(withResponseBudgetParams(tool), withResponseBudget(handler))
+}
+
+// withToolErrors wraps a tool handler so that the Go errors it returns, and
+// the plain-text error results it builds, become ToolError results.
+func withToolErrors(handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		result, err := handler(ctx, request)
+		if err != nil {
+			return classifyError(err).Result(), nil
+		}
+		if result == nil || !result.IsError || len(result.Content) != 1 {
+			return result, nil
+		}
+		text, ok := result.Content[0].(mcp.TextContent)
+		if !ok || json.Valid([]byte(text.Text)) {
+			return result, nil
+		}
+		return classifyMessage(text.Text).Result(), nil
+	}
+}
+
+// parameterErrorPattern matches the messages of the parameter helpers in
+// server.go.
+var parameterErrorPattern = regexp.MustCompile(`^(?:missing required parameter: (\w+)|parameter (\w+) )`)
+
+// classifyMessage types an error message a handler reported itself. Those
+// are about the request, so they count as validation errors.
+func classifyMessage(message string) ToolError {
+	te := ToolError{Code: ErrorCodeValidation, Message: message}
+	if m := parameterErrorPattern.FindStringSubmatch(message); m != nil {
+		te.Parameter = m[1] + m[2]
+		te.Hint = fmt.Sprintf("Pass a valid %s; see the tool's input schema", te.Parameter)
+	}
+	return te
+}
+
+// classifyError types an error returned by a tool handler, looking through
+// its wrapping for the go-github error types and at the messages of GraphQL
+// errors.
+func classifyError(err error) ToolError {
+	// Some handlers return the parameter helpers' errors as Go errors.
+	if parameterErrorPattern.MatchString(err.Error()) {
+		return classifyMessage(err.Error())
+	}
+
+	te := ToolError{Code: ErrorCodeInternal, Message: err.Error()}
+
+	var rateLimitErr *github.RateLimitError
+	var abuseErr *github.AbuseRateLimitError
+	var errResp *github.ErrorResponse
+	switch {
+	case errors.As(err, &rateLimitErr):
+		te.Code = ErrorCodeRateLimited
+		te.Hint = fmt.Sprintf("The rate limit resets at %s; retry after that", rateLimitErr.Rate.Reset.UTC().Format(time.RFC3339))
+	case errors.As(err, &abuseErr):
+		te.Code = ErrorCodeRateLimited
+		te.Hint = "A secondary rate limit was hit; slow down and retry later"
+		if retryAfter := abuseErr.GetRetryAfter(); retryAfter > 0 {
+			te.Hint = fmt.Sprintf("A secondary rate limit was hit; retry in %s", retryAfter)
+		}
+	case errors.As(err, &errResp) && errResp.Response != nil:
+		switch errResp.Response.StatusCode {
+		case http.StatusNotFound:
+			te.Code = ErrorCodeNotFound
+		case http.StatusUnauthorized, http.StatusForbidden:
+			te.Code = ErrorCodeForbidden
+		case http.StatusBadRequest, http.StatusUnprocessableEntity, http.StatusConflict:
+			te.Code = ErrorCodeValidation
+			if len(errResp.Errors) > 0 {
+				te.Parameter = errResp.Errors[0].Field
+			}
+		}
+	case strings.Contains(err.Error(), "github graphql error"):
+		msg := err.Error()
+		switch {
+		case isUnresolvedError(err), strings.Contains(msg, "NOT_FOUND"):
+			te.Code = ErrorCodeNotFound
+		case strings.Contains(msg, "not accessible"), strings.Contains(msg, "FORBIDDEN"), strings.Contains(msg, "INSUFFICIENT_SCOPES"):
+			te.Code = ErrorCodeForbidden
+		case strings.Contains(msg, "rate limit"), strings.Contains(msg, "RATE_LIMITED"):
+			te.Code = ErrorCodeRateLimited
+			te.Hint = "The GraphQL rate limit was hit; retry later"
+		}
+	}
+
+	switch te.Code {
+	case ErrorCodeNotFound:
+		te.Hint = "Check the owner, repository and numbers or IDs; private resources the token cannot see are also reported as not found"
+	case ErrorCodeForbidden:
+		te.Hint = "The token may lack a scope or permission for this; call check_token_permissions to see which"
+	case ErrorCodeValidation:
+		if te.Parameter != "" {
+			te.Hint = fmt.Sprintf("GitHub rejected the value of %s", te.Parameter)
+		} else {
+			te.Hint = "GitHub rejected the request; check the parameters against the message"
+		}
+	}
+	return te
+}
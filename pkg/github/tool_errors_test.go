@@ -0,0 +1,155 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_classifyError(t *testing.T) {
+	response := func(code int) *http.Response {
+		return &http.Response{StatusCode: code, Request: &http.Request{Method: http.MethodGet}}
+	}
+	reset := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	retryAfter := 30 * time.Second
+
+	tests := []struct {
+		name     string
+		err      error
+		expected ToolError
+	}{
+		{
+			name: "not found",
+			err:  fmt.Errorf("failed to get issue: %w", &github.ErrorResponse{Response: response(http.StatusNotFound), Message: "Not Found"}),
+			expected: ToolError{
+				Code: ErrorCodeNotFound,
+				Hint: "Check the owner, repository and numbers or IDs; private resources the token cannot see are also reported as not found",
+			},
+		},
+		{
+			name: "forbidden",
+			err:  fmt.Errorf("failed to create issue: %w", &github.ErrorResponse{Response: response(http.StatusForbidden), Message: "Resource not accessible"}),
+			expected: ToolError{
+				Code: ErrorCodeForbidden,
+				Hint: "The token may lack a scope or permission for this; call check_token_permissions to see which",
+			},
+		},
+		{
+			name: "validation with field",
+			err: fmt.Errorf("failed to create label: %w", &github.ErrorResponse{
+				Response: response(http.StatusUnprocessableEntity),
+				Message:  "Validation Failed",
+				Errors:   []github.Error{{Resource: "Label", Field: "color", Code: "invalid"}},
+			}),
+			expected: ToolError{Code: ErrorCodeValidation, Parameter: "color", Hint: "GitHub rejected the value of color"},
+		},
+		{
+			name:     "rate limited",
+			err:      fmt.Errorf("failed to list issues: %w", &github.RateLimitError{Rate: github.Rate{Reset: github.Timestamp{Time: reset}}, Response: response(http.StatusForbidden)}),
+			expected: ToolError{Code: ErrorCodeRateLimited, Hint: "The rate limit resets at 2025-06-01T12:00:00Z; retry after that"},
+		},
+		{
+			name:     "secondary rate limit",
+			err:      &github.AbuseRateLimitError{Response: response(http.StatusForbidden), RetryAfter: &retryAfter},
+			expected: ToolError{Code: ErrorCodeRateLimited, Hint: "A secondary rate limit was hit; retry in 30s"},
+		},
+		{
+			name: "graphql unresolved",
+			err:  fmt.Errorf("github graphql error: %w", errors.New("Could not resolve to a ProjectV2 with the number 9.")),
+			expected: ToolError{
+				Code: ErrorCodeNotFound,
+				Hint: "Check the owner, repository and numbers or IDs; private resources the token cannot see are also reported as not found",
+			},
+		},
+		{
+			name: "parameter",
+			err:  errors.New("parameter first must be between 1 and 100, is 500"),
+			expected: ToolError{
+				Code:      ErrorCodeValidation,
+				Parameter: "first",
+				Hint:      "Pass a valid first; see the tool's input schema",
+			},
+		},
+		{
+			name:     "other",
+			err:      errors.New("failed to marshal response: boom"),
+			expected: ToolError{Code: ErrorCodeInternal},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.expected.Message = tc.err.Error()
+			assert.Equal(t, tc.expected, classifyError(tc.err))
+		})
+	}
+}
+
+func Test_withToolErrors(t *testing.T) {
+	decode := func(t *testing.T, result *mcp.CallToolResult) ToolError {
+		t.Helper()
+		require.True(t, result.IsError)
+		var te ToolError
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &te))
+		return te
+	}
+
+	t.Run("missing parameter", func(t *testing.T) {
+		_, handler := GetIssue(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+		result, err := withToolErrors(handler)(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner": "owner",
+			"repo":  "repo",
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, ToolError{
+			Code:      ErrorCodeValidation,
+			Message:   "missing required parameter: issue_number",
+			Parameter: "issue_number",
+			Hint:      "Pass a valid issue_number; see the tool's input schema",
+		}, decode(t, result))
+	})
+
+	t.Run("not found from GitHub", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+				mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+			),
+		))
+		_, handler := GetIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+		result, err := withToolErrors(handler)(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner":        "owner",
+			"repo":         "repo",
+			"issue_number": float64(404),
+		}))
+		require.NoError(t, err)
+		te := decode(t, result)
+		assert.Equal(t, ErrorCodeNotFound, te.Code)
+		assert.Contains(t, te.Message, "failed to get issue")
+	})
+
+	t.Run("success is untouched", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(mock.GetReposIssuesByOwnerByRepoByIssueNumber, &github.Issue{Number: github.Ptr(1)}),
+		))
+		_, handler := GetIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+		result, err := withToolErrors(handler)(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner":        "owner",
+			"repo":         "repo",
+			"issue_number": float64(1),
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
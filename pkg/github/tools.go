@@ -1,8 +1,8 @@
 package github
 
 import (
-	ghv4 "github.com/shurcooL/githubv4"
 	"context"
+	ghv4 "github.com/shurcooL/githubv4"
 
 	"github.com/github/github-mcp-server/pkg/toolsets"
 	"github.com/github/github-mcp-server/pkg/translations"
@@ -12,6 +12,7 @@ import (
 
 // GetClientFn returns a GitHub REST API client.
 type GetClientFn func(context.Context) (*github.Client, error)
+
 // GetGraphQLClientFn returns a GitHub GraphQL API (Projects V2) client.
 type GetGraphQLClientFn func(context.Context) (*ghv4.Client, error)
 
@@ -21,79 +22,256 @@ func InitToolsets(passedToolsets []string, readOnly bool, getClient GetClientFn,
 	// Create a new toolset group
 	tsg := toolsets.NewToolsetGroup(readOnly)
 
+	// Classic token scopes the tools need; public_repo is enough for public
+	// repositories.
+	repoScopes := []string{"repo", "public_repo"}
+	securityScopes := []string{"security_events", "public_repo"}
+
 	// Define all available features with their default state (disabled)
 	// Create toolsets
 	repos := toolsets.NewToolset("repos", "GitHub Repository related tools").
 		AddReadTools(
-			toolsets.NewServerTool(SearchRepositories(getClient, t)),
-			toolsets.NewServerTool(GetFileContents(getClient, t)),
-			toolsets.NewServerTool(ListCommits(getClient, t)),
-			toolsets.NewServerTool(SearchCode(getClient, t)),
-			toolsets.NewServerTool(GetCommit(getClient, t)),
-			toolsets.NewServerTool(ListBranches(getClient, t)),
+			newReadServerTool(SearchRepositories(getClient, t)),
+			newReadServerTool(GetFileContents(getClient, t)),
+			newReadServerTool(ListDirectory(getClient, t)),
+			newReadServerTool(ListCommits(getClient, t)),
+			newReadServerTool(SearchCode(getClient, t)),
+			newReadServerTool(GetCommit(getClient, t)),
+			newReadServerTool(CompareCommits(getClient, t)),
+			newReadServerTool(ListBranches(getClient, t)),
+			newReadServerTool(GetBranchProtection(getClient, t)),
+			newReadServerTool(ListRepositoryRulesets(getClient, t)),
+			newReadServerTool(GetRepositoryRuleset(getClient, t)),
+			newReadServerTool(ListReleases(getClient, t)),
+			newReadServerTool(GetLatestRelease(getClient, t)),
+			newReadServerTool(ListTags(getClient, t)),
+			newReadServerTool(GetTag(getClient, t)),
+			newReadServerTool(ListCollaborators(getClient, t)),
+			newReadServerTool(GetCollaboratorPermission(getClient, t)),
+			newReadServerTool(ListRepositoryInvitations(getClient, t)),
 		).
 		AddWriteTools(
-			toolsets.NewServerTool(CreateOrUpdateFile(getClient, t)),
-			toolsets.NewServerTool(CreateRepository(getClient, t)),
-			toolsets.NewServerTool(ForkRepository(getClient, t)),
-			toolsets.NewServerTool(CreateBranch(getClient, t)),
-			toolsets.NewServerTool(PushFiles(getClient, t)),
-		)
+			newServerTool(CreateOrUpdateFile(getClient, t)),
+			newServerTool(DeleteFile(getClient, t)),
+			newServerTool(CreateRepository(getClient, t)),
+			newServerTool(UpdateRepositorySettings(getClient, t)),
+			newServerTool(ForkRepository(getClient, t)),
+			newServerTool(CreateBranch(getClient, t)),
+			newServerTool(UpdateBranchProtection(getClient, t)),
+			newServerTool(UpdateRepositoryRuleset(getClient, t)),
+			newServerTool(PushFiles(getClient, t)),
+			newServerTool(CreateRelease(getClient, t)),
+			newServerTool(UpdateRelease(getClient, t)),
+			newServerTool(UploadReleaseAsset(getClient, t)),
+			newServerTool(AddCollaborator(getClient, t)),
+			newServerTool(RemoveCollaborator(getClient, t)),
+			newServerTool(CancelRepositoryInvitation(getClient, t)),
+		).
+		RequireScopes(repoScopes, repoScopes)
 	issues := toolsets.NewToolset("issues", "GitHub Issues related tools").
 		AddReadTools(
-			toolsets.NewServerTool(GetIssue(getClient, t)),
-			toolsets.NewServerTool(SearchIssues(getClient, t)),
-			toolsets.NewServerTool(ListIssues(getClient, t)),
-			toolsets.NewServerTool(GetIssueComments(getClient, t)),
+			newReadServerTool(GetIssue(getClient, t)),
+			newReadServerTool(SearchIssues(getClient, t)),
+			newReadServerTool(ListIssues(getClient, t)),
+			newReadServerTool(GetIssueComments(getClient, t)),
+			newReadServerTool(ListSubIssues(getClient, t)),
+			newReadServerTool(ListIssueTypes(getClient, t)),
+			newReadServerTool(GetCopilotSessionStatus(getClient, t)),
+			newReadServerTool(ListLabels(getClient, t)),
+			newReadServerTool(ListMilestones(getClient, t)),
 		).
 		AddWriteTools(
-			toolsets.NewServerTool(CreateIssue(getClient, t)),
-			toolsets.NewServerTool(AddIssueComment(getClient, t)),
-			toolsets.NewServerTool(UpdateIssue(getClient, t)),
-		)
+			newServerTool(CreateIssue(getClient, t)),
+			newServerTool(AddIssueComment(getClient, t)),
+			newServerTool(UpdateIssue(getClient, t)),
+			newServerTool(AddSubIssue(getClient, t)),
+			newServerTool(RemoveSubIssue(getClient, t)),
+			newServerTool(ReprioritizeSubIssue(getClient, t)),
+			newServerTool(AddAssignees(getClient, t)),
+			newServerTool(RemoveAssignees(getClient, t)),
+			newServerTool(AddLabels(getClient, t)),
+			newServerTool(RemoveLabels(getClient, t)),
+			newServerTool(SetLabels(getClient, t)),
+			newServerTool(CreateLabel(getClient, t)),
+			newServerTool(CreateMilestone(getClient, t)),
+			newServerTool(UpdateMilestone(getClient, t)),
+			newServerTool(CloseMilestone(getClient, t)),
+			newServerTool(SetMilestone(getClient, t)),
+			newServerTool(PinIssue(getGraphQLClient, t)),
+			newServerTool(UnpinIssue(getGraphQLClient, t)),
+			newServerTool(AssignCopilotToIssue(getClient, t)),
+		).
+		RequireScopes(repoScopes, repoScopes)
 	users := toolsets.NewToolset("users", "GitHub User related tools").
 		AddReadTools(
-			toolsets.NewServerTool(SearchUsers(getClient, t)),
+			newReadServerTool(SearchUsers(getClient, t)),
+			newReadServerTool(SearchOrgs(getClient, t)),
 		)
+	orgs := toolsets.NewToolset("orgs", "GitHub Organization members and teams").
+		AddReadTools(
+			newReadServerTool(ListOrgMembers(getClient, t)),
+			newReadServerTool(GetTeam(getClient, t)),
+			newReadServerTool(ListTeamMembers(getClient, t)),
+			newReadServerTool(ListTeamsForUser(getGraphQLClient, t)),
+		).
+		AddWriteTools(
+			newServerTool(AddTeamMember(getClient, t)),
+			newServerTool(RemoveTeamMember(getClient, t)),
+		).
+		RequireScopes([]string{"read:org"}, []string{"admin:org"})
+	activity := toolsets.NewToolset("activity", "Stars, watched repositories and pinned items of the authenticated user").
+		AddReadTools(
+			newReadServerTool(ListStarred(getClient, t)),
+			newReadServerTool(ListPinnedItems(getGraphQLClient, t)),
+		).
+		AddWriteTools(
+			newServerTool(StarRepository(getClient, t)),
+			newServerTool(UnstarRepository(getClient, t)),
+			newServerTool(WatchRepository(getClient, t)),
+			newServerTool(UnwatchRepository(getClient, t)),
+		).
+		RequireScopes(nil, repoScopes)
 	pullRequests := toolsets.NewToolset("pull_requests", "GitHub Pull Request related tools").
 		AddReadTools(
-			toolsets.NewServerTool(GetPullRequest(getClient, t)),
-			toolsets.NewServerTool(ListPullRequests(getClient, t)),
-			toolsets.NewServerTool(GetPullRequestFiles(getClient, t)),
-			toolsets.NewServerTool(GetPullRequestStatus(getClient, t)),
-			toolsets.NewServerTool(GetPullRequestComments(getClient, t)),
-			toolsets.NewServerTool(GetPullRequestReviews(getClient, t)),
+			newReadServerTool(GetPullRequest(getClient, t)),
+			newReadServerTool(ListPullRequests(getClient, t)),
+			newReadServerTool(GetPullRequestFiles(getClient, t)),
+			newReadServerTool(GetPullRequestDiff(getClient, t)),
+			newReadServerTool(GetPullRequestStatus(getClient, t)),
+			newReadServerTool(GetPullRequestComments(getClient, t)),
+			newReadServerTool(GetPullRequestReviews(getClient, t)),
+			newReadServerTool(GetMergeQueueEntryTool(getGraphQLClient, t)),
 		).
 		AddWriteTools(
-			toolsets.NewServerTool(MergePullRequest(getClient, t)),
-			toolsets.NewServerTool(UpdatePullRequestBranch(getClient, t)),
-			toolsets.NewServerTool(CreatePullRequestReview(getClient, t)),
-			toolsets.NewServerTool(CreatePullRequest(getClient, t)),
-			toolsets.NewServerTool(UpdatePullRequest(getClient, t)),
-			toolsets.NewServerTool(AddPullRequestReviewComment(getClient, t)),
-		)
+			newServerTool(MergePullRequest(getClient, t)),
+			newServerTool(UpdatePullRequestBranch(getClient, t)),
+			newServerTool(CreatePullRequestReview(getClient, t)),
+			newServerTool(CreatePullRequest(getClient, t)),
+			newServerTool(UpdatePullRequest(getClient, t)),
+			newServerTool(AddPullRequestReviewComment(getClient, t)),
+			newServerTool(CreatePendingPullRequestReview(getClient, t)),
+			newServerTool(AddPendingPullRequestReviewComment(getClient, getGraphQLClient, t)),
+			newServerTool(SubmitPendingPullRequestReview(getClient, t)),
+			newServerTool(DeletePendingPullRequestReview(getClient, t)),
+			newServerTool(RequestReviewers(getClient, t)),
+			newServerTool(RemoveRequestedReviewers(getClient, t)),
+			newServerTool(EnqueuePullRequestTool(getGraphQLClient, t)),
+			newServerTool(DequeuePullRequestTool(getGraphQLClient, t)),
+			newServerTool(ConvertPullRequestToDraftTool(getGraphQLClient, t)),
+			newServerTool(MarkPullRequestReadyForReviewTool(getGraphQLClient, t)),
+			newServerTool(EnablePullRequestAutoMergeTool(getGraphQLClient, t)),
+			newServerTool(DisablePullRequestAutoMergeTool(getGraphQLClient, t)),
+		).
+		RequireScopes(repoScopes, repoScopes)
 	codeSecurity := toolsets.NewToolset("code_security", "Code security related tools, such as GitHub Code Scanning").
 		AddReadTools(
-			toolsets.NewServerTool(GetCodeScanningAlert(getClient, t)),
-			toolsets.NewServerTool(ListCodeScanningAlerts(getClient, t)),
-		)
+			newReadServerTool(GetCodeScanningAlert(getClient, t)),
+			newReadServerTool(ListCodeScanningAlerts(getClient, t)),
+		).
+		AddWriteTools(
+			newServerTool(UpdateCodeScanningAlert(getClient, t)),
+		).
+		RequireScopes(securityScopes, securityScopes)
 	secretProtection := toolsets.NewToolset("secret_protection", "Secret protection related tools, such as GitHub Secret Scanning").
 		AddReadTools(
-			toolsets.NewServerTool(GetSecretScanningAlert(getClient, t)),
-			toolsets.NewServerTool(ListSecretScanningAlerts(getClient, t)),
+			newReadServerTool(GetSecretScanningAlert(getClient, t)),
+			newReadServerTool(ListSecretScanningAlerts(getClient, t)),
+		).
+		RequireScopes(securityScopes, securityScopes)
+	dependabot := toolsets.NewToolset("dependabot", "Dependabot alerts, the dependency graph and SBOM export").
+		AddReadTools(
+			newReadServerTool(ListDependabotAlerts(getClient, t)),
+			newReadServerTool(GetDependabotAlert(getClient, t)),
+			newReadServerTool(GetDependencyGraph(getClient, getGraphQLClient, t)),
+			newReadServerTool(ExportSBOM(getClient, t)),
+		).
+		AddWriteTools(
+			newServerTool(UpdateDependabotAlert(getClient, t)),
+		).
+		RequireScopes(securityScopes, securityScopes)
+	securityAdvisories := toolsets.NewToolset("security_advisories", "Security advisories published or drafted by repositories").
+		AddReadTools(
+			newReadServerTool(ListRepositorySecurityAdvisories(getClient, t)),
 		)
+	actions := toolsets.NewToolset("actions", "GitHub Actions workflows, runs, secrets, variables and deployments").
+		AddReadTools(
+			newReadServerTool(ListWorkflows(getClient, t)),
+			newReadServerTool(ListWorkflowRuns(getClient, t)),
+			newReadServerTool(GetWorkflowRun(getClient, t)),
+			newReadServerTool(GetJobLogs(getClient, t)),
+			newReadServerTool(ListWorkflowArtifacts(getClient, t)),
+			newReadServerTool(DownloadWorkflowArtifact(getClient, t)),
+			newReadServerTool(ListActionsCaches(getClient, t)),
+			newReadServerTool(GetActionsUsage(getClient, t)),
+			newReadServerTool(ListActionsSecrets(getClient, t)),
+			newReadServerTool(ListActionsVariables(getClient, t)),
+			newReadServerTool(ListDeployments(getClient, t)),
+			newReadServerTool(ListEnvironments(getClient, t)),
+		).
+		AddWriteTools(
+			newServerTool(RunWorkflow(getClient, t)),
+			newServerTool(CancelWorkflowRun(getClient, t)),
+			newServerTool(RerunWorkflowRun(getClient, t)),
+			newServerTool(DeleteActionsCache(getClient, t)),
+			newServerTool(SetActionsSecret(getClient, t)),
+			newServerTool(DeleteActionsSecret(getClient, t)),
+			newServerTool(SetActionsVariable(getClient, t)),
+			newServerTool(DeleteActionsVariable(getClient, t)),
+			newServerTool(CreateDeployment(getClient, t)),
+			newServerTool(SetDeploymentStatus(getClient, t)),
+			newServerTool(ApprovePendingDeployment(getClient, t)),
+		).
+		RequireScopes(repoScopes, repoScopes)
 	projects := toolsets.NewToolset("projects", "GitHub Projects (V2): project creation, item addition, field updates").
 		AddReadTools(
-			toolsets.NewServerTool(ListOrganizationProjectsTool(getGraphQLClient, t)),
-			toolsets.NewServerTool(ListUserProjectsTool(getGraphQLClient, t)),
-			toolsets.NewServerTool(GetProjectTool(getGraphQLClient, t)),
-			toolsets.NewServerTool(GetProjectItemsTool(getGraphQLClient, t)),
+			newReadServerTool(ListOrganizationProjectsTool(getGraphQLClient, t)),
+			newReadServerTool(ListUserProjectsTool(getGraphQLClient, t)),
+			newReadServerTool(ListRepositoryProjectsTool(getGraphQLClient, t)),
+			newReadServerTool(ListTeamProjectsTool(getGraphQLClient, t)),
+			newReadServerTool(ListTemplateProjectsTool(getGraphQLClient, t)),
+			newReadServerTool(ListProjectCollaboratorsTool(getGraphQLClient, t)),
+			newReadServerTool(GetProjectTool(getGraphQLClient, t)),
+			newReadServerTool(GetProjectByURLTool(getGraphQLClient, t)),
+			newReadServerTool(GetProjectWithItemsTool(getGraphQLClient, t)),
+			newReadServerTool(GetProjectItemsTool(getGraphQLClient, t)),
+			newReadServerTool(GetAllProjectItemsTool(getGraphQLClient, t)),
+			newReadServerTool(GetProjectSummaryTool(getGraphQLClient, t)),
+			newReadServerTool(GetProjectActivityTool(getGraphQLClient, t)),
+			newReadServerTool(ListProjectFieldsTool(getGraphQLClient, t)),
+			newReadServerTool(ListProjectViewsTool(getGraphQLClient, t)),
+			newReadServerTool(GetProjectViewTool(getGraphQLClient, t)),
+			newReadServerTool(ListProjectStatusUpdatesTool(getGraphQLClient, t)),
 		).
 		AddWriteTools(
-			toolsets.NewServerTool(CreateProjectTool(getGraphQLClient, t)),
-			toolsets.NewServerTool(AddProjectItemTool(getGraphQLClient, t)),
-			toolsets.NewServerTool(UpdateProjectItemFieldTool(getGraphQLClient, t)),
-		)
+			newServerTool(CreateProjectTool(getGraphQLClient, t)),
+			newServerTool(CopyProjectTool(getGraphQLClient, t)),
+			newServerTool(UpdateProjectTool(getGraphQLClient, t)),
+			newServerTool(UpdateProjectReadmeTool(getGraphQLClient, t)),
+			newServerTool(DeleteProjectTool(getGraphQLClient, t)),
+			newServerTool(LinkProjectToRepositoryTool(getGraphQLClient, t)),
+			newServerTool(UnlinkProjectFromRepositoryTool(getGraphQLClient, t)),
+			newServerTool(LinkProjectToTeamTool(getGraphQLClient, t)),
+			newServerTool(UnlinkProjectFromTeamTool(getGraphQLClient, t)),
+			newServerTool(AddProjectCollaboratorsTool(getGraphQLClient, t)),
+			newServerTool(RemoveProjectCollaboratorsTool(getGraphQLClient, t)),
+			newServerTool(CreateProjectFieldTool(getGraphQLClient, t)),
+			newServerTool(UpdateProjectFieldTool(getGraphQLClient, t)),
+			newServerTool(DeleteProjectFieldTool(getGraphQLClient, t)),
+			newServerTool(CreateProjectStatusUpdateTool(getGraphQLClient, t)),
+			newServerTool(DeleteProjectStatusUpdateTool(getGraphQLClient, t)),
+			newServerTool(AddProjectItemTool(getGraphQLClient, t)),
+			newServerTool(AddProjectDraftIssueTool(getGraphQLClient, t)),
+			newServerTool(UpdateProjectItemFieldTool(getGraphQLClient, t)),
+			newServerTool(ClearProjectItemFieldTool(getGraphQLClient, t)),
+			newServerTool(DeleteProjectItemTool(getGraphQLClient, t)),
+			newServerTool(MoveItemBetweenProjectsTool(getGraphQLClient, t)),
+			newServerTool(ReorderProjectItemTool(getGraphQLClient, t)),
+			newServerTool(SetProjectTemplateTool(getGraphQLClient, t)),
+			newServerTool(BulkAddProjectItemsTool(getGraphQLClient, t)),
+			newServerTool(UpdateProjectItemFieldBulkTool(getGraphQLClient, t)),
+			newServerTool(UpdateDraftIssueTool(getGraphQLClient, t)),
+		).
+		RequireScopes([]string{"read:project"}, []string{"project"})
 	// Keep experiments alive so the system doesn't error out when it's always enabled
 	experiments := toolsets.NewToolset("experiments", "Experimental features that are not considered stable yet")
 
@@ -101,9 +279,14 @@ func InitToolsets(passedToolsets []string, readOnly bool, getClient GetClientFn,
 	tsg.AddToolset(repos)
 	tsg.AddToolset(issues)
 	tsg.AddToolset(users)
+	tsg.AddToolset(orgs)
+	tsg.AddToolset(activity)
 	tsg.AddToolset(pullRequests)
 	tsg.AddToolset(codeSecurity)
 	tsg.AddToolset(secretProtection)
+	tsg.AddToolset(dependabot)
+	tsg.AddToolset(securityAdvisories)
+	tsg.AddToolset(actions)
 	tsg.AddToolset(projects)
 	tsg.AddToolset(experiments)
 	// Enable the requested features
@@ -115,11 +298,13 @@ func InitToolsets(passedToolsets []string, readOnly bool, getClient GetClientFn,
 	return tsg, nil
 }
 
-func InitContextToolset(getClient GetClientFn, t translations.TranslationHelperFunc) *toolsets.Toolset {
+func InitContextToolset(getClient GetClientFn, tracker *RateLimitTracker, tsg *toolsets.ToolsetGroup, t translations.TranslationHelperFunc) *toolsets.Toolset {
 	// Create a new context toolset
 	contextTools := toolsets.NewToolset("context", "Tools that provide context about the current user and GitHub context you are operating in").
 		AddReadTools(
-			toolsets.NewServerTool(GetMe(getClient, t)),
+			newReadServerTool(GetMe(getClient, t)),
+			newReadServerTool(CheckTokenPermissions(getClient, tsg, t)),
+			newReadServerTool(GetRateLimitTool(getClient, tracker, t)),
 		)
 	contextTools.Enabled = true
 	return contextTools
@@ -131,9 +316,9 @@ func InitDynamicToolset(s *server.MCPServer, tsg *toolsets.ToolsetGroup, t trans
 	// Need to add the dynamic toolset last so it can be used to enable other toolsets
 	dynamicToolSelection := toolsets.NewToolset("dynamic", "Discover GitHub MCP tools that can help achieve tasks by enabling additional sets of tools, you can control the enablement of any toolset to access its tools when this toolset is enabled.").
 		AddReadTools(
-			toolsets.NewServerTool(ListAvailableToolsets(tsg, t)),
-			toolsets.NewServerTool(GetToolsetsTools(tsg, t)),
-			toolsets.NewServerTool(EnableToolset(s, tsg, t)),
+			newReadServerTool(ListAvailableToolsets(tsg, t)),
+			newReadServerTool(GetToolsetsTools(tsg, t)),
+			newServerTool(EnableToolset(s, tsg, t)),
 		)
 	dynamicToolSelection.Enabled = true
 	return dynamicToolSelection
@@ -0,0 +1,144 @@
+package github
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	ghv4 "github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func stubGetGraphQLClientFn(client *ghv4.Client) GetGraphQLClientFn {
+	return func(_ context.Context) (*ghv4.Client, error) {
+		return client, nil
+	}
+}
+
+func activeToolNames(t *testing.T, readOnly bool, toolset string) []string {
+	t.Helper()
+	tsg, err := InitToolsets(
+		[]string{toolset},
+		readOnly,
+		stubGetClientFn(github.NewClient(nil)),
+		stubGetGraphQLClientFn(ghv4.NewClient(nil)),
+		translations.NullTranslationHelper,
+	)
+	require.NoError(t, err)
+
+	ts, ok := tsg.Toolsets[toolset]
+	require.True(t, ok)
+
+	var names []string
+	for _, tool := range ts.GetActiveTools() {
+		names = append(names, tool.Tool.Name)
+	}
+	return names
+}
+
+func Test_InitToolsets_ReadOnly(t *testing.T) {
+	// Tools whose names start with these verbs change something on GitHub and
+	// must be added with AddWriteTools so --read-only leaves them out.
+	mutatingPrefixes := []string{
+		"add_", "approve_", "assign_", "bulk_", "cancel_", "clear_", "close_",
+		"convert_", "copy_", "create_", "delete_", "dequeue_", "disable_",
+		"enable_", "enqueue_", "fork_", "link_", "mark_", "merge_", "move_",
+		"pin_", "push_", "remove_", "reorder_", "reprioritize_", "request_",
+		"rerun_", "run_", "set_", "star_", "submit_", "unlink_", "unpin_",
+		"unstar_", "unwatch_", "update_", "upload_", "watch_",
+	}
+	isMutating := func(name string) bool {
+		for _, prefix := range mutatingPrefixes {
+			if strings.HasPrefix(name, prefix) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, readOnly := range []bool{true, false} {
+		tsg, err := InitToolsets(
+			[]string{"all"},
+			readOnly,
+			stubGetClientFn(github.NewClient(nil)),
+			stubGetGraphQLClientFn(ghv4.NewClient(nil)),
+			translations.NullTranslationHelper,
+		)
+		require.NoError(t, err)
+
+		mutating := 0
+		for _, ts := range tsg.Toolsets {
+			for _, tool := range ts.GetActiveTools() {
+				if isMutating(tool.Tool.Name) {
+					mutating++
+					assert.False(t, readOnly, "%s is registered in read-only mode", tool.Tool.Name)
+				}
+			}
+		}
+		if !readOnly {
+			assert.NotZero(t, mutating)
+		}
+	}
+}
+
+func Test_InitToolsets_ProjectsReadOnly(t *testing.T) {
+	mutating := []string{
+		"create_project",
+		"add_project_item",
+		"update_project_item_field",
+		"clear_project_item_field",
+		"move_item_between_projects",
+		"reorder_project_item",
+		"set_project_template",
+		"update_project_item_field_bulk",
+		"update_draft_issue",
+		"delete_project_item",
+		"add_project_draft_issue",
+		"update_project",
+		"update_project_readme",
+		"delete_project",
+		"create_project_status_update",
+		"delete_project_status_update",
+		"create_project_field",
+		"update_project_field",
+		"delete_project_field",
+		"bulk_add_project_items",
+		"link_project_to_repository",
+		"unlink_project_from_repository",
+		"link_project_to_team",
+		"unlink_project_from_team",
+		"add_project_collaborators",
+		"remove_project_collaborators",
+		"copy_project",
+	}
+
+	names := activeToolNames(t, true, "projects")
+	require.NotEmpty(t, names)
+	for _, name := range mutating {
+		assert.NotContains(t, names, name)
+	}
+	assert.Contains(t, names, "list_organization_projects")
+	assert.Contains(t, names, "list_user_projects")
+	assert.Contains(t, names, "list_repository_projects")
+	assert.Contains(t, names, "list_team_projects")
+	assert.Contains(t, names, "list_template_projects")
+	assert.Contains(t, names, "list_project_collaborators")
+	assert.Contains(t, names, "get_project")
+	assert.Contains(t, names, "get_project_items")
+	assert.Contains(t, names, "get_all_project_items")
+	assert.Contains(t, names, "get_project_summary")
+	assert.Contains(t, names, "get_project_activity")
+	assert.Contains(t, names, "list_project_fields")
+	assert.Contains(t, names, "get_project_with_items")
+	assert.Contains(t, names, "list_project_views")
+	assert.Contains(t, names, "get_project_view")
+	assert.Contains(t, names, "list_project_status_updates")
+
+	names = activeToolNames(t, false, "projects")
+	for _, name := range mutating {
+		assert.Contains(t, names, name)
+	}
+}
@@ -0,0 +1,124 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName identifies the spans of this package. They go to the global
+// tracer provider, which drops them until one is installed with
+// otel.SetTracerProvider.
+const tracerName = "github.com/github/github-mcp-server/pkg/github"
+
+// requestIDMetaKey is the key of the request's _meta under which
+// TraceRequestID leaves the JSON-RPC ID of a tools/call request for
+// withCallTracing.
+const requestIDMetaKey = "github-mcp-server/request-id"
+
+// tracedRequestID is the type of the value stored under requestIDMetaKey.
+// JSON decoding cannot produce it, so a client sending the same key in _meta
+// cannot pass its own ID off as the request's.
+type tracedRequestID string
+
+// TraceRequestID is an OnBeforeCallTool hook that records the JSON-RPC ID of
+// the request in its _meta, so that the span of the tool call can carry it.
+// mcp-go passes the ID to hooks but not to tool handlers, whose context the
+// hooks cannot change.
+func TraceRequestID(_ context.Context, id any, message *mcp.CallToolRequest) {
+	if id == nil {
+		return
+	}
+	if message.Params.Meta == nil {
+		message.Params.Meta = &mcp.Meta{}
+	}
+	if message.Params.Meta.AdditionalFields == nil {
+		message.Params.Meta.AdditionalFields = make(map[string]any)
+	}
+	message.Params.Meta.AdditionalFields[requestIDMetaKey] = tracedRequestID(fmt.Sprint(id))
+}
+
+// requestID returns the JSON-RPC ID TraceRequestID recorded for request.
+func requestID(request mcp.CallToolRequest) (string, bool) {
+	if request.Params.Meta == nil {
+		return "", false
+	}
+	id, ok := request.Params.Meta.AdditionalFields[requestIDMetaKey].(tracedRequestID)
+	return string(id), ok
+}
+
+// withCallTracing wraps the handler of the tool name in a span, which the
+// spans of the GitHub requests the call makes are children of. Failed calls
+// set the span's status and record their ToolError code as error.type.
+func withCallTracing(name string, handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		attrs := []attribute.KeyValue{
+			attribute.String("mcp.method.name", string(mcp.MethodToolsCall)),
+			attribute.String("gen_ai.tool.name", name),
+		}
+		if id, ok := requestID(request); ok {
+			attrs = append(attrs, attribute.String("mcp.request.id", id))
+		}
+		ctx, span := otel.Tracer(tracerName).Start(ctx, string(mcp.MethodToolsCall)+" "+name,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(attrs...),
+		)
+		defer span.End()
+
+		result, err := handler(ctx, request)
+		switch {
+		case err != nil:
+			span.SetAttributes(attribute.String("error.type", ErrorCodeInternal))
+			span.SetStatus(codes.Error, err.Error())
+		case result != nil && result.IsError:
+			code := resultErrorCode(result)
+			span.SetAttributes(attribute.String("error.type", code))
+			span.SetStatus(codes.Error, code)
+		}
+		return result, err
+	}
+}
+
+// TracingTransport records a client span for every GitHub request, as a
+// child of the span in the request's context. Placed below a
+// CachingTransport, it sees only the requests that reach GitHub.
+type TracingTransport struct {
+	Base http.RoundTripper
+}
+
+func (t *TracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := otel.Tracer(tracerName).Start(req.Context(), req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", req.Method),
+			attribute.String("server.address", req.URL.Hostname()),
+			attribute.String("url.path", req.URL.Path),
+		),
+	)
+	defer span.End()
+
+	resp, err := t.Base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	if id := resp.Header.Get("X-GitHub-Request-Id"); id != "" {
+		span.SetAttributes(attribute.String("github.request.id", id))
+	}
+	if remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining")); err == nil {
+		span.SetAttributes(attribute.Int("github.rate_limit.remaining", remaining))
+	}
+	if resp.StatusCode >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, resp.Status)
+	}
+	return resp, nil
+}
@@ -0,0 +1,108 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+func Test_withCallTracing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-GitHub-Request-Id", "ABCD:1234")
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: &TracingTransport{Base: http.DefaultTransport}}
+
+	var arguments map[string]interface{}
+	tool := newServerTool(mcp.NewTool("get_thing"), func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		arguments = request.GetArguments()
+		path, _ := request.GetArguments()["path"].(string)
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+path, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		if resp.StatusCode == http.StatusNotFound {
+			return ToolError{Code: ErrorCodeNotFound, Message: "not found"}.Result(), nil
+		}
+		return mcp.NewToolResultText("ok"), nil
+	})
+	recorder := recordSpans(t)
+
+	request := createMCPRequest(map[string]interface{}{"path": "/repos/owner/repo"})
+	TraceRequestID(context.Background(), 7, &request)
+	_, err := tool.Handler(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"path": "/repos/owner/repo"}, arguments)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	get, call := spans[0], spans[1]
+	assert.Equal(t, "tools/call get_thing", call.Name())
+	assert.Equal(t, trace.SpanKindServer, call.SpanKind())
+	assert.Equal(t, codes.Unset, call.Status().Code)
+	attrs := spanAttributes(call)
+	assert.Equal(t, "get_thing", attrs["gen_ai.tool.name"].AsString())
+	assert.Equal(t, "7", attrs["mcp.request.id"].AsString())
+
+	assert.Equal(t, "GET", get.Name())
+	assert.Equal(t, trace.SpanKindClient, get.SpanKind())
+	assert.Equal(t, call.SpanContext().SpanID(), get.Parent().SpanID())
+	attrs = spanAttributes(get)
+	assert.Equal(t, "/repos/owner/repo", attrs["url.path"].AsString())
+	assert.Equal(t, int64(200), attrs["http.response.status_code"].AsInt64())
+	assert.Equal(t, "ABCD:1234", attrs["github.request.id"].AsString())
+	assert.Equal(t, int64(4999), attrs["github.rate_limit.remaining"].AsInt64())
+
+	t.Run("client-sent request ID", func(t *testing.T) {
+		request := createMCPRequest(map[string]interface{}{"path": "/repos/owner/repo"})
+		request.Params.Meta = &mcp.Meta{AdditionalFields: map[string]any{requestIDMetaKey: "spoofed"}}
+		_, err := tool.Handler(context.Background(), request)
+		require.NoError(t, err)
+		spans := recorder.Ended()
+		assert.NotContains(t, spanAttributes(spans[len(spans)-1]), attribute.Key("mcp.request.id"))
+	})
+
+	t.Run("failed call", func(t *testing.T) {
+		_, err := tool.Handler(context.Background(), createMCPRequest(map[string]interface{}{"path": "/missing"}))
+		require.NoError(t, err)
+		spans := recorder.Ended()
+		call := spans[len(spans)-1]
+		assert.Equal(t, codes.Error, call.Status().Code)
+		assert.Equal(t, ErrorCodeNotFound, spanAttributes(call)["error.type"].AsString())
+		assert.NotContains(t, spanAttributes(call), attribute.Key("mcp.request.id"))
+	})
+}
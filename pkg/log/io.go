@@ -2,8 +2,7 @@ package log
 
 import (
 	"io"
-
-	log "github.com/sirupsen/logrus"
+	"log/slog"
 )
 
 // IOLogger is a wrapper around io.Reader and io.Writer that can be used
@@ -11,11 +10,11 @@ import (
 type IOLogger struct {
 	reader io.Reader
 	writer io.Writer
-	logger *log.Logger
+	logger *slog.Logger
 }
 
 // NewIOLogger creates a new IOLogger instance
-func NewIOLogger(r io.Reader, w io.Writer, logger *log.Logger) *IOLogger {
+func NewIOLogger(r io.Reader, w io.Writer, logger *slog.Logger) *IOLogger {
 	return &IOLogger{
 		reader: r,
 		writer: w,
@@ -30,7 +29,7 @@ func (l *IOLogger) Read(p []byte) (n int, err error) {
 	}
 	n, err = l.reader.Read(p)
 	if n > 0 {
-		l.logger.Infof("[stdin]: received %d bytes: %s", n, string(p[:n]))
+		l.logger.Info("[stdin]: received", "bytes", n, "data", string(p[:n]))
 	}
 	return n, err
 }
@@ -40,6 +39,6 @@ func (l *IOLogger) Write(p []byte) (n int, err error) {
 	if l.writer == nil {
 		return 0, io.ErrClosedPipe
 	}
-	l.logger.Infof("[stdout]: sending %d bytes: %s", len(p), string(p))
+	l.logger.Info("[stdout]: sending", "bytes", len(p), "data", string(p))
 	return l.writer.Write(p)
 }
@@ -2,6 +2,7 @@ package toolsets
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -18,6 +19,10 @@ type Toolset struct {
 	readOnly    bool
 	writeTools  []server.ServerTool
 	readTools   []server.ServerTool
+	// readScopes and writeScopes are the classic token scopes the read and
+	// write tools need; any one of them is enough.
+	readScopes  []string
+	writeScopes []string
 }
 
 func (t *Toolset) GetActiveTools() []server.ServerTool {
@@ -69,6 +74,63 @@ func (t *Toolset) AddReadTools(tools ...server.ServerTool) *Toolset {
 	return t
 }
 
+// RemoveTools drops the named tools from the toolset, for tools the GitHub
+// instance the server talks to does not support.
+func (t *Toolset) RemoveTools(names ...string) {
+	remove := func(tools []server.ServerTool) []server.ServerTool {
+		kept := tools[:0]
+		for _, tool := range tools {
+			if !slices.Contains(names, tool.Tool.Name) {
+				kept = append(kept, tool)
+			}
+		}
+		return kept
+	}
+	t.readTools = remove(t.readTools)
+	t.writeTools = remove(t.writeTools)
+}
+
+// WrapTools replaces the handlers of the named tools with what wrap returns
+// for them, for behaviour that depends on the server's configuration.
+func (t *Toolset) WrapTools(wrap func(tool mcp.Tool, handler server.ToolHandlerFunc) server.ToolHandlerFunc, names ...string) {
+	for _, tools := range [][]server.ServerTool{t.readTools, t.writeTools} {
+		for i, tool := range tools {
+			if slices.Contains(names, tool.Tool.Name) {
+				tools[i].Handler = wrap(tool.Tool, tool.Handler)
+			}
+		}
+	}
+}
+
+// RequireScopes records the classic token scopes the toolset's read and write
+// tools need, any one of each list being enough. A nil list means no scope is
+// needed.
+func (t *Toolset) RequireScopes(read, write []string) *Toolset {
+	t.readScopes = read
+	t.writeScopes = write
+	return t
+}
+
+// ActiveToolScopes maps the name of each active tool that needs a scope to the
+// scopes, any one of which is enough, that it needs.
+func (t *Toolset) ActiveToolScopes() map[string][]string {
+	scopes := make(map[string][]string)
+	if !t.Enabled {
+		return scopes
+	}
+	if len(t.readScopes) > 0 {
+		for _, tool := range t.readTools {
+			scopes[tool.Tool.Name] = t.readScopes
+		}
+	}
+	if !t.readOnly && len(t.writeScopes) > 0 {
+		for _, tool := range t.writeTools {
+			scopes[tool.Tool.Name] = t.writeScopes
+		}
+	}
+	return scopes
+}
+
 type ToolsetGroup struct {
 	Toolsets     map[string]*Toolset
 	everythingOn bool
@@ -99,6 +161,12 @@ func NewToolset(name string, description string) *Toolset {
 	}
 }
 
+// RemoveToolset drops a toolset from the group entirely, so it can neither be
+// registered nor enabled later.
+func (tg *ToolsetGroup) RemoveToolset(name string) {
+	delete(tg.Toolsets, name)
+}
+
 func (tg *ToolsetGroup) IsEnabled(name string) bool {
 	// If everythingOn is true, all features are enabled
 	if tg.everythingOn {
@@ -1,7 +1,11 @@
 package toolsets
 
 import (
+	"context"
 	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
 )
 
 func TestNewToolsetGroup(t *testing.T) {
@@ -228,3 +232,81 @@ func TestIsEnabledWithEverythingOn(t *testing.T) {
 		t.Error("Expected IsEnabled to return true for any toolset when everythingOn is true")
 	}
 }
+
+func TestActiveToolScopes(t *testing.T) {
+	toolset := NewToolset("test-toolset", "A test toolset").
+		AddReadTools(NewServerTool(mcp.NewTool("read_tool"), nil)).
+		AddWriteTools(NewServerTool(mcp.NewTool("write_tool"), nil)).
+		RequireScopes([]string{"read:org"}, []string{"admin:org"})
+
+	if scopes := toolset.ActiveToolScopes(); len(scopes) != 0 {
+		t.Errorf("Expected no scopes for a disabled toolset, got %v", scopes)
+	}
+
+	toolset.Enabled = true
+	scopes := toolset.ActiveToolScopes()
+	if len(scopes) != 2 || scopes["read_tool"][0] != "read:org" || scopes["write_tool"][0] != "admin:org" {
+		t.Errorf("Unexpected scopes %v", scopes)
+	}
+
+	toolset.SetReadOnly()
+	scopes = toolset.ActiveToolScopes()
+	if _, ok := scopes["write_tool"]; ok || len(scopes) != 1 {
+		t.Errorf("Expected only read tool scopes in read-only mode, got %v", scopes)
+	}
+}
+
+func TestRemoveTools(t *testing.T) {
+	tsg := NewToolsetGroup(false)
+	toolset := NewToolset("test-toolset", "A test toolset").
+		AddReadTools(
+			NewServerTool(mcp.NewTool("read_kept"), nil),
+			NewServerTool(mcp.NewTool("read_removed"), nil),
+		).
+		AddWriteTools(NewServerTool(mcp.NewTool("write_removed"), nil))
+	tsg.AddToolset(toolset)
+	tsg.AddToolset(NewToolset("removed-toolset", "Another test toolset"))
+
+	toolset.RemoveTools("read_removed", "write_removed")
+	tools := toolset.GetAvailableTools()
+	if len(tools) != 1 || tools[0].Tool.Name != "read_kept" {
+		t.Errorf("Expected only read_kept to remain, got %v", tools)
+	}
+
+	tsg.RemoveToolset("removed-toolset")
+	if _, exists := tsg.Toolsets["removed-toolset"]; exists {
+		t.Error("Expected removed-toolset to be removed")
+	}
+	if err := tsg.EnableToolset("removed-toolset"); err == nil {
+		t.Error("Expected enabling a removed toolset to fail")
+	}
+}
+
+func TestWrapTools(t *testing.T) {
+	handler := func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText("original"), nil
+	}
+	toolset := NewToolset("test-toolset", "A test toolset").
+		AddReadTools(NewServerTool(mcp.NewTool("read"), handler)).
+		AddWriteTools(NewServerTool(mcp.NewTool("write"), handler))
+
+	toolset.WrapTools(func(tool mcp.Tool, _ server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return mcp.NewToolResultText("wrapped " + tool.Name), nil
+		}
+	}, "write")
+
+	for _, tool := range toolset.GetAvailableTools() {
+		result, err := tool.Handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := "original"
+		if tool.Tool.Name == "write" {
+			expected = "wrapped write"
+		}
+		if text := result.Content[0].(mcp.TextContent).Text; text != expected {
+			t.Errorf("Expected %s to return %q, got %q", tool.Tool.Name, expected, text)
+		}
+	}
+}